@@ -0,0 +1,186 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// tables are the codes of allowed-value sets and those deprecated, by set name
+type tables struct {
+	version    string
+	values     map[string][]string
+	deprecated map[string][]string
+}
+
+// names returns the set names in alphabetical order
+func (t *tables) names() []string {
+	names := make([]string, 0, len(t.values))
+	for name := range t.values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// only returns the tables of the named sets; it is an error if one isn't in t
+func (t *tables) only(names []string) (*tables, error) {
+	selected := &tables{version: t.version, values: make(map[string][]string), deprecated: make(map[string][]string)}
+	var missing []string
+	for _, name := range names {
+		values, ok := t.values[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		selected.values[name] = values
+		if deprecated := t.deprecated[name]; len(deprecated) > 0 {
+			selected.deprecated[name] = deprecated
+		}
+	}
+	if len(missing) > 0 {
+		return selected, fmt.Errorf("AVS %s has no set %s", t.version, strings.Join(missing, ", "))
+	}
+	return selected, nil
+}
+
+// avsSchema is the part of an AVS schema avsgen reads: the enumerations of its simple
+// types and their documentation
+type avsSchema struct {
+	Version     string `xml:"version,attr"`
+	SimpleTypes []struct {
+		Name         string `xml:"name,attr"`
+		Enumerations []struct {
+			Value         string `xml:"value,attr"`
+			Documentation string `xml:"annotation>documentation"`
+		} `xml:"restriction>enumeration"`
+	} `xml:"simpleType"`
+}
+
+// readSchema reads the allowed-value sets of an AVS schema file or URL. The version is
+// the version attribute of the schema, or its file name without extension.
+func readSchema(location string) (*tables, error) {
+	data, err := read(location)
+	if err != nil {
+		return nil, err
+	}
+	var schema avsSchema
+	if err := xml.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", location, err)
+	}
+
+	t := &tables{version: schema.Version, values: make(map[string][]string), deprecated: make(map[string][]string)}
+	if t.version == "" {
+		t.version = strings.TrimSuffix(path.Base(location), ".xsd")
+	}
+	for _, simpleType := range schema.SimpleTypes {
+		for _, enumeration := range simpleType.Enumerations {
+			t.values[simpleType.Name] = append(t.values[simpleType.Name], enumeration.Value)
+			if strings.Contains(strings.ToLower(enumeration.Documentation), "deprecated") {
+				t.deprecated[simpleType.Name] = append(t.deprecated[simpleType.Name], enumeration.Value)
+			}
+		}
+	}
+	if len(t.values) == 0 {
+		return nil, fmt.Errorf("%s has no allowed-value sets", location)
+	}
+	return t, nil
+}
+
+// read returns the content of a file or http(s) URL
+func read(location string) ([]byte, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		return os.ReadFile(location)
+	}
+	client := &http.Client{Timeout: time.Minute}
+	resp, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: %s", location, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// writeReport prints the sets and codes added (+), removed (-) and newly deprecated or no
+// longer deprecated (~) from previous to current
+func writeReport(w io.Writer, previous, current *tables) {
+	if previous == nil {
+		fmt.Fprintf(w, "AVS %s: %d sets\n", current.version, len(current.values))
+		return
+	}
+	fmt.Fprintf(w, "AVS %s -> %s\n", previous.version, current.version)
+
+	changes := 0
+	for _, name := range union(previous.names(), current.names()) {
+		before, after := previous.values[name], current.values[name]
+		switch {
+		case before == nil:
+			fmt.Fprintf(w, "+ %s (%d codes)\n", name, len(after))
+			changes++
+			continue
+		case after == nil:
+			fmt.Fprintf(w, "- %s\n", name)
+			changes++
+			continue
+		}
+
+		wasDeprecated, isDeprecated := set(previous.deprecated[name]), set(current.deprecated[name])
+		for _, code := range difference(after, before) {
+			fmt.Fprintf(w, "+ %s: %s\n", name, code)
+			changes++
+		}
+		for _, code := range difference(before, after) {
+			fmt.Fprintf(w, "- %s: %s\n", name, code)
+			changes++
+		}
+		for _, code := range after {
+			switch {
+			case isDeprecated[code] && !wasDeprecated[code]:
+				fmt.Fprintf(w, "~ %s: %s is deprecated\n", name, code)
+				changes++
+			case wasDeprecated[code] && !isDeprecated[code] && set(before)[code]:
+				fmt.Fprintf(w, "~ %s: %s is no longer deprecated\n", name, code)
+				changes++
+			}
+		}
+	}
+	if changes == 0 {
+		fmt.Fprintln(w, "no changes")
+	}
+}
+
+func set(values []string) map[string]bool {
+	m := make(map[string]bool, len(values))
+	for _, v := range values {
+		m[v] = true
+	}
+	return m
+}
+
+// difference returns the values of a that are not in b, in the order of a
+func difference(a, b []string) []string {
+	inB := set(b)
+	var diff []string
+	for _, v := range a {
+		if !inB[v] {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
+
+// union returns the sorted names in a or b
+func union(a, b []string) []string {
+	names := append(append([]string{}, a...), difference(b, a)...)
+	sort.Strings(names)
+	return names
+}
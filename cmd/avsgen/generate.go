@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+)
+
+// generate writes the Go file holding the tables
+func generate(t *tables, pkg, source string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by avsgen from %s. DO NOT EDIT.\n\n", path.Base(source))
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	buf.WriteString("// AVSVersion is the version of the DDEX allowed-value sets in the tables of\n")
+	buf.WriteString("// AllowedValues and IsDeprecatedValue\n")
+	fmt.Fprintf(&buf, "const AVSVersion = %q\n\n", t.version)
+	buf.WriteString("// allowedValues lists the codes of each allowed-value set, in schema order\n")
+	writeTable(&buf, "allowedValues", t.names(), t.values)
+	buf.WriteString("\n// deprecatedValues lists the codes of allowedValues the AVS documents as deprecated\n")
+	writeTable(&buf, "deprecatedValues", t.names(), t.deprecated)
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+// writeTable writes a map of set names to codes, a code per line so that regenerating
+// gives readable diffs
+func writeTable(buf *bytes.Buffer, name string, names []string, values map[string][]string) {
+	fmt.Fprintf(buf, "var %s = map[string][]string{\n", name)
+	for _, set := range names {
+		if len(values[set]) == 0 {
+			continue
+		}
+		fmt.Fprintf(buf, "\t%q: {\n", set)
+		for _, code := range values[set] {
+			fmt.Fprintf(buf, "\t\t%q,\n", code)
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+}
+
+// readGenerated reads the tables back from a file written by generate
+func readGenerated(filename string) (*tables, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &tables{values: make(map[string][]string), deprecated: make(map[string][]string)}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value, ok := spec.(*ast.ValueSpec)
+			if !ok || len(value.Names) != 1 || len(value.Values) != 1 {
+				continue
+			}
+			switch value.Names[0].Name {
+			case "AVSVersion":
+				if lit, ok := value.Values[0].(*ast.BasicLit); ok {
+					t.version, _ = strconv.Unquote(lit.Value)
+				}
+			case "allowedValues":
+				err = readTable(value.Values[0], t.values)
+			case "deprecatedValues":
+				err = readTable(value.Values[0], t.deprecated)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filename, err)
+			}
+		}
+	}
+	if len(t.values) == 0 {
+		return nil, fmt.Errorf("%s has no allowedValues table", filename)
+	}
+	return t, nil
+}
+
+// readTable reads a map[string][]string literal of string literals into table
+func readTable(expr ast.Expr, table map[string][]string) error {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return fmt.Errorf("table is not a map literal")
+	}
+	for _, elt := range lit.Elts {
+		entry, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return fmt.Errorf("table entry is not a key-value pair")
+		}
+		name, err := stringLiteral(entry.Key)
+		if err != nil {
+			return err
+		}
+		codes, ok := entry.Value.(*ast.CompositeLit)
+		if !ok {
+			return fmt.Errorf("codes of %s are not a slice literal", name)
+		}
+		table[name] = []string{}
+		for _, code := range codes.Elts {
+			value, err := stringLiteral(code)
+			if err != nil {
+				return err
+			}
+			table[name] = append(table[name], value)
+		}
+	}
+	return nil
+}
+
+func stringLiteral(expr ast.Expr) (string, error) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", fmt.Errorf("expected a string literal")
+	}
+	return strconv.Unquote(lit.Value)
+}
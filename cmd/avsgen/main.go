@@ -0,0 +1,107 @@
+// Command avsgen regenerates the DDEX allowed-value-set (AVS) tables of package ddex from
+// the published AVS schema, and reports the codes added, removed and deprecated since the
+// tables were last generated.
+//
+// Usage:
+//
+//	avsgen [-xsd file|URL] [-o file] [-pkg name] [-sets Set,...] [-old file|URL] [-n]
+//
+// avsgen reads the enumerations of the simple types named by -sets, or of the sets already
+// in the -o file, and writes them to -o with the version of the AVS. Codes whose
+// documentation calls them deprecated are listed as such. Before writing, it prints a
+// report comparing the new tables with those in the -o file, or with the AVS schema given
+// by -old:
+//
+//	AVS <previous version> -> <version>
+//	+ <set>: <code added>
+//	- <set>: <code removed>
+//	~ <set>: <code> is deprecated
+//
+// -n prints the report without writing.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// defaultXSD is the current version of the DDEX allowed-value sets
+const defaultXSD = "http://ddex.net/xml/avs/avs.xsd"
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "avsgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("avsgen", flag.ContinueOnError)
+	xsd := fs.String("xsd", defaultXSD, "AVS schema `file or URL` to read")
+	output := fs.String("o", "avs_values.go", "generated `file` to update")
+	pkg := fs.String("pkg", "ddex", "package `name` of the generated file")
+	sets := fs.String("sets", "", "comma-separated `names` of the sets to generate (default: the sets in the -o file)")
+	old := fs.String("old", "", "compare with the AVS schema in `file or URL` instead of the -o file")
+	dryRun := fs.Bool("n", false, "print the report without writing the -o file")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: avsgen [-xsd file|URL] [-o file] [-pkg name] [-sets Set,...] [-old file|URL] [-n]")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var previous *tables
+	if *old != "" {
+		var err error
+		if previous, err = readSchema(*old); err != nil {
+			return err
+		}
+	} else if _, err := os.Stat(*output); err == nil {
+		if previous, err = readGenerated(*output); err != nil {
+			return err
+		}
+	}
+
+	current, err := readSchema(*xsd)
+	if err != nil {
+		return err
+	}
+	var names []string
+	switch {
+	case *sets != "":
+		for _, name := range strings.Split(*sets, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	case previous != nil && *old == "":
+		names = previous.names()
+	default:
+		return errors.New("-sets is required when the -o file doesn't exist yet")
+	}
+	if current, err = current.only(names); err != nil {
+		return err
+	}
+	if previous != nil {
+		previous, _ = previous.only(names)
+	}
+
+	writeReport(stdout, previous, current)
+	if *dryRun {
+		return nil
+	}
+	source, err := generate(current, *pkg, *xsd)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*output, source, 0644)
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+func runBuild(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("build", flag.ContinueOnError)
+	out := fs.String("o", ".", "output `dir`ectory")
+	header := fs.String("header", "", "YAML or JSON `file` with the message and deals sections used for CSV manifests")
+	resources := fs.String("resources", "", "copy the files referenced by each message from `dir` into its resources folder")
+	batch := fs.Bool("batch", false, "write the releases into a batch folder and add its BatchComplete file")
+	checksums := fs.String("checksums", "", "with -batch, add a checksum manifest of the batch using `algorithm` md5 or sha256")
+	specPath := fs.String("spec", "", "prepare and check each message for the recipient described by the TOML, JSON or YAML delivery spec `file`")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ddex build [flags] manifest.yaml|manifest.json|catalog.csv ...")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Writes <dir>/<ICPN>/<ICPN>.xml and a resources/ folder for every release.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	files, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	var algorithm ddex.ChecksumAlgorithm
+	if *checksums != "" {
+		if !*batch {
+			return fmt.Errorf("-checksums needs -batch")
+		}
+		if algorithm, err = ddex.ParseChecksumAlgorithm(*checksums); err != nil {
+			return err
+		}
+	}
+
+	var spec *ddex.DeliverySpec
+	if *specPath != "" {
+		if spec, err = ddex.LoadDeliverySpec(*specPath); err != nil {
+			return err
+		}
+	}
+
+	var builders []*ddex.Builder
+	for _, file := range files {
+		fileBuilders, err := loadBuilders(file, *header)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		builders = append(builders, fileBuilders...)
+	}
+
+	dir := *out
+	batchID := ""
+	if *batch {
+		batchID = ddex.BatchFolderName(time.Now())
+		dir = filepath.Join(dir, batchID)
+	}
+
+	for _, b := range builders {
+		// Spreadsheet cells often carry tabs, line breaks and stray control characters
+		b.Sanitize()
+		message := b.Build()
+		if spec != nil {
+			if err := spec.MutateMessage(message); err != nil {
+				return fmt.Errorf("message %s: %w", message.MessageHeader.MessageId, err)
+			}
+			err = spec.Validate(message)
+		} else {
+			err = message.Validate()
+		}
+		if err != nil {
+			return fmt.Errorf("message %s: %w", message.MessageHeader.MessageId, err)
+		}
+
+		path, err := b.WriteToDelivery(dir)
+		if err != nil {
+			return err
+		}
+		if *resources != "" {
+			if err := copyResources(b.Message, *resources, filepath.Join(filepath.Dir(path), ddex.ResourcesFolder)); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintln(stdout, path)
+	}
+
+	if algorithm != "" {
+		manifest, err := ddex.ChecksumManifestForDir(dir, algorithm)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(dir, ddex.ChecksumManifestFileName(batchID, algorithm))
+		if err := os.WriteFile(path, manifest.Bytes(), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		fmt.Fprintln(stdout, path)
+	}
+
+	if *batch {
+		path := filepath.Join(dir, ddex.BatchCompleteFileName(batchID))
+		if err := os.WriteFile(path, nil, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		fmt.Fprintln(stdout, path)
+	}
+
+	return nil
+}
+
+// loadBuilders returns a builder per release described by a manifest file. CSV files take
+// their message header and default deals from headerPath.
+func loadBuilders(path, headerPath string) ([]*ddex.Builder, error) {
+	if !strings.EqualFold(filepath.Ext(path), ".csv") {
+		manifest, err := ddex.LoadManifest(path)
+		if err != nil {
+			return nil, err
+		}
+		b, err := manifest.Builder()
+		if err != nil {
+			return nil, err
+		}
+		return []*ddex.Builder{b}, nil
+	}
+
+	if headerPath == "" {
+		return nil, fmt.Errorf("CSV manifests need -header with the message sender and recipients")
+	}
+	header, err := ddex.LoadManifest(headerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	manifests, err := ddex.ParseCSVManifests(f, ddex.CSVImportOptions{
+		Message: header.Message,
+		Deals:   header.Deals,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	builders := make([]*ddex.Builder, 0, len(manifests))
+	for _, manifest := range manifests {
+		b, err := manifest.Builder()
+		if err != nil {
+			return nil, fmt.Errorf("release %q: %w", manifest.Release.Title, err)
+		}
+		builders = append(builders, b)
+	}
+	return builders, nil
+}
+
+// copyResources copies every file referenced by the message, looked up by base name in
+// sourceDir, into resourcesDir
+func copyResources(message *ddex.NewReleaseMessage, sourceDir, resourcesDir string) error {
+	for _, fileName := range message.ResourceFiles() {
+		base := filepath.Base(strings.ReplaceAll(fileName, "\\", "/"))
+		if err := copyFile(filepath.Join(sourceDir, base), filepath.Join(resourcesDir, base)); err != nil {
+			return fmt.Errorf("failed to copy resource %s: %w", base, err)
+		}
+	}
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/manosdetijera/ddex/pkg/ddex/catalog"
+)
+
+func runCatalog(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("catalog", flag.ContinueOnError)
+	duplicates := fs.Bool("duplicates", false, "list the ICPNs, GRids, catalog numbers and ISRCs delivered more than once")
+	icpn := fs.String("icpn", "", "list the releases with an `ICPN`")
+	isrc := fs.String("isrc", "", "list the resources with an `ISRC` and the releases containing them")
+	csvOut := fs.Bool("csv", false, "write a CSV row per release instead of the summary")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ddex catalog [-duplicates] [-icpn code] [-isrc code] [-csv] dir...")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Indexes the messages under each directory and prints a summary of the")
+		fmt.Fprintln(fs.Output(), "catalog: files, releases, resources and the files that failed to parse.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	dirs, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(dirs) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	index := catalog.New()
+	for _, dir := range dirs {
+		if err := index.AddDir(dir); err != nil {
+			return err
+		}
+	}
+
+	if *csvOut {
+		return index.WriteCSV(stdout)
+	}
+
+	writeCatalogStats(stdout, index)
+	if *icpn != "" {
+		fmt.Fprintf(stdout, "\nICPN %s:\n", *icpn)
+		writeReleases(stdout, index.ReleasesByICPN(*icpn))
+	}
+	if *isrc != "" {
+		fmt.Fprintf(stdout, "\nISRC %s:\n", *isrc)
+		writeResources(stdout, index.ResourcesByISRC(*isrc))
+		writeReleases(stdout, index.ReleasesContaining(*isrc))
+	}
+	if *duplicates {
+		found := index.Duplicates()
+		fmt.Fprintf(stdout, "\n%d duplicate identifiers\n", len(found))
+		for _, duplicate := range found {
+			fmt.Fprintf(stdout, "\n%s %s:\n", duplicate.Kind, duplicate.Value)
+			writeReleases(stdout, duplicate.Releases)
+			writeResources(stdout, duplicate.Resources)
+		}
+	}
+	return nil
+}
+
+// writeCatalogStats prints the counts of an index and the files it couldn't read
+func writeCatalogStats(w io.Writer, index *catalog.Index) {
+	stats := index.Stats()
+	fmt.Fprintf(w, "Files:      %d messages, %d skipped, %d unreadable\n", stats.Files, stats.Skipped, stats.Errors)
+	fmt.Fprintf(w, "Releases:   %d (%d distinct ICPNs)\n", stats.Releases, stats.ICPNs)
+
+	var types []string
+	for resourceType := range stats.ByType {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+	var counts []string
+	for _, resourceType := range types {
+		counts = append(counts, fmt.Sprintf("%d %s", stats.ByType[resourceType], resourceType))
+	}
+	fmt.Fprintf(w, "Resources:  %d", stats.Resources)
+	if len(counts) > 0 {
+		fmt.Fprintf(w, " (%s)", strings.Join(counts, ", "))
+	}
+	fmt.Fprintf(w, ", %d distinct ISRCs\n", stats.ISRCs)
+	fmt.Fprintf(w, "Duplicates: %d identifiers\n", stats.Duplicates)
+
+	for _, fileErr := range index.Errors {
+		fmt.Fprintf(w, "  %v\n", fileErr)
+	}
+}
+
+func writeReleases(w io.Writer, releases []*catalog.Release) {
+	for _, release := range releases {
+		fmt.Fprintf(w, "  %s  release %s  %s - %s  ICPN %s\n", release.File.Path, release.Reference,
+			release.Artist, release.Title, release.ICPN)
+	}
+}
+
+func writeResources(w io.Writer, resources []*catalog.Resource) {
+	for _, resource := range resources {
+		fmt.Fprintf(w, "  %s  %s %s  %s - %s  ISRC %s\n", resource.File.Path, resource.Type, resource.Reference,
+			resource.Artist, resource.Title, resource.ISRC)
+	}
+}
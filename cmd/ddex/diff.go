@@ -0,0 +1,64 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// errDifferences makes ddex diff exit with status 1, like diff(1)
+var errDifferences = errors.New("messages differ")
+
+func runDiff(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ddex diff old.xml new.xml")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Prints releases, resources and deals added (+), removed (-) or changed (~).")
+		fmt.Fprintln(fs.Output(), "Exits with status 1 if the messages differ.")
+	}
+
+	files, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) != 2 {
+		fs.Usage()
+		return errUsage
+	}
+
+	old, err := readMessage(files[0])
+	if err != nil {
+		return err
+	}
+	new, err := readMessage(files[1])
+	if err != nil {
+		return err
+	}
+
+	diffs := ddex.Diff(old, new)
+	for _, d := range diffs {
+		fmt.Fprintln(stdout, d)
+	}
+	if len(diffs) > 0 {
+		return errDifferences
+	}
+	return nil
+}
+
+// readMessage parses an ERN message file
+func readMessage(path string) (*ddex.NewReleaseMessage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	message, err := ddex.FromXML(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return message, nil
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+func runInspect(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ddex inspect file.xml ...")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Prints the sender, recipients, releases, resources and deals of each message.")
+	}
+
+	files, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	for i, file := range files {
+		message, err := readMessage(file)
+		if err != nil {
+			return err
+		}
+		if len(files) > 1 {
+			if i > 0 {
+				fmt.Fprintln(stdout)
+			}
+			fmt.Fprintf(stdout, "==> %s <==\n", file)
+		}
+		inspect(stdout, message)
+	}
+	return nil
+}
+
+// inspect writes a human-readable summary of a message
+func inspect(out io.Writer, nrm *ddex.NewReleaseMessage) {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	if header := nrm.MessageHeader; header != nil {
+		fmt.Fprintf(w, "Message:\t%s\n", header.MessageId)
+		fmt.Fprintf(w, "Thread:\t%s\n", header.MessageThreadId)
+		if header.MessageControlType != "" {
+			fmt.Fprintf(w, "Control type:\t%s\n", header.MessageControlType)
+		}
+		if header.MessageCreatedDateTime != nil {
+			fmt.Fprintf(w, "Created:\t%s\n", ddex.FormatDateTime(header.MessageCreatedDateTime.Time))
+		}
+		if header.MessageSender != nil {
+			fmt.Fprintf(w, "Sender:\t%s\n", partyLabel(header.MessageSender.PartyName, header.MessageSender.PartyId))
+		}
+		if header.SentOnBehalfOf != nil {
+			fmt.Fprintf(w, "On behalf of:\t%s\n", partyLabel(header.SentOnBehalfOf.PartyName, header.SentOnBehalfOf.PartyId))
+		}
+		for i, recipient := range header.MessageRecipient {
+			label := "Recipients:"
+			if i > 0 {
+				label = ""
+			}
+			fmt.Fprintf(w, "%s\t%s\n", label, partyLabel(recipient.PartyName, recipient.PartyId))
+		}
+	}
+
+	resources := make(map[string]string)
+	if list := nrm.ResourceList; list != nil {
+		fmt.Fprintf(w, "Resources:\t%d sound recordings, %d videos, %d images, %d texts\n",
+			len(list.SoundRecording), len(list.Video), len(list.Image), len(list.Text))
+		for _, recording := range list.SoundRecording {
+			isrc := ""
+			for _, id := range recording.SoundRecordingId {
+				if id.ISRC != "" {
+					isrc = id.ISRC
+					break
+				}
+			}
+			resources[recording.ResourceReference] = resourceLabel(isrc, recording.ReferenceTitle, recording.Duration)
+		}
+		for _, video := range list.Video {
+			isrc := ""
+			if video.VideoId != nil {
+				isrc = video.VideoId.ISRC
+			}
+			resources[video.ResourceReference] = resourceLabel(isrc, video.ReferenceTitle, video.Duration)
+		}
+	}
+
+	deals := make(map[string][]ddex.Deal)
+	if nrm.DealList != nil {
+		for _, releaseDeal := range nrm.DealList.ReleaseDeal {
+			deals[releaseDeal.DealReleaseReference] = append(deals[releaseDeal.DealReleaseReference], releaseDeal.Deal...)
+		}
+	}
+
+	if nrm.ReleaseList == nil {
+		return
+	}
+	for _, release := range nrm.ReleaseList.Release {
+		fmt.Fprintln(w)
+		title := ""
+		if release.ReferenceTitle != nil {
+			title = release.ReferenceTitle.TitleText
+		}
+		var types []string
+		for _, releaseType := range release.ReleaseType {
+			types = append(types, releaseType.Value)
+		}
+		main := ""
+		if release.IsMainRelease {
+			main = " (main)"
+		}
+		fmt.Fprintf(w, "Release %s%s:\t%q %s\n", release.ReleaseReference, main, title, strings.Join(types, ", "))
+
+		for _, id := range release.ReleaseId {
+			for _, field := range [][2]string{{"ICPN", id.ICPN}, {"GRid", id.GRid}, {"ISRC", id.ISRC}, {"ISAN", id.ISAN}} {
+				if field[1] != "" {
+					fmt.Fprintf(w, "  %s:\t%s\n", field[0], field[1])
+				}
+			}
+			for _, proprietary := range id.ProprietaryId {
+				fmt.Fprintf(w, "  ProprietaryId:\t%s (%s)\n", proprietary.Value, proprietary.Namespace)
+			}
+		}
+
+		var territories []string
+		for _, details := range release.ReleaseDetailsByTerritory {
+			territories = append(territories, territoryList(details.TerritoryCode, details.ExcludedTerritoryCode))
+		}
+		fmt.Fprintf(w, "  Territories:\t%s\n", strings.Join(territories, "; "))
+
+		if release.ReleaseResourceReferenceList != nil {
+			for _, ref := range release.ReleaseResourceReferenceList.ReleaseResourceReference {
+				if label, ok := resources[ref.Value]; ok {
+					fmt.Fprintf(w, "  %s\t%s\n", ref.Value, label)
+				}
+			}
+		}
+
+		for _, deal := range deals[release.ReleaseReference] {
+			if deal.DealTerms != nil {
+				fmt.Fprintf(w, "  Deal:\t%s\n", dealLabel(deal.DealTerms))
+			}
+		}
+	}
+}
+
+// partyLabel formats a party as "Name (DPID)"
+func partyLabel(names []ddex.Name, ids []ddex.PartyID) string {
+	var label []string
+	if len(names) > 0 {
+		label = append(label, names[0].FullName)
+	}
+	for _, id := range ids {
+		if id.Namespace != "" {
+			label = append(label, "("+id.Namespace+":"+id.Value+")")
+			continue
+		}
+		label = append(label, "("+id.Value+")")
+	}
+	return strings.Join(label, " ")
+}
+
+// resourceLabel formats a resource as `ISRC "Title" duration`
+func resourceLabel(isrc string, title *ddex.ReferenceTitle, duration string) string {
+	text := ""
+	if title != nil {
+		text = title.TitleText
+	}
+	if isrc == "" {
+		isrc = "-"
+	}
+	return fmt.Sprintf("%s %q %s", isrc, text, duration)
+}
+
+// dealLabel formats deal terms as "models / use types  territories  start..end"
+func dealLabel(terms *ddex.DealTerms) string {
+	if terms.TakeDown != nil && *terms.TakeDown {
+		return "TakeDown " + territoryList(terms.TerritoryCode, terms.ExcludedTerritoryCode)
+	}
+
+	var useTypes []string
+	for _, usage := range terms.Usage {
+		useTypes = append(useTypes, usage.UseType...)
+	}
+	var periods []string
+	for _, period := range terms.ValidityPeriod {
+		start := period.StartDate
+		if start == "" {
+			start = period.StartDateTime
+		}
+		end := period.EndDate
+		if end == "" {
+			end = period.EndDateTime
+		}
+		periods = append(periods, start+".."+end)
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("%s / %s  %s  %s",
+		strings.Join(terms.CommercialModelType, ", "),
+		strings.Join(useTypes, ", "),
+		territoryList(terms.TerritoryCode, terms.ExcludedTerritoryCode),
+		strings.Join(periods, ", ")))
+}
+
+// territoryList formats included territories, or excluded ones prefixed with "not"
+func territoryList(included, excluded []string) string {
+	if len(excluded) > 0 {
+		return "not " + strings.Join(excluded, ", ")
+	}
+	return strings.Join(included, ", ")
+}
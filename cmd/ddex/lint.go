@@ -0,0 +1,55 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+)
+
+// errLowScore makes ddex lint exit with status 1 when a message scores below -min
+var errLowScore = errors.New("completeness score below minimum")
+
+func runLint(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("lint", flag.ContinueOnError)
+	quiet := fs.Bool("q", false, "print only the score of each file")
+	minScore := fs.Float64("min", 0, "exit with status 1 if a message scores below `score` (0-100)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ddex lint [-q] [-min score] message.xml...")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Scores how complete the metadata of each message is and lists the")
+		fmt.Fprintln(fs.Output(), "recommended fields it lacks, such as genres, original release dates,")
+		fmt.Fprintln(fs.Output(), "keywords and artist IDs.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	files, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	low := false
+	for _, file := range files {
+		message, err := readMessage(file)
+		if err != nil {
+			return err
+		}
+		report := message.Lint()
+		fmt.Fprintf(stdout, "%s: %.1f%% complete\n", file, report.Score())
+		if !*quiet {
+			for _, finding := range report.Missing {
+				fmt.Fprintf(stdout, "  %s\n", finding)
+			}
+		}
+		low = low || report.Score() < *minScore
+	}
+	if low {
+		return errLowScore
+	}
+	return nil
+}
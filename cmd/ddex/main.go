@@ -0,0 +1,113 @@
+// Command ddex builds and inspects DDEX ERN 3.8 deliveries.
+//
+// Usage:
+//
+//	ddex <command> [flags] [arguments]
+//
+// Commands:
+//
+//	build      build delivery folders from YAML, JSON or CSV manifests
+//	catalog    index the messages under directories and find duplicate identifiers
+//	diff       print the semantic differences between two messages
+//	inspect    print a summary of messages
+//	lint       score how complete the metadata of messages is
+//	roundtrip  report what parsing and re-marshaling messages loses
+//	serve      serve validation, conversion and builds over HTTP
+//	sheet      render release sheets for review as HTML or Markdown
+//	validate   validate messages and report problems as text, JSON or SARIF
+//	watch      validate the messages dropped into an inbox folder as they arrive
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// command is a ddex subcommand
+type command struct {
+	summary string
+	run     func(args []string, stdout io.Writer) error
+}
+
+var commands = map[string]command{
+	"build":     {"build delivery folders from YAML, JSON or CSV manifests", runBuild},
+	"catalog":   {"index the messages under directories and find duplicate identifiers", runCatalog},
+	"diff":      {"print the semantic differences between two messages", runDiff},
+	"inspect":   {"print a summary of messages", runInspect},
+	"lint":      {"score how complete the metadata of messages is", runLint},
+	"roundtrip": {"report what parsing and re-marshaling messages loses", runRoundTrip},
+	"serve":     {"serve validation, conversion and builds over HTTP", runServe},
+	"sheet":     {"render release sheets for review as HTML or Markdown", runSheet},
+	"validate":  {"validate messages and report problems as text, JSON or SARIF", runValidate},
+	"watch":     {"validate the messages dropped into an inbox folder as they arrive", runWatch},
+}
+
+// errUsage is returned by commands after printing their usage
+var errUsage = errors.New("usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		usage(stderr)
+		return 2
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "ddex: unknown command %q\n\n", args[0])
+		usage(stderr)
+		return 2
+	}
+
+	if err := cmd.run(args[1:], stdout); err != nil {
+		if errors.Is(err, errUsage) || errors.Is(err, flag.ErrHelp) {
+			return 2
+		}
+		if errors.Is(err, errDifferences) || errors.Is(err, errLowScore) || errors.Is(err, errInvalid) {
+			return 1
+		}
+		fmt.Fprintf(stderr, "ddex %s: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: ddex <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-10s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run 'ddex <command> -h' for the flags of a command.")
+}
+
+// parseArgs parses flags appearing anywhere in args, so both "ddex build -o out a.yaml"
+// and "ddex build a.yaml -o out" work, and returns the positional arguments
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
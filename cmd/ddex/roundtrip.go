@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+func runRoundTrip(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("roundtrip", flag.ContinueOnError)
+	quiet := fs.Bool("q", false, "print only the coverage of each file")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ddex roundtrip [-q] message.xml...")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Parses and re-marshals each message and prints the elements and attributes")
+		fmt.Fprintln(fs.Output(), "lost (-), changed (~) or added (+) on the way, with the share preserved.")
+		fmt.Fprintln(fs.Output(), "Exits with status 1 if any message doesn't survive the round trip unchanged.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	files, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	changed := false
+	for _, path := range files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		report, err := ddex.VerifyRoundTrip(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		fmt.Fprintf(stdout, "%s: %.1f%% of %d elements and %d attributes preserved\n",
+			path, 100*report.Coverage(), report.Elements, report.Attributes)
+		if !*quiet {
+			for _, d := range report.Differences {
+				fmt.Fprintf(stdout, "  %s\n", d)
+			}
+		}
+		changed = changed || !report.OK()
+	}
+	if changed {
+		return errDifferences
+	}
+	return nil
+}
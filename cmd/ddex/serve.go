@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex/server"
+)
+
+func runServe(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := fs.String("addr", "localhost:8080", "listen on `address`")
+	maxBody := fs.Int64("max-body", server.DefaultMaxBodySize, "reject request bodies larger than `bytes`")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ddex serve [-addr host:port] [-max-body bytes]")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Serves POST /validate, /convert and /build over HTTP until interrupted.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           server.New().WithMaxBodySize(*maxBody),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(stdout, "listening on %s\n", *addr)
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+func runSheet(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("sheet", flag.ContinueOnError)
+	markdown := fs.Bool("md", false, "write Markdown instead of HTML")
+	all := fs.Bool("all", false, "write a sheet for every release, not only the main release")
+	out := fs.String("o", "", "write each sheet to a file named after the release in `dir` instead of stdout")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ddex sheet [-md] [-all] [-o dir] message.xml...")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Renders a release sheet for review before delivery: identifiers, metadata,")
+		fmt.Fprintln(fs.Output(), "artwork, tracklist, territories and deal windows.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	files, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	extension := ".html"
+	if *markdown {
+		extension = ".md"
+	}
+	for _, file := range files {
+		message, err := readMessage(file)
+		if err != nil {
+			return err
+		}
+		for _, sheet := range selectSheets(message.ReleaseSheets(), *all) {
+			if *out == "" {
+				if err := writeSheet(stdout, sheet, *markdown); err != nil {
+					return err
+				}
+				continue
+			}
+
+			path := filepath.Join(*out, sheetName(sheet)+extension)
+			f, err := os.Create(path)
+			if err != nil {
+				return err
+			}
+			if err := writeSheet(f, sheet, *markdown); err != nil {
+				f.Close()
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			fmt.Fprintln(stdout, path)
+		}
+	}
+	return nil
+}
+
+// selectSheets returns all sheets, or only that of the main release: the one marked as
+// main, or else the first
+func selectSheets(sheets []*ddex.ReleaseSheet, all bool) []*ddex.ReleaseSheet {
+	if all || len(sheets) == 0 {
+		return sheets
+	}
+	for _, sheet := range sheets {
+		if sheet.IsMain {
+			return []*ddex.ReleaseSheet{sheet}
+		}
+	}
+	return sheets[:1]
+}
+
+func writeSheet(w io.Writer, sheet *ddex.ReleaseSheet, markdown bool) error {
+	if markdown {
+		return sheet.WriteMarkdown(w)
+	}
+	return sheet.WriteHTML(w)
+}
+
+// sheetName names a sheet file after the first identifier of the release, e.g. its ICPN,
+// or else its reference
+func sheetName(sheet *ddex.ReleaseSheet) string {
+	if len(sheet.Identifiers) > 0 {
+		fields := strings.Fields(sheet.Identifiers[0])
+		return fields[len(fields)-1]
+	}
+	return sheet.Reference
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// errInvalid makes ddex validate exit with status 1 when a message has problems
+var errInvalid = errors.New("messages have validation problems")
+
+func runValidate(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	format := fs.String("format", "text", "output `format`: text, json or sarif")
+	youtube := fs.Bool("youtube", false, "also run the checks YouTube requires, such as file checksums")
+	fullShares := fs.Bool("full-shares", false, "require right shares to add up to exactly 100%")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ddex validate [-format text|json|sarif] [-youtube] [-full-shares] message.xml...")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Validates each message and lists every problem found. json writes a stable")
+		fmt.Fprintln(fs.Output(), "report for dashboards and sarif a SARIF 2.1.0 log for code-scanning CI.")
+		fmt.Fprintln(fs.Output(), "Exits with status 1 if a message has problems or can't be read.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	files, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+	if *format != "text" && *format != "json" && *format != "sarif" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	var opts ddex.ValidationOptions
+	if *youtube {
+		opts = ddex.YouTubeValidationOptions
+	}
+	opts.RequireFullRightShares = opts.RequireFullRightShares || *fullShares
+
+	var report ddex.ValidationReport
+	for _, file := range files {
+		message, err := readMessage(file)
+		if err != nil {
+			report.AddError(file, err)
+			continue
+		}
+		report.Add(file, message, opts)
+	}
+
+	switch *format {
+	case "json":
+		err = report.WriteJSON(stdout)
+	case "sarif":
+		err = report.WriteSARIF(stdout)
+	default:
+		writeValidationText(stdout, &report)
+	}
+	if err != nil {
+		return err
+	}
+	if !report.Valid() {
+		return errInvalid
+	}
+	return nil
+}
+
+// writeValidationText prints "file: valid" or the problems of each file, one per line
+func writeValidationText(w io.Writer, report *ddex.ValidationReport) {
+	for _, file := range report.Files {
+		if len(file.Problems) == 0 {
+			fmt.Fprintf(w, "%s: valid\n", file.File)
+			continue
+		}
+		noun := "problems"
+		if len(file.Problems) == 1 {
+			noun = "problem"
+		}
+		fmt.Fprintf(w, "%s: %d %s\n", file.File, len(file.Problems), noun)
+		for _, problem := range file.Problems {
+			writeProblem(w, problem)
+		}
+	}
+}
+
+// writeProblem prints an indented "[Rule] path: message" line
+func writeProblem(w io.Writer, problem ddex.ValidationProblem) {
+	if problem.Path == "" {
+		fmt.Fprintf(w, "  [%s] %s\n", problem.Rule, problem.Message)
+	} else {
+		fmt.Fprintf(w, "  [%s] %s: %s\n", problem.Rule, problem.Path, problem.Message)
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+	"github.com/manosdetijera/ddex/pkg/ddex/inbox"
+)
+
+func runWatch(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("watch", flag.ContinueOnError)
+	interval := fs.Duration("interval", 10*time.Second, "scan the inbox every `duration`")
+	settle := fs.Duration("settle", inbox.DefaultSettleTime, "process messages unmodified for `duration`")
+	batches := fs.Bool("batches", false, "process batch folders once they hold a BatchComplete file")
+	accepted := fs.String("accepted", "", "move valid messages to `dir`")
+	rejected := fs.String("rejected", "", "move invalid and unreadable messages to `dir`")
+	youtube := fs.Bool("youtube", false, "also run the checks YouTube requires, such as file checksums")
+	fullShares := fs.Bool("full-shares", false, "require right shares to add up to exactly 100%")
+	once := fs.Bool("once", false, "scan the inbox once and exit")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: ddex watch [-interval d] [-settle d] [-batches] [-accepted dir] [-rejected dir] inbox")
+		fmt.Fprintln(fs.Output())
+		fmt.Fprintln(fs.Output(), "Watches an inbox folder for incoming messages, validates each one and")
+		fmt.Fprintln(fs.Output(), "prints whether it was received, invalid or unreadable, until interrupted.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	dirs, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(dirs) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	var opts ddex.ValidationOptions
+	if *youtube {
+		opts = ddex.YouTubeValidationOptions
+	}
+	opts.RequireFullRightShares = opts.RequireFullRightShares || *fullShares
+
+	watcher := inbox.NewWatcher(dirs[0], *interval).
+		WithSettleTime(*settle).
+		WithValidationOptions(opts).
+		MoveProcessed(*accepted, *rejected).
+		OnError(func(err error) {
+			fmt.Fprintf(os.Stderr, "ddex watch: %v\n", err)
+		})
+	if *batches {
+		watcher.WaitForBatches()
+	}
+
+	if *once {
+		events, err := watcher.Poll(context.Background())
+		for _, event := range events {
+			writeEvent(stdout, event)
+		}
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	fmt.Fprintf(stdout, "watching %s\n", dirs[0])
+	watcher.Run(ctx, func(event inbox.Event) {
+		writeEvent(stdout, event)
+	})
+	return nil
+}
+
+// writeEvent prints an event as "received path (MessageId)", or the problems or error of
+// a rejected message
+func writeEvent(w io.Writer, event inbox.Event) {
+	switch event.Type {
+	case inbox.MessageReceived:
+		messageId := ""
+		if event.Message.MessageHeader != nil {
+			messageId = event.Message.MessageHeader.MessageId
+		}
+		fmt.Fprintf(w, "received %s (%s)\n", event.Path, messageId)
+	case inbox.MessageInvalid:
+		noun := "problems"
+		if len(event.Problems) == 1 {
+			noun = "problem"
+		}
+		fmt.Fprintf(w, "invalid %s: %d %s\n", event.Path, len(event.Problems), noun)
+		for _, problem := range event.Problems {
+			writeProblem(w, problem)
+		}
+	default:
+		fmt.Fprintf(w, "failed %s: %v\n", event.Path, event.Err)
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+)
+
+// generateConverters writes the From<Type> and To<Type> functions of messages
+func generateConverters(messages []*message, pkg string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by protogen from package ddex. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	buf.WriteString("import \"github.com/manosdetijera/ddex/pkg/ddex\"\n")
+
+	for _, m := range messages {
+		writeFrom(&buf, m)
+		writeTo(&buf, m)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+// writeFrom writes the function converting a struct to its message
+func writeFrom(buf *bytes.Buffer, m *message) {
+	fmt.Fprintf(buf, "\n// From%s converts a ddex.%s to its protobuf message\n", m.name, m.name)
+	fmt.Fprintf(buf, "func From%s(in *ddex.%s) *%s {\n", m.name, m.name, m.name)
+	buf.WriteString("if in == nil {\nreturn nil\n}\n")
+	fmt.Fprintf(buf, "out := &%s{}\n", m.name)
+	for _, f := range m.fields {
+		in, out := "in."+f.goName, "out."+f.protoGoName
+		switch {
+		case f.kind == kindMessage && f.repeated && f.pointer:
+			fmt.Fprintf(buf, "for _, v := range %s {\n%s = append(%s, From%s(v))\n}\n", in, out, out, f.message)
+		case f.kind == kindMessage && f.repeated:
+			fmt.Fprintf(buf, "for i := range %s {\n%s = append(%s, From%s(&%s[i]))\n}\n", in, out, out, f.message, in)
+		case f.kind == kindMessage && f.pointer:
+			fmt.Fprintf(buf, "%s = From%s(%s)\n", out, f.message, in)
+		case f.kind == kindMessage:
+			fmt.Fprintf(buf, "%s = From%s(&%s)\n", out, f.message, in)
+		default:
+			fmt.Fprintf(buf, "%s = %s\n", out, fromScalar(f, in))
+		}
+	}
+	buf.WriteString("return out\n}\n")
+}
+
+// fromScalar returns the expression converting the struct field in to its protobuf value
+func fromScalar(f *field, in string) string {
+	switch {
+	case f.kind == kindTimestamp && f.pointer:
+		return "fromDateTime(" + in + ")"
+	case f.kind == kindTimestamp:
+		return "fromDateTime(&" + in + ")"
+	case f.kind == kindDecimal && f.pointer:
+		return "fromDecimalPtr(" + in + ")"
+	case f.kind == kindDecimal:
+		return in + ".String()"
+	case f.kind == kindXMLName:
+		return "fromXMLName(" + in + ")"
+	case f.kind == kindXMLAttr:
+		return "fromXMLAttrs(" + in + ")"
+	case f.kind == kindInt && f.repeated:
+		return "fromInts(" + in + ")"
+	case f.kind == kindInt && f.pointer:
+		return "fromIntPtr(" + in + ")"
+	case f.kind == kindInt:
+		return "int64(" + in + ")"
+	case f.repeated:
+		return "cloneSlice(" + in + ")"
+	case f.pointer:
+		return "clonePtr(" + in + ")"
+	default:
+		return in
+	}
+}
+
+// writeTo writes the function converting a message to its struct
+func writeTo(buf *bytes.Buffer, m *message) {
+	fmt.Fprintf(buf, "\n// To%s converts a %s message to a ddex.%s\n", m.name, m.name, m.name)
+	fmt.Fprintf(buf, "func To%s(in *%s) (*ddex.%s, error) {\n", m.name, m.name, m.name)
+	buf.WriteString("if in == nil {\nreturn nil, nil\n}\n")
+	fmt.Fprintf(buf, "out := &ddex.%s{}\n", m.name)
+	if needsErr(m) {
+		buf.WriteString("var err error\n")
+	}
+	for _, f := range m.fields {
+		in, out := "in."+f.protoGoName, "out."+f.goName
+		wrap := fmt.Sprintf("return nil, wrapField(%q, err)", f.goName)
+		switch {
+		case f.kind == kindMessage && f.repeated:
+			elem := "*v"
+			if f.pointer {
+				elem = "v"
+			}
+			elemType := "ddex." + f.message
+			if f.pointer {
+				elemType = "*" + elemType
+			}
+			fmt.Fprintf(buf, "if len(%s) > 0 {\n%s = make([]%s, len(%s))\n", in, out, elemType, in)
+			fmt.Fprintf(buf, "for i, m := range %s {\nv, err := To%s(m)\n", in, f.message)
+			fmt.Fprintf(buf, "if err != nil {\nreturn nil, wrapIndex(%q, i, err)\n}\n", f.goName)
+			if f.pointer {
+				fmt.Fprintf(buf, "%s[i] = %s\n}\n}\n", out, elem)
+			} else {
+				fmt.Fprintf(buf, "if v != nil {\n%s[i] = %s\n}\n}\n}\n", out, elem)
+			}
+		case f.kind == kindMessage && f.pointer:
+			fmt.Fprintf(buf, "if %s, err = To%s(%s); err != nil {\n%s\n}\n", out, f.message, in, wrap)
+		case f.kind == kindMessage:
+			fmt.Fprintf(buf, "if v, err := To%s(%s); err != nil {\n%s\n} else if v != nil {\n%s = *v\n}\n", f.message, in, wrap, out)
+		case f.kind == kindTimestamp && f.pointer:
+			fmt.Fprintf(buf, "if %s, err = toDateTime(%s); err != nil {\n%s\n}\n", out, in, wrap)
+		case f.kind == kindTimestamp:
+			fmt.Fprintf(buf, "if v, err := toDateTime(%s); err != nil {\n%s\n} else if v != nil {\n%s = *v\n}\n", in, wrap, out)
+		case f.kind == kindDecimal && f.pointer:
+			fmt.Fprintf(buf, "if %s, err = toDecimalPtr(%s); err != nil {\n%s\n}\n", out, in, wrap)
+		case f.kind == kindDecimal:
+			fmt.Fprintf(buf, "if %s, err = toDecimal(%s); err != nil {\n%s\n}\n", out, in, wrap)
+		default:
+			fmt.Fprintf(buf, "%s = %s\n", out, toScalar(f, in))
+		}
+	}
+	buf.WriteString("return out, nil\n}\n")
+}
+
+// toScalar returns the expression converting the protobuf value in to its struct field
+func toScalar(f *field, in string) string {
+	switch {
+	case f.kind == kindXMLName:
+		return "toXMLName(" + in + ")"
+	case f.kind == kindXMLAttr:
+		return "toXMLAttrs(" + in + ")"
+	case f.kind == kindInt && f.repeated:
+		return "toInts(" + in + ")"
+	case f.kind == kindInt && f.pointer:
+		return "toIntPtr(" + in + ")"
+	case f.kind == kindInt:
+		return "int(" + in + ")"
+	case f.repeated:
+		return "cloneSlice(" + in + ")"
+	case f.pointer:
+		return "clonePtr(" + in + ")"
+	default:
+		return in
+	}
+}
+
+// needsErr reports whether the To function of m assigns to a shared err variable
+func needsErr(m *message) bool {
+	for _, f := range m.fields {
+		switch {
+		case f.kind == kindMessage && f.pointer && !f.repeated,
+			f.kind == kindTimestamp && f.pointer,
+			f.kind == kindDecimal:
+			return true
+		}
+	}
+	return false
+}
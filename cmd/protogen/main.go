@@ -0,0 +1,84 @@
+// Command protogen generates the protobuf representation of the ERN 3.8 model of package
+// ddex: a .proto file with a message per struct, and Go functions converting between the
+// structs and the messages compiled from it.
+//
+// Usage:
+//
+//	protogen [-model dir] [-proto file] [-o file] [-pkg name]
+//
+// protogen walks the structs reachable from NewReleaseMessage and writes a message for
+// each to -proto, with the struct and field comments of the Go source in -model. Field
+// numbers are kept from the existing -proto file, so regenerating after a field is added
+// to the model never renumbers fields already on the wire; the numbers of removed fields
+// are reserved. It then writes to -o a From<Type> and To<Type> function per message.
+//
+// The Go code of the messages is generated from the .proto file by protoc-gen-go:
+//
+//	protoc --go_out=. --go_opt=paths=source_relative ern38.proto
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "protogen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("protogen", flag.ContinueOnError)
+	modelDir := fs.String("model", "..", "`dir`ectory of the Go source of package ddex, for comments")
+	protoFile := fs.String("proto", "ern38.proto", "protobuf definitions `file` to update")
+	output := fs.String("o", "convert.go", "converter `file` to write")
+	pkg := fs.String("pkg", "ddexpb", "package `name` of the converter file")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: protogen [-model dir] [-proto file] [-o file] [-pkg name]")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	docs, err := readDocs(*modelDir)
+	if err != nil {
+		return err
+	}
+	messages, err := buildModel(reflect.TypeOf(ddex.NewReleaseMessage{}), docs)
+	if err != nil {
+		return err
+	}
+
+	previous, err := readNumbers(*protoFile)
+	if err != nil {
+		return err
+	}
+	added := assignNumbers(messages, previous)
+
+	proto := generateProto(messages)
+	converters, err := generateConverters(messages, *pkg)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*protoFile, proto, 0644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(*output, converters, 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "%d messages, %d new fields\n", len(messages), added)
+	return nil
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// fieldKind is how a struct field is represented in protobuf
+type fieldKind int
+
+const (
+	kindString    fieldKind = iota
+	kindBool                // bool
+	kindInt                 // Go int as int64
+	kindInt64               // int64
+	kindDouble              // float64
+	kindMessage             // struct of the model
+	kindTimestamp           // ddex.DateTime as DateTime, a Timestamp with its UTC offset
+	kindDecimal             // ddex.Decimal as its decimal string
+	kindXMLName             // xml.Name as XmlName
+	kindXMLAttr             // xml.Attr as XmlAttr
+)
+
+var (
+	dateTimeType = reflect.TypeOf(ddex.DateTime{})
+	decimalType  = reflect.TypeOf(ddex.Decimal{})
+	xmlNameType  = reflect.TypeOf(xml.Name{})
+	xmlAttrType  = reflect.TypeOf(xml.Attr{})
+)
+
+// message is a struct of the model and the protobuf message representing it
+type message struct {
+	name     string // Of both the struct and the message
+	doc      string
+	fields   []*field
+	reserved []int // Numbers of fields removed from the struct
+}
+
+// field is a struct field and the protobuf field representing it
+type field struct {
+	goName      string // In the struct
+	name        string // In the .proto file
+	number      int
+	kind        fieldKind
+	message     string // Message type of kindMessage
+	repeated    bool   // A slice
+	pointer     bool   // A pointer, or a slice of pointers; optional for scalars
+	comment     string
+	protoGoName string // In the Go code of the message
+}
+
+// docs are the comments of the Go source: type docs by type name, field comments by
+// "Type.Field"
+type docs map[string]string
+
+// readDocs reads the comments of the struct types declared in the non-test files of dir
+func readDocs(dir string) (docs, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	d := make(docs)
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				st, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				doc := typeSpec.Doc
+				if doc == nil && len(gen.Specs) == 1 {
+					doc = gen.Doc
+				}
+				if doc != nil {
+					d[typeSpec.Name.Name] = strings.TrimSpace(doc.Text())
+				}
+				for _, f := range st.Fields.List {
+					if f.Comment == nil {
+						continue
+					}
+					for _, fieldName := range f.Names {
+						d[typeSpec.Name.Name+"."+fieldName.Name] = strings.TrimSpace(f.Comment.Text())
+					}
+				}
+			}
+		}
+	}
+	return d, nil
+}
+
+// buildModel returns a message per struct reachable from root, in breadth-first order
+func buildModel(root reflect.Type, d docs) ([]*message, error) {
+	var messages []*message
+	queued := map[reflect.Type]bool{root: true}
+	queue := []reflect.Type{root}
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+
+		m := &message{name: t.Name(), doc: d[t.Name()]}
+		names := make(map[string]string)
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if sf.PkgPath != "" || sf.Type == xmlNameType && sf.Name == "XMLName" {
+				continue
+			}
+			f, elem, err := newField(sf)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", t.Name(), sf.Name, err)
+			}
+			if other, ok := names[f.name]; ok {
+				return nil, fmt.Errorf("%s: fields %s and %s are both %s in protobuf", t.Name(), other, sf.Name, f.name)
+			}
+			names[f.name] = sf.Name
+			f.comment = d[t.Name()+"."+sf.Name]
+			m.fields = append(m.fields, f)
+
+			if elem != nil && !queued[elem] {
+				queued[elem] = true
+				queue = append(queue, elem)
+			}
+		}
+		messages = append(messages, m)
+	}
+	return messages, nil
+}
+
+// newField returns the protobuf field of a struct field and, for kindMessage, the struct
+// type it holds
+func newField(sf reflect.StructField) (*field, reflect.Type, error) {
+	f := &field{goName: sf.Name, name: snakeCase(sf.Name)}
+	f.protoGoName = goCamelCase(f.name)
+	if generatedMethods[f.protoGoName] {
+		f.protoGoName += "_"
+	}
+
+	t := sf.Type
+	if t.Kind() == reflect.Slice {
+		f.repeated = true
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Ptr {
+		f.pointer = true
+		t = t.Elem()
+	}
+
+	switch {
+	case t == dateTimeType:
+		f.kind = kindTimestamp
+	case t == decimalType:
+		f.kind = kindDecimal
+	case t == xmlNameType:
+		f.kind = kindXMLName
+	case t == xmlAttrType:
+		f.kind = kindXMLAttr
+	case t.Kind() == reflect.Struct && t.PkgPath() == dateTimeType.PkgPath():
+		f.kind = kindMessage
+		f.message = t.Name()
+		return f, t, nil
+	case t.Kind() == reflect.String:
+		f.kind = kindString
+	case t.Kind() == reflect.Bool:
+		f.kind = kindBool
+	case t.Kind() == reflect.Int:
+		f.kind = kindInt
+	case t.Kind() == reflect.Int64:
+		f.kind = kindInt64
+	case t.Kind() == reflect.Float64:
+		f.kind = kindDouble
+	default:
+		return nil, nil, fmt.Errorf("no protobuf representation for %s", sf.Type)
+	}
+	// The converters handle these types as single values, and xml.Attr only as a list
+	unsupported := f.pointer && f.repeated
+	switch f.kind {
+	case kindTimestamp, kindDecimal, kindXMLName:
+		unsupported = unsupported || f.repeated
+	case kindXMLAttr:
+		unsupported = unsupported || !f.repeated || f.pointer
+	}
+	if unsupported {
+		return nil, nil, fmt.Errorf("no protobuf representation for %s", sf.Type)
+	}
+	return f, nil, nil
+}
+
+// generatedMethods are the names protoc-gen-go suffixes with _ when a field has them
+var generatedMethods = map[string]bool{
+	"Reset": true, "String": true, "ProtoMessage": true, "Marshal": true, "Unmarshal": true,
+	"ExtensionRangeArray": true, "ExtensionMap": true, "Descriptor": true,
+}
+
+// snakeCase returns the protobuf field name of a Go field name: ReleaseId is release_id,
+// ISRC is isrc and PLine is p_line
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		upper := r >= 'A' && r <= 'Z'
+		if upper && i > 0 {
+			prevLower := runes[i-1] >= 'a' && runes[i-1] <= 'z' || runes[i-1] >= '0' && runes[i-1] <= '9'
+			nextLower := i+1 < len(runes) && runes[i+1] >= 'a' && runes[i+1] <= 'z'
+			prevUpper := runes[i-1] >= 'A' && runes[i-1] <= 'Z'
+			if prevLower || prevUpper && nextLower {
+				b.WriteByte('_')
+			}
+		}
+		if upper {
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// goCamelCase returns the Go name protoc-gen-go gives a field with a snake_case name
+func goCamelCase(name string) string {
+	var b []byte
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(name) && isLower(name[i+1]):
+		case c >= '0' && c <= '9':
+			b = append(b, c)
+		default:
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(name) && isLower(name[i+1]); i++ {
+				b = append(b, name[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+func isLower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
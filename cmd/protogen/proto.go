@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// protoPackage and goPackage are the packages of the generated definitions
+const (
+	protoPackage = "ddex.ern38"
+	goPackage    = "github.com/manosdetijera/ddex/pkg/ddex/ddexpb"
+)
+
+// numbering is the field numbers of an existing .proto file by message, and the numbers
+// it reserves
+type numbering struct {
+	fields   map[string]map[string]int
+	reserved map[string][]int
+}
+
+var (
+	messageLine  = regexp.MustCompile(`^message (\w+) \{`)
+	fieldLine    = regexp.MustCompile(`^\s+(?:optional |repeated )?[\w.]+ (\w+) = (\d+);`)
+	reservedLine = regexp.MustCompile(`^\s+reserved ([\d, ]+);`)
+)
+
+// readNumbers reads the field numbers of a .proto file written by generateProto. A file
+// that doesn't exist yet has none.
+func readNumbers(filename string) (*numbering, error) {
+	n := &numbering{fields: make(map[string]map[string]int), reserved: make(map[string][]int)}
+	f, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return n, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	current := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := messageLine.FindStringSubmatch(line); m != nil {
+			current = m[1]
+			n.fields[current] = make(map[string]int)
+			continue
+		}
+		if current == "" {
+			continue
+		}
+		if m := fieldLine.FindStringSubmatch(line); m != nil {
+			number, _ := strconv.Atoi(m[2])
+			n.fields[current][m[1]] = number
+		} else if m := reservedLine.FindStringSubmatch(line); m != nil {
+			for _, s := range strings.Split(m[1], ",") {
+				number, err := strconv.Atoi(strings.TrimSpace(s))
+				if err != nil {
+					return nil, fmt.Errorf("%s: invalid reserved number %q", filename, s)
+				}
+				n.reserved[current] = append(n.reserved[current], number)
+			}
+		} else if line == "}" {
+			current = ""
+		}
+	}
+	return n, scanner.Err()
+}
+
+// assignNumbers numbers the fields of messages, keeping the numbers of previous and
+// reserving those of removed fields. It returns how many fields got a new number.
+func assignNumbers(messages []*message, previous *numbering) int {
+	added := 0
+	for _, m := range messages {
+		old := previous.fields[m.name]
+		used := make(map[string]bool)
+		next := 1
+		for _, number := range old {
+			next = max(next, number+1)
+		}
+		for _, number := range previous.reserved[m.name] {
+			next = max(next, number+1)
+		}
+
+		for _, f := range m.fields {
+			if number, ok := old[f.name]; ok {
+				f.number = number
+				used[f.name] = true
+				continue
+			}
+			f.number = next
+			next++
+			added++
+		}
+
+		m.reserved = append([]int(nil), previous.reserved[m.name]...)
+		for name, number := range old {
+			if !used[name] {
+				m.reserved = append(m.reserved, number)
+			}
+		}
+		sort.Ints(m.reserved)
+	}
+	return added
+}
+
+// generateProto writes the .proto file of messages
+func generateProto(messages []*message) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by protogen from package ddex. DO NOT EDIT.\n\n")
+	buf.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&buf, "package %s;\n\n", protoPackage)
+	fmt.Fprintf(&buf, "option go_package = %q;\n", goPackage)
+
+	for _, m := range messages {
+		buf.WriteString("\n")
+		writeComment(&buf, "", m.doc)
+		fmt.Fprintf(&buf, "message %s {\n", m.name)
+		for _, f := range m.fields {
+			label := ""
+			switch {
+			case f.repeated:
+				label = "repeated "
+			case f.pointer && f.kind != kindMessage && f.kind != kindTimestamp:
+				label = "optional "
+			}
+			fmt.Fprintf(&buf, "  %s%s %s = %d;", label, protoType(f), f.name, f.number)
+			if f.comment != "" {
+				fmt.Fprintf(&buf, " // %s", strings.ReplaceAll(f.comment, "\n", " "))
+			}
+			buf.WriteString("\n")
+		}
+		if len(m.reserved) > 0 {
+			numbers := make([]string, len(m.reserved))
+			for i, number := range m.reserved {
+				numbers[i] = strconv.Itoa(number)
+			}
+			fmt.Fprintf(&buf, "  reserved %s;\n", strings.Join(numbers, ", "))
+		}
+		buf.WriteString("}\n")
+	}
+
+	buf.WriteString(`
+// DateTime is a date and time with the UTC offset it was written with, so that it
+// marshals to the same XML. Its first fields are those of google.protobuf.Timestamp, which
+// can decode it, dropping the offset.
+message DateTime {
+  int64 seconds = 1;
+  int32 nanos = 2;
+  int32 utc_offset_seconds = 3;
+}
+
+// XmlName is the namespace and local name of an XML element or attribute
+message XmlName {
+  string space = 1;
+  string local = 2;
+}
+
+// XmlAttr is an XML attribute
+message XmlAttr {
+  XmlName name = 1;
+  string value = 2;
+}
+`)
+	return buf.Bytes()
+}
+
+func writeComment(buf *bytes.Buffer, indent, text string) {
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(text, "\n") {
+		if line == "" {
+			fmt.Fprintf(buf, "%s//\n", indent)
+		} else {
+			fmt.Fprintf(buf, "%s// %s\n", indent, line)
+		}
+	}
+}
+
+// protoType returns the protobuf type of a field
+func protoType(f *field) string {
+	switch f.kind {
+	case kindBool:
+		return "bool"
+	case kindInt, kindInt64:
+		return "int64"
+	case kindDouble:
+		return "double"
+	case kindMessage:
+		return f.message
+	case kindTimestamp:
+		return "DateTime"
+	case kindXMLName:
+		return "XmlName"
+	case kindXMLAttr:
+		return "XmlAttr"
+	default: // kindString, kindDecimal
+		return "string"
+	}
+}
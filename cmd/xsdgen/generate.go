@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"strings"
+	"unicode"
+)
+
+// generate writes a Go file with a struct for every complex type reachable from root
+func generate(s *schemaSet, root *particle, pkg, source string) ([]byte, error) {
+	if root.complex == nil {
+		return nil, fmt.Errorf("element %s has a simple type", root.name)
+	}
+
+	// Types in the order they are first reached from the root
+	types := []*complexType{root.complex}
+	names := map[*complexType]string{}
+	used := map[string]bool{}
+	for i := 0; i < len(types); i++ {
+		t := types[i]
+		names[t] = s.goTypeName(t, used)
+		for _, element := range t.elements {
+			if element.complex != nil && !contains(types, element.complex) {
+				types = append(types, element.complex)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by xsdgen from %s. DO NOT EDIT.\n\n", path.Base(source))
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	buf.WriteString("import \"encoding/xml\"\n")
+
+	usesAny := false
+	for _, t := range types {
+		buf.WriteString("\n")
+		writeComment(&buf, typeComment(names[t], t, s.prefixes[t.name.space]))
+		fmt.Fprintf(&buf, "type %s struct {\n", names[t])
+		fields := map[string]bool{}
+		if t == root.complex {
+			fields["XMLName"] = true
+			fmt.Fprintf(&buf, "\tXMLName xml.Name `xml:%q json:\"-\"`\n", root.name)
+		}
+
+		for _, a := range t.attributes {
+			goType, omitEmpty := a.simple, !a.required
+			if omitEmpty && goType != "string" {
+				goType = "*" + goType
+			}
+			writeField(&buf, fieldName(a.name, "Attr", fields), goType, a.name+",attr", omitEmpty, "")
+		}
+		if t.value != "" {
+			writeField(&buf, fieldName("Value", "Text", fields), t.value, ",chardata", false, "")
+		}
+		for _, element := range t.elements {
+			goType := element.simple
+			if element.complex != nil {
+				goType = names[element.complex]
+			}
+			omitEmpty := element.min == 0
+			switch {
+			case element.max != 1:
+				goType = "[]" + goType
+			case omitEmpty && (element.complex != nil || goType != "string"):
+				goType = "*" + goType
+			}
+			comment := ""
+			switch {
+			case element.min == 1 && element.max == 1:
+				comment = "Mandatory"
+			case element.min > 0:
+				comment = "Mandatory " + cardinality(element.min, element.max)
+			case element.max != 1:
+				comment = cardinality(element.min, element.max)
+			}
+			writeField(&buf, fieldName(element.name, "Element", fields), goType, element.name, omitEmpty, comment)
+		}
+		if t.any {
+			usesAny = true
+			fmt.Fprintf(&buf, "\t%s []AnyElement `xml:\",any\" json:\",omitempty\"`\n", fieldName("Any", "Wildcard", fields))
+		}
+		buf.WriteString("}\n")
+	}
+
+	if usesAny {
+		buf.WriteString("\n// AnyElement keeps an element matched by an xs:any wildcard\n")
+		buf.WriteString("type AnyElement struct {\n")
+		buf.WriteString("\tXMLName xml.Name\n")
+		buf.WriteString("\tAttrs   []xml.Attr `xml:\",any,attr\"`\n")
+		buf.WriteString("\tInner   []byte     `xml:\",innerxml\"`\n")
+		buf.WriteString("}\n")
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+func contains(types []*complexType, t *complexType) bool {
+	for _, other := range types {
+		if other == t {
+			return true
+		}
+	}
+	return false
+}
+
+// goTypeName names the struct of t after the XSD type, or after the namespace prefix and
+// the type when another namespace has a type of the same name
+func (s *schemaSet) goTypeName(t *complexType, used map[string]bool) string {
+	name := identifier(t.name.local)
+	if used[name] {
+		name = identifier(s.prefixes[t.name.space]) + name
+	}
+	for base, i := name, 2; used[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	used[name] = true
+	return name
+}
+
+// typeComment returns the doc comment of the struct of t, from its XSD documentation
+func typeComment(name string, t *complexType, prefix string) string {
+	source := t.name.local
+	switch {
+	case t.anonymous:
+		source = "element " + source
+	case prefix != "":
+		source = prefix + ":" + source
+	}
+	doc := strings.TrimSuffix(t.doc, ".")
+	switch {
+	case doc == "":
+		return name + " is generated from " + source
+	case strings.HasPrefix(doc, "A ") || strings.HasPrefix(doc, "An ") || strings.HasPrefix(doc, "The "):
+		return name + " is " + strings.ToLower(doc[:1]) + doc[1:] + " (" + source + ")"
+	}
+	return name + " (" + source + "): " + doc
+}
+
+// writeField writes a struct field with its xml and json tags
+func writeField(buf *bytes.Buffer, name, goType, tag string, omitEmpty bool, comment string) {
+	if omitEmpty {
+		tag += ",omitempty"
+	}
+	fmt.Fprintf(buf, "\t%s %s `xml:%q json:\",omitempty\"`", name, goType, tag)
+	if comment != "" {
+		buf.WriteString(" // " + comment)
+	}
+	buf.WriteString("\n")
+}
+
+// fieldName returns the Go name of an attribute, element or text field, with suffix
+// appended if another field of the struct already has the name
+func fieldName(xmlName, suffix string, fields map[string]bool) string {
+	name := identifier(xmlName)
+	if fields[name] {
+		name += suffix
+	}
+	for base, i := name, 2; fields[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	fields[name] = true
+	return name
+}
+
+// identifier turns an XML name into an exported Go identifier, dropping the characters Go
+// doesn't allow and capitalizing the word after each of them
+func identifier(xmlName string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range xmlName {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	name := b.String()
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+// writeComment writes text as // comment lines of at most 90 columns
+func writeComment(buf *bytes.Buffer, text string) {
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && 3+len(line)+1+len(word) > 90 {
+			buf.WriteString("// " + line + "\n")
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		buf.WriteString("// " + line + "\n")
+	}
+}
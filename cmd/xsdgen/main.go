@@ -0,0 +1,105 @@
+// Command xsdgen generates Go structs from DDEX XSDs, or verifies hand-written ones
+// against them. It is meant to run from go:generate, so that supporting a new schema
+// version starts from generated structs and field drift in the existing ones is caught by
+// a tool instead of by reading the schema.
+//
+// Usage:
+//
+//	xsdgen [-xsd file|URL] [-dir dir] [-root element] [-pkg name] [-o file]
+//	xsdgen -verify [-missing] [-xsd file|URL] [-dir dir] [-root element] [package dir]
+//
+// Without -verify, xsdgen writes a struct for every complex type reachable from the root
+// element, with encoding/xml and JSON tags in the style of package ddex. With -verify, it
+// walks the structs of the package in dir (default ".") from the struct named after the
+// root element, and reports fields that are not in the schema, that are attributes in
+// the schema but elements in Go or the other way around, or that hold a single value
+// where the schema allows several. -missing also reports schema elements and attributes
+// without a field. xsdgen -verify exits with status 1 when it reports anything.
+//
+// Schemas are read from files or http(s) URLs, following xs:include and xs:import.
+// -dir looks up included and imported schemas by file name in a local folder first, for
+// working offline with copies of the DDEX schemas.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// defaultXSD is the ERN 3.8.2 schema the ddex package is written against
+const defaultXSD = "http://ddex.net/xml/ern/382/release-notification.xsd"
+
+// errDrift makes xsdgen -verify exit with status 1
+var errDrift = errors.New("structs differ from the schema")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+		if !errors.Is(err, errDrift) {
+			fmt.Fprintf(os.Stderr, "xsdgen: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("xsdgen", flag.ContinueOnError)
+	xsd := fs.String("xsd", defaultXSD, "schema `file or URL` to read")
+	dir := fs.String("dir", "", "look up included and imported schemas in `dir` by file name first")
+	root := fs.String("root", "NewReleaseMessage", "root `element` of the messages")
+	pkg := fs.String("pkg", "ddex", "package `name` of the generated file")
+	output := fs.String("o", "", "write the generated structs to `file` instead of stdout")
+	verify := fs.Bool("verify", false, "check the structs of a package against the schema instead of generating")
+	missing := fs.Bool("missing", false, "with -verify, also report schema elements and attributes without a field")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: xsdgen [-xsd file|URL] [-dir dir] [-root element] [-pkg name] [-o file]")
+		fmt.Fprintln(fs.Output(), "       xsdgen -verify [-missing] [-xsd file|URL] [-dir dir] [-root element] [package dir]")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	schemas, err := loadSchemas(*xsd, *dir)
+	if err != nil {
+		return err
+	}
+	rootElement, err := schemas.rootElement(*root)
+	if err != nil {
+		return err
+	}
+
+	if *verify {
+		pkgDir := "."
+		if fs.NArg() > 0 {
+			pkgDir = fs.Arg(0)
+		}
+		findings, err := verifyPackage(pkgDir, schemas, rootElement, *missing)
+		if err != nil {
+			return err
+		}
+		for _, finding := range findings {
+			fmt.Fprintln(stdout, finding)
+		}
+		if len(findings) > 0 {
+			return errDrift
+		}
+		return nil
+	}
+
+	source, err := generate(schemas, rootElement, *pkg, *xsd)
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err = stdout.Write(source)
+		return err
+	}
+	return os.WriteFile(*output, source, 0644)
+}
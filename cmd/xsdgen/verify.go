@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// verifyPackage checks the structs of the package in dir against the schema, starting
+// from the struct named after the root element, and returns what differs as
+// "Struct.Field: problem" lines
+func verifyPackage(dir string, s *schemaSet, root *particle, missing bool) ([]string, error) {
+	structs, err := parseStructs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := structs[root.name]; !ok {
+		return nil, fmt.Errorf("package in %s has no struct %s", dir, root.name)
+	}
+	if root.complex == nil {
+		return nil, fmt.Errorf("element %s has a simple type", root.name)
+	}
+
+	v := &verifier{structs: structs, missing: missing, visited: make(map[string]bool)}
+	v.check(root.name, root.complex)
+	sort.Strings(v.findings)
+	return v.findings, nil
+}
+
+// parseStructs returns the struct types declared in the non-test files of dir by name
+func parseStructs(dir string) (map[string]*ast.StructType, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	structs := make(map[string]*ast.StructType)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if st, ok := typeSpec.Type.(*ast.StructType); ok {
+					structs[typeSpec.Name.Name] = st
+				}
+			}
+		}
+	}
+	return structs, nil
+}
+
+// verifier walks Go structs and the complex types their fields hold in parallel
+type verifier struct {
+	structs  map[string]*ast.StructType
+	missing  bool
+	visited  map[string]bool // "Struct XSDType" pairs already checked
+	findings []string
+}
+
+// check compares the struct goType with the complex type t, and the structs of its fields
+// with the types of the matching elements
+func (v *verifier) check(goType string, t *complexType) {
+	key := goType + " " + t.name.space + " " + t.name.local
+	if v.visited[key] {
+		return
+	}
+	v.visited[key] = true
+	typeName := "XSD type " + t.name.local
+	if t.anonymous {
+		typeName = "the type of element " + t.name.local
+	}
+
+	seenElements := make(map[string]bool)
+	seenAttributes := make(map[string]bool)
+	hasAny := false
+	for _, field := range v.structs[goType].Fields.List {
+		tag := ""
+		if field.Tag != nil {
+			if unquoted, err := strconv.Unquote(field.Tag.Value); err == nil {
+				tag = reflect.StructTag(unquoted).Get("xml")
+			}
+		}
+		if tag == "-" || len(field.Names) == 0 || !field.Names[0].IsExported() || field.Names[0].Name == "XMLName" {
+			continue
+		}
+		fieldPath := goType + "." + field.Names[0].Name
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" && options != "" && !hasOption(options, "attr") {
+			// ",chardata", ",innerxml", ",any" and ",comment" fields
+			hasAny = hasAny || hasOption(options, "any")
+			continue
+		}
+		if name == "" {
+			name = field.Names[0].Name
+		}
+		if strings.Contains(name, " ") || strings.Contains(name, ">") {
+			// Namespaced names and parent>child paths aren't checked
+			continue
+		}
+		prefix, local, ok := strings.Cut(name, ":")
+		if ok {
+			if prefix == "xmlns" || prefix == "xsi" {
+				continue
+			}
+			name = local
+		}
+
+		if hasOption(options, "attr") {
+			seenAttributes[name] = true
+			if t.attribute(name) != nil {
+				continue
+			}
+			if t.element(name) != nil {
+				v.report(fieldPath, "%s is an element of %s, not an attribute", name, typeName)
+			} else {
+				v.report(fieldPath, "%s has no attribute %s", typeName, name)
+			}
+			continue
+		}
+
+		seenElements[name] = true
+		element := t.element(name)
+		if element == nil {
+			if t.attribute(name) != nil {
+				v.report(fieldPath, "%s is an attribute of %s, not an element", name, typeName)
+			} else {
+				v.report(fieldPath, "%s has no element %s", typeName, name)
+			}
+			continue
+		}
+		elemType, slice := fieldType(field.Type)
+		if element.max != 1 && !slice {
+			v.report(fieldPath, "%s allows %s %s elements, the field holds one", typeName, cardinality(element.min, element.max), name)
+		}
+		if _, ok := v.structs[elemType]; ok && element.complex != nil {
+			v.check(elemType, element.complex)
+		}
+	}
+
+	if !v.missing {
+		return
+	}
+	kept := ""
+	if hasAny {
+		kept = " (kept as an extension)"
+	}
+	for _, a := range t.attributes {
+		if !seenAttributes[a.name] {
+			v.report(goType, "no field for attribute %s of %s", a.name, typeName)
+		}
+	}
+	for _, element := range t.elements {
+		if !seenElements[element.name] {
+			v.report(goType, "no field for element %s (%s) of %s%s", element.name, cardinality(element.min, element.max), typeName, kept)
+		}
+	}
+}
+
+func (v *verifier) report(path, format string, args ...interface{}) {
+	v.findings = append(v.findings, path+": "+fmt.Sprintf(format, args...))
+}
+
+// fieldType returns the name of the type a field holds, or of its elements for slices
+// other than []byte, and whether it is such a slice
+func fieldType(expr ast.Expr) (string, bool) {
+	slice := false
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ArrayType:
+			if ident, ok := e.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+				return "[]byte", false
+			}
+			slice = true
+			expr = e.Elt
+		case *ast.Ident:
+			return e.Name, slice
+		case *ast.SelectorExpr:
+			return fmt.Sprint(e.X) + "." + e.Sel.Name, slice
+		default:
+			return "", slice
+		}
+	}
+}
+
+// hasOption reports whether the comma-separated options of a struct tag include option
+func hasOption(options, option string) bool {
+	for _, o := range strings.Split(options, ",") {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,682 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// unbounded is the maxOccurs of elements that may repeat without limit
+const unbounded = -1
+
+// node is an element of a schema document
+type node struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Children []node     `xml:",any"`
+	Text     string     `xml:",chardata"`
+}
+
+// attr returns the value of the unqualified attribute name
+func (n *node) attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Space == "" && a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// is reports whether n is the XSD element local
+func (n *node) is(local string) bool {
+	return n.XMLName.Space == xsdNamespace && n.XMLName.Local == local
+}
+
+// documentation returns the text of the xs:annotation/xs:documentation child of n
+func (n *node) documentation() string {
+	for i := range n.Children {
+		if !n.Children[i].is("annotation") {
+			continue
+		}
+		for j := range n.Children[i].Children {
+			if doc := n.Children[i].Children[j]; doc.is("documentation") {
+				return strings.Join(strings.Fields(doc.Text), " ")
+			}
+		}
+	}
+	return ""
+}
+
+// qname is a namespace-qualified name
+type qname struct {
+	space, local string
+}
+
+// schemaDoc is a loaded schema document
+type schemaDoc struct {
+	location string
+	target   string
+	prefixes map[string]string // Namespace by prefix, "" for the default namespace
+}
+
+// resolve returns the qualified name of a QName attribute value such as ddexC:Title
+func (d *schemaDoc) resolve(value string) qname {
+	prefix, local, ok := strings.Cut(value, ":")
+	if !ok {
+		return qname{d.prefixes[""], value}
+	}
+	return qname{d.prefixes[prefix], local}
+}
+
+// definition is a global definition of a schema document
+type definition struct {
+	doc  *schemaDoc
+	node *node
+}
+
+// schemaSet holds the global definitions of a schema and the documents it includes and
+// imports, and resolves its complex types as they are needed
+type schemaSet struct {
+	dir      string
+	target   string            // Target namespace of the main document
+	prefixes map[string]string // First prefix seen by namespace, for naming types
+	loaded   map[string]bool
+
+	elements        map[qname]definition
+	complexTypes    map[qname]definition
+	simpleTypes     map[qname]definition
+	groups          map[qname]definition
+	attributeGroups map[qname]definition
+	attributes      map[qname]definition
+
+	resolved  map[qname]*complexType
+	anonymous map[*node]*complexType
+}
+
+// complexType is a resolved complex type, with its content model flattened to the
+// elements it may contain
+type complexType struct {
+	name       qname // Of the type, or of the element declaring it if anonymous
+	anonymous  bool
+	doc        string
+	value      string // Go type of the text content; empty for element-only content
+	attributes []attribute
+	elements   []particle
+	any        bool // Has an xs:any wildcard
+}
+
+// particle is an element a complex type may contain
+type particle struct {
+	name     string
+	min, max int          // Occurrences, with choices and repeated groups applied
+	simple   string       // Go type of a simple-typed element
+	complex  *complexType // Type of a complex-typed element
+	doc      string
+}
+
+// attribute is an attribute of a complex type
+type attribute struct {
+	name     string
+	simple   string // Go type
+	required bool
+	doc      string
+}
+
+// element returns the element name of t, nil if there is none
+func (t *complexType) element(name string) *particle {
+	for i := range t.elements {
+		if t.elements[i].name == name {
+			return &t.elements[i]
+		}
+	}
+	return nil
+}
+
+// attribute returns the attribute name of t, nil if there is none
+func (t *complexType) attribute(name string) *attribute {
+	for i := range t.attributes {
+		if t.attributes[i].name == name {
+			return &t.attributes[i]
+		}
+	}
+	return nil
+}
+
+// loadSchemas reads the schema at location and everything it includes and imports
+func loadSchemas(location, dir string) (*schemaSet, error) {
+	s := &schemaSet{
+		dir:             dir,
+		prefixes:        make(map[string]string),
+		loaded:          make(map[string]bool),
+		elements:        make(map[qname]definition),
+		complexTypes:    make(map[qname]definition),
+		simpleTypes:     make(map[qname]definition),
+		groups:          make(map[qname]definition),
+		attributeGroups: make(map[qname]definition),
+		attributes:      make(map[qname]definition),
+		resolved:        make(map[qname]*complexType),
+		anonymous:       make(map[*node]*complexType),
+	}
+	doc, err := s.load(location, "")
+	if err != nil {
+		return nil, err
+	}
+	s.target = doc.target
+	return s, nil
+}
+
+// load reads a schema document and registers its global definitions. An included document
+// without a target namespace takes the one of the including document.
+func (s *schemaSet) load(location, includedInto string) (*schemaDoc, error) {
+	s.loaded[location] = true
+	data, err := s.read(location)
+	if err != nil {
+		return nil, err
+	}
+	var root node
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", location, err)
+	}
+	if !root.is("schema") {
+		return nil, fmt.Errorf("%s is not an XML schema", location)
+	}
+
+	doc := &schemaDoc{location: location, target: root.attr("targetNamespace"), prefixes: make(map[string]string)}
+	if doc.target == "" {
+		doc.target = includedInto
+	}
+	for _, a := range root.Attrs {
+		switch {
+		case a.Name.Space == "xmlns":
+			doc.prefixes[a.Name.Local] = a.Value
+			if _, ok := s.prefixes[a.Value]; !ok {
+				s.prefixes[a.Value] = a.Name.Local
+			}
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			doc.prefixes[""] = a.Value
+		}
+	}
+
+	for i := range root.Children {
+		child := &root.Children[i]
+		if child.XMLName.Space != xsdNamespace {
+			continue
+		}
+		switch child.XMLName.Local {
+		case "include", "import", "redefine":
+			ref := child.attr("schemaLocation")
+			if ref == "" {
+				continue
+			}
+			included := resolveLocation(location, ref)
+			if s.loaded[included] {
+				continue
+			}
+			target := ""
+			if child.XMLName.Local != "import" {
+				target = doc.target
+			}
+			if _, err := s.load(included, target); err != nil {
+				return nil, err
+			}
+		case "element", "complexType", "simpleType", "group", "attributeGroup", "attribute":
+			definitions := map[string]map[qname]definition{
+				"element":        s.elements,
+				"complexType":    s.complexTypes,
+				"simpleType":     s.simpleTypes,
+				"group":          s.groups,
+				"attributeGroup": s.attributeGroups,
+				"attribute":      s.attributes,
+			}[child.XMLName.Local]
+			definitions[qname{doc.target, child.attr("name")}] = definition{doc: doc, node: child}
+		}
+	}
+	return doc, nil
+}
+
+// read returns the content of a schema file or URL, from s.dir if it has a file of the
+// same name
+func (s *schemaSet) read(location string) ([]byte, error) {
+	if s.dir != "" {
+		name := location
+		if u, err := url.Parse(location); err == nil && u.Scheme != "" {
+			name = u.Path
+		}
+		if data, err := os.ReadFile(filepath.Join(s.dir, path.Base(filepath.ToSlash(name)))); err == nil {
+			return data, nil
+		}
+	}
+	if !isURL(location) {
+		return os.ReadFile(location)
+	}
+
+	client := &http.Client{Timeout: time.Minute}
+	resp, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: %s", location, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// resolveLocation resolves a schemaLocation against the location of the document
+// referring to it
+func resolveLocation(base, ref string) string {
+	if isURL(ref) || filepath.IsAbs(ref) {
+		return ref
+	}
+	if isURL(base) {
+		if baseURL, err := url.Parse(base); err == nil {
+			if refURL, err := url.Parse(ref); err == nil {
+				return baseURL.ResolveReference(refURL).String()
+			}
+		}
+	}
+	return filepath.Join(filepath.Dir(base), filepath.FromSlash(ref))
+}
+
+// rootElement returns the global element name, preferring the target namespace of the
+// main document
+func (s *schemaSet) rootElement(name string) (*particle, error) {
+	def, ok := s.elements[qname{s.target, name}]
+	if !ok {
+		for key, d := range s.elements {
+			if key.local == name {
+				def, ok = d, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("the schema has no element %s", name)
+	}
+	root := &particle{min: 1, max: 1}
+	if err := s.declareElement(root, def.doc, def.node); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// declareElement fills in the name, type and documentation of an element declaration or
+// reference
+func (s *schemaSet) declareElement(p *particle, doc *schemaDoc, n *node) error {
+	if ref := n.attr("ref"); ref != "" {
+		def, ok := s.elements[doc.resolve(ref)]
+		if !ok {
+			return fmt.Errorf("%s: unknown element %s", doc.location, ref)
+		}
+		if err := s.declareElement(p, def.doc, def.node); err != nil {
+			return err
+		}
+		if text := n.documentation(); text != "" {
+			p.doc = text
+		}
+		return nil
+	}
+
+	p.name = n.attr("name")
+	p.doc = n.documentation()
+	if typeName := n.attr("type"); typeName != "" {
+		var err error
+		p.simple, p.complex, err = s.typeNamed(doc, typeName)
+		return err
+	}
+	for i := range n.Children {
+		switch child := &n.Children[i]; {
+		case child.is("complexType"):
+			t, err := s.anonymousType(doc, child, p.name)
+			if t != nil && t.doc == "" {
+				t.doc = p.doc
+			}
+			p.complex = t
+			return err
+		case child.is("simpleType"):
+			var err error
+			p.simple, err = s.simpleGoType(doc, child)
+			return err
+		}
+	}
+	// xs:anyType
+	p.simple = "string"
+	return nil
+}
+
+// typeNamed resolves a type attribute to a Go type for simple types or a complex type
+func (s *schemaSet) typeNamed(doc *schemaDoc, typeName string) (string, *complexType, error) {
+	name := doc.resolve(typeName)
+	if name.space == xsdNamespace {
+		if name.local == "anyType" {
+			return "string", nil, nil
+		}
+		return builtinGoType(name.local), nil, nil
+	}
+	if _, ok := s.complexTypes[name]; ok {
+		t, err := s.complexType(name)
+		return "", t, err
+	}
+	if def, ok := s.simpleTypes[name]; ok {
+		goType, err := s.simpleGoType(def.doc, def.node)
+		return goType, nil, err
+	}
+	return "", nil, fmt.Errorf("%s: unknown type %s", doc.location, typeName)
+}
+
+// simpleGoType returns the Go type of a simple type: bool or int if it derives from a
+// boolean or integer type, else string
+func (s *schemaSet) simpleGoType(doc *schemaDoc, n *node) (string, error) {
+	for i := range n.Children {
+		child := &n.Children[i]
+		if !child.is("restriction") {
+			if child.is("list") || child.is("union") {
+				return "string", nil
+			}
+			continue
+		}
+		if base := child.attr("base"); base != "" {
+			goType, _, err := s.typeNamed(doc, base)
+			return goType, err
+		}
+		for j := range child.Children {
+			if child.Children[j].is("simpleType") {
+				return s.simpleGoType(doc, &child.Children[j])
+			}
+		}
+	}
+	return "string", nil
+}
+
+// builtinGoType returns the Go type of an XSD built-in type
+func builtinGoType(local string) string {
+	switch local {
+	case "boolean":
+		return "bool"
+	case "byte", "short", "int", "integer", "long", "negativeInteger", "nonNegativeInteger",
+		"nonPositiveInteger", "positiveInteger", "unsignedByte", "unsignedShort", "unsignedInt", "unsignedLong":
+		return "int"
+	}
+	return "string"
+}
+
+// complexType resolves the global complex type name
+func (s *schemaSet) complexType(name qname) (*complexType, error) {
+	if t, ok := s.resolved[name]; ok {
+		return t, nil
+	}
+	def := s.complexTypes[name]
+	t := &complexType{name: name, doc: def.node.documentation()}
+	// Registered before its content is resolved, as types may contain themselves
+	s.resolved[name] = t
+	if err := s.resolveContent(t, def.doc, def.node); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// anonymousType resolves the complex type declared inside the element named element
+func (s *schemaSet) anonymousType(doc *schemaDoc, n *node, element string) (*complexType, error) {
+	if t, ok := s.anonymous[n]; ok {
+		return t, nil
+	}
+	t := &complexType{name: qname{doc.target, element}, anonymous: true, doc: n.documentation()}
+	s.anonymous[n] = t
+	if err := s.resolveContent(t, doc, n); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// resolveContent fills in the attributes and elements of t from its complexType node, or
+// from the extension or restriction node of its simple or complex content
+func (s *schemaSet) resolveContent(t *complexType, doc *schemaDoc, n *node) error {
+	if n.attr("mixed") == "true" {
+		t.value = "string"
+	}
+	for i := range n.Children {
+		child := &n.Children[i]
+		if child.XMLName.Space != xsdNamespace {
+			continue
+		}
+		switch child.XMLName.Local {
+		case "sequence", "choice", "all", "group":
+			if err := s.addParticles(t, doc, child, 1, 1); err != nil {
+				return err
+			}
+		case "attribute", "attributeGroup":
+			if err := s.addAttributes(t, doc, child); err != nil {
+				return err
+			}
+		case "simpleContent", "complexContent":
+			for j := range child.Children {
+				derivation := &child.Children[j]
+				if !derivation.is("extension") && !derivation.is("restriction") {
+					continue
+				}
+				if err := s.derive(t, doc, derivation, child.XMLName.Local == "simpleContent"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// derive resolves the content of a type extending or restricting a base type
+func (s *schemaSet) derive(t *complexType, doc *schemaDoc, n *node, simpleContent bool) error {
+	simple, base, err := s.typeNamed(doc, n.attr("base"))
+	if err != nil {
+		return err
+	}
+	switch {
+	case base != nil && (n.is("extension") || simpleContent):
+		// A restriction of complex content restates the elements it keeps
+		t.value = base.value
+		t.attributes = append(t.attributes, base.attributes...)
+		t.elements = append(t.elements, base.elements...)
+		t.any = base.any
+	case base == nil && simpleContent:
+		t.value = simple
+	}
+	if n.is("restriction") && simpleContent && t.value == "" {
+		t.value = "string"
+	}
+	return s.resolveContent(t, doc, n)
+}
+
+// addParticles adds the elements of a sequence, choice, all or group to t. Occurrences
+// multiply with those of the enclosing particles, and choice branches become optional.
+func (s *schemaSet) addParticles(t *complexType, doc *schemaDoc, n *node, min, max int) error {
+	min *= occurs(n.attr("minOccurs"), 1)
+	max = multiply(max, occurs(n.attr("maxOccurs"), 1))
+
+	if n.is("group") {
+		ref := n.attr("ref")
+		def, ok := s.groups[doc.resolve(ref)]
+		if !ok {
+			return fmt.Errorf("%s: unknown group %s", doc.location, ref)
+		}
+		for i := range def.node.Children {
+			child := &def.node.Children[i]
+			if child.is("sequence") || child.is("choice") || child.is("all") {
+				if err := s.addParticles(t, def.doc, child, min, max); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	branches := 0
+	for i := range n.Children {
+		if n.Children[i].XMLName.Space == xsdNamespace && n.Children[i].XMLName.Local != "annotation" {
+			branches++
+		}
+	}
+	if n.is("choice") && branches > 1 {
+		min = 0
+	}
+
+	for i := range n.Children {
+		child := &n.Children[i]
+		if child.XMLName.Space != xsdNamespace {
+			continue
+		}
+		switch child.XMLName.Local {
+		case "element":
+			p := particle{
+				min: min * occurs(child.attr("minOccurs"), 1),
+				max: multiply(max, occurs(child.attr("maxOccurs"), 1)),
+			}
+			if err := s.declareElement(&p, doc, child); err != nil {
+				return err
+			}
+			t.addElement(p)
+		case "sequence", "choice", "all", "group":
+			if err := s.addParticles(t, doc, child, min, max); err != nil {
+				return err
+			}
+		case "any":
+			t.any = true
+		}
+	}
+	return nil
+}
+
+// addElement adds an element to t, or adds the occurrences of an element t already has,
+// as in choices listing the same element in several branches
+func (t *complexType) addElement(p particle) {
+	existing := t.element(p.name)
+	if existing == nil {
+		t.elements = append(t.elements, p)
+		return
+	}
+	if p.min < existing.min {
+		existing.min = p.min
+	}
+	if existing.max != unbounded {
+		if p.max == unbounded {
+			existing.max = unbounded
+		} else if p.max > existing.max {
+			existing.max = p.max
+		}
+	}
+}
+
+// addAttributes adds an attribute declaration or reference, or an attribute group, to t
+func (s *schemaSet) addAttributes(t *complexType, doc *schemaDoc, n *node) error {
+	if n.is("attributeGroup") {
+		ref := n.attr("ref")
+		def, ok := s.attributeGroups[doc.resolve(ref)]
+		if !ok {
+			return fmt.Errorf("%s: unknown attribute group %s", doc.location, ref)
+		}
+		for i := range def.node.Children {
+			child := &def.node.Children[i]
+			if child.is("attribute") || child.is("attributeGroup") {
+				if err := s.addAttributes(t, def.doc, child); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if n.attr("use") == "prohibited" {
+		return nil
+	}
+	a := attribute{required: n.attr("use") == "required", doc: n.documentation(), simple: "string"}
+	declaration, declaredIn := n, doc
+	if ref := n.attr("ref"); ref != "" {
+		name := doc.resolve(ref)
+		a.name = name.local
+		def, ok := s.attributes[name]
+		if !ok {
+			// Such as xml:lang, from a schema that isn't imported
+			t.attributes = append(t.attributes, a)
+			return nil
+		}
+		declaration, declaredIn = def.node, def.doc
+		if a.doc == "" {
+			a.doc = def.node.documentation()
+		}
+	} else {
+		a.name = n.attr("name")
+	}
+
+	if typeName := declaration.attr("type"); typeName != "" {
+		simple, _, err := s.typeNamed(declaredIn, typeName)
+		if err != nil {
+			return err
+		}
+		a.simple = simple
+	} else {
+		for i := range declaration.Children {
+			if declaration.Children[i].is("simpleType") {
+				simple, err := s.simpleGoType(declaredIn, &declaration.Children[i])
+				if err != nil {
+					return err
+				}
+				a.simple = simple
+			}
+		}
+	}
+	if existing := t.attribute(a.name); existing != nil {
+		*existing = a
+		return nil
+	}
+	t.attributes = append(t.attributes, a)
+	return nil
+}
+
+// occurs parses a minOccurs or maxOccurs value
+func occurs(value string, fallback int) int {
+	if value == "" {
+		return fallback
+	}
+	if value == "unbounded" {
+		return unbounded
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fallback
+	}
+	return n
+}
+
+// multiply multiplies maxOccurs values
+func multiply(a, b int) int {
+	if a == unbounded || b == unbounded {
+		if a == 0 || b == 0 {
+			return 0
+		}
+		return unbounded
+	}
+	return a * b
+}
+
+// cardinality formats occurrences as in the comments of package ddex: 0-1, 1, 0-n, 1-n
+func cardinality(min, max int) string {
+	switch {
+	case max == unbounded:
+		return fmt.Sprintf("%d-n", min)
+	case min == max:
+		return strconv.Itoa(min)
+	}
+	return fmt.Sprintf("%d-%d", min, max)
+}
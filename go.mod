@@ -1,3 +1,10 @@
 module github.com/manosdetijera/ddex
 
 go 1.21
+
+require (
+	github.com/BurntSushi/toml v1.5.0
+	golang.org/x/text v0.14.0
+	google.golang.org/protobuf v1.36.5
+	gopkg.in/yaml.v3 v3.0.1
+)
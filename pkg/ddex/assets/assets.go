@@ -0,0 +1,169 @@
+// Package assets reads tags and stream information from audio files (ID3v2 in MP3,
+// Vorbis comments in FLAC) and video files (through ffprobe) to pre-populate DDEX
+// SoundRecording and Video resources.
+package assets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// ErrUnsupportedFormat is returned when a file is neither MP3 nor FLAC
+var ErrUnsupportedFormat = errors.New("unsupported audio format")
+
+// Audio formats, matching the DDEX AudioCodecType values
+const (
+	FormatMP3  = "MP3"
+	FormatFLAC = "FLAC"
+)
+
+// AudioMetadata holds the tags and stream information read from an audio file
+type AudioMetadata struct {
+	Format      string // FormatMP3 or FormatFLAC
+	FileName    string // Path passed to ReadFile
+	FileSize    int64
+	Title       string
+	Artist      string
+	AlbumArtist string
+	Album       string
+	ISRC        string
+	Genre       string
+	Label       string
+	Year        string
+	TrackNumber int
+
+	Duration      time.Duration
+	SampleRate    int // Hz
+	Channels      int
+	BitsPerSample int // 0 for lossy formats
+	BitRate       int // kbps, averaged over the file for VBR
+}
+
+// ReadFile reads the metadata of an MP3 or FLAC file
+func ReadFile(path string) (*AudioMetadata, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audio file: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat audio file: %w", err)
+	}
+
+	m, err := Read(f, info.Size())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	m.FileName = path
+	return m, nil
+}
+
+// Read reads the metadata of MP3 or FLAC data of the given size
+func Read(r io.ReaderAt, size int64) (*AudioMetadata, error) {
+	m := &AudioMetadata{FileSize: size}
+
+	// FLAC files occasionally carry an ID3v2 tag too, so it is read first in both cases
+	offset, err := readID3v2(r, m)
+	if err != nil {
+		return nil, err
+	}
+
+	magic := make([]byte, 4)
+	if _, err := r.ReadAt(magic, offset); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read audio data: %w", err)
+	}
+
+	if bytes.Equal(magic, []byte("fLaC")) {
+		m.Format = FormatFLAC
+		if err := readFLAC(r, offset, size, m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	if err := readMPEG(r, offset, size, m); err != nil {
+		return nil, err
+	}
+	m.Format = FormatMP3
+	return m, nil
+}
+
+// PrefillOptions configures Prefill
+type PrefillOptions struct {
+	Territories        []string // Defaults to Worldwide
+	Language           string   // Defaults to en (audio only)
+	TechnicalReference string   // TechnicalResourceDetailsReference, e.g. T1
+	FileName           string   // Defaults to the base name of the metadata FileName
+}
+
+// Prefill sets the ISRC, reference title and duration on sb and adds territory details with
+// the display title, artist, genre, label and technical details. The returned territory
+// builder can be used to add what tags don't carry (contributors, P line, ...).
+func (m *AudioMetadata) Prefill(sb *ddex.SoundRecordingBuilder, opts PrefillOptions) *ddex.SoundRecordingDetailsByTerritoryBuilder {
+	territories := opts.Territories
+	if len(territories) == 0 {
+		territories = []string{"Worldwide"}
+	}
+	language := opts.Language
+	if language == "" {
+		language = "en"
+	}
+	fileName := opts.FileName
+	if fileName == "" && m.FileName != "" {
+		fileName = filepath.Base(m.FileName)
+	}
+
+	duration := ""
+	if m.Duration > 0 {
+		duration = ddex.FormatDuration(m.Duration.Seconds())
+		sb.WithDuration(duration)
+	}
+	if m.ISRC != "" {
+		sb.WithISRC(m.ISRC)
+	}
+	if m.Title != "" {
+		sb.WithReferenceTitle(m.Title, "")
+	}
+
+	td := sb.AddSoundRecordingDetailsByTerritory(territories)
+	if m.Title != "" {
+		td.AddTitle(m.Title, "", language, "DisplayTitle")
+	}
+	if m.Artist != "" {
+		td.WithDisplayArtistName(m.Artist, language)
+	}
+	if m.Label != "" {
+		td.WithLabel(m.Label, "", language)
+	}
+	if m.Genre != "" {
+		td.WithGenre(m.Genre)
+	}
+	if m.TrackNumber > 0 {
+		td.WithSequenceNumber(m.TrackNumber)
+	}
+
+	details := ddex.TechnicalSoundRecordingDetails{
+		TechnicalResourceDetailsReference: opts.TechnicalReference,
+		AudioCodecType:                    m.Format,
+		BitRate:                           m.BitRate,
+		NumberOfChannels:                  m.Channels,
+		SamplingRate:                      float64(m.SampleRate),
+		BitsPerSample:                     m.BitsPerSample,
+		Duration:                          duration,
+	}
+	if fileName != "" {
+		details.File = &ddex.File{FileName: fileName, FileSize: int(m.FileSize)}
+	}
+	td.WithTechnicalSoundRecordingDetails(details)
+
+	return td
+}
@@ -0,0 +1,110 @@
+package assets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+func TestAudioPrefillFileName(t *testing.T) {
+	tests := []struct {
+		path   string
+		option string
+		want   string
+	}{
+		{"/data/incoming/track1.flac", "", "track1.flac"},
+		{"resources/track1.flac", "", "track1.flac"},
+		{"track1.flac", "", "track1.flac"},
+		{"/data/incoming/track1.flac", "resources/01.flac", "resources/01.flac"},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		meta := &AudioMetadata{Format: FormatFLAC, FileName: tt.path, FileSize: 1024}
+		builder := ddex.NewDDEXBuilder()
+		meta.Prefill(builder.AddSoundRecording("A1", "MusicalWorkSoundRecording"), PrefillOptions{FileName: tt.option})
+		message := builder.Build()
+
+		details := message.ResourceList.SoundRecording[0].SoundRecordingDetailsByTerritory[0].TechnicalSoundRecordingDetails[0]
+		var got string
+		if details.File != nil {
+			got = details.File.FileName
+		}
+		if got != tt.want {
+			t.Errorf("path %q: FileName = %q, want %q", tt.path, got, tt.want)
+		}
+		if errs := message.CheckFileNames(); len(errs) > 0 {
+			t.Errorf("path %q: CheckFileNames: %v", tt.path, errs)
+		}
+	}
+}
+
+func TestVideoPrefillFileName(t *testing.T) {
+	tests := []struct {
+		path   string
+		option string
+		want   string
+	}{
+		{"/data/incoming/video.mp4", "", "video.mp4"},
+		{"resources/video.mp4", "", "video.mp4"},
+		{"/data/incoming/video.mp4", "resources/01.mp4", "resources/01.mp4"},
+	}
+	for _, tt := range tests {
+		meta := &VideoMetadata{FileName: tt.path, Codec: "h264", Width: 1920, Height: 1080}
+		builder := ddex.NewDDEXBuilder()
+		meta.Prefill(builder.AddVideo("A1", "ShortFormMusicalWorkVideo"), PrefillOptions{FileName: tt.option})
+		message := builder.Build()
+
+		details := message.ResourceList.Video[0].VideoDetailsByTerritory[0].TechnicalVideoDetails[0]
+		if details.File == nil || details.File.FileName != tt.want {
+			t.Errorf("path %q: File = %+v, want FileName %q", tt.path, details.File, tt.want)
+		}
+	}
+}
+
+func TestProbeVideoArguments(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as ffprobe")
+	}
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	script := filepath.Join(dir, "ffprobe")
+	output := `{"streams":[{"codec_type":"video","codec_name":"h264","width":1280,"height":720}],"format":{"format_name":"mp4","duration":"10.5"}}`
+	err := os.WriteFile(script, []byte("#!/bin/sh\nprintf '%s\\n' \"$@\" > '"+argsFile+"'\necho '"+output+"'\n"), 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func(path string) { FFprobePath = path }(FFprobePath)
+	FFprobePath = script
+
+	tests := []struct {
+		path  string
+		input string
+	}{
+		{"-video.mp4", "./-video.mp4"},
+		{"http:video.mp4", "./http:video.mp4"},
+		{"resources/video.mp4", "./resources/video.mp4"},
+		{"/data/video.mp4", "/data/video.mp4"},
+	}
+	for _, tt := range tests {
+		meta, err := ProbeVideo(context.Background(), tt.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		args, err := os.ReadFile(argsFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(strings.TrimSpace(string(args)), "\n")
+		if n := len(lines); n < 2 || lines[n-2] != "-i" || lines[n-1] != tt.input {
+			t.Errorf("path %q: ffprobe arguments %q, want them to end with -i %s", tt.path, lines, tt.input)
+		}
+		if meta.FileName != tt.path || meta.Height != 720 {
+			t.Errorf("path %q: got %+v", tt.path, meta)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package assets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// FFprobePath is the ffprobe binary used by ProbeVideo; it is looked up in PATH by default
+var FFprobePath = "ffprobe"
+
+// VideoMetadata holds the stream information of a video file as reported by ffprobe
+type VideoMetadata struct {
+	FileName   string
+	FileSize   int64
+	Container  string // ffprobe format_name, e.g. "mov,mp4,m4a,3gp,3g2,mj2"
+	Codec      string // ffprobe codec_name of the first video stream, e.g. h264
+	Width      int
+	Height     int
+	FrameRate  float64
+	AudioCodec string
+	BitRate    int // kbps, overall
+	Duration   time.Duration
+}
+
+// ffprobeOutput is the subset of `ffprobe -print_format json -show_format -show_streams`
+type ffprobeOutput struct {
+	Streams []struct {
+		CodecType    string `json:"codec_type"`
+		CodecName    string `json:"codec_name"`
+		Width        int    `json:"width"`
+		Height       int    `json:"height"`
+		AvgFrameRate string `json:"avg_frame_rate"`
+		Duration     string `json:"duration"`
+	} `json:"streams"`
+	Format struct {
+		Filename   string `json:"filename"`
+		FormatName string `json:"format_name"`
+		Duration   string `json:"duration"`
+		Size       string `json:"size"`
+		BitRate    string `json:"bit_rate"`
+	} `json:"format"`
+}
+
+// ProbeVideo runs ffprobe on path and parses its output
+func ProbeVideo(ctx context.Context, path string) (*VideoMetadata, error) {
+	input := path
+	if !filepath.IsAbs(input) {
+		// keep ffprobe from reading names such as -f or http:x as options or protocols
+		input = "." + string(filepath.Separator) + input
+	}
+	cmd := exec.CommandContext(ctx, FFprobePath,
+		"-v", "error",
+		"-print_format", "json",
+		"-show_format",
+		"-show_streams",
+		"-i", input,
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("ffprobe failed: %w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("ffprobe failed: %w", err)
+	}
+
+	m, err := ParseFFprobe(output)
+	if err != nil {
+		return nil, err
+	}
+	m.FileName = path
+	if m.FileSize == 0 {
+		if info, err := os.Stat(path); err == nil {
+			m.FileSize = info.Size()
+		}
+	}
+	return m, nil
+}
+
+// ParseFFprobe parses the JSON written by
+// `ffprobe -print_format json -show_format -show_streams <file>`, for pipelines that run
+// ffprobe themselves
+func ParseFFprobe(data []byte) (*VideoMetadata, error) {
+	var probe ffprobeOutput
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("failed to parse ffprobe output: %w", err)
+	}
+
+	m := &VideoMetadata{
+		FileName:  probe.Format.Filename,
+		Container: probe.Format.FormatName,
+	}
+	m.FileSize, _ = strconv.ParseInt(probe.Format.Size, 10, 64)
+	if bitRate, err := strconv.Atoi(probe.Format.BitRate); err == nil {
+		m.BitRate = bitRate / 1000
+	}
+	m.Duration = ffprobeDuration(probe.Format.Duration)
+
+	foundVideo := false
+	for _, stream := range probe.Streams {
+		switch stream.CodecType {
+		case "video":
+			if foundVideo {
+				continue
+			}
+			foundVideo = true
+			m.Codec = stream.CodecName
+			m.Width = stream.Width
+			m.Height = stream.Height
+			m.FrameRate = ffprobeRate(stream.AvgFrameRate)
+			if m.Duration == 0 {
+				m.Duration = ffprobeDuration(stream.Duration)
+			}
+		case "audio":
+			if m.AudioCodec == "" {
+				m.AudioCodec = stream.CodecName
+			}
+		}
+	}
+	if !foundVideo {
+		return nil, fmt.Errorf("ffprobe output has no video stream")
+	}
+
+	return m, nil
+}
+
+// VideoCodecType maps the ffprobe codec name onto a DDEX VideoCodecType
+func (m *VideoMetadata) VideoCodecType() string {
+	switch m.Codec {
+	case "h264":
+		return "H.264"
+	case "hevc":
+		return "H.265"
+	case "mpeg1video":
+		return "MPEG-1"
+	case "mpeg2video":
+		return "MPEG-2"
+	case "mpeg4":
+		return "MPEG-4"
+	case "prores":
+		return "ProRes"
+	case "wmv1", "wmv2", "wmv3":
+		return "WMV"
+	case "":
+		return ""
+	default:
+		return "UserDefined"
+	}
+}
+
+// VideoDefinitionType returns HighDefinition for 720 lines and above, StandardDefinition otherwise
+func (m *VideoMetadata) VideoDefinitionType() string {
+	if m.Height == 0 {
+		return ""
+	}
+	// Portrait videos are classified by their shorter side
+	lines := m.Height
+	if m.Width > 0 && m.Width < lines {
+		lines = m.Width
+	}
+	if lines >= 720 {
+		return "HighDefinition"
+	}
+	return "StandardDefinition"
+}
+
+// Prefill sets the duration on vb and adds territory details carrying technical video
+// details (codec, definition, duration, file name and size). The returned territory builder
+// can be used to add titles, artists and the rest of the territory metadata.
+func (m *VideoMetadata) Prefill(vb *ddex.VideoBuilder, opts PrefillOptions) *ddex.VideoDetailsByTerritoryBuilder {
+	territories := opts.Territories
+	if len(territories) == 0 {
+		territories = []string{"Worldwide"}
+	}
+	fileName := opts.FileName
+	if fileName == "" && m.FileName != "" {
+		fileName = filepath.Base(m.FileName)
+	}
+
+	duration := ""
+	if m.Duration > 0 {
+		duration = ddex.FormatDuration(m.Duration.Seconds())
+		vb.WithDuration(duration)
+	}
+
+	details := ddex.TechnicalVideoDetails{
+		TechnicalResourceDetailsReference: opts.TechnicalReference,
+		VideoCodecType:                    m.VideoCodecType(),
+		VideoDefinitionType:               m.VideoDefinitionType(),
+		Duration:                          duration,
+	}
+	if fileName != "" {
+		details.File = &ddex.File{FileName: fileName, FileSize: int(m.FileSize)}
+	}
+
+	return vb.AddVideoDetailsByTerritory(territories).WithTechnicalVideoDetails(details)
+}
+
+// ffprobeDuration parses a duration in (fractional) seconds
+func ffprobeDuration(value string) time.Duration {
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// ffprobeRate parses a rational frame rate such as "30000/1001"
+func ffprobeRate(value string) float64 {
+	num, den, found := strings.Cut(value, "/")
+	n, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0
+	}
+	if !found {
+		return n
+	}
+	d, err := strconv.ParseFloat(den, 64)
+	if err != nil || d == 0 {
+		return 0
+	}
+	return n / d
+}
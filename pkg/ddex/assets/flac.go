@@ -0,0 +1,129 @@
+package assets
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FLAC metadata block types
+const (
+	flacStreamInfo    = 0
+	flacVorbisComment = 4
+)
+
+// readFLAC reads the STREAMINFO and VORBIS_COMMENT blocks of a FLAC stream starting at
+// offset (the "fLaC" marker)
+func readFLAC(r io.ReaderAt, offset, size int64, m *AudioMetadata) error {
+	pos := offset + 4
+	header := make([]byte, 4)
+	var totalSamples uint64
+
+	for {
+		if _, err := r.ReadAt(header, pos); err != nil {
+			return fmt.Errorf("failed to read FLAC metadata block: %w", err)
+		}
+		last := header[0]&0x80 != 0
+		blockType := header[0] & 0x7F
+		length := int64(header[1])<<16 | int64(header[2])<<8 | int64(header[3])
+		pos += 4
+		if pos+length > size {
+			return fmt.Errorf("FLAC metadata block exceeds file size")
+		}
+
+		if blockType == flacStreamInfo || blockType == flacVorbisComment {
+			block := make([]byte, length)
+			if _, err := r.ReadAt(block, pos); err != nil && err != io.EOF {
+				return fmt.Errorf("failed to read FLAC metadata block: %w", err)
+			}
+			switch blockType {
+			case flacStreamInfo:
+				if len(block) < 18 {
+					return fmt.Errorf("FLAC STREAMINFO block is too short")
+				}
+				m.SampleRate = int(block[10])<<12 | int(block[11])<<4 | int(block[12])>>4
+				m.Channels = int(block[12]>>1&0x07) + 1
+				m.BitsPerSample = int(block[12]&0x01)<<4 | int(block[13]>>4) + 1
+				totalSamples = uint64(block[13]&0x0F)<<32 | uint64(binary.BigEndian.Uint32(block[14:18]))
+			case flacVorbisComment:
+				m.setVorbisComments(block)
+			}
+		}
+
+		pos += length
+		if last {
+			break
+		}
+	}
+
+	if m.SampleRate > 0 && totalSamples > 0 {
+		seconds := float64(totalSamples) / float64(m.SampleRate)
+		m.Duration = time.Duration(seconds * float64(time.Second))
+		m.BitRate = int(float64(size-pos) * 8 / seconds / 1000)
+	}
+	return nil
+}
+
+// setVorbisComments parses a VORBIS_COMMENT block (little-endian lengths, KEY=value pairs)
+func (m *AudioMetadata) setVorbisComments(block []byte) {
+	if len(block) < 4 {
+		return
+	}
+	vendorLength := int(binary.LittleEndian.Uint32(block))
+	pos := 4 + vendorLength
+	if pos+4 > len(block) {
+		return
+	}
+	count := int(binary.LittleEndian.Uint32(block[pos:]))
+	pos += 4
+
+	for i := 0; i < count && pos+4 <= len(block); i++ {
+		length := int(binary.LittleEndian.Uint32(block[pos:]))
+		pos += 4
+		if length < 0 || pos+length > len(block) {
+			return
+		}
+		key, value, found := strings.Cut(string(block[pos:pos+length]), "=")
+		pos += length
+		if !found || value == "" {
+			continue
+		}
+
+		// The first occurrence of a field wins
+		switch strings.ToUpper(key) {
+		case "TITLE":
+			setOnce(&m.Title, value)
+		case "ARTIST":
+			setOnce(&m.Artist, value)
+		case "ALBUMARTIST":
+			setOnce(&m.AlbumArtist, value)
+		case "ALBUM":
+			setOnce(&m.Album, value)
+		case "ISRC":
+			setOnce(&m.ISRC, strings.ToUpper(strings.ReplaceAll(value, "-", "")))
+		case "GENRE":
+			setOnce(&m.Genre, value)
+		case "LABEL", "ORGANIZATION", "PUBLISHER":
+			setOnce(&m.Label, value)
+		case "DATE", "YEAR":
+			if len(value) >= 4 {
+				setOnce(&m.Year, value[:4])
+			}
+		case "TRACKNUMBER":
+			if m.TrackNumber == 0 {
+				number, _, _ := strings.Cut(value, "/")
+				m.TrackNumber, _ = strconv.Atoi(strings.TrimSpace(number))
+			}
+		}
+	}
+}
+
+// setOnce assigns value to field unless it is already set
+func setOnce(field *string, value string) {
+	if *field == "" {
+		*field = strings.TrimSpace(value)
+	}
+}
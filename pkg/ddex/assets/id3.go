@@ -0,0 +1,230 @@
+package assets
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// ID3v2 header and frame flags
+const (
+	id3Unsynchronisation   = 0x80
+	id3ExtendedHeader      = 0x40
+	id3Footer              = 0x10
+	id3FrameCompression    = 0x08
+	id3FrameEncryption     = 0x04
+	id3FrameUnsync         = 0x02
+	id3FrameDataLengthInd  = 0x01
+	id3v23FrameCompression = 0x80
+	id3v23FrameEncryption  = 0x40
+)
+
+// readID3v2 reads an ID3v2.3/2.4 tag at the start of r into m and returns the offset of
+// the audio data following it (0 when there is no tag)
+func readID3v2(r io.ReaderAt, m *AudioMetadata) (int64, error) {
+	header := make([]byte, 10)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		if err == io.EOF {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read ID3 header: %w", err)
+	}
+	if !bytes.Equal(header[:3], []byte("ID3")) {
+		return 0, nil
+	}
+
+	major := header[3]
+	flags := header[5]
+	size := int(syncsafe(header[6:10]))
+	end := int64(10 + size)
+	if flags&id3Footer != 0 {
+		end += 10
+	}
+	if major != 3 && major != 4 {
+		// ID3v2.2 uses three-character frame IDs; skip the tag rather than misread it
+		return end, nil
+	}
+
+	data := make([]byte, size)
+	if _, err := r.ReadAt(data, 10); err != nil && err != io.EOF {
+		return 0, fmt.Errorf("failed to read ID3 tag: %w", err)
+	}
+	if major == 3 && flags&id3Unsynchronisation != 0 {
+		data = removeUnsynchronisation(data)
+	}
+
+	pos := 0
+	if flags&id3ExtendedHeader != 0 && len(data) >= 4 {
+		if major == 3 {
+			pos = 4 + int(binary.BigEndian.Uint32(data[:4]))
+		} else {
+			pos = int(syncsafe(data[:4]))
+		}
+	}
+
+	for pos+10 <= len(data) {
+		id := string(data[pos : pos+4])
+		if data[pos] == 0 {
+			break // Padding
+		}
+
+		var frameSize int
+		if major == 4 {
+			frameSize = int(syncsafe(data[pos+4 : pos+8]))
+		} else {
+			frameSize = int(binary.BigEndian.Uint32(data[pos+4 : pos+8]))
+		}
+		formatFlags := data[pos+9]
+		pos += 10
+		if frameSize <= 0 || pos+frameSize > len(data) {
+			break
+		}
+		body := data[pos : pos+frameSize]
+		pos += frameSize
+
+		if major == 4 {
+			if formatFlags&(id3FrameCompression|id3FrameEncryption) != 0 {
+				continue
+			}
+			if formatFlags&id3FrameDataLengthInd != 0 && len(body) >= 4 {
+				body = body[4:]
+			}
+			if formatFlags&id3FrameUnsync != 0 || flags&id3Unsynchronisation != 0 {
+				body = removeUnsynchronisation(body)
+			}
+		} else if formatFlags&(id3v23FrameCompression|id3v23FrameEncryption) != 0 {
+			continue
+		}
+
+		if id[0] != 'T' || id == "TXXX" || len(body) == 0 {
+			continue
+		}
+		m.setID3Frame(id, decodeID3Text(body))
+	}
+
+	return end, nil
+}
+
+// setID3Frame maps a text frame onto the metadata
+func (m *AudioMetadata) setID3Frame(id, value string) {
+	if value == "" {
+		return
+	}
+	switch id {
+	case "TIT2":
+		m.Title = value
+	case "TPE1":
+		m.Artist = value
+	case "TPE2":
+		m.AlbumArtist = value
+	case "TALB":
+		m.Album = value
+	case "TSRC":
+		m.ISRC = strings.ToUpper(strings.ReplaceAll(value, "-", ""))
+	case "TCON":
+		m.Genre = id3Genre(value)
+	case "TPUB":
+		m.Label = value
+	case "TYER", "TDRC":
+		if len(value) >= 4 {
+			m.Year = value[:4]
+		}
+	case "TRCK":
+		number, _, _ := strings.Cut(value, "/")
+		m.TrackNumber, _ = strconv.Atoi(strings.TrimSpace(number))
+	case "TLEN":
+		// Only used when the audio stream itself doesn't yield a duration
+		if ms, err := strconv.Atoi(value); err == nil && m.Duration == 0 {
+			m.Duration = time.Duration(ms) * time.Millisecond
+		}
+	}
+}
+
+// decodeID3Text decodes a text frame body, returning its first value
+func decodeID3Text(body []byte) string {
+	encoding, text := body[0], body[1:]
+
+	var value string
+	switch encoding {
+	case 1, 2: // UTF-16 with BOM, UTF-16BE
+		order := binary.ByteOrder(binary.BigEndian)
+		if encoding == 1 && len(text) >= 2 {
+			if text[0] == 0xFF && text[1] == 0xFE {
+				order = binary.LittleEndian
+			}
+			if (text[0] == 0xFF && text[1] == 0xFE) || (text[0] == 0xFE && text[1] == 0xFF) {
+				text = text[2:]
+			}
+		}
+		units := make([]uint16, 0, len(text)/2)
+		for i := 0; i+1 < len(text); i += 2 {
+			units = append(units, order.Uint16(text[i:]))
+		}
+		value = string(utf16.Decode(units))
+	case 3: // UTF-8
+		value = string(text)
+	default: // ISO-8859-1
+		runes := make([]rune, len(text))
+		for i, b := range text {
+			runes[i] = rune(b)
+		}
+		value = string(runes)
+	}
+
+	// ID3v2.4 separates multiple values with NUL
+	value, _, _ = strings.Cut(value, "\x00")
+	return strings.TrimSpace(value)
+}
+
+// id3Genre strips ID3v1 numeric genre references such as "(17)Rock" or "(17)"
+func id3Genre(value string) string {
+	for strings.HasPrefix(value, "(") {
+		end := strings.Index(value, ")")
+		if end < 0 {
+			break
+		}
+		ref := value[1:end]
+		rest := value[end+1:]
+		if rest == "" {
+			if n, err := strconv.Atoi(ref); err == nil && n >= 0 && n < len(id3v1Genres) {
+				return id3v1Genres[n]
+			}
+			return value
+		}
+		value = rest
+	}
+	if n, err := strconv.Atoi(value); err == nil && n >= 0 && n < len(id3v1Genres) {
+		return id3v1Genres[n]
+	}
+	return value
+}
+
+// syncsafe decodes a 28-bit ID3 synchsafe integer
+func syncsafe(b []byte) uint32 {
+	return uint32(b[0]&0x7F)<<21 | uint32(b[1]&0x7F)<<14 | uint32(b[2]&0x7F)<<7 | uint32(b[3]&0x7F)
+}
+
+// removeUnsynchronisation reverses the ID3 unsynchronisation scheme (0xFF 0x00 -> 0xFF)
+func removeUnsynchronisation(data []byte) []byte {
+	return bytes.ReplaceAll(data, []byte{0xFF, 0x00}, []byte{0xFF})
+}
+
+// id3v1Genres is the standard ID3v1 genre list referenced by numeric TCON values
+var id3v1Genres = []string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop",
+	"Jazz", "Metal", "New Age", "Oldies", "Other", "Pop", "R&B", "Rap", "Reggae", "Rock",
+	"Techno", "Industrial", "Alternative", "Ska", "Death Metal", "Pranks", "Soundtrack",
+	"Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk", "Fusion", "Trance",
+	"Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"Alternative Rock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop",
+	"Instrumental Rock", "Ethnic", "Gothic", "Darkwave", "Techno-Industrial", "Electronic",
+	"Pop-Folk", "Eurodance", "Dream", "Southern Rock", "Comedy", "Cult", "Gangsta",
+	"Top 40", "Christian Rap", "Pop/Funk", "Jungle", "Native American", "Cabaret",
+	"New Wave", "Psychedelic", "Rave", "Showtunes", "Trailer", "Lo-Fi", "Tribal",
+	"Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+}
@@ -0,0 +1,186 @@
+package assets
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// maxSyncSearch limits how far past the ID3 tag the first MPEG frame is searched for
+const maxSyncSearch = 64 << 10
+
+// MPEG audio versions as encoded in the frame header
+const (
+	mpeg25 = 0
+	mpeg2  = 2
+	mpeg1  = 3
+)
+
+// mpegBitRates in kbps, indexed by [MPEG1?0:1][layer-1][index]
+var mpegBitRates = [2][3][16]int{
+	{ // MPEG1
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448, 0},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384, 0},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0},
+	},
+	{ // MPEG2 and 2.5
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256, 0},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+	},
+}
+
+// mpegSampleRates in Hz, indexed by version then index
+var mpegSampleRates = map[int][3]int{
+	mpeg1:  {44100, 48000, 32000},
+	mpeg2:  {22050, 24000, 16000},
+	mpeg25: {11025, 12000, 8000},
+}
+
+// mpegFrame is a decoded MPEG audio frame header
+type mpegFrame struct {
+	version    int
+	layer      int // 1, 2 or 3
+	bitRate    int // kbps
+	sampleRate int
+	channels   int
+}
+
+// parseMPEGFrame decodes a 4-byte frame header, reporting false if it isn't one
+func parseMPEGFrame(h []byte) (mpegFrame, bool) {
+	if h[0] != 0xFF || h[1]&0xE0 != 0xE0 {
+		return mpegFrame{}, false
+	}
+	version := int(h[1]>>3) & 3
+	layerBits := int(h[1]>>1) & 3
+	bitRateIndex := int(h[2] >> 4)
+	sampleRateIndex := int(h[2]>>2) & 3
+	if version == 1 || layerBits == 0 || bitRateIndex == 0 || bitRateIndex == 15 || sampleRateIndex == 3 {
+		return mpegFrame{}, false
+	}
+
+	frame := mpegFrame{
+		version:    version,
+		layer:      4 - layerBits,
+		sampleRate: mpegSampleRates[version][sampleRateIndex],
+		channels:   2,
+	}
+	table := 0
+	if version != mpeg1 {
+		table = 1
+	}
+	frame.bitRate = mpegBitRates[table][frame.layer-1][bitRateIndex]
+	if h[3]>>6 == 3 {
+		frame.channels = 1
+	}
+	return frame, true
+}
+
+// samplesPerFrame returns the number of PCM samples encoded by one frame
+func (f mpegFrame) samplesPerFrame() int {
+	switch {
+	case f.layer == 1:
+		return 384
+	case f.layer == 3 && f.version != mpeg1:
+		return 576
+	default:
+		return 1152
+	}
+}
+
+// xingOffset returns where a Xing/Info header would start relative to the frame header
+func (f mpegFrame) xingOffset() int {
+	if f.version == mpeg1 {
+		if f.channels == 1 {
+			return 4 + 17
+		}
+		return 4 + 32
+	}
+	if f.channels == 1 {
+		return 4 + 9
+	}
+	return 4 + 17
+}
+
+// readMPEG locates the first MPEG audio frame after offset and derives the stream
+// parameters and duration, using a Xing/Info or VBRI header for VBR files
+func readMPEG(r io.ReaderAt, offset, size int64, m *AudioMetadata) error {
+	window := int64(maxSyncSearch)
+	if offset+window > size {
+		window = size - offset
+	}
+	if window < 4 {
+		return ErrUnsupportedFormat
+	}
+	buf := make([]byte, window)
+	if _, err := r.ReadAt(buf, offset); err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read audio data: %w", err)
+	}
+
+	for i := 0; i+4 <= len(buf); i++ {
+		frame, ok := parseMPEGFrame(buf[i : i+4])
+		if !ok {
+			continue
+		}
+
+		m.SampleRate = frame.sampleRate
+		m.Channels = frame.channels
+		m.BitRate = frame.bitRate
+
+		audioStart := offset + int64(i)
+		audioBytes := size - audioStart
+		if hasID3v1(r, size) {
+			audioBytes -= 128
+		}
+
+		frames := vbrFrameCount(buf[i:], frame)
+		if frames > 0 {
+			seconds := float64(frames) * float64(frame.samplesPerFrame()) / float64(frame.sampleRate)
+			m.Duration = time.Duration(seconds * float64(time.Second))
+			if seconds > 0 {
+				m.BitRate = int(float64(audioBytes) * 8 / seconds / 1000)
+			}
+		} else if frame.bitRate > 0 {
+			seconds := float64(audioBytes) * 8 / float64(frame.bitRate*1000)
+			m.Duration = time.Duration(seconds * float64(time.Second))
+		}
+		return nil
+	}
+
+	return ErrUnsupportedFormat
+}
+
+// vbrFrameCount reads the frame count from a Xing/Info or VBRI header in the first frame
+func vbrFrameCount(data []byte, frame mpegFrame) int {
+	if off := frame.xingOffset(); off+12 <= len(data) {
+		tag := data[off : off+4]
+		if bytes.Equal(tag, []byte("Xing")) || bytes.Equal(tag, []byte("Info")) {
+			flags := binary.BigEndian.Uint32(data[off+4:])
+			if flags&1 != 0 {
+				return int(binary.BigEndian.Uint32(data[off+8:]))
+			}
+			return 0
+		}
+	}
+
+	// VBRI: tag, version, delay, quality, byte count, frame count
+	const vbriOffset = 4 + 32
+	if vbriOffset+18 <= len(data) && bytes.Equal(data[vbriOffset:vbriOffset+4], []byte("VBRI")) {
+		return int(binary.BigEndian.Uint32(data[vbriOffset+14:]))
+	}
+	return 0
+}
+
+// hasID3v1 reports whether the file ends with a 128-byte ID3v1 tag
+func hasID3v1(r io.ReaderAt, size int64) bool {
+	if size < 128 {
+		return false
+	}
+	tag := make([]byte, 3)
+	if _, err := r.ReadAt(tag, size-128); err != nil {
+		return false
+	}
+	return bytes.Equal(tag, []byte("TAG"))
+}
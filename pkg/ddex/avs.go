@@ -0,0 +1,135 @@
+package ddex
+
+import (
+	"fmt"
+	"sync"
+)
+
+// The tables of avs_values.go are generated from the DDEX allowed-value sets. go generate
+// refreshes them from the current AVS and prints the codes added, removed and deprecated.
+//go:generate go run ../../cmd/avsgen -sets CommercialModelType,ImageType,ParentalWarningType,ReleaseType,ResourceRelationshipType,SoundRecordingType,UseType,VideoType
+
+// userDefined is the code every set allows for values outside it
+const userDefined = "UserDefined"
+
+var (
+	avsIndexOnce  sync.Once
+	avsIndex      map[string]map[string]bool // allowedValues as sets
+	avsDeprecated map[string]map[string]bool
+)
+
+func indexAVS() {
+	avsIndexOnce.Do(func() {
+		avsIndex = make(map[string]map[string]bool, len(allowedValues))
+		for set, codes := range allowedValues {
+			avsIndex[set] = stringSet(codes)
+		}
+		avsDeprecated = make(map[string]map[string]bool, len(deprecatedValues))
+		for set, codes := range deprecatedValues {
+			avsDeprecated[set] = stringSet(codes)
+		}
+	})
+}
+
+// AllowedValues returns the codes of a DDEX allowed-value set such as "UseType" or
+// "CommercialModelType" as of AVSVersion, or nil if the tables don't include the set
+func AllowedValues(set string) []string {
+	return append([]string(nil), allowedValues[set]...)
+}
+
+// IsAllowedValue reports whether code is in the allowed-value set. UserDefined and the
+// codes of sets the tables don't include are always allowed.
+func IsAllowedValue(set, code string) bool {
+	indexAVS()
+	codes, ok := avsIndex[set]
+	return !ok || code == userDefined || codes[code]
+}
+
+// IsDeprecatedValue reports whether the AVS documents code as deprecated in the set
+func IsDeprecatedValue(set, code string) bool {
+	indexAVS()
+	return avsDeprecated[set][code]
+}
+
+// CheckAllowedValues reports release, resource and deal types, parental warnings,
+// commercial models and use types that are not in their allowed-value set (see
+// IsAllowedValue)
+func (nrm *NewReleaseMessage) CheckAllowedValues() ValidationErrors {
+	var errs ValidationErrors
+	check := func(path, set, code string) {
+		if code != "" && !IsAllowedValue(set, code) {
+			errs.add(path, "%q is not a %s of AVS %s", code, set, AVSVersion)
+		}
+	}
+	checkEach := func(path, set string, codes []string) {
+		for i, code := range codes {
+			check(fmt.Sprintf("%s[%d]", path, i), set, code)
+		}
+	}
+
+	if nrm.ReleaseList != nil {
+		for _, release := range nrm.ReleaseList.Release {
+			path := fmt.Sprintf("Release[%s]", release.ReleaseReference)
+			for i, releaseType := range release.ReleaseType {
+				check(fmt.Sprintf("%s.ReleaseType[%d]", path, i), "ReleaseType", releaseType.Value)
+			}
+			for i, related := range release.RelatedResource {
+				check(fmt.Sprintf("%s.RelatedResource[%d].ResourceRelationshipType", path, i), "ResourceRelationshipType", related.ResourceRelationshipType)
+			}
+			for i, details := range release.ReleaseDetailsByTerritory {
+				detailsPath := fmt.Sprintf("%s.ReleaseDetailsByTerritory[%d]", path, i)
+				for j, releaseType := range details.ReleaseType {
+					check(fmt.Sprintf("%s.ReleaseType[%d]", detailsPath, j), "ReleaseType", releaseType.Value)
+				}
+				for j, warning := range details.ParentalWarningType {
+					check(fmt.Sprintf("%s.ParentalWarningType[%d]", detailsPath, j), "ParentalWarningType", warning.Value)
+				}
+			}
+		}
+	}
+
+	if nrm.ResourceList != nil {
+		for _, sr := range nrm.ResourceList.SoundRecording {
+			path := fmt.Sprintf("SoundRecording[%s]", sr.ResourceReference)
+			check(path+".SoundRecordingType", "SoundRecordingType", sr.SoundRecordingType)
+			for i, details := range sr.SoundRecordingDetailsByTerritory {
+				checkEach(fmt.Sprintf("%s.SoundRecordingDetailsByTerritory[%d].ParentalWarningType", path, i), "ParentalWarningType", details.ParentalWarningType)
+			}
+		}
+		for _, v := range nrm.ResourceList.Video {
+			path := fmt.Sprintf("Video[%s]", v.ResourceReference)
+			if v.VideoType != nil {
+				check(path+".VideoType", "VideoType", v.VideoType.Value)
+			}
+			for i, details := range v.VideoDetailsByTerritory {
+				checkEach(fmt.Sprintf("%s.VideoDetailsByTerritory[%d].ParentalWarningType", path, i), "ParentalWarningType", details.ParentalWarningType)
+			}
+		}
+		for _, image := range nrm.ResourceList.Image {
+			path := fmt.Sprintf("Image[%s]", image.ResourceReference)
+			if image.ImageType != nil {
+				check(path+".ImageType", "ImageType", image.ImageType.Value)
+			}
+			for i, details := range image.ImageDetailsByTerritory {
+				checkEach(fmt.Sprintf("%s.ImageDetailsByTerritory[%d].ParentalWarningType", path, i), "ParentalWarningType", details.ParentalWarningType)
+			}
+		}
+	}
+
+	if nrm.DealList != nil {
+		for _, releaseDeal := range nrm.DealList.ReleaseDeal {
+			for i, deal := range releaseDeal.Deal {
+				if deal.DealTerms == nil {
+					continue
+				}
+				path := fmt.Sprintf("ReleaseDeal[%s].Deal[%d]", releaseDeal.DealReleaseReference, i)
+				checkEach(path+".CommercialModelType", "CommercialModelType", deal.DealTerms.CommercialModelType)
+				for j, usage := range deal.DealTerms.Usage {
+					checkEach(fmt.Sprintf("%s.Usage[%d].UseType", path, j), "UseType", usage.UseType)
+				}
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,34 @@
+// Code generated by avsgen from avs-excerpt.xsd. DO NOT EDIT.
+
+package ddex
+
+// AVSVersion is the version of the DDEX allowed-value sets in the tables of
+// AllowedValues and IsDeprecatedValue
+const AVSVersion = "avs-excerpt"
+
+// allowedValues lists the codes of each allowed-value set, in schema order
+var allowedValues = map[string][]string{
+	"CommercialModelType": {
+		"AdvertisementSupportedModel",
+		"AsPerContract",
+		"DeviceFeeModel",
+		"FreeOfChargeModel",
+		"PayAsYouGoModel",
+		"PerformanceRoyaltiesModel",
+		"RightsClaimModel",
+		"SubscriptionModel",
+		"Unknown",
+		"UserDefined",
+	},
+	"ParentalWarningType": {
+		"Explicit",
+		"ExplicitContentEdited",
+		"NoAdviceAvailable",
+		"NotExplicit",
+		"Unknown",
+		"UserDefined",
+	},
+}
+
+// deprecatedValues lists the codes of allowedValues the AVS documents as deprecated
+var deprecatedValues = map[string][]string{}
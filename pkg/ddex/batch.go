@@ -0,0 +1,119 @@
+package ddex
+
+// BatchBuilder produces many NewReleaseMessages that share sender, recipient and header
+// configuration, typically one message per release for bulk catalog migrations.
+// Every message gets the batch MessageThreadId and an auto-generated MessageId.
+type BatchBuilder struct {
+	threadId           string
+	messageIdPrefix    string
+	senderDPID         string
+	senderName         string
+	recipients         []*MessageRecipient
+	onBehalfOfDPID     string
+	onBehalfOfName     string
+	messageControlType string
+	builders           []*Builder
+}
+
+// NewBatchBuilder creates a batch builder for the given sender.
+// If threadId is empty a thread ID is generated with GenerateThreadID.
+func NewBatchBuilder(threadId, senderDPID, senderName string) *BatchBuilder {
+	if threadId == "" {
+		threadId = GenerateThreadID("")
+	}
+
+	return &BatchBuilder{
+		threadId:   threadId,
+		senderDPID: senderDPID,
+		senderName: senderName,
+	}
+}
+
+// WithMessageIdPrefix sets the prefix passed to GenerateMessageID for every message in the batch
+func (bb *BatchBuilder) WithMessageIdPrefix(prefix string) *BatchBuilder {
+	bb.messageIdPrefix = prefix
+	return bb
+}
+
+// WithMessageControlType sets the message control type (TestMessage or LiveMessage) for every message
+func (bb *BatchBuilder) WithMessageControlType(controlType string) *BatchBuilder {
+	bb.messageControlType = controlType
+	return bb
+}
+
+// AddRecipient adds a recipient that every message in the batch is addressed to
+func (bb *BatchBuilder) AddRecipient(dpid, name string) *BatchBuilder {
+	bb.recipients = append(bb.recipients, &MessageRecipient{
+		PartyId: []PartyID{
+			{Value: dpid},
+		},
+		PartyName: []Name{
+			{FullName: name},
+		},
+	})
+	return bb
+}
+
+// AddYouTubeRecipient adds YouTube as a recipient of every message in the batch
+func (bb *BatchBuilder) AddYouTubeRecipient() *BatchBuilder {
+	return bb.AddRecipient(YouTubeDPID, "YouTube")
+}
+
+// AddYouTubeContentIDRecipient adds YouTube Content ID as a recipient of every message in the batch
+func (bb *BatchBuilder) AddYouTubeContentIDRecipient() *BatchBuilder {
+	return bb.AddRecipient(YouTubeContentIDDPID, "YouTube_ContentID")
+}
+
+// WithSentOnBehalfOf sets the party every message in the batch is sent on behalf of
+func (bb *BatchBuilder) WithSentOnBehalfOf(dpid, name string) *BatchBuilder {
+	bb.onBehalfOfDPID = dpid
+	bb.onBehalfOfName = name
+	return bb
+}
+
+// ThreadId returns the MessageThreadId shared by all messages in the batch
+func (bb *BatchBuilder) ThreadId() string {
+	return bb.threadId
+}
+
+// NewMessage starts a new message in the batch and returns its Builder with the
+// shared header already applied. Add the release, its resources and deals to it.
+func (bb *BatchBuilder) NewMessage() *Builder {
+	builder := NewDDEXBuilder().WithMessageHeader(
+		GenerateMessageID(bb.messageIdPrefix),
+		bb.threadId,
+		bb.senderDPID,
+		bb.senderName,
+	)
+
+	for _, recipient := range bb.recipients {
+		// Copy so that messages don't share (and later mutate) the same recipient
+		r := *recipient
+		r.PartyId = append([]PartyID(nil), recipient.PartyId...)
+		r.PartyName = append([]Name(nil), recipient.PartyName...)
+		builder.Message.MessageHeader.MessageRecipient = append(builder.Message.MessageHeader.MessageRecipient, &r)
+	}
+	if bb.onBehalfOfDPID != "" {
+		builder.WithSentOnBehalfOf(bb.onBehalfOfDPID, bb.onBehalfOfName)
+	}
+	if bb.messageControlType != "" {
+		builder.Message.MessageHeader.MessageControlType = bb.messageControlType
+	}
+
+	bb.builders = append(bb.builders, builder)
+	return builder
+}
+
+// Len returns the number of messages in the batch
+func (bb *BatchBuilder) Len() int {
+	return len(bb.builders)
+}
+
+// Messages returns every message built so far, in the order they were started
+func (bb *BatchBuilder) Messages() []*NewReleaseMessage {
+	messages := make([]*NewReleaseMessage, 0, len(bb.builders))
+	for _, builder := range bb.builders {
+		messages = append(messages, builder.Build())
+	}
+	return messages
+}
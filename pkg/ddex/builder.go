@@ -1,15 +1,38 @@
 package ddex
 
 import (
+	"crypto"
+	"crypto/x509"
 	"encoding/xml"
 	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
 	"os"
-	"time"
 )
 
-// Builder provides a fluent interface for creating DDEX ERN 3.8 messages
+// Builder provides a fluent interface for creating DDEX ERN 3.8 messages.
+//
+// Sub-builders such as VideoBuilder or DealBuilder address what they build by its index in
+// the message, like ValidityPeriodBuilder, rather than holding a pointer into a slice that
+// a later append may reallocate. Any number of them can be kept open and used in any order
+// while more resources, releases and deals are added. Removing or reordering items of the
+// message, e.g. with Normalize, invalidates the sub-builders of the items after them.
 type Builder struct {
 	Message *NewReleaseMessage
+
+	signingKey   crypto.Signer
+	certificates []*x509.Certificate
+	canonical    bool
+	marshal      MarshalOptions
+	unicode      UnicodeOptions
+	style        MetadataStyle
+	logger       *slog.Logger
+	autoIDs      bool
+	idPrefix     string
+	clock        Clock
+	random       io.Reader
+	err          error // First invalid value given to a builder method
 }
 
 // NewDDEXBuilder creates a new builder for ERN 3.8 messages
@@ -28,7 +51,8 @@ func NewDDEXBuilder() *Builder {
 	}
 }
 
-// WithMessageHeader sets the message header
+// WithMessageHeader sets the message header. The message is a TestMessage unless
+// AsLiveMessage marks it live.
 func (b *Builder) WithMessageHeader(messageId, threadId, senderDPID, senderName string) *Builder {
 	sender := &MessageSender{
 		PartyId: []PartyID{
@@ -39,16 +63,92 @@ func (b *Builder) WithMessageHeader(messageId, threadId, senderDPID, senderName
 		},
 	}
 
+	controlType := MessageControlTypeTest
+	if b.Message.MessageHeader != nil && b.Message.MessageHeader.MessageControlType != "" {
+		controlType = b.Message.MessageHeader.MessageControlType
+	}
+
 	b.Message.MessageHeader = &MessageHeader{
 		MessageThreadId:        threadId,
 		MessageId:              messageId,
 		MessageSender:          sender,
-		MessageCreatedDateTime: &DateTime{Time: time.Now()},
+		MessageCreatedDateTime: &DateTime{Time: b.now()},
+		MessageControlType:     controlType,
 	}
 
 	return b
 }
 
+// WithAutoGeneratedIDs fills in the header IDs the caller leaves empty: the MessageId and
+// MessageThreadId with GenerateMessageID and GenerateThreadID using prefix (MSG and THR
+// if empty), and the MessageFileName with the MessageFileName of the main release's
+// identifier. They are filled in by Build, ToXML and the Write methods, so
+// WithMessageHeader may be called before or after with empty IDs, and once generated the
+// IDs stay the same. If the random source set with WithRandom fails, ToXML and the Write
+// methods return its error and Build leaves the IDs empty.
+func (b *Builder) WithAutoGeneratedIDs(prefix string) *Builder {
+	b.autoIDs = true
+	b.idPrefix = prefix
+	return b
+}
+
+// WithRandom makes WithAutoGeneratedIDs read the random part of the IDs from random
+// instead of crypto/rand, e.g. a seeded source for reproducible messages
+func (b *Builder) WithRandom(random io.Reader) *Builder {
+	b.random = random
+	return b
+}
+
+// Err returns the first invalid value given to a builder method, such as a right share
+// that isn't a decimal number. ToXML and the other output methods return it too.
+func (b *Builder) Err() error {
+	return b.err
+}
+
+// fail records the first invalid value given to a builder method
+func (b *Builder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// fillGeneratedIDs fills in the header IDs if WithAutoGeneratedIDs was used, after
+// returning the error of an invalid value given to a builder method
+func (b *Builder) fillGeneratedIDs() error {
+	if b.err != nil {
+		return b.err
+	}
+	if !b.autoIDs {
+		return nil
+	}
+	if b.Message.MessageHeader == nil {
+		b.Message.MessageHeader = &MessageHeader{}
+	}
+
+	ids := IDGenerator{Random: b.random, Clock: b.clock}
+	header := b.Message.MessageHeader
+	if header.MessageId == "" {
+		id, err := ids.MessageID(b.idPrefix)
+		if err != nil {
+			return fmt.Errorf("failed to generate MessageId: %w", err)
+		}
+		header.MessageId = id
+	}
+	if header.MessageThreadId == "" {
+		id, err := ids.ThreadID(b.idPrefix)
+		if err != nil {
+			return fmt.Errorf("failed to generate MessageThreadId: %w", err)
+		}
+		header.MessageThreadId = id
+	}
+	if header.MessageFileName == "" {
+		if identifier, err := b.Message.DeliveryIdentifier(); err == nil {
+			header.MessageFileName = MessageFileName(identifier)
+		}
+	}
+	return nil
+}
+
 // AddRecipient adds a message recipient (e.g., YouTube)
 func (b *Builder) AddRecipient(dpid, name string) *Builder {
 	if b.Message.MessageHeader == nil {
@@ -68,14 +168,99 @@ func (b *Builder) AddRecipient(dpid, name string) *Builder {
 	return b
 }
 
+// WithSentOnBehalfOf sets the party the message is sent on behalf of, e.g. the label an
+// aggregator delivers for
+func (b *Builder) WithSentOnBehalfOf(dpid, name string) *Builder {
+	if b.Message.MessageHeader == nil {
+		b.Message.MessageHeader = &MessageHeader{}
+	}
+
+	b.Message.MessageHeader.SentOnBehalfOf = &SentOnBehalfOf{
+		PartyId: []PartyID{
+			{Value: dpid},
+		},
+		PartyName: []Name{
+			{FullName: name},
+		},
+	}
+	return b
+}
+
+// WithSenderProprietaryId adds a namespaced party ID to the message sender, next to its DPID
+func (b *Builder) WithSenderProprietaryId(namespace, id string) *Builder {
+	if b.Message.MessageHeader == nil {
+		b.Message.MessageHeader = &MessageHeader{}
+	}
+	if b.Message.MessageHeader.MessageSender == nil {
+		b.Message.MessageHeader.MessageSender = &MessageSender{}
+	}
+
+	sender := b.Message.MessageHeader.MessageSender
+	sender.PartyId = append(sender.PartyId, PartyID{Value: id, Namespace: namespace})
+	return b
+}
+
+// WithRecipientProprietaryId adds a namespaced party ID to the recipient added last. It
+// does nothing if the message has no recipient yet.
+func (b *Builder) WithRecipientProprietaryId(namespace, id string) *Builder {
+	if b.Message.MessageHeader == nil || len(b.Message.MessageHeader.MessageRecipient) == 0 {
+		return b
+	}
+
+	recipients := b.Message.MessageHeader.MessageRecipient
+	recipient := recipients[len(recipients)-1]
+	recipient.PartyId = append(recipient.PartyId, PartyID{Value: id, Namespace: namespace})
+	return b
+}
+
+// WithSentOnBehalfOfProprietaryId adds a namespaced party ID to the party the message is
+// sent on behalf of. It does nothing before WithSentOnBehalfOf.
+func (b *Builder) WithSentOnBehalfOfProprietaryId(namespace, id string) *Builder {
+	if b.Message.MessageHeader == nil || b.Message.MessageHeader.SentOnBehalfOf == nil {
+		return b
+	}
+
+	party := b.Message.MessageHeader.SentOnBehalfOf
+	party.PartyId = append(party.PartyId, PartyID{Value: id, Namespace: namespace})
+	return b
+}
+
 // AddYouTubeRecipient adds YouTube as the message recipient
 func (b *Builder) AddYouTubeRecipient() *Builder {
-	return b.AddRecipient("PADPIDA2013020802I", "YouTube")
+	return b.AddRecipient(YouTubeDPID, "YouTube")
 }
 
 // AddYouTubeRecipient adds YouTube as the message recipient
 func (b *Builder) AddYouTubeContentIDRecipient() *Builder {
-	return b.AddRecipient("PADPIDA2015120100H", "YouTube_ContentID")
+	return b.AddRecipient(YouTubeContentIDDPID, "YouTube_ContentID")
+}
+
+// WithNamespace declares a namespace prefix on the message root for the proprietary
+// elements added with WithExtension
+func (b *Builder) WithNamespace(prefix, namespace string) *Builder {
+	b.Message.DeclareNamespace(prefix, namespace)
+	return b
+}
+
+// AsTestMessage marks the message as a TestMessage, which recipients validate but don't
+// ingest
+func (b *Builder) AsTestMessage() *Builder {
+	return b.withMessageControlType(MessageControlTypeTest)
+}
+
+// AsLiveMessage marks the message as a LiveMessage for production ingestion. The delivery
+// package refuses to send live messages unless its AllowLive option is set.
+func (b *Builder) AsLiveMessage() *Builder {
+	return b.withMessageControlType(MessageControlTypeLive)
+}
+
+func (b *Builder) withMessageControlType(controlType string) *Builder {
+	if b.Message.MessageHeader == nil {
+		b.Message.MessageHeader = &MessageHeader{}
+	}
+
+	b.Message.MessageHeader.MessageControlType = controlType
+	return b
 }
 
 // WithUpdateIndicator sets the update indicator
@@ -94,11 +279,25 @@ func (b *Builder) AddVideo(resourceRef, videoType string) *VideoBuilder {
 	}
 
 	b.Message.ResourceList.Video = append(b.Message.ResourceList.Video, *video)
-	videoIndex := len(b.Message.ResourceList.Video) - 1
 
 	return &VideoBuilder{
 		builder: b,
-		video:   &b.Message.ResourceList.Video[videoIndex],
+		index:   len(b.Message.ResourceList.Video) - 1,
+	}
+}
+
+// AddSoundRecording adds a sound recording resource
+func (b *Builder) AddSoundRecording(resourceRef, soundRecordingType string) *SoundRecordingBuilder {
+	recording := &SoundRecording{
+		SoundRecordingType: soundRecordingType,
+		ResourceReference:  resourceRef,
+	}
+
+	b.Message.ResourceList.SoundRecording = append(b.Message.ResourceList.SoundRecording, *recording)
+
+	return &SoundRecordingBuilder{
+		builder: b,
+		index:   len(b.Message.ResourceList.SoundRecording) - 1,
 	}
 }
 
@@ -113,11 +312,10 @@ func (b *Builder) AddImage(resourceRef, imageType string) *ImageBuilder {
 	}
 
 	b.Message.ResourceList.Image = append(b.Message.ResourceList.Image, *image)
-	imageIndex := len(b.Message.ResourceList.Image) - 1
 
 	return &ImageBuilder{
 		builder: b,
-		image:   &b.Message.ResourceList.Image[imageIndex],
+		index:   len(b.Message.ResourceList.Image) - 1,
 	}
 }
 
@@ -132,11 +330,10 @@ func (b *Builder) AddRelease(releaseRef, releaseType string) *ReleaseBuilder {
 	}
 
 	b.Message.ReleaseList.Release = append(b.Message.ReleaseList.Release, *release)
-	releaseIndex := len(b.Message.ReleaseList.Release) - 1
 
 	return &ReleaseBuilder{
 		builder: b,
-		release: &b.Message.ReleaseList.Release[releaseIndex],
+		index:   len(b.Message.ReleaseList.Release) - 1,
 	}
 }
 
@@ -149,53 +346,220 @@ func (b *Builder) AddReleaseDeal(releaseRef string) *ReleaseDealBuilder {
 	}
 
 	b.Message.DealList.ReleaseDeal = append(b.Message.DealList.ReleaseDeal, *releaseDeal)
-	dealIndex := len(b.Message.DealList.ReleaseDeal) - 1
 
 	return &ReleaseDealBuilder{
-		builder:     b,
-		releaseDeal: &b.Message.DealList.ReleaseDeal[dealIndex],
+		builder: b,
+		index:   len(b.Message.DealList.ReleaseDeal) - 1,
+	}
+}
+
+// AutoLinkResources derives the main release's resource references from the resources
+// added so far. Sound recordings and videos become PrimaryResources and images and texts
+// SecondaryResources, numbered in that order. The release's ReleaseResourceReferenceList
+// is replaced, and the first ResourceGroup of each ReleaseDetailsByTerritory gets the
+// matching content items; territories without a group get one titled after the release.
+// The main release is the one marked IsMainRelease, or else the first release.
+func (b *Builder) AutoLinkResources() *Builder {
+	release := b.mainRelease()
+	if release == nil || b.Message.ResourceList == nil {
+		return b
+	}
+
+	var items []ResourceGroupContentItem
+	add := func(resourceType, resourceRef, releaseResourceType string) {
+		items = append(items, ResourceGroupContentItem{
+			SequenceNumber: len(items) + 1,
+			ResourceType:   resourceType,
+			ReleaseResourceReference: ReleaseResourceReference{
+				ReleaseResourceType: releaseResourceType,
+				Value:               resourceRef,
+			},
+		})
+	}
+	resources := b.Message.ResourceList
+	for _, recording := range resources.SoundRecording {
+		add("SoundRecording", recording.ResourceReference, "PrimaryResource")
+	}
+	for _, video := range resources.Video {
+		add("Video", video.ResourceReference, "PrimaryResource")
+	}
+	for _, image := range resources.Image {
+		add("Image", image.ResourceReference, "SecondaryResource")
 	}
+	for _, text := range resources.Text {
+		add("Text", text.ResourceReference, "SecondaryResource")
+	}
+	if len(items) == 0 {
+		return b
+	}
+
+	list := &ReleaseResourceReferenceList{}
+	for _, item := range items {
+		list.ReleaseResourceReference = append(list.ReleaseResourceReference, item.ReleaseResourceReference)
+	}
+	release.ReleaseResourceReferenceList = list
+
+	for i := range release.ReleaseDetailsByTerritory {
+		details := &release.ReleaseDetailsByTerritory[i]
+		if len(details.ResourceGroup) == 0 {
+			group := ResourceGroup{SequenceNumber: 1}
+			if release.ReferenceTitle != nil {
+				group.Title = Title{TitleText: release.ReferenceTitle.TitleText}
+			}
+			details.ResourceGroup = append(details.ResourceGroup, group)
+		}
+		details.ResourceGroup[0].ResourceGroupContentItem = append([]ResourceGroupContentItem(nil), items...)
+	}
+	return b
+}
+
+// mainRelease returns the release marked IsMainRelease, the first release, or nil
+func (b *Builder) mainRelease() *Release {
+	return b.Message.mainRelease()
 }
 
 // Build returns the completed NewReleaseMessage
 func (b *Builder) Build() *NewReleaseMessage {
+	if b.err != nil {
+		logEvent(b.logger, slog.LevelWarn, "ddex: invalid builder value", "error", b.err)
+	} else if err := b.fillGeneratedIDs(); err != nil {
+		logEvent(b.logger, slog.LevelWarn, "ddex: message IDs not generated", "error", err)
+	}
+	var releases, deals int
+	if b.Message.ReleaseList != nil {
+		releases = len(b.Message.ReleaseList.Release)
+	}
+	if b.Message.DealList != nil {
+		deals = len(b.Message.DealList.ReleaseDeal)
+	}
+	logEvent(b.logger, slog.LevelDebug, "ddex: message built", "message_id", b.Message.messageId(),
+		"releases", releases, "resources", len(b.Message.FindResources()), "release_deals", deals)
 	return b.Message
 }
 
+// WithLogger reports the events of this builder to logger instead of the SetLogger logger
+func (b *Builder) WithLogger(logger *slog.Logger) *Builder {
+	b.logger = logger
+	return b
+}
+
+// WithSigningKey signs the XML produced by ToXML, WriteToFile and WriteToDelivery with an
+// enveloped XML-DSIG signature (see SignXML)
+func (b *Builder) WithSigningKey(key crypto.Signer, certificates ...*x509.Certificate) *Builder {
+	b.signingKey = key
+	b.certificates = certificates
+	return b
+}
+
+// WithCanonicalOutput makes ToXML, WriteToFile and WriteToDelivery produce canonical XML
+// (see Canonicalize), so the bytes and their checksum don't depend on the encoder. Files
+// still start with the XML declaration.
+func (b *Builder) WithCanonicalOutput() *Builder {
+	b.canonical = true
+	return b
+}
+
+// WithMarshalOptions sets the root element prefix and schema location used by ToXML,
+// WriteToFile and WriteToDelivery
+func (b *Builder) WithMarshalOptions(opts MarshalOptions) *Builder {
+	b.marshal = opts
+	return b
+}
+
 // ToXML converts the message to XML bytes
 func (b *Builder) ToXML() ([]byte, error) {
-	return xml.MarshalIndent(b.Message, "", "    ")
+	if err := b.fillGeneratedIDs(); err != nil {
+		return nil, err
+	}
+	b.Message.ApplyMetadataStyle(b.style)
+	b.Message.NormalizeUnicode(b.unicode)
+	data, err := marshalXML(b.Message, "    ", false)
+	if err != nil {
+		return nil, err
+	}
+	return b.finishXML(data, false)
 }
 
 // WriteToFile writes the message to an XML file
 func (b *Builder) WriteToFile(filename string) error {
-	xmlData, err := b.ToXML()
+	return b.writeFile(filename, func(filename string, data []byte) error {
+		return os.WriteFile(filename, data, 0644)
+	})
+}
+
+// writeFile marshals the message with its XML declaration and stores it with write
+func (b *Builder) writeFile(filename string, write func(filename string, data []byte) error) error {
+	if err := b.fillGeneratedIDs(); err != nil {
+		return err
+	}
+	b.Message.ApplyMetadataStyle(b.style)
+	b.Message.NormalizeUnicode(b.unicode)
+	var writeErr error
+	err := encodeXML(b.Message, "    ", true, func(xmlWithDeclaration []byte) error {
+		data, err := b.finishXML(xmlWithDeclaration, true)
+		if err != nil {
+			return err
+		}
+		writeErr = write(filename, data)
+		return writeErr
+	})
+	if writeErr != nil {
+		return fmt.Errorf("failed to write file: %w", writeErr)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to marshal XML: %w", err)
 	}
 
-	// Add XML declaration
-	xmlWithDeclaration := []byte(xml.Header + string(xmlData))
+	return nil
+}
 
-	if err := os.WriteFile(filename, xmlWithDeclaration, 0644); err != nil {
-		return fmt.Errorf("failed to write file: %w", err)
+// finishXML applies the output options to marshaled XML: marshal options, canonicalization,
+// then signing. The signature is added in canonical form, so a canonical message stays
+// canonical.
+func (b *Builder) finishXML(data []byte, withHeader bool) ([]byte, error) {
+	data, err := applyMarshalOptions(data, b.marshal)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	if b.canonical {
+		canonical, err := Canonicalize(data)
+		if err != nil {
+			return nil, err
+		}
+		if withHeader {
+			canonical = append([]byte(xml.Header), canonical...)
+		}
+		data = canonical
+	}
+	if b.signingKey != nil {
+		if data, err = SignXML(data, b.signingKey, b.certificates...); err != nil {
+			return nil, err
+		}
+	}
+	logEvent(b.logger, slog.LevelDebug, "ddex: message marshaled", "message_id", b.Message.messageId(),
+		"bytes", len(data), "signed", b.signingKey != nil)
+	return data, nil
 }
 
 // VideoBuilder provides fluent interface for building video resources
 type VideoBuilder struct {
-	builder                 *Builder
-	video                   *Video
-	currentTerritoryDetails *VideoDetailsByTerritory
-	currentTerritoryIndex   int
+	builder *Builder
+	index   int
+}
+
+func (vb *VideoBuilder) video() *Video {
+	return &vb.builder.Message.ResourceList.Video[vb.index]
 }
 
 // VideoDetailsByTerritoryBuilder provides fluent interface for building video territory details
 type VideoDetailsByTerritoryBuilder struct {
-	videoBuilder     *VideoBuilder
-	territoryDetails *VideoDetailsByTerritory
+	videoBuilder *VideoBuilder
+	index        int
+}
+
+func (vtb *VideoDetailsByTerritoryBuilder) territoryDetails() *VideoDetailsByTerritory {
+	return &vtb.videoBuilder.video().VideoDetailsByTerritory[vtb.index]
 }
 
 // AddVideoDetailsByTerritory creates a new territory details section and returns a builder for it
@@ -209,13 +573,12 @@ func (vb *VideoBuilder) AddVideoDetailsByTerritory(territoryCodes []string) *Vid
 	newDetails := VideoDetailsByTerritory{
 		TerritoryCode: territoryCodes,
 	}
-	vb.video.VideoDetailsByTerritory = append(vb.video.VideoDetailsByTerritory, newDetails)
-	vb.currentTerritoryIndex = len(vb.video.VideoDetailsByTerritory) - 1
-	vb.currentTerritoryDetails = &vb.video.VideoDetailsByTerritory[vb.currentTerritoryIndex]
+	video := vb.video()
+	video.VideoDetailsByTerritory = append(video.VideoDetailsByTerritory, newDetails)
 
 	return &VideoDetailsByTerritoryBuilder{
-		videoBuilder:     vb,
-		territoryDetails: vb.currentTerritoryDetails,
+		videoBuilder: vb,
+		index:        len(video.VideoDetailsByTerritory) - 1,
 	}
 }
 
@@ -242,7 +605,7 @@ func (vtb *VideoDetailsByTerritoryBuilder) AddTitle(titleText, subtitle, languag
 		title.TitleType = titleType
 	}
 
-	vtb.territoryDetails.Title = append(vtb.territoryDetails.Title, title)
+	vtb.territoryDetails().Title = append(vtb.territoryDetails().Title, title)
 	return vtb
 }
 
@@ -251,7 +614,7 @@ func (vtb *VideoDetailsByTerritoryBuilder) WithDisplayArtistName(artistName, lan
 	if languageCode == "" {
 		languageCode = "en"
 	}
-	vtb.territoryDetails.DisplayArtistName = append(vtb.territoryDetails.DisplayArtistName, DisplayArtistName{
+	vtb.territoryDetails().DisplayArtistName = append(vtb.territoryDetails().DisplayArtistName, DisplayArtistName{
 		Value:                 artistName,
 		LanguageAndScriptCode: languageCode,
 	})
@@ -267,17 +630,30 @@ func (vtb *VideoDetailsByTerritoryBuilder) WithArtist(artistName string, roles [
 		},
 		ArtistRole: roles,
 	}
-	vtb.territoryDetails.DisplayArtist = append(vtb.territoryDetails.DisplayArtist, artist)
+	vtb.territoryDetails().DisplayArtist = append(vtb.territoryDetails().DisplayArtist, artist)
 
 	return vtb
 }
 
+// WithConductor adds a display conductor to the video (territory specific). ERN 3.8 names
+// conductors inline, so partyName is the conductor's name rather than a party reference.
+func (vtb *VideoDetailsByTerritoryBuilder) WithConductor(partyName string, sequence int) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().DisplayConductor = append(vtb.territoryDetails().DisplayConductor, DisplayConductor{
+		SequenceNumber: sequence,
+		PartyName: []PartyName{
+			{FullName: partyName},
+		},
+		ArtistRole: []string{"Conductor"},
+	})
+	return vtb
+}
+
 // WithLabel adds a label name for the video (territory specific)
 func (vtb *VideoDetailsByTerritoryBuilder) WithLabel(labelName, labelNameType, languageCode string) *VideoDetailsByTerritoryBuilder {
 	if languageCode == "" {
 		languageCode = "en"
 	}
-	vtb.territoryDetails.LabelName = append(vtb.territoryDetails.LabelName, LabelName{
+	vtb.territoryDetails().LabelName = append(vtb.territoryDetails().LabelName, LabelName{
 		Value:                 labelName,
 		LabelNameType:         labelNameType,
 		LanguageAndScriptCode: languageCode,
@@ -289,7 +665,7 @@ func (vtb *VideoDetailsByTerritoryBuilder) WithLabel(labelName, labelNameType, l
 // role can be multiple values like "Producer", "Director", "Cinematographer", etc.
 func (vtb *VideoDetailsByTerritoryBuilder) WithResourceContributor(partyName string, roles []string, sequence int) *VideoDetailsByTerritoryBuilder {
 	if partyName != "" && len(roles) > 0 {
-		vtb.territoryDetails.ResourceContributor = append(vtb.territoryDetails.ResourceContributor, ResourceContributor{
+		vtb.territoryDetails().ResourceContributor = append(vtb.territoryDetails().ResourceContributor, ResourceContributor{
 			SequenceNumber: sequence,
 			PartyName: []PartyName{
 				{FullName: partyName},
@@ -305,7 +681,7 @@ func (vtb *VideoDetailsByTerritoryBuilder) WithResourceContributor(partyName str
 // role can be multiple values like "Composer", "Lyricist", etc.
 func (vtb *VideoDetailsByTerritoryBuilder) WithIndirectResourceContributor(partyName string, roles []string, sequence int) *VideoDetailsByTerritoryBuilder {
 	if partyName != "" && len(roles) > 0 {
-		vtb.territoryDetails.IndirectResourceContributor = append(vtb.territoryDetails.IndirectResourceContributor, IndirectResourceContributor{
+		vtb.territoryDetails().IndirectResourceContributor = append(vtb.territoryDetails().IndirectResourceContributor, IndirectResourceContributor{
 			SequenceNumber: sequence,
 			PartyName: []PartyName{
 				{FullName: partyName},
@@ -317,251 +693,1042 @@ func (vtb *VideoDetailsByTerritoryBuilder) WithIndirectResourceContributor(party
 	return vtb
 }
 
+// WithIndirectContributor adds a composer, lyricist, arranger or other indirect contributor
+// to the video (territory specific), numbered after the ones already added
+func (vtb *VideoDetailsByTerritoryBuilder) WithIndirectContributor(partyName string, roles ...string) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().IndirectResourceContributor = appendIndirectContributor(vtb.territoryDetails().IndirectResourceContributor, partyName, roles)
+	return vtb
+}
+
+// WithCharacter adds a character played in the video, e.g. by an actor. partyRef refers to
+// a Party in a PartyList delivered with the message and may be empty.
+func (vtb *VideoDetailsByTerritoryBuilder) WithCharacter(partyRef, name string) *VideoDetailsByTerritoryBuilder {
+	if partyRef != "" || name != "" {
+		vtb.territoryDetails().Character = append(vtb.territoryDetails().Character, Character{
+			CharacterPartyReference: partyRef,
+			Name:                    name,
+		})
+	}
+	return vtb
+}
+
 // WithRightsController sets the rights controller (territory specific)
-// Parameters: partyName, partyId, and percentage
+// Parameters: partyName, partyId, and percentage (rounded to two decimals)
 func (vtb *VideoDetailsByTerritoryBuilder) WithRightsController(partyName, partyId string, percentage float64) *VideoDetailsByTerritoryBuilder {
-	rightsController := RightsController{
-		PartyName: []Name{
-			{FullName: partyName},
-		},
-		PartyId: []PartyID{
-			{Value: partyId},
-		},
-		RightsControllerRole: []string{"RightsController"},
-		RightSharePercentage: fmt.Sprintf("%.2f", percentage),
+	share, err := DecimalFromFloat(percentage, 2)
+	if err != nil {
+		vtb.videoBuilder.builder.fail(fmt.Errorf("right share of %s: %w", partyName, err))
+		return vtb
 	}
+	return vtb.WithRightsControllerShare(partyName, partyId, share)
+}
 
-	vtb.territoryDetails.RightsController = append(vtb.territoryDetails.RightsController, rightsController)
-
+// WithRightsControllerShare sets the rights controller with an exact share, e.g.
+// ddex.MustParseDecimal("33.34") or ddex.DecimalFromRat(share, 4) (territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) WithRightsControllerShare(partyName, partyId string, share Decimal) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().RightsController = append(vtb.territoryDetails().RightsController, newRightsController(partyName, partyId, share))
 	return vtb
 }
 
+// WithRightsControllerShareString sets the rights controller with a share such as "33.34"
+// (territory specific). An invalid share is returned by Err and the output methods.
+func (vtb *VideoDetailsByTerritoryBuilder) WithRightsControllerShareString(partyName, partyId, share string) *VideoDetailsByTerritoryBuilder {
+	d, err := ParseDecimal(share)
+	if err != nil {
+		vtb.videoBuilder.builder.fail(fmt.Errorf("right share of %s: %w", partyName, err))
+		return vtb
+	}
+	return vtb.WithRightsControllerShare(partyName, partyId, d)
+}
+
+// WithRightsControllerShareRat sets the rights controller with a share rounded to scale
+// digits after the decimal point (territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) WithRightsControllerShareRat(partyName, partyId string, share *big.Rat, scale int) *VideoDetailsByTerritoryBuilder {
+	return vtb.WithRightsControllerShare(partyName, partyId, DecimalFromRat(share, scale))
+}
+
 // WithDuration sets the video duration (e.g., "PT3M10S") - at video level, not territory
 func (vb *VideoBuilder) WithDuration(duration string) *VideoBuilder {
-	vb.video.Duration = duration
+	vb.video().Duration = duration
+	return vb
+}
+
+// WithCreationDate sets the creation date - at video level, not territory
+func (vb *VideoBuilder) WithCreationDate(date string, isApproximate bool) *VideoBuilder {
+	vb.video().CreationDate = &EventDate{
+		Value:         date,
+		IsApproximate: isApproximate,
+	}
+	return vb
+}
+
+// SetIsArtistRelated marks the video as artist related (e.g. a track on an artist album rather than a compilation)
+func (vb *VideoBuilder) SetIsArtistRelated(isArtistRelated bool) *VideoBuilder {
+	vb.video().IsArtistRelated = Bool(isArtistRelated)
+	return vb
+}
+
+// SetIsMedley marks the video as a medley of several works
+func (vb *VideoBuilder) SetIsMedley(isMedley bool) *VideoBuilder {
+	vb.video().IsMedley = Bool(isMedley)
+	return vb
+}
+
+// SetIsPotpourri marks the video as a potpourri of several works
+func (vb *VideoBuilder) SetIsPotpourri(isPotpourri bool) *VideoBuilder {
+	vb.video().IsPotpourri = Bool(isPotpourri)
+	return vb
+}
+
+// SetIsInstrumental marks the video as instrumental, without lyrics
+func (vb *VideoBuilder) SetIsInstrumental(isInstrumental bool) *VideoBuilder {
+	vb.video().IsInstrumental = Bool(isInstrumental)
+	return vb
+}
+
+// SetIsBackground marks the video as background material
+func (vb *VideoBuilder) SetIsBackground(isBackground bool) *VideoBuilder {
+	vb.video().IsBackground = Bool(isBackground)
+	return vb
+}
+
+// SetIsHiddenResource marks the video as hidden, e.g. a hidden track after the last one
+func (vb *VideoBuilder) SetIsHiddenResource(isHidden bool) *VideoBuilder {
+	vb.video().IsHiddenResource = Bool(isHidden)
+	return vb
+}
+
+// SetHasPreOrderFulfillment marks the video as delivered to pre-order customers before the release date
+func (vb *VideoBuilder) SetHasPreOrderFulfillment(hasFulfillment bool) *VideoBuilder {
+	vb.video().HasPreOrderFulfillment = Bool(hasFulfillment)
+	return vb
+}
+
+// SetIsRemastered marks the video as remastered
+func (vb *VideoBuilder) SetIsRemastered(isRemastered bool) *VideoBuilder {
+	vb.video().IsRemastered = Bool(isRemastered)
+	return vb
+}
+
+// SetNoSilenceBefore marks the video as starting without silence, for gapless playback
+func (vb *VideoBuilder) SetNoSilenceBefore(noSilence bool) *VideoBuilder {
+	vb.video().NoSilenceBefore = Bool(noSilence)
+	return vb
+}
+
+// SetNoSilenceAfter marks the video as ending without silence, for gapless playback
+func (vb *VideoBuilder) SetNoSilenceAfter(noSilence bool) *VideoBuilder {
+	vb.video().NoSilenceAfter = Bool(noSilence)
+	return vb
+}
+
+// SetPerformerInformationRequired marks the video as needing performer information from the recipient
+func (vb *VideoBuilder) SetPerformerInformationRequired(required bool) *VideoBuilder {
+	vb.video().PerformerInformationRequired = Bool(required)
+	return vb
+}
+
+// WithReferenceTitle sets the reference title for the video - at video level, not territory
+func (vb *VideoBuilder) WithReferenceTitle(titleText, subtitle string) *VideoBuilder {
+	vb.video().ReferenceTitle = &ReferenceTitle{
+		TitleText: titleText,
+		SubTitle:  subtitle,
+	}
 	return vb
 }
 
-// WithCreationDate sets the creation date - at video level, not territory
-func (vb *VideoBuilder) WithCreationDate(date string, isApproximate bool) *VideoBuilder {
-	vb.video.CreationDate = &EventDate{
-		Value:         date,
-		IsApproximate: isApproximate,
+// WithParentalWarning sets the parental warning type (territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) WithParentalWarning(warningType string) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().ParentalWarningType = append(vtb.territoryDetails().ParentalWarningType, warningType)
+	return vtb
+}
+
+// WithPLine sets the P-Line information for ERN 3.8 (territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) WithPLine(year int, text string) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().PLine = append(vtb.territoryDetails().PLine, PLine{
+		Year:      year,
+		PLineText: text,
+	})
+	return vtb
+}
+
+// WithCLine sets the C-Line information for ERN 3.8 (territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) WithCLine(year int, text string) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().CLine = append(vtb.territoryDetails().CLine, CLine{
+		Year:      year,
+		CLineText: text,
+	})
+	return vtb
+}
+
+// WithGenre adds genre information (territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) WithGenre(genreText string) *VideoDetailsByTerritoryBuilder {
+	genre := Genre{
+		GenreText: genreText,
+	}
+	vtb.territoryDetails().Genre = append(vtb.territoryDetails().Genre, genre)
+	return vtb
+}
+
+// WithGenreAndSubGenre adds genre information with a subgenre for the current territory
+func (vtb *VideoDetailsByTerritoryBuilder) WithGenreAndSubGenre(genreText, subGenre string) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().Genre = append(vtb.territoryDetails().Genre, Genre{
+		GenreText: genreText,
+		SubGenre:  subGenre,
+	})
+	return vtb
+}
+
+// WithTechnicalDetails adds technical details and file FileName for ERN 3.8 (territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) WithTechnicalDetails(techRef, fileName string) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().TechnicalVideoDetails = append(vtb.territoryDetails().TechnicalVideoDetails, TechnicalVideoDetails{
+		TechnicalResourceDetailsReference: techRef,
+		File: &File{
+			FileName: fileName,
+		},
+	})
+	return vtb
+}
+
+// WithTechnicalVideoDetails adds fully populated technical details (codec, definition,
+// duration, file size) as probed from the video file
+func (vtb *VideoDetailsByTerritoryBuilder) WithTechnicalVideoDetails(details TechnicalVideoDetails) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().TechnicalVideoDetails = append(vtb.territoryDetails().TechnicalVideoDetails, details)
+	return vtb
+}
+
+// AddHostSoundCarrier records the release the video originally came out on (territory
+// specific): its ICPN, catalog number and title, each optional
+func (vtb *VideoDetailsByTerritoryBuilder) AddHostSoundCarrier(icpn, catalogNumber, title string) *VideoDetailsByTerritoryBuilder {
+	return vtb.WithHostSoundCarrier(newHostSoundCarrier(icpn, catalogNumber, title))
+}
+
+// WithHostSoundCarrier adds a fully specified host sound carrier (territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) WithHostSoundCarrier(carrier HostSoundCarrier) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().HostSoundCarrier = append(vtb.territoryDetails().HostSoundCarrier, carrier)
+	return vtb
+}
+
+// WithLanguageOfPerformance adds a language the video is performed in, as ISO 639-2 ("en"
+// is converted to "eng") - at video level, not territory
+func (vb *VideoBuilder) WithLanguageOfPerformance(language string) *VideoBuilder {
+	vb.video().LanguageOfPerformance = appendLanguage(vb.video().LanguageOfPerformance, language)
+	return vb
+}
+
+// WithLanguageOfDubbing adds a language the video is dubbed in, as ISO 639-2
+func (vb *VideoBuilder) WithLanguageOfDubbing(language string) *VideoBuilder {
+	vb.video().LanguageOfDubbing = appendLanguage(vb.video().LanguageOfDubbing, language)
+	return vb
+}
+
+// WithSubTitleLanguage adds a language the video has subtitles in, as ISO 639-2
+func (vb *VideoBuilder) WithSubTitleLanguage(language string) *VideoBuilder {
+	vb.video().SubTitleLanguage = appendLanguage(vb.video().SubTitleLanguage, language)
+	return vb
+}
+
+// WithArtistCounts sets the number of featured, non-featured, contracted and
+// non-contracted artists on the video, as used for neighbouring rights. A negative count
+// leaves that number unset.
+func (vb *VideoBuilder) WithArtistCounts(featured, nonFeatured, contracted, nonContracted int) *VideoBuilder {
+	vb.video().NumberOfFeaturedArtists = artistCount(featured)
+	vb.video().NumberOfNonFeaturedArtists = artistCount(nonFeatured)
+	vb.video().NumberOfContractedArtists = artistCount(contracted)
+	vb.video().NumberOfNonContractedArtists = artistCount(nonContracted)
+	return vb
+}
+
+// WithISRC sets the ISRC for the video in ERN 3.8 - at video level, not territory
+func (vb *VideoBuilder) WithISRC(isrc string) *VideoBuilder {
+	if vb.video().VideoId == nil {
+		vb.video().VideoId = &VideoId{}
+	}
+	vb.video().VideoId.ISRC = isrc
+	return vb
+}
+
+// AddKeywordsWithLanguage adds keywords with specific language (ERN 3.8 - territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) AddKeywordsWithLanguage(keywords []string, languageCode string) *VideoDetailsByTerritoryBuilder {
+	for _, keyword := range keywords {
+		vtb.territoryDetails().Keywords = append(vtb.territoryDetails().Keywords, Keywords{
+			Value:                 keyword,
+			LanguageAndScriptCode: languageCode,
+		})
+	}
+	return vtb
+}
+
+// WithCourtesyLine sets the courtesy line crediting the video's source (territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) WithCourtesyLine(year int, text string) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().CourtesyLine = &CourtesyLine{
+		Year:             year,
+		CourtesyLineText: text,
+	}
+	return vtb
+}
+
+// WithSynopsis sets the synopsis of the video (territory specific)
+func (vtb *VideoDetailsByTerritoryBuilder) WithSynopsis(text, languageCode string) *VideoDetailsByTerritoryBuilder {
+	if languageCode == "" {
+		languageCode = "en"
+	}
+	vtb.territoryDetails().Synopsis = &Synopsis{
+		Value:                 text,
+		LanguageAndScriptCode: languageCode,
+	}
+	return vtb
+}
+
+// WithFulfillmentDate sets the date from which the video may be fulfilled (territory
+// specific). releaseRef limits it to one release of the message and may be empty.
+func (vtb *VideoDetailsByTerritoryBuilder) WithFulfillmentDate(date, releaseRef string) *VideoDetailsByTerritoryBuilder {
+	vtb.territoryDetails().FulfillmentDate = &FulfillmentDate{
+		Date:                     date,
+		ResourceReleaseReference: releaseRef,
+	}
+	return vtb
+}
+
+// AddProprietaryId adds a proprietary ID (e.g., YouTube channel ID) for ERN 3.8 - at video level
+func (vb *VideoBuilder) AddProprietaryId(namespace, value string) *VideoBuilder {
+	if vb.video().VideoId == nil {
+		vb.video().VideoId = &VideoId{}
+	}
+	vb.video().VideoId.ProprietaryId = append(vb.video().VideoId.ProprietaryId, ProprietaryId{
+		Namespace: namespace,
+		Value:     value,
+	})
+	return vb
+}
+
+// AddMusicalWorkReference adds a musical work used in the video - at video level. duration
+// is the part of the work used (ISO 8601, e.g. "PT1M30S") and startPoint where it starts in
+// the video; both may be empty when the whole work is used. Content ID uses these to claim
+// the composition.
+func (vb *VideoBuilder) AddMusicalWorkReference(iswc, duration, startPoint string) *VideoBuilder {
+	vb.video().ResourceMusicalWorkReferenceList = addMusicalWorkReference(vb.video().ResourceMusicalWorkReferenceList, iswc, duration, startPoint)
+	return vb
+}
+
+// AddMusicalWorkProprietaryId adds a proprietary work ID (e.g. a publisher's) to the musical
+// work reference added last, or to a new one without ISWC
+func (vb *VideoBuilder) AddMusicalWorkProprietaryId(namespace, value string) *VideoBuilder {
+	vb.video().ResourceMusicalWorkReferenceList = addMusicalWorkProprietaryId(vb.video().ResourceMusicalWorkReferenceList, namespace, value)
+	return vb
+}
+
+// WithExtension adds proprietary elements to the video (see NewExtension)
+func (vb *VideoBuilder) WithExtension(extensions ...RawElement) *VideoBuilder {
+	vb.video().Extensions = append(vb.video().Extensions, extensions...)
+	return vb
+}
+
+// Done returns to the main builder
+func (vb *VideoBuilder) Done() *Builder {
+	return vb.builder
+}
+
+// ImageBuilder provides fluent interface for building image resources
+type ImageBuilder struct {
+	builder *Builder
+	index   int
+}
+
+func (ib *ImageBuilder) image() *Image {
+	return &ib.builder.Message.ResourceList.Image[ib.index]
+}
+
+// ImageDetailsByTerritoryBuilder provides fluent interface for building image territory details
+type ImageDetailsByTerritoryBuilder struct {
+	imageBuilder *ImageBuilder
+	index        int
+}
+
+func (itb *ImageDetailsByTerritoryBuilder) territoryDetails() *ImageDetailsByTerritory {
+	return &itb.imageBuilder.image().ImageDetailsByTerritory[itb.index]
+}
+
+// AddImageDetailsByTerritory creates a new territory details section and returns a builder for it
+func (ib *ImageBuilder) AddImageDetailsByTerritory(territoryCodes []string) *ImageDetailsByTerritoryBuilder {
+	// Validate that at least one territory code is provided
+	if len(territoryCodes) == 0 {
+		territoryCodes = []string{"Worldwide"}
+	}
+
+	// Create new territory details
+	newDetails := ImageDetailsByTerritory{
+		TerritoryCode: territoryCodes,
+	}
+	image := ib.image()
+	image.ImageDetailsByTerritory = append(image.ImageDetailsByTerritory, newDetails)
+
+	return &ImageDetailsByTerritoryBuilder{
+		imageBuilder: ib,
+		index:        len(image.ImageDetailsByTerritory) - 1,
+	}
+}
+
+// Done returns to the image builder
+func (itb *ImageDetailsByTerritoryBuilder) Done() *ImageBuilder {
+	return itb.imageBuilder
+}
+
+// WithProprietaryId adds a proprietary ID to the image (image level, not territory)
+func (ib *ImageBuilder) WithProprietaryId(namespace, value string) *ImageBuilder {
+	ib.image().ImageId = []ImageId{
+		{
+			ProprietaryId: []ProprietaryId{
+				{Namespace: namespace, Value: value},
+			},
+		},
+	}
+	return ib
+}
+
+// WithCreationDate sets the creation date - at image level, not territory
+func (ib *ImageBuilder) WithCreationDate(date string, isApproximate bool) *ImageBuilder {
+	ib.image().CreationDate = &EventDate{
+		Value:         date,
+		IsApproximate: isApproximate,
+	}
+	return ib
+}
+
+// WithParentalWarning sets the parental warning type (territory specific)
+func (itb *ImageDetailsByTerritoryBuilder) WithParentalWarning(warningType string) *ImageDetailsByTerritoryBuilder {
+	itb.territoryDetails().ParentalWarningType = append(itb.territoryDetails().ParentalWarningType, warningType)
+	return itb
+}
+
+// WithCLine sets the C-Line information (territory specific)
+func (itb *ImageDetailsByTerritoryBuilder) WithCLine(year int, text string) *ImageDetailsByTerritoryBuilder {
+	itb.territoryDetails().CLine = append(itb.territoryDetails().CLine, CLine{
+		Year:      year,
+		CLineText: text,
+	})
+	return itb
+}
+
+// WithCourtesyLine sets the courtesy line crediting the image's source (territory specific)
+func (itb *ImageDetailsByTerritoryBuilder) WithCourtesyLine(year int, text string) *ImageDetailsByTerritoryBuilder {
+	itb.territoryDetails().CourtesyLine = &CourtesyLine{
+		Year:             year,
+		CourtesyLineText: text,
+	}
+	return itb
+}
+
+// WithSynopsis sets the synopsis of the image (territory specific)
+func (itb *ImageDetailsByTerritoryBuilder) WithSynopsis(text, languageCode string) *ImageDetailsByTerritoryBuilder {
+	if languageCode == "" {
+		languageCode = "en"
+	}
+	itb.territoryDetails().Synopsis = &Synopsis{
+		Value:                 text,
+		LanguageAndScriptCode: languageCode,
+	}
+	return itb
+}
+
+// WithFulfillmentDate sets the date from which the image may be fulfilled (territory
+// specific). releaseRef limits it to one release of the message and may be empty.
+func (itb *ImageDetailsByTerritoryBuilder) WithFulfillmentDate(date, releaseRef string) *ImageDetailsByTerritoryBuilder {
+	itb.territoryDetails().FulfillmentDate = &FulfillmentDate{
+		Date:                     date,
+		ResourceReleaseReference: releaseRef,
+	}
+	return itb
+}
+
+// WithIndirectContributor adds an indirect contributor to the image (territory specific),
+// e.g. the composer of the work a cover refers to
+func (itb *ImageDetailsByTerritoryBuilder) WithIndirectContributor(partyName string, roles ...string) *ImageDetailsByTerritoryBuilder {
+	itb.territoryDetails().IndirectResourceContributor = appendIndirectContributor(itb.territoryDetails().IndirectResourceContributor, partyName, roles)
+	return itb
+}
+
+// appendIndirectContributor adds an indirect contributor with the next sequence number.
+// Contributors without a name or role are skipped, as ERN 3.8 requires both.
+func appendIndirectContributor(contributors []IndirectResourceContributor, partyName string, roles []string) []IndirectResourceContributor {
+	if partyName == "" || len(roles) == 0 {
+		return contributors
+	}
+	return append(contributors, IndirectResourceContributor{
+		SequenceNumber:                  len(contributors) + 1,
+		PartyName:                       []PartyName{{FullName: partyName}},
+		IndirectResourceContributorRole: roles,
+	})
+}
+
+// Note: RightsController is not part of ImageDetailsByTerritory in ERN 3.8
+// Rights information for images should be managed at the Image resource level, not territory level
+
+// WithTechnicalDetails adds technical details and file FileName for images (ERN 3.8 - territory specific)
+func (itb *ImageDetailsByTerritoryBuilder) WithTechnicalDetails(techRef, fileName string) *ImageDetailsByTerritoryBuilder {
+	itb.territoryDetails().TechnicalImageDetails = append(itb.territoryDetails().TechnicalImageDetails, TechnicalImageDetails{
+		TechnicalResourceDetailsReference: techRef,
+		File: &File{
+			FileName: fileName,
+		},
+	})
+	return itb
+}
+
+// WithExtension adds proprietary elements to the image (see NewExtension)
+func (ib *ImageBuilder) WithExtension(extensions ...RawElement) *ImageBuilder {
+	ib.image().Extensions = append(ib.image().Extensions, extensions...)
+	return ib
+}
+
+// Done returns to the main builder
+func (ib *ImageBuilder) Done() *Builder {
+	return ib.builder
+}
+
+// SoundRecordingBuilder provides fluent interface for building sound recording resources
+type SoundRecordingBuilder struct {
+	builder *Builder
+	index   int
+}
+
+func (sb *SoundRecordingBuilder) soundRecording() *SoundRecording {
+	return &sb.builder.Message.ResourceList.SoundRecording[sb.index]
+}
+
+// SoundRecordingDetailsByTerritoryBuilder provides fluent interface for building sound recording territory details
+type SoundRecordingDetailsByTerritoryBuilder struct {
+	soundRecordingBuilder *SoundRecordingBuilder
+	index                 int
+}
+
+func (stb *SoundRecordingDetailsByTerritoryBuilder) territoryDetails() *SoundRecordingDetailsByTerritory {
+	return &stb.soundRecordingBuilder.soundRecording().SoundRecordingDetailsByTerritory[stb.index]
+}
+
+// AddSoundRecordingDetailsByTerritory creates a new territory details section and returns a builder for it
+func (sb *SoundRecordingBuilder) AddSoundRecordingDetailsByTerritory(territoryCodes []string) *SoundRecordingDetailsByTerritoryBuilder {
+	// Validate that at least one territory code is provided
+	if len(territoryCodes) == 0 {
+		territoryCodes = []string{"Worldwide"}
+	}
+
+	// Create new territory details
+	newDetails := SoundRecordingDetailsByTerritory{
+		TerritoryCode: territoryCodes,
+	}
+	recording := sb.soundRecording()
+	recording.SoundRecordingDetailsByTerritory = append(recording.SoundRecordingDetailsByTerritory, newDetails)
+
+	return &SoundRecordingDetailsByTerritoryBuilder{
+		soundRecordingBuilder: sb,
+		index:                 len(recording.SoundRecordingDetailsByTerritory) - 1,
+	}
+}
+
+// Done returns to the sound recording builder
+func (stb *SoundRecordingDetailsByTerritoryBuilder) Done() *SoundRecordingBuilder {
+	return stb.soundRecordingBuilder
+}
+
+// WithISRC sets the ISRC for the sound recording - at sound recording level, not territory
+func (sb *SoundRecordingBuilder) WithISRC(isrc string) *SoundRecordingBuilder {
+	if len(sb.soundRecording().SoundRecordingId) == 0 {
+		sb.soundRecording().SoundRecordingId = append(sb.soundRecording().SoundRecordingId, SoundRecordingId{})
+	}
+	sb.soundRecording().SoundRecordingId[0].ISRC = isrc
+	return sb
+}
+
+// AddProprietaryId adds a proprietary ID to the sound recording - at sound recording level
+func (sb *SoundRecordingBuilder) AddProprietaryId(namespace, value string) *SoundRecordingBuilder {
+	if len(sb.soundRecording().SoundRecordingId) == 0 {
+		sb.soundRecording().SoundRecordingId = append(sb.soundRecording().SoundRecordingId, SoundRecordingId{})
+	}
+	sb.soundRecording().SoundRecordingId[0].ProprietaryId = append(sb.soundRecording().SoundRecordingId[0].ProprietaryId, ProprietaryId{
+		Namespace: namespace,
+		Value:     value,
+	})
+	return sb
+}
+
+// AddMusicalWorkReference adds a musical work used in the sound recording - at sound
+// recording level. duration is the part of the work used (ISO 8601) and startPoint where it
+// starts; both may be empty when the whole work is used.
+func (sb *SoundRecordingBuilder) AddMusicalWorkReference(iswc, duration, startPoint string) *SoundRecordingBuilder {
+	sb.soundRecording().ResourceMusicalWorkReferenceList = addMusicalWorkReference(sb.soundRecording().ResourceMusicalWorkReferenceList, iswc, duration, startPoint)
+	return sb
+}
+
+// AddMusicalWorkProprietaryId adds a proprietary work ID to the musical work reference added
+// last, or to a new one without ISWC
+func (sb *SoundRecordingBuilder) AddMusicalWorkProprietaryId(namespace, value string) *SoundRecordingBuilder {
+	sb.soundRecording().ResourceMusicalWorkReferenceList = addMusicalWorkProprietaryId(sb.soundRecording().ResourceMusicalWorkReferenceList, namespace, value)
+	return sb
+}
+
+// addMusicalWorkReference appends a musical work reference, creating the list if needed
+func addMusicalWorkReference(list *ResourceMusicalWorkReferenceList, iswc, duration, startPoint string) *ResourceMusicalWorkReferenceList {
+	if list == nil {
+		list = &ResourceMusicalWorkReferenceList{}
+	}
+	reference := ResourceMusicalWorkReference{
+		Duration:   duration,
+		StartPoint: startPoint,
+	}
+	if iswc != "" {
+		reference.MusicalWorkId = []MusicalWorkId{{ISWC: iswc}}
+	}
+	list.ResourceMusicalWorkReference = append(list.ResourceMusicalWorkReference, reference)
+	return list
+}
+
+// addMusicalWorkProprietaryId adds a proprietary ID to the last musical work reference of
+// the list
+func addMusicalWorkProprietaryId(list *ResourceMusicalWorkReferenceList, namespace, value string) *ResourceMusicalWorkReferenceList {
+	if list == nil || len(list.ResourceMusicalWorkReference) == 0 {
+		list = addMusicalWorkReference(list, "", "", "")
+	}
+	reference := &list.ResourceMusicalWorkReference[len(list.ResourceMusicalWorkReference)-1]
+	if len(reference.MusicalWorkId) == 0 {
+		reference.MusicalWorkId = []MusicalWorkId{{}}
+	}
+	reference.MusicalWorkId[0].ProprietaryId = append(reference.MusicalWorkId[0].ProprietaryId, ProprietaryId{
+		Namespace: namespace,
+		Value:     value,
+	})
+	return list
+}
+
+// WithReferenceTitle sets the reference title for the sound recording - at sound recording level, not territory
+func (sb *SoundRecordingBuilder) WithReferenceTitle(titleText, subtitle string) *SoundRecordingBuilder {
+	sb.soundRecording().ReferenceTitle = &ReferenceTitle{
+		TitleText: titleText,
+		SubTitle:  subtitle,
+	}
+	return sb
+}
+
+// WithArtistCounts sets the number of featured, non-featured, contracted and
+// non-contracted artists on the sound recording, as used for neighbouring rights. A negative count
+// leaves that number unset.
+func (sb *SoundRecordingBuilder) WithArtistCounts(featured, nonFeatured, contracted, nonContracted int) *SoundRecordingBuilder {
+	sb.soundRecording().NumberOfFeaturedArtists = artistCount(featured)
+	sb.soundRecording().NumberOfNonFeaturedArtists = artistCount(nonFeatured)
+	sb.soundRecording().NumberOfContractedArtists = artistCount(contracted)
+	sb.soundRecording().NumberOfNonContractedArtists = artistCount(nonContracted)
+	return sb
+}
+
+// WithDuration sets the sound recording duration (e.g., "PT3M10S") - at sound recording level, not territory
+func (sb *SoundRecordingBuilder) WithDuration(duration string) *SoundRecordingBuilder {
+	sb.soundRecording().Duration = duration
+	return sb
+}
+
+// WithCreationDate sets the creation date - at sound recording level, not territory
+func (sb *SoundRecordingBuilder) WithCreationDate(date string, isApproximate bool) *SoundRecordingBuilder {
+	sb.soundRecording().CreationDate = &EventDate{
+		Value:         date,
+		IsApproximate: isApproximate,
+	}
+	return sb
+}
+
+// SetIsArtistRelated marks the sound recording as artist related (e.g. a track on an artist album rather than a compilation)
+func (sb *SoundRecordingBuilder) SetIsArtistRelated(isArtistRelated bool) *SoundRecordingBuilder {
+	sb.soundRecording().IsArtistRelated = Bool(isArtistRelated)
+	return sb
+}
+
+// SetIsMedley marks the sound recording as a medley of several works
+func (sb *SoundRecordingBuilder) SetIsMedley(isMedley bool) *SoundRecordingBuilder {
+	sb.soundRecording().IsMedley = Bool(isMedley)
+	return sb
+}
+
+// SetIsPotpourri marks the sound recording as a potpourri of several works
+func (sb *SoundRecordingBuilder) SetIsPotpourri(isPotpourri bool) *SoundRecordingBuilder {
+	sb.soundRecording().IsPotpourri = Bool(isPotpourri)
+	return sb
+}
+
+// SetIsInstrumental marks the sound recording as instrumental, without lyrics
+func (sb *SoundRecordingBuilder) SetIsInstrumental(isInstrumental bool) *SoundRecordingBuilder {
+	sb.soundRecording().IsInstrumental = Bool(isInstrumental)
+	return sb
+}
+
+// SetIsBackground marks the sound recording as background material
+func (sb *SoundRecordingBuilder) SetIsBackground(isBackground bool) *SoundRecordingBuilder {
+	sb.soundRecording().IsBackground = Bool(isBackground)
+	return sb
+}
+
+// SetIsHiddenResource marks the sound recording as hidden, e.g. a hidden track after the last one
+func (sb *SoundRecordingBuilder) SetIsHiddenResource(isHidden bool) *SoundRecordingBuilder {
+	sb.soundRecording().IsHiddenResource = Bool(isHidden)
+	return sb
+}
+
+// SetHasPreOrderFulfillment marks the sound recording as delivered to pre-order customers before the release date
+func (sb *SoundRecordingBuilder) SetHasPreOrderFulfillment(hasFulfillment bool) *SoundRecordingBuilder {
+	sb.soundRecording().HasPreOrderFulfillment = Bool(hasFulfillment)
+	return sb
+}
+
+// SetIsRemastered marks the sound recording as remastered
+func (sb *SoundRecordingBuilder) SetIsRemastered(isRemastered bool) *SoundRecordingBuilder {
+	sb.soundRecording().IsRemastered = Bool(isRemastered)
+	return sb
+}
+
+// SetNoSilenceBefore marks the sound recording as starting without silence, for gapless playback
+func (sb *SoundRecordingBuilder) SetNoSilenceBefore(noSilence bool) *SoundRecordingBuilder {
+	sb.soundRecording().NoSilenceBefore = Bool(noSilence)
+	return sb
+}
+
+// SetNoSilenceAfter marks the sound recording as ending without silence, for gapless playback
+func (sb *SoundRecordingBuilder) SetNoSilenceAfter(noSilence bool) *SoundRecordingBuilder {
+	sb.soundRecording().NoSilenceAfter = Bool(noSilence)
+	return sb
+}
+
+// SetPerformerInformationRequired marks the sound recording as needing performer information from the recipient
+func (sb *SoundRecordingBuilder) SetPerformerInformationRequired(required bool) *SoundRecordingBuilder {
+	sb.soundRecording().PerformerInformationRequired = Bool(required)
+	return sb
+}
+
+// AddTitle adds a title to the current territory
+func (stb *SoundRecordingDetailsByTerritoryBuilder) AddTitle(titleText, subtitle, languageCode, titleType string) *SoundRecordingDetailsByTerritoryBuilder {
+	title := Title{
+		TitleText: titleText,
+	}
+
+	if subtitle != "" {
+		title.SubTitle = subtitle
+	}
+
+	if languageCode != "" {
+		title.LanguageAndScriptCode = languageCode
+	}
+
+	if titleType != "" {
+		title.TitleType = titleType
+	}
+
+	stb.territoryDetails().Title = append(stb.territoryDetails().Title, title)
+	return stb
+}
+
+// WithDisplayArtistName sets the display artist name for the current territory
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithDisplayArtistName(artistName, languageCode string) *SoundRecordingDetailsByTerritoryBuilder {
+	if languageCode == "" {
+		languageCode = "en"
+	}
+	stb.territoryDetails().DisplayArtistName = append(stb.territoryDetails().DisplayArtistName, DisplayArtistName{
+		Value:                 artistName,
+		LanguageAndScriptCode: languageCode,
+	})
+	return stb
+}
+
+// WithArtist adds a display artist reference for the current territory
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithArtist(artistName string, roles []string, sequence int) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().DisplayArtist = append(stb.territoryDetails().DisplayArtist, DisplayArtist{
+		SequenceNumber: sequence,
+		PartyName: []PartyName{
+			{FullName: artistName},
+		},
+		ArtistRole: roles,
+	})
+	return stb
+}
+
+// WithConductor adds a display conductor for the current territory. ERN 3.8 names
+// conductors inline, so partyName is the conductor's name rather than a party reference.
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithConductor(partyName string, sequence int) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().DisplayConductor = append(stb.territoryDetails().DisplayConductor, DisplayConductor{
+		SequenceNumber: sequence,
+		PartyName: []PartyName{
+			{FullName: partyName},
+		},
+		ArtistRole: []string{"Conductor"},
+	})
+	return stb
+}
+
+// WithLabel adds a label name for the current territory
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithLabel(labelName, labelNameType, languageCode string) *SoundRecordingDetailsByTerritoryBuilder {
+	if languageCode == "" {
+		languageCode = "en"
+	}
+	stb.territoryDetails().LabelName = append(stb.territoryDetails().LabelName, LabelName{
+		Value:                 labelName,
+		LabelNameType:         labelNameType,
+		LanguageAndScriptCode: languageCode,
+	})
+	return stb
+}
+
+// WithResourceContributor adds a contributor to the sound recording (territory specific)
+// role can be multiple values like "Producer", "MixingEngineer", etc.
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithResourceContributor(partyName string, roles []string, sequence int) *SoundRecordingDetailsByTerritoryBuilder {
+	if partyName != "" && len(roles) > 0 {
+		stb.territoryDetails().ResourceContributor = append(stb.territoryDetails().ResourceContributor, ResourceContributor{
+			SequenceNumber: sequence,
+			PartyName: []PartyName{
+				{FullName: partyName},
+			},
+			ResourceContributorRole: roles,
+		})
+	}
+
+	return stb
+}
+
+// WithIndirectResourceContributor adds an indirect contributor to the sound recording (territory specific)
+// role can be multiple values like "Composer", "Lyricist", etc.
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithIndirectResourceContributor(partyName string, roles []string, sequence int) *SoundRecordingDetailsByTerritoryBuilder {
+	if partyName != "" && len(roles) > 0 {
+		stb.territoryDetails().IndirectResourceContributor = append(stb.territoryDetails().IndirectResourceContributor, IndirectResourceContributor{
+			SequenceNumber: sequence,
+			PartyName: []PartyName{
+				{FullName: partyName},
+			},
+			IndirectResourceContributorRole: roles,
+		})
+	}
+
+	return stb
+}
+
+// WithIndirectContributor adds a composer, lyricist, arranger or other indirect contributor
+// to the sound recording (territory specific), numbered after the ones already added
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithIndirectContributor(partyName string, roles ...string) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().IndirectResourceContributor = appendIndirectContributor(stb.territoryDetails().IndirectResourceContributor, partyName, roles)
+	return stb
+}
+
+// WithRightsController sets the rights controller (territory specific)
+// Parameters: partyName, partyId, and percentage (rounded to two decimals)
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithRightsController(partyName, partyId string, percentage float64) *SoundRecordingDetailsByTerritoryBuilder {
+	share, err := DecimalFromFloat(percentage, 2)
+	if err != nil {
+		stb.soundRecordingBuilder.builder.fail(fmt.Errorf("right share of %s: %w", partyName, err))
+		return stb
 	}
-	return vb
+	return stb.WithRightsControllerShare(partyName, partyId, share)
 }
 
-// WithReferenceTitle sets the reference title for the video - at video level, not territory
-func (vb *VideoBuilder) WithReferenceTitle(titleText, subtitle string) *VideoBuilder {
-	vb.video.ReferenceTitle = &ReferenceTitle{
-		TitleText: titleText,
-		SubTitle:  subtitle,
+// WithRightsControllerShare sets the rights controller with an exact share (territory
+// specific)
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithRightsControllerShare(partyName, partyId string, share Decimal) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().RightsController = append(stb.territoryDetails().RightsController, newRightsController(partyName, partyId, share))
+	return stb
+}
+
+// WithRightsControllerShareString sets the rights controller with a share such as "33.34"
+// (territory specific). An invalid share is returned by Err and the output methods.
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithRightsControllerShareString(partyName, partyId, share string) *SoundRecordingDetailsByTerritoryBuilder {
+	d, err := ParseDecimal(share)
+	if err != nil {
+		stb.soundRecordingBuilder.builder.fail(fmt.Errorf("right share of %s: %w", partyName, err))
+		return stb
 	}
-	return vb
+	return stb.WithRightsControllerShare(partyName, partyId, d)
 }
 
-// WithParentalWarning sets the parental warning type (territory specific)
-func (vtb *VideoDetailsByTerritoryBuilder) WithParentalWarning(warningType string) *VideoDetailsByTerritoryBuilder {
-	vtb.territoryDetails.ParentalWarningType = append(vtb.territoryDetails.ParentalWarningType, warningType)
-	return vtb
+// WithRightsControllerShareRat sets the rights controller with a share rounded to scale
+// digits after the decimal point (territory specific)
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithRightsControllerShareRat(partyName, partyId string, share *big.Rat, scale int) *SoundRecordingDetailsByTerritoryBuilder {
+	return stb.WithRightsControllerShare(partyName, partyId, DecimalFromRat(share, scale))
 }
 
-// WithPLine sets the P-Line information for ERN 3.8 (territory specific)
-func (vtb *VideoDetailsByTerritoryBuilder) WithPLine(year int, text string) *VideoDetailsByTerritoryBuilder {
-	vtb.territoryDetails.PLine = append(vtb.territoryDetails.PLine, PLine{
-		Year:      year,
-		PLineText: text,
-	})
-	return vtb
+// newRightsController creates a rights controller with a right share
+func newRightsController(partyName, partyId string, share Decimal) RightsController {
+	return RightsController{
+		PartyName: []Name{
+			{FullName: partyName},
+		},
+		PartyId: []PartyID{
+			{Value: partyId},
+		},
+		RightsControllerRole: []string{"RightsController"},
+		RightSharePercentage: &share,
+	}
 }
 
-// WithCLine sets the C-Line information for ERN 3.8 (territory specific)
-func (vtb *VideoDetailsByTerritoryBuilder) WithCLine(year int, text string) *VideoDetailsByTerritoryBuilder {
-	vtb.territoryDetails.CLine = append(vtb.territoryDetails.CLine, CLine{
+// WithPLine sets the P-Line information (territory specific)
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithPLine(year int, text string) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().PLine = append(stb.territoryDetails().PLine, PLine{
 		Year:      year,
-		CLineText: text,
+		PLineText: text,
 	})
-	return vtb
+	return stb
 }
 
 // WithGenre adds genre information (territory specific)
-func (vtb *VideoDetailsByTerritoryBuilder) WithGenre(genreText string) *VideoDetailsByTerritoryBuilder {
-	genre := Genre{
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithGenre(genreText string) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().Genre = append(stb.territoryDetails().Genre, Genre{
 		GenreText: genreText,
-	}
-	vtb.territoryDetails.Genre = append(vtb.territoryDetails.Genre, genre)
-	return vtb
+	})
+	return stb
 }
 
 // WithGenreAndSubGenre adds genre information with a subgenre for the current territory
-func (vtb *VideoDetailsByTerritoryBuilder) WithGenreAndSubGenre(genreText, subGenre string) *VideoDetailsByTerritoryBuilder {
-	vtb.territoryDetails.Genre = append(vtb.territoryDetails.Genre, Genre{
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithGenreAndSubGenre(genreText, subGenre string) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().Genre = append(stb.territoryDetails().Genre, Genre{
 		GenreText: genreText,
 		SubGenre:  subGenre,
 	})
-	return vtb
+	return stb
 }
 
-// WithTechnicalDetails adds technical details and file FileName for ERN 3.8 (territory specific)
-func (vtb *VideoDetailsByTerritoryBuilder) WithTechnicalDetails(techRef, fileName string) *VideoDetailsByTerritoryBuilder {
-	vtb.territoryDetails.TechnicalVideoDetails = append(vtb.territoryDetails.TechnicalVideoDetails, TechnicalVideoDetails{
-		TechnicalResourceDetailsReference: techRef,
-		File: &File{
-			FileName: fileName,
-		},
-	})
-	return vtb
+// WithParentalWarning sets the parental warning type (territory specific)
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithParentalWarning(warningType string) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().ParentalWarningType = append(stb.territoryDetails().ParentalWarningType, warningType)
+	return stb
 }
 
-// WithISRC sets the ISRC for the video in ERN 3.8 - at video level, not territory
-func (vb *VideoBuilder) WithISRC(isrc string) *VideoBuilder {
-	if vb.video.VideoId == nil {
-		vb.video.VideoId = &VideoId{}
-	}
-	vb.video.VideoId.ISRC = isrc
-	return vb
+// WithSequenceNumber sets the position of the sound recording within its release (territory specific)
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithSequenceNumber(sequence int) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().SequenceNumber = &sequence
+	return stb
 }
 
-// AddKeywordsWithLanguage adds keywords with specific language (ERN 3.8 - territory specific)
-func (vtb *VideoDetailsByTerritoryBuilder) AddKeywordsWithLanguage(keywords []string, languageCode string) *VideoDetailsByTerritoryBuilder {
+// AddKeywordsWithLanguage adds keywords with specific language (territory specific)
+func (stb *SoundRecordingDetailsByTerritoryBuilder) AddKeywordsWithLanguage(keywords []string, languageCode string) *SoundRecordingDetailsByTerritoryBuilder {
 	for _, keyword := range keywords {
-		vtb.territoryDetails.Keywords = append(vtb.territoryDetails.Keywords, Keywords{
+		stb.territoryDetails().Keywords = append(stb.territoryDetails().Keywords, Keywords{
 			Value:                 keyword,
 			LanguageAndScriptCode: languageCode,
 		})
 	}
-	return vtb
+	return stb
 }
 
-// AddProprietaryId adds a proprietary ID (e.g., YouTube channel ID) for ERN 3.8 - at video level
-func (vb *VideoBuilder) AddProprietaryId(namespace, value string) *VideoBuilder {
-	if vb.video.VideoId == nil {
-		vb.video.VideoId = &VideoId{}
+// WithCourtesyLine sets the courtesy line crediting the sound recording's source (territory specific)
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithCourtesyLine(year int, text string) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().CourtesyLine = &CourtesyLine{
+		Year:             year,
+		CourtesyLineText: text,
 	}
-	vb.video.VideoId.ProprietaryId = append(vb.video.VideoId.ProprietaryId, ProprietaryId{
-		Namespace: namespace,
-		Value:     value,
-	})
-	return vb
-}
-
-// Done returns to the main builder
-func (vb *VideoBuilder) Done() *Builder {
-	return vb.builder
-}
-
-// ImageBuilder provides fluent interface for building image resources
-type ImageBuilder struct {
-	builder                 *Builder
-	image                   *Image
-	currentTerritoryDetails *ImageDetailsByTerritory
-	currentTerritoryIndex   int
-}
-
-// ImageDetailsByTerritoryBuilder provides fluent interface for building image territory details
-type ImageDetailsByTerritoryBuilder struct {
-	imageBuilder     *ImageBuilder
-	territoryDetails *ImageDetailsByTerritory
+	return stb
 }
 
-// AddImageDetailsByTerritory creates a new territory details section and returns a builder for it
-func (ib *ImageBuilder) AddImageDetailsByTerritory(territoryCodes []string) *ImageDetailsByTerritoryBuilder {
-	// Validate that at least one territory code is provided
-	if len(territoryCodes) == 0 {
-		territoryCodes = []string{"Worldwide"}
+// WithSynopsis sets the synopsis of the sound recording (territory specific)
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithSynopsis(text, languageCode string) *SoundRecordingDetailsByTerritoryBuilder {
+	if languageCode == "" {
+		languageCode = "en"
 	}
-
-	// Create new territory details
-	newDetails := ImageDetailsByTerritory{
-		TerritoryCode: territoryCodes,
+	stb.territoryDetails().Synopsis = &Synopsis{
+		Value:                 text,
+		LanguageAndScriptCode: languageCode,
 	}
-	ib.image.ImageDetailsByTerritory = append(ib.image.ImageDetailsByTerritory, newDetails)
-	ib.currentTerritoryIndex = len(ib.image.ImageDetailsByTerritory) - 1
-	ib.currentTerritoryDetails = &ib.image.ImageDetailsByTerritory[ib.currentTerritoryIndex]
+	return stb
+}
 
-	return &ImageDetailsByTerritoryBuilder{
-		imageBuilder:     ib,
-		territoryDetails: ib.currentTerritoryDetails,
+// WithFulfillmentDate sets the date from which the sound recording may be fulfilled (territory
+// specific). releaseRef limits it to one release of the message and may be empty.
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithFulfillmentDate(date, releaseRef string) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().FulfillmentDate = &FulfillmentDate{
+		Date:                     date,
+		ResourceReleaseReference: releaseRef,
 	}
+	return stb
 }
 
-// Done returns to the image builder
-func (itb *ImageDetailsByTerritoryBuilder) Done() *ImageBuilder {
-	return itb.imageBuilder
+// WithTechnicalDetails adds technical details and file FileName (territory specific)
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithTechnicalDetails(techRef, fileName string) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().TechnicalSoundRecordingDetails = append(stb.territoryDetails().TechnicalSoundRecordingDetails, TechnicalSoundRecordingDetails{
+		TechnicalResourceDetailsReference: techRef,
+		File: &File{
+			FileName: fileName,
+		},
+	})
+	return stb
 }
 
-// WithProprietaryId adds a proprietary ID to the image (image level, not territory)
-func (ib *ImageBuilder) WithProprietaryId(namespace, value string) *ImageBuilder {
-	ib.image.ImageId = []ImageId{
-		{
-			ProprietaryId: []ProprietaryId{
-				{Namespace: namespace, Value: value},
-			},
-		},
-	}
-	return ib
+// WithTechnicalSoundRecordingDetails adds fully populated technical details (codec, bit rate,
+// channels, sampling rate, ...) as read from the audio file
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithTechnicalSoundRecordingDetails(details TechnicalSoundRecordingDetails) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().TechnicalSoundRecordingDetails = append(stb.territoryDetails().TechnicalSoundRecordingDetails, details)
+	return stb
 }
 
-// WithCreationDate sets the creation date - at image level, not territory
-func (ib *ImageBuilder) WithCreationDate(date string, isApproximate bool) *ImageBuilder {
-	ib.image.CreationDate = &EventDate{
-		Value:         date,
-		IsApproximate: isApproximate,
-	}
-	return ib
+// AddHostSoundCarrier records the release the sound recording originally came out on, for
+// reissues and remasters: its ICPN, catalog number and title, each optional
+func (stb *SoundRecordingDetailsByTerritoryBuilder) AddHostSoundCarrier(icpn, catalogNumber, title string) *SoundRecordingDetailsByTerritoryBuilder {
+	return stb.WithHostSoundCarrier(newHostSoundCarrier(icpn, catalogNumber, title))
 }
 
-// WithParentalWarning sets the parental warning type (territory specific)
-func (itb *ImageDetailsByTerritoryBuilder) WithParentalWarning(warningType string) *ImageDetailsByTerritoryBuilder {
-	itb.territoryDetails.ParentalWarningType = append(itb.territoryDetails.ParentalWarningType, warningType)
-	return itb
+// WithHostSoundCarrier adds a fully specified host sound carrier, e.g. with a GRid, several
+// titles or the original release date
+func (stb *SoundRecordingDetailsByTerritoryBuilder) WithHostSoundCarrier(carrier HostSoundCarrier) *SoundRecordingDetailsByTerritoryBuilder {
+	stb.territoryDetails().HostSoundCarrier = append(stb.territoryDetails().HostSoundCarrier, carrier)
+	return stb
 }
 
-// WithCLine sets the C-Line information (territory specific)
-func (itb *ImageDetailsByTerritoryBuilder) WithCLine(year int, text string) *ImageDetailsByTerritoryBuilder {
-	itb.territoryDetails.CLine = append(itb.territoryDetails.CLine, CLine{
-		Year:      year,
-		CLineText: text,
-	})
-	return itb
+// artistCount returns a pointer to n, or nil if n is negative
+func artistCount(n int) *int {
+	if n < 0 {
+		return nil
+	}
+	return &n
 }
 
-// Note: RightsController is not part of ImageDetailsByTerritory in ERN 3.8
-// Rights information for images should be managed at the Image resource level, not territory level
+// newHostSoundCarrier creates a host sound carrier with the fields that are not empty
+func newHostSoundCarrier(icpn, catalogNumber, title string) HostSoundCarrier {
+	var carrier HostSoundCarrier
+	if icpn != "" {
+		carrier.ReleaseId = []ReleaseId{{ICPN: icpn}}
+	}
+	if catalogNumber != "" {
+		carrier.CatalogNumber = &CatalogNumber{Value: catalogNumber}
+	}
+	if title != "" {
+		carrier.Title = []Title{{TitleText: title}}
+	}
+	return carrier
+}
 
-// WithTechnicalDetails adds technical details and file FileName for images (ERN 3.8 - territory specific)
-func (itb *ImageDetailsByTerritoryBuilder) WithTechnicalDetails(techRef, fileName string) *ImageDetailsByTerritoryBuilder {
-	itb.territoryDetails.TechnicalImageDetails = append(itb.territoryDetails.TechnicalImageDetails, TechnicalImageDetails{
-		TechnicalResourceDetailsReference: techRef,
-		File: &File{
-			FileName: fileName,
-		},
-	})
-	return itb
+// WithExtension adds proprietary elements to the sound recording (see NewExtension)
+func (sb *SoundRecordingBuilder) WithExtension(extensions ...RawElement) *SoundRecordingBuilder {
+	sb.soundRecording().Extensions = append(sb.soundRecording().Extensions, extensions...)
+	return sb
 }
 
 // Done returns to the main builder
-func (ib *ImageBuilder) Done() *Builder {
-	return ib.builder
+func (sb *SoundRecordingBuilder) Done() *Builder {
+	return sb.builder
 }
 
 // ReleaseBuilder provides fluent interface for building releases
 type ReleaseBuilder struct {
-	builder                 *Builder
-	release                 *Release
-	currentTerritoryDetails *ReleaseDetailsByTerritory
-	currentTerritoryIndex   int
+	builder *Builder
+	index   int
+}
+
+func (rb *ReleaseBuilder) release() *Release {
+	return &rb.builder.Message.ReleaseList.Release[rb.index]
 }
 
 // ReleaseDetailsByTerritoryBuilder provides fluent interface for building release territory details
 type ReleaseDetailsByTerritoryBuilder struct {
-	releaseBuilder   *ReleaseBuilder
-	territoryDetails *ReleaseDetailsByTerritory
+	releaseBuilder *ReleaseBuilder
+	index          int
+}
+
+func (rtb *ReleaseDetailsByTerritoryBuilder) territoryDetails() *ReleaseDetailsByTerritory {
+	return &rtb.releaseBuilder.release().ReleaseDetailsByTerritory[rtb.index]
 }
 
 // WithTitle sets the reference title for the release (mandatory in ERN 3.8)
 func (rb *ReleaseBuilder) WithTitle(title, subtitle string) *ReleaseBuilder {
-	rb.release.ReferenceTitle = &ReferenceTitle{
+	rb.release().ReferenceTitle = &ReferenceTitle{
 		TitleText: title,
 		SubTitle:  subtitle,
 	}
@@ -570,10 +1737,43 @@ func (rb *ReleaseBuilder) WithTitle(title, subtitle string) *ReleaseBuilder {
 
 // SetMainRelease sets whether this release is the main release
 func (rb *ReleaseBuilder) SetMainRelease(isMain bool) *ReleaseBuilder {
-	rb.release.IsMainRelease = isMain
+	rb.release().IsMainRelease = isMain
+	return rb
+}
+
+// WithLanguageOfPerformance adds a language the release is performed in, as ISO 639-2
+// ("en" is converted to "eng")
+func (rb *ReleaseBuilder) WithLanguageOfPerformance(language string) *ReleaseBuilder {
+	rb.release().LanguageOfPerformance = appendLanguage(rb.release().LanguageOfPerformance, language)
+	return rb
+}
+
+// WithLanguageOfDubbing adds a language the release is dubbed in, as ISO 639-2
+func (rb *ReleaseBuilder) WithLanguageOfDubbing(language string) *ReleaseBuilder {
+	rb.release().LanguageOfDubbing = appendLanguage(rb.release().LanguageOfDubbing, language)
+	return rb
+}
+
+// WithSubTitleLanguage adds a language the release has subtitles in, as ISO 639-2
+func (rb *ReleaseBuilder) WithSubTitleLanguage(language string) *ReleaseBuilder {
+	rb.release().SubTitleLanguage = appendLanguage(rb.release().SubTitleLanguage, language)
 	return rb
 }
 
+// appendLanguage adds language to languages as ISO 639-2 unless it is empty or already there
+func appendLanguage(languages []string, language string) []string {
+	code := languageCode(language)
+	if code == "" {
+		return languages
+	}
+	for _, existing := range languages {
+		if existing == code {
+			return languages
+		}
+	}
+	return append(languages, code)
+}
+
 // AddReleaseDetailsByTerritory creates a new territory details section and returns a builder for it
 // This is mandatory in ERN 3.8 - at least one territory must be specified
 func (rb *ReleaseBuilder) AddReleaseDetailsByTerritory(territoryCodes []string) *ReleaseDetailsByTerritoryBuilder {
@@ -586,13 +1786,12 @@ func (rb *ReleaseBuilder) AddReleaseDetailsByTerritory(territoryCodes []string)
 	territoryDetails := ReleaseDetailsByTerritory{
 		TerritoryCode: territoryCodes,
 	}
-	rb.release.ReleaseDetailsByTerritory = append(rb.release.ReleaseDetailsByTerritory, territoryDetails)
-	rb.currentTerritoryIndex = len(rb.release.ReleaseDetailsByTerritory) - 1
-	rb.currentTerritoryDetails = &rb.release.ReleaseDetailsByTerritory[rb.currentTerritoryIndex]
+	release := rb.release()
+	release.ReleaseDetailsByTerritory = append(release.ReleaseDetailsByTerritory, territoryDetails)
 
 	return &ReleaseDetailsByTerritoryBuilder{
-		releaseBuilder:   rb,
-		territoryDetails: rb.currentTerritoryDetails,
+		releaseBuilder: rb,
+		index:          len(release.ReleaseDetailsByTerritory) - 1,
 	}
 }
 
@@ -606,7 +1805,7 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) WithDisplayArtistName(artistName, l
 	if languageCode == "" {
 		languageCode = "en"
 	}
-	rtb.territoryDetails.DisplayArtistName = append(rtb.territoryDetails.DisplayArtistName, DisplayArtistName{
+	rtb.territoryDetails().DisplayArtistName = append(rtb.territoryDetails().DisplayArtistName, DisplayArtistName{
 		Value:                 artistName,
 		LanguageAndScriptCode: languageCode,
 	})
@@ -622,7 +1821,7 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) WithArtist(artistName string, roles
 		},
 		ArtistRole: roles,
 	}
-	rtb.territoryDetails.DisplayArtist = append(rtb.territoryDetails.DisplayArtist, artist)
+	rtb.territoryDetails().DisplayArtist = append(rtb.territoryDetails().DisplayArtist, artist)
 	return rtb
 }
 
@@ -631,7 +1830,7 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) WithLabel(labelName, languageCode s
 	if languageCode == "" {
 		languageCode = "en"
 	}
-	rtb.territoryDetails.LabelName = append(rtb.territoryDetails.LabelName, LabelName{
+	rtb.territoryDetails().LabelName = append(rtb.territoryDetails().LabelName, LabelName{
 		Value:                 labelName,
 		LanguageAndScriptCode: languageCode,
 	})
@@ -656,7 +1855,7 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) AddTitle(titleText, subtitle, langu
 		title.TitleType = titleType
 	}
 
-	rtb.territoryDetails.Title = append(rtb.territoryDetails.Title, title)
+	rtb.territoryDetails().Title = append(rtb.territoryDetails().Title, title)
 	return rtb
 }
 
@@ -667,13 +1866,13 @@ func (rb *ReleaseBuilder) WithPLine(year int, text string) *ReleaseBuilder {
 		PLineText: text,
 	}
 	// Add to global release
-	rb.release.PLine = append(rb.release.PLine, pline)
+	rb.release().PLine = append(rb.release().PLine, pline)
 	return rb
 }
 
 // WithTerritoryPLine adds P-Line information for the current territory
 func (rtb *ReleaseDetailsByTerritoryBuilder) WithTerritoryPLine(year int, text string) *ReleaseDetailsByTerritoryBuilder {
-	rtb.territoryDetails.PLine = append(rtb.territoryDetails.PLine, PLine{
+	rtb.territoryDetails().PLine = append(rtb.territoryDetails().PLine, PLine{
 		Year:      year,
 		PLineText: text,
 	})
@@ -687,13 +1886,13 @@ func (rb *ReleaseBuilder) WithCLine(year int, text string) *ReleaseBuilder {
 		CLineText: text,
 	}
 	// Add to global release
-	rb.release.CLine = append(rb.release.CLine, cline)
+	rb.release().CLine = append(rb.release().CLine, cline)
 	return rb
 }
 
 // WithTerritoryCLine adds C-Line information for the current territory
 func (rtb *ReleaseDetailsByTerritoryBuilder) WithTerritoryCLine(year int, text string) *ReleaseDetailsByTerritoryBuilder {
-	rtb.territoryDetails.CLine = append(rtb.territoryDetails.CLine, CLine{
+	rtb.territoryDetails().CLine = append(rtb.territoryDetails().CLine, CLine{
 		Year:      year,
 		CLineText: text,
 	})
@@ -702,22 +1901,23 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) WithTerritoryCLine(year int, text s
 
 // WithDuration sets the release duration
 func (rb *ReleaseBuilder) WithDuration(duration string) *ReleaseBuilder {
-	rb.release.Duration = duration
+	rb.release().Duration = duration
 	return rb
 }
 
 // WithReleaseDate sets ReleaseDate for the current territory
 func (rtb *ReleaseDetailsByTerritoryBuilder) WithReleaseDate(date string) *ReleaseDetailsByTerritoryBuilder {
-	rtb.territoryDetails.ReleaseDate = &EventDate{
+	rtb.territoryDetails().ReleaseDate = &EventDate{
 		XMLName: xml.Name{Local: "ReleaseDate"},
 		Value:   date,
 	}
 	return rtb
 }
 
-// WithOriginalReleaseDate sets OriginalReleaseDate for the current territory
+// WithOriginalReleaseDate sets OriginalReleaseDate for the current territory; partial dates
+// (YYYY or YYYY-MM) are accepted, as original release dates are often only known by year
 func (rtb *ReleaseDetailsByTerritoryBuilder) WithOriginalReleaseDate(date string) *ReleaseDetailsByTerritoryBuilder {
-	rtb.territoryDetails.OriginalReleaseDate = &EventDate{
+	rtb.territoryDetails().OriginalReleaseDate = &EventDate{
 		XMLName: xml.Name{Local: "OriginalReleaseDate"},
 		Value:   date,
 	}
@@ -726,7 +1926,7 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) WithOriginalReleaseDate(date string
 
 // WithGenre adds genre information for the current territory
 func (rtb *ReleaseDetailsByTerritoryBuilder) WithGenre(genreText string) *ReleaseDetailsByTerritoryBuilder {
-	rtb.territoryDetails.Genre = append(rtb.territoryDetails.Genre, Genre{
+	rtb.territoryDetails().Genre = append(rtb.territoryDetails().Genre, Genre{
 		GenreText: genreText,
 	})
 	return rtb
@@ -734,7 +1934,7 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) WithGenre(genreText string) *Releas
 
 // WithGenreAndSubGenre adds genre information with a subgenre for the current territory
 func (rtb *ReleaseDetailsByTerritoryBuilder) WithGenreAndSubGenre(genreText, subGenre string) *ReleaseDetailsByTerritoryBuilder {
-	rtb.territoryDetails.Genre = append(rtb.territoryDetails.Genre, Genre{
+	rtb.territoryDetails().Genre = append(rtb.territoryDetails().Genre, Genre{
 		GenreText: genreText,
 		SubGenre:  subGenre,
 	})
@@ -743,7 +1943,7 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) WithGenreAndSubGenre(genreText, sub
 
 // WithParentalWarning sets the parental warning type for the current territory
 func (rtb *ReleaseDetailsByTerritoryBuilder) WithParentalWarning(warningType string) *ReleaseDetailsByTerritoryBuilder {
-	rtb.territoryDetails.ParentalWarningType = append(rtb.territoryDetails.ParentalWarningType, ParentalWarningType{
+	rtb.territoryDetails().ParentalWarningType = append(rtb.territoryDetails().ParentalWarningType, ParentalWarningType{
 		Value: warningType,
 	})
 	return rtb
@@ -758,7 +1958,7 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) WithAvRating(ratingText, agencyValu
 			Namespace: agencyNamespace,
 		},
 	}
-	rtb.territoryDetails.AvRating = append(rtb.territoryDetails.AvRating, avRating)
+	rtb.territoryDetails().AvRating = append(rtb.territoryDetails().AvRating, avRating)
 	return rtb
 }
 
@@ -767,7 +1967,7 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) WithMarketingComment(comment, langu
 	if languageCode == "" {
 		languageCode = "en"
 	}
-	rtb.territoryDetails.MarketingComment = &Comment{
+	rtb.territoryDetails().MarketingComment = &Comment{
 		Value:                 comment,
 		LanguageAndScriptCode: languageCode,
 	}
@@ -784,14 +1984,27 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) AddKeywordsWithLanguage(keywords []
 			Value:                 keyword,
 			LanguageAndScriptCode: languageCode,
 		}
-		rtb.territoryDetails.Keywords = append(rtb.territoryDetails.Keywords, keywordsEntry)
+		rtb.territoryDetails().Keywords = append(rtb.territoryDetails().Keywords, keywordsEntry)
+	}
+	return rtb
+}
+
+// WithSynopsis sets the synopsis of the release for the current territory. ERN 3.8 has no
+// courtesy line for releases; set it on the resources instead.
+func (rtb *ReleaseDetailsByTerritoryBuilder) WithSynopsis(text, languageCode string) *ReleaseDetailsByTerritoryBuilder {
+	if languageCode == "" {
+		languageCode = "en"
+	}
+	rtb.territoryDetails().Synopsis = &Synopsis{
+		Value:                 text,
+		LanguageAndScriptCode: languageCode,
 	}
 	return rtb
 }
 
 // WithICPN sets the ICPN identifier for the release (ERN 3.8)
 func (rb *ReleaseBuilder) WithICPN(icpn string) *ReleaseBuilder {
-	rb.release.ReleaseId = append(rb.release.ReleaseId, ReleaseId{
+	rb.release().ReleaseId = append(rb.release().ReleaseId, ReleaseId{
 		ICPN: icpn,
 	})
 	return rb
@@ -800,7 +2013,7 @@ func (rb *ReleaseBuilder) WithICPN(icpn string) *ReleaseBuilder {
 // WithISRC sets the ISRC identifier for the release
 // Only applicable when the Release contains only one SoundRecording or one MusicalWorkVideo
 func (rb *ReleaseBuilder) WithISRC(isrc string) *ReleaseBuilder {
-	rb.release.ReleaseId = append(rb.release.ReleaseId, ReleaseId{
+	rb.release().ReleaseId = append(rb.release().ReleaseId, ReleaseId{
 		ISRC: isrc,
 	})
 	return rb
@@ -808,21 +2021,33 @@ func (rb *ReleaseBuilder) WithISRC(isrc string) *ReleaseBuilder {
 
 // WithGRid sets the GRid identifier for the release
 func (rb *ReleaseBuilder) WithGRid(grid string) *ReleaseBuilder {
-	rb.release.ReleaseId = append(rb.release.ReleaseId, ReleaseId{
+	rb.release().ReleaseId = append(rb.release().ReleaseId, ReleaseId{
 		GRid: grid,
 	})
 	return rb
 }
 
+// WithCatalogNumber sets the catalog number on the first release ID entry
+func (rb *ReleaseBuilder) WithCatalogNumber(catalogNumber, namespace string) *ReleaseBuilder {
+	if len(rb.release().ReleaseId) == 0 {
+		rb.release().ReleaseId = append(rb.release().ReleaseId, ReleaseId{})
+	}
+	rb.release().ReleaseId[0].CatalogNumber = &CatalogNumber{
+		Value:     catalogNumber,
+		Namespace: namespace,
+	}
+	return rb
+}
+
 // AddProprietaryId adds a proprietary identifier to the release ID
 func (rb *ReleaseBuilder) AddProprietaryId(namespace, value string) *ReleaseBuilder {
 	// Find or create the first ReleaseId entry
-	if len(rb.release.ReleaseId) == 0 {
-		rb.release.ReleaseId = append(rb.release.ReleaseId, ReleaseId{})
+	if len(rb.release().ReleaseId) == 0 {
+		rb.release().ReleaseId = append(rb.release().ReleaseId, ReleaseId{})
 	}
 
 	// Add the ProprietaryId to the first ReleaseId
-	rb.release.ReleaseId[0].ProprietaryId = append(rb.release.ReleaseId[0].ProprietaryId, ProprietaryId{
+	rb.release().ReleaseId[0].ProprietaryId = append(rb.release().ReleaseId[0].ProprietaryId, ProprietaryId{
 		Namespace: namespace,
 		Value:     value,
 	})
@@ -833,11 +2058,11 @@ func (rb *ReleaseBuilder) AddProprietaryId(namespace, value string) *ReleaseBuil
 // In ERN 3.8, this is used at the Release level to reference resources
 // releaseResourceType can be "PrimaryResource", "SecondaryResource", etc.
 func (rb *ReleaseBuilder) AddReleaseResourceReference(resourceRef, releaseResourceType string) *ReleaseBuilder {
-	if rb.release.ReleaseResourceReferenceList == nil {
-		rb.release.ReleaseResourceReferenceList = &ReleaseResourceReferenceList{}
+	if rb.release().ReleaseResourceReferenceList == nil {
+		rb.release().ReleaseResourceReferenceList = &ReleaseResourceReferenceList{}
 	}
-	rb.release.ReleaseResourceReferenceList.ReleaseResourceReference = append(
-		rb.release.ReleaseResourceReferenceList.ReleaseResourceReference,
+	rb.release().ReleaseResourceReferenceList.ReleaseResourceReference = append(
+		rb.release().ReleaseResourceReferenceList.ReleaseResourceReference,
 		ReleaseResourceReference{
 			ReleaseResourceType: releaseResourceType,
 			Value:               resourceRef,
@@ -846,9 +2071,28 @@ func (rb *ReleaseBuilder) AddReleaseResourceReference(resourceRef, releaseResour
 	return rb
 }
 
+// AddRelatedResource relates the release to a resource identified by its ISRC, e.g.
+// ResourceRelationshipHasContentFrom for the recording a music video uses
+func (rb *ReleaseBuilder) AddRelatedResource(relationshipType, isrc string) *ReleaseBuilder {
+	rb.release().RelatedResource = append(rb.release().RelatedResource, RelatedResource{
+		ResourceRelationshipType: relationshipType,
+		ResourceId:               &ResourceRelatedId{ISRC: isrc},
+	})
+	return rb
+}
+
+// AddRelatedResourceReference relates the release to a resource of the message
+func (rb *ReleaseBuilder) AddRelatedResourceReference(relationshipType, resourceRef string) *ReleaseBuilder {
+	rb.release().RelatedResource = append(rb.release().RelatedResource, RelatedResource{
+		ResourceRelationshipType:         relationshipType,
+		ResourceRelatedResourceReference: resourceRef,
+	})
+	return rb
+}
+
 // AddRelatedRelease adds a related release for the current territory
 func (rtb *ReleaseDetailsByTerritoryBuilder) AddRelatedRelease(relationshipType string, releaseId ReleaseId) *ReleaseDetailsByTerritoryBuilder {
-	rtb.territoryDetails.RelatedRelease = append(rtb.territoryDetails.RelatedRelease, RelatedRelease{
+	rtb.territoryDetails().RelatedRelease = append(rtb.territoryDetails().RelatedRelease, RelatedRelease{
 		ReleaseId:               releaseId,
 		ReleaseRelationshipType: relationshipType,
 	})
@@ -868,15 +2112,21 @@ func (rtb *ReleaseDetailsByTerritoryBuilder) AddResourceGroup(titleText, titleTy
 		}
 	}
 
-	rtb.territoryDetails.ResourceGroup = append(rtb.territoryDetails.ResourceGroup, group)
-	groupIndex := len(rtb.territoryDetails.ResourceGroup) - 1
+	details := rtb.territoryDetails()
+	details.ResourceGroup = append(details.ResourceGroup, group)
 
 	return &ResourceGroupBuilder{
 		releaseDetailsByTerritoryBuilder: rtb,
-		group:                            &rtb.territoryDetails.ResourceGroup[groupIndex],
+		index:                            len(details.ResourceGroup) - 1,
 	}
 }
 
+// WithExtension adds proprietary elements to the release (see NewExtension)
+func (rb *ReleaseBuilder) WithExtension(extensions ...RawElement) *ReleaseBuilder {
+	rb.release().Extensions = append(rb.release().Extensions, extensions...)
+	return rb
+}
+
 // Done returns to the main builder
 func (rb *ReleaseBuilder) Done() *Builder {
 	return rb.builder
@@ -885,7 +2135,11 @@ func (rb *ReleaseBuilder) Done() *Builder {
 // ResourceGroupBuilder provides fluent interface for building resource groups
 type ResourceGroupBuilder struct {
 	releaseDetailsByTerritoryBuilder *ReleaseDetailsByTerritoryBuilder
-	group                            *ResourceGroup
+	index                            int
+}
+
+func (rgb *ResourceGroupBuilder) group() *ResourceGroup {
+	return &rgb.releaseDetailsByTerritoryBuilder.territoryDetails().ResourceGroup[rgb.index]
 }
 
 // AddContentItem adds a content item to the resource group
@@ -901,16 +2155,16 @@ func (rgb *ResourceGroupBuilder) AddContentItem(sequenceNumber int, resourceType
 		},
 	}
 
-	rgb.group.ResourceGroupContentItem = append(rgb.group.ResourceGroupContentItem, item)
+	rgb.group().ResourceGroupContentItem = append(rgb.group().ResourceGroupContentItem, item)
 	return rgb
 }
 
 // AddLinkedResource adds a linked resource (e.g., cover art)
 func (rgb *ResourceGroupBuilder) AddLinkedResource(linkDescription, resourceRef string) *ResourceGroupBuilder {
-	if len(rgb.group.ResourceGroupContentItem) > 0 {
-		lastIndex := len(rgb.group.ResourceGroupContentItem) - 1
-		rgb.group.ResourceGroupContentItem[lastIndex].LinkedReleaseResourceReference = append(
-			rgb.group.ResourceGroupContentItem[lastIndex].LinkedReleaseResourceReference,
+	if len(rgb.group().ResourceGroupContentItem) > 0 {
+		lastIndex := len(rgb.group().ResourceGroupContentItem) - 1
+		rgb.group().ResourceGroupContentItem[lastIndex].LinkedReleaseResourceReference = append(
+			rgb.group().ResourceGroupContentItem[lastIndex].LinkedReleaseResourceReference,
 			LinkedReleaseResourceReference{
 				LinkDescription: linkDescription,
 				Value:           resourceRef,
@@ -927,20 +2181,24 @@ func (rgb *ResourceGroupBuilder) Done() *ReleaseDetailsByTerritoryBuilder {
 
 // ReleaseDealBuilder provides fluent interface for building release deals
 type ReleaseDealBuilder struct {
-	builder     *Builder
-	releaseDeal *ReleaseDeal
+	builder *Builder
+	index   int
+}
+
+func (rdb *ReleaseDealBuilder) releaseDeal() *ReleaseDeal {
+	return &rdb.builder.Message.DealList.ReleaseDeal[rdb.index]
 }
 
 // AddDeal adds a new deal to the release deal
 func (rdb *ReleaseDealBuilder) AddDeal() *DealBuilder {
 	newDeal := Deal{}
-	rdb.releaseDeal.Deal = append(rdb.releaseDeal.Deal, newDeal)
-	dealIndex := len(rdb.releaseDeal.Deal) - 1
+	releaseDeal := rdb.releaseDeal()
+	releaseDeal.Deal = append(releaseDeal.Deal, newDeal)
 
 	return &DealBuilder{
 		builder:            rdb.builder,
 		releaseDealBuilder: rdb,
-		deal:               &rdb.releaseDeal.Deal[dealIndex],
+		index:              len(releaseDeal.Deal) - 1,
 	}
 }
 
@@ -953,118 +2211,291 @@ func (rdb *ReleaseDealBuilder) Done() *Builder {
 type DealBuilder struct {
 	builder            *Builder
 	releaseDealBuilder *ReleaseDealBuilder
-	deal               *Deal
+	index              int
+}
+
+func (db *DealBuilder) deal() *Deal {
+	return &db.releaseDealBuilder.releaseDeal().Deal[db.index]
 }
 
 // WithTerritories sets the deal territories for ERN 3.8
 func (db *DealBuilder) WithTerritories(territoryCodes []string) *DealBuilder {
-	if db.deal.DealTerms == nil {
-		db.deal.DealTerms = &DealTerms{}
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
 	}
-	db.deal.DealTerms.TerritoryCode = append(db.deal.DealTerms.TerritoryCode, territoryCodes...)
+	db.deal().DealTerms.TerritoryCode = append(db.deal().DealTerms.TerritoryCode, territoryCodes...)
 	return db
 }
 
-// WithValidityPeriodStartDate sets the deal validity period start date (YYYY-MM-DD)
+// WithValidityPeriodStartDate sets the deal validity period start date (YYYY-MM-DD) on the
+// first ValidityPeriod; use AddValidityPeriod for deals with several windows
 func (db *DealBuilder) WithValidityPeriodStartDate(startDate string) *DealBuilder {
-	if db.deal.DealTerms == nil {
-		db.deal.DealTerms = &DealTerms{}
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
 	}
 
 	// Ensure at least one ValidityPeriod exists
-	if len(db.deal.DealTerms.ValidityPeriod) == 0 {
-		db.deal.DealTerms.ValidityPeriod = append(db.deal.DealTerms.ValidityPeriod, ValidityPeriod{})
+	if len(db.deal().DealTerms.ValidityPeriod) == 0 {
+		db.deal().DealTerms.ValidityPeriod = append(db.deal().DealTerms.ValidityPeriod, ValidityPeriod{})
 	}
 
 	// Set the start date on the first ValidityPeriod
-	db.deal.DealTerms.ValidityPeriod[0].StartDate = startDate
+	db.deal().DealTerms.ValidityPeriod[0].StartDate = startDate
 
 	return db
 }
 
-// WithValidityPeriodEndDate sets the deal validity period end date (YYYY-MM-DD)
+// WithValidityPeriodEndDate sets the deal validity period end date (YYYY-MM-DD) on the
+// first ValidityPeriod; use AddValidityPeriod for deals with several windows
 func (db *DealBuilder) WithValidityPeriodEndDate(endDate string) *DealBuilder {
-	if db.deal.DealTerms == nil {
-		db.deal.DealTerms = &DealTerms{}
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
 	}
 
 	// Ensure at least one ValidityPeriod exists
-	if len(db.deal.DealTerms.ValidityPeriod) == 0 {
-		db.deal.DealTerms.ValidityPeriod = append(db.deal.DealTerms.ValidityPeriod, ValidityPeriod{})
+	if len(db.deal().DealTerms.ValidityPeriod) == 0 {
+		db.deal().DealTerms.ValidityPeriod = append(db.deal().DealTerms.ValidityPeriod, ValidityPeriod{})
 	}
 
 	// Set the end date on the first ValidityPeriod
-	db.deal.DealTerms.ValidityPeriod[0].EndDate = endDate
+	db.deal().DealTerms.ValidityPeriod[0].EndDate = endDate
 
 	return db
 }
 
 // WithEmptyValidityPeriod adds an empty ValidityPeriod tag to the deal
 func (db *DealBuilder) WithEmptyValidityPeriod() *DealBuilder {
-	if db.deal.DealTerms == nil {
-		db.deal.DealTerms = &DealTerms{}
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
 	}
 
-	db.deal.DealTerms.ValidityPeriod = append(db.deal.DealTerms.ValidityPeriod, ValidityPeriod{})
+	db.deal().DealTerms.ValidityPeriod = append(db.deal().DealTerms.ValidityPeriod, ValidityPeriod{})
 
 	return db
 }
 
 // WithValidityPeriodDateTime sets the deal validity period with a start date-time (YYYY-MM-DDTHH:MM:SS)
 func (db *DealBuilder) WithValidityPeriodDateTime(startDateTime string) *DealBuilder {
-	if db.deal.DealTerms == nil {
-		db.deal.DealTerms = &DealTerms{}
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
 	}
 
-	db.deal.DealTerms.ValidityPeriod = append(db.deal.DealTerms.ValidityPeriod, ValidityPeriod{
+	db.deal().DealTerms.ValidityPeriod = append(db.deal().DealTerms.ValidityPeriod, ValidityPeriod{
 		StartDateTime: startDateTime,
 	})
 
 	return db
 }
 
+// ValidityPeriodBuilder is a handle on one ValidityPeriod of a deal, addressed by its index
+// so it stays valid when more periods are added
+type ValidityPeriodBuilder struct {
+	dealBuilder *DealBuilder
+	index       int
+}
+
+// AddValidityPeriod adds a validity window with a start and end date (YYYY-MM-DD); either
+// may be empty for an open-ended window
+func (db *DealBuilder) AddValidityPeriod(startDate, endDate string) *ValidityPeriodBuilder {
+	return db.addValidityPeriod(ValidityPeriod{StartDate: startDate, EndDate: endDate})
+}
+
+// AddValidityPeriodDateTimeRange adds a validity window with a start and end date-time
+// (YYYY-MM-DDTHH:MM:SS, optionally with a UTC offset); either may be empty
+func (db *DealBuilder) AddValidityPeriodDateTimeRange(startDateTime, endDateTime string) *ValidityPeriodBuilder {
+	return db.addValidityPeriod(ValidityPeriod{StartDateTime: startDateTime, EndDateTime: endDateTime})
+}
+
+func (db *DealBuilder) addValidityPeriod(period ValidityPeriod) *ValidityPeriodBuilder {
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
+	}
+	db.deal().DealTerms.ValidityPeriod = append(db.deal().DealTerms.ValidityPeriod, period)
+	return &ValidityPeriodBuilder{dealBuilder: db, index: len(db.deal().DealTerms.ValidityPeriod) - 1}
+}
+
+// ValidityPeriod returns a handle on the deal's ValidityPeriod at index, or nil if there is none
+func (db *DealBuilder) ValidityPeriod(index int) *ValidityPeriodBuilder {
+	if db.deal().DealTerms == nil || index < 0 || index >= len(db.deal().DealTerms.ValidityPeriod) {
+		return nil
+	}
+	return &ValidityPeriodBuilder{dealBuilder: db, index: index}
+}
+
+// Index returns the position of the period in the deal's ValidityPeriod list
+func (vpb *ValidityPeriodBuilder) Index() int {
+	return vpb.index
+}
+
+func (vpb *ValidityPeriodBuilder) period() *ValidityPeriod {
+	return &vpb.dealBuilder.deal().DealTerms.ValidityPeriod[vpb.index]
+}
+
+// WithStartDate sets the start date of the period (YYYY-MM-DD)
+func (vpb *ValidityPeriodBuilder) WithStartDate(startDate string) *ValidityPeriodBuilder {
+	vpb.period().StartDate = startDate
+	return vpb
+}
+
+// WithEndDate sets the end date of the period (YYYY-MM-DD)
+func (vpb *ValidityPeriodBuilder) WithEndDate(endDate string) *ValidityPeriodBuilder {
+	vpb.period().EndDate = endDate
+	return vpb
+}
+
+// WithStartDateTime sets the start date-time of the period (YYYY-MM-DDTHH:MM:SS)
+func (vpb *ValidityPeriodBuilder) WithStartDateTime(startDateTime string) *ValidityPeriodBuilder {
+	vpb.period().StartDateTime = startDateTime
+	return vpb
+}
+
+// WithEndDateTime sets the end date-time of the period (YYYY-MM-DDTHH:MM:SS)
+func (vpb *ValidityPeriodBuilder) WithEndDateTime(endDateTime string) *ValidityPeriodBuilder {
+	vpb.period().EndDateTime = endDateTime
+	return vpb
+}
+
+// Done returns to the deal builder
+func (vpb *ValidityPeriodBuilder) Done() *DealBuilder {
+	return vpb.dealBuilder
+}
+
 // WithCommercialModel adds a commercial model type for ERN 3.8 (can be called multiple times)
 func (db *DealBuilder) WithCommercialModel(modelType string) *DealBuilder {
-	if db.deal.DealTerms == nil {
-		db.deal.DealTerms = &DealTerms{}
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
 	}
-	db.deal.DealTerms.CommercialModelType = append(db.deal.DealTerms.CommercialModelType, modelType)
+	db.deal().DealTerms.CommercialModelType = append(db.deal().DealTerms.CommercialModelType, modelType)
 	return db
 }
 
 // WithUseType adds a use type for ERN 3.8 (can be called multiple times)
 func (db *DealBuilder) WithUseType(useType string) *DealBuilder {
-	if db.deal.DealTerms == nil {
-		db.deal.DealTerms = &DealTerms{}
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
 	}
 
 	// Ensure Usage array exists
-	if len(db.deal.DealTerms.Usage) == 0 {
-		db.deal.DealTerms.Usage = append(db.deal.DealTerms.Usage, Usage{})
+	if len(db.deal().DealTerms.Usage) == 0 {
+		db.deal().DealTerms.Usage = append(db.deal().DealTerms.Usage, Usage{})
 	}
 
 	// Add to the first Usage element's UseType array
-	db.deal.DealTerms.Usage[0].UseType = append(db.deal.DealTerms.Usage[0].UseType, useType)
+	db.deal().DealTerms.Usage[0].UseType = append(db.deal().DealTerms.Usage[0].UseType, useType)
 	return db
 }
 
 // WithRightsClaimPolicy adds a rights claim policy for the deal (can be called multiple times)
 func (db *DealBuilder) WithRightsClaimPolicy(policyType string) *DealBuilder {
-	if db.deal.DealTerms == nil {
-		db.deal.DealTerms = &DealTerms{}
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
 	}
-	db.deal.DealTerms.RightsClaimPolicy = append(db.deal.DealTerms.RightsClaimPolicy, RightsClaimPolicy{
+	db.deal().DealTerms.RightsClaimPolicy = append(db.deal().DealTerms.RightsClaimPolicy, RightsClaimPolicy{
 		RightsClaimPolicyType: policyType,
 	})
 	return db
 }
 
+// WithWholesalePricePerUnit adds the wholesale price per unit for bulk orders, e.g.
+// ddex.MustParseDecimal("0.99")
+func (db *DealBuilder) WithWholesalePricePerUnit(price Decimal) *DealBuilder {
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
+	}
+	db.deal().DealTerms.PriceInformation = append(db.deal().DealTerms.PriceInformation, PriceInformation{
+		BulkOrderWholesalePricePerUnit: &price,
+	})
+	return db
+}
+
+// WithWholesalePricePerUnitString adds the wholesale price per unit for bulk orders, e.g.
+// "0.99". An invalid price is returned by Err and the output methods.
+func (db *DealBuilder) WithWholesalePricePerUnitString(price string) *DealBuilder {
+	d, err := ParseDecimal(price)
+	if err != nil {
+		db.builder.fail(fmt.Errorf("wholesale price: %w", err))
+		return db
+	}
+	return db.WithWholesalePricePerUnit(d)
+}
+
+// WithWholesalePricePerUnitRat adds the wholesale price per unit for bulk orders, rounded
+// to scale digits after the decimal point
+func (db *DealBuilder) WithWholesalePricePerUnitRat(price *big.Rat, scale int) *DealBuilder {
+	return db.WithWholesalePricePerUnit(DecimalFromRat(price, scale))
+}
+
 // IsTakedown sets whether the deal is a takedown (can be called multiple times)
 func (db *DealBuilder) IsTakedown(takedown bool) *DealBuilder {
-	if db.deal.DealTerms == nil {
-		db.deal.DealTerms = &DealTerms{}
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
+	}
+	db.deal().DealTerms.TakeDown = &takedown
+	return db
+}
+
+// IsPreOrderDeal marks the deal as a pre-order deal
+func (db *DealBuilder) IsPreOrderDeal(preOrder bool) *DealBuilder {
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
+	}
+	db.deal().DealTerms.IsPreOrderDeal = &preOrder
+	return db
+}
+
+// WithPreOrderReleaseDate sets the date the pre-ordered release is made available (YYYY-MM-DD)
+func (db *DealBuilder) WithPreOrderReleaseDate(date string) *DealBuilder {
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
+	}
+	db.deal().DealTerms.PreOrderReleaseDate = &EventDate{Value: date}
+	return db
+}
+
+// WithPreOrderPreviewDate sets the date from which the release may be previewed for
+// pre-order (YYYY-MM-DD)
+func (db *DealBuilder) WithPreOrderPreviewDate(date string) *DealBuilder {
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
+	}
+	db.deal().DealTerms.PreOrderPreviewDate = &EventDate{Value: date}
+	return db
+}
+
+// AddPreOrderIncentiveResource adds a resource, by its ResourceReference, that customers
+// receive when they pre-order the release (can be called multiple times)
+func (db *DealBuilder) AddPreOrderIncentiveResource(resourceRef string) *DealBuilder {
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
+	}
+	if db.deal().DealTerms.PreOrderIncentiveResourceList == nil {
+		db.deal().DealTerms.PreOrderIncentiveResourceList = &DealResourceReferenceList{}
+	}
+	list := db.deal().DealTerms.PreOrderIncentiveResourceList
+	list.DealResourceReference = append(list.DealResourceReference, resourceRef)
+	return db
+}
+
+// AddInstantGratificationResource adds a track, by its ResourceReference, that customers
+// receive immediately when they pre-order the release (can be called multiple times)
+func (db *DealBuilder) AddInstantGratificationResource(resourceRef string) *DealBuilder {
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
+	}
+	if db.deal().DealTerms.InstantGratificationResourceList == nil {
+		db.deal().DealTerms.InstantGratificationResourceList = &DealResourceReferenceList{}
+	}
+	list := db.deal().DealTerms.InstantGratificationResourceList
+	list.DealResourceReference = append(list.DealResourceReference, resourceRef)
+	return db
+}
+
+// WithExtension adds proprietary elements to the deal terms (see NewExtension)
+func (db *DealBuilder) WithExtension(extensions ...RawElement) *DealBuilder {
+	if db.deal().DealTerms == nil {
+		db.deal().DealTerms = &DealTerms{}
 	}
-	db.deal.DealTerms.TakeDown = &takedown
+	db.deal().DealTerms.Extensions = append(db.deal().DealTerms.Extensions, extensions...)
 	return db
 }
 
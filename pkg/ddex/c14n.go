@@ -0,0 +1,458 @@
+package ddex
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// xmlNamespace is the namespace bound to the reserved xml: prefix
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// Canonicalize returns the Exclusive XML Canonicalization 1.0 (without comments) form of
+// an XML document: no XML declaration or comments, attributes sorted, namespace
+// declarations only where used, empty elements as start/end pairs and a fixed escaping.
+// Documents that differ only in such serialization details canonicalize to the same bytes.
+// DTDs are not processed: default attributes and declared entities are not applied.
+func Canonicalize(data []byte) ([]byte, error) {
+	document, err := parseC14N(data)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := canonicalizeDocument(&buf, document); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// CanonicalChecksum returns the hex SHA-256 of the canonical form of an XML document. It
+// doesn't change with attribute order, quoting, escaping, empty-element syntax or where
+// namespaces are declared; whitespace between elements is content and does count.
+func CanonicalChecksum(data []byte) (string, error) {
+	canonical, err := Canonicalize(data)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ToCanonicalXML marshals the message in canonical form (see Canonicalize)
+func (nrm *NewReleaseMessage) ToCanonicalXML() ([]byte, error) {
+	data, err := nrm.ToXML()
+	if err != nil {
+		return nil, err
+	}
+	return Canonicalize(data)
+}
+
+// Checksum returns the hex SHA-256 of the message's canonical XML
+func (nrm *NewReleaseMessage) Checksum() (string, error) {
+	data, err := nrm.ToXML()
+	if err != nil {
+		return "", err
+	}
+	return CanonicalChecksum(data)
+}
+
+// c14nNode is a node of a parsed document: an element, a text node or a processing
+// instruction. Names are kept raw, with the prefix in Space, as canonicalization works
+// on prefixes rather than resolved namespaces.
+type c14nNode struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*c14nNode
+	parent   *c14nNode
+	text     string
+	isText   bool
+	procInst *xml.ProcInst
+}
+
+// parseC14N parses data into a document node whose children are the top-level processing
+// instructions and the root element. Comments, directives and the XML declaration are
+// dropped, as canonical XML omits them.
+func parseC14N(data []byte) (*c14nNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(normalizeAttributeWhitespace(data)))
+	document := &c14nNode{}
+	current := document
+
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &c14nNode{name: t.Name, attrs: append([]xml.Attr(nil), t.Attr...), parent: current}
+			current.children = append(current.children, node)
+			current = node
+		case xml.EndElement:
+			if current == document || current.name != t.Name {
+				return nil, fmt.Errorf("failed to parse XML: unexpected end element </%s>", rawName(t.Name))
+			}
+			current = current.parent
+		case xml.CharData:
+			if current == document {
+				continue
+			}
+			if n := len(current.children); n > 0 && current.children[n-1].isText {
+				current.children[n-1].text += string(t)
+			} else {
+				current.children = append(current.children, &c14nNode{text: string(t), isText: true, parent: current})
+			}
+		case xml.ProcInst:
+			if t.Target == "xml" {
+				continue
+			}
+			current.children = append(current.children, &c14nNode{procInst: &xml.ProcInst{Target: t.Target, Inst: append([]byte(nil), t.Inst...)}, parent: current})
+		}
+	}
+
+	if current != document {
+		return nil, fmt.Errorf("failed to parse XML: unclosed element <%s>", rawName(current.name))
+	}
+	if document.root() == nil {
+		return nil, fmt.Errorf("failed to parse XML: no root element")
+	}
+	return document, nil
+}
+
+// normalizeAttributeWhitespace replaces literal tabs and line breaks inside attribute
+// values with spaces, as XML 1.0 attribute-value normalization requires and encoding/xml
+// doesn't do. Character references such as &#xA; are left alone, so they still decode to
+// the character itself.
+func normalizeAttributeWhitespace(data []byte) []byte {
+	var out []byte // Copy of data up to last, made at the first change
+	last := 0
+	var quote byte // Quote of the attribute value being scanned, or 0
+	inTag := false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\t' || c == '\n' || c == '\r' {
+				out = append(out, data[last:i]...)
+				out = append(out, ' ')
+				last = i + 1
+				if c == '\r' && i+1 < len(data) && data[i+1] == '\n' {
+					// A CRLF line break is a single line feed
+					i++
+					last++
+				}
+			}
+		case inTag:
+			if c == '"' || c == '\'' {
+				quote = c
+			} else if c == '>' {
+				inTag = false
+			}
+		case c == '<':
+			// Skip markup that has no attributes
+			end := ""
+			rest := data[i:]
+			switch {
+			case bytes.HasPrefix(rest, []byte("<!--")):
+				end = "-->"
+			case bytes.HasPrefix(rest, []byte("<![CDATA[")):
+				end = "]]>"
+			case bytes.HasPrefix(rest, []byte("<?")):
+				end = "?>"
+			case bytes.HasPrefix(rest, []byte("<!")):
+				end = ">"
+				if open := bytes.IndexByte(rest, '['); open >= 0 && open < bytes.IndexByte(rest, '>') {
+					end = "]>"
+				}
+			}
+			if end == "" {
+				inTag = true
+			} else if j := bytes.Index(rest, []byte(end)); j >= 0 {
+				i += j + len(end) - 1
+			} else {
+				i = len(data)
+			}
+		}
+	}
+	if out == nil {
+		return data
+	}
+	return append(out, data[last:]...)
+}
+
+// root returns the root element of a document node
+func (n *c14nNode) root() *c14nNode {
+	for _, child := range n.children {
+		if !child.isText && child.procInst == nil {
+			return child
+		}
+	}
+	return nil
+}
+
+// scope returns the namespaces in scope at n, keyed by prefix ("" for the default namespace)
+func (n *c14nNode) scope() map[string]string {
+	var chain []*c14nNode
+	for node := n; node != nil; node = node.parent {
+		chain = append(chain, node)
+	}
+
+	scope := map[string]string{"xml": xmlNamespace}
+	for i := len(chain) - 1; i >= 0; i-- {
+		for _, attr := range chain[i].attrs {
+			if prefix, ok := namespaceDeclaration(attr); ok {
+				scope[prefix] = attr.Value
+			}
+		}
+	}
+	return scope
+}
+
+// remove detaches n from its parent
+func (n *c14nNode) remove() {
+	if n.parent == nil {
+		return
+	}
+	siblings := n.parent.children
+	for i, child := range siblings {
+		if child == n {
+			n.parent.children = append(siblings[:i:i], siblings[i+1:]...)
+			break
+		}
+	}
+	n.parent = nil
+}
+
+// namespaceDeclaration reports whether attr declares a namespace and the prefix it binds
+func namespaceDeclaration(attr xml.Attr) (string, bool) {
+	if attr.Name.Space == "xmlns" {
+		return attr.Name.Local, true
+	}
+	if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+		return "", true
+	}
+	return "", false
+}
+
+// canonicalizeDocument writes the exclusive canonical form (without comments) of a document
+func canonicalizeDocument(w *bytes.Buffer, document *c14nNode) error {
+	seenRoot := false
+	for _, child := range document.children {
+		if child.procInst != nil {
+			if seenRoot {
+				w.WriteByte('\n')
+			}
+			writeProcInst(w, child.procInst)
+			if !seenRoot {
+				w.WriteByte('\n')
+			}
+			continue
+		}
+		if err := canonicalizeElement(w, child, child.parent.scope(), map[string]string{}); err != nil {
+			return err
+		}
+		seenRoot = true
+	}
+	return nil
+}
+
+// canonicalizeSubtree writes the exclusive canonical form of the element n, resolving the
+// namespaces it uses against its ancestors
+func canonicalizeSubtree(w *bytes.Buffer, n *c14nNode) error {
+	parentScope := map[string]string{"xml": xmlNamespace}
+	if n.parent != nil {
+		parentScope = n.parent.scope()
+	}
+	return canonicalizeElement(w, n, parentScope, map[string]string{})
+}
+
+// canonicalizeElement renders an element following Exclusive XML Canonicalization 1.0:
+// only the namespaces visibly used by the element and its attributes are declared, and
+// only where they differ from what an output ancestor already declared. Declarations are
+// sorted by prefix and attributes by namespace URI, then local name.
+func canonicalizeElement(w *bytes.Buffer, n *c14nNode, parentScope, rendered map[string]string) error {
+	// Maps are copied only when an element changes them
+	scope := parentScope
+	scopeCopied := false
+	for _, attr := range n.attrs {
+		if prefix, ok := namespaceDeclaration(attr); ok {
+			if !scopeCopied {
+				scope = copyMap(parentScope)
+				scopeCopied = true
+			}
+			scope[prefix] = attr.Value
+		}
+	}
+
+	// Namespaces visibly utilized by the element and its attributes
+	used := []string{n.name.Space}
+	for _, attr := range n.attrs {
+		if _, ok := namespaceDeclaration(attr); ok {
+			continue
+		}
+		if attr.Name.Space != "" && attr.Name.Space != "xml" {
+			used = append(used, attr.Name.Space)
+		}
+	}
+
+	type declaration struct{ prefix, uri string }
+	var declarations []declaration
+	childRendered := rendered
+	renderedCopied := false
+	for _, prefix := range used {
+		if prefix == "xml" {
+			continue
+		}
+		uri, declared := scope[prefix]
+		if !declared && prefix != "" {
+			return fmt.Errorf("undeclared namespace prefix %q on <%s>", prefix, rawName(n.name))
+		}
+		if current, ok := childRendered[prefix]; ok && current == uri || !ok && prefix == "" && uri == "" {
+			continue
+		}
+		if !renderedCopied {
+			childRendered = copyMap(rendered)
+			renderedCopied = true
+		}
+		childRendered[prefix] = uri
+		declarations = append(declarations, declaration{prefix, uri})
+	}
+	sort.Slice(declarations, func(i, j int) bool { return declarations[i].prefix < declarations[j].prefix })
+
+	type attribute struct{ uri, name, value string }
+	var attributes []attribute
+	for _, attr := range n.attrs {
+		if _, ok := namespaceDeclaration(attr); ok {
+			continue
+		}
+		uri := ""
+		if attr.Name.Space != "" {
+			var declared bool
+			if uri, declared = scope[attr.Name.Space]; !declared {
+				return fmt.Errorf("undeclared namespace prefix %q on attribute %s", attr.Name.Space, rawName(attr.Name))
+			}
+		}
+		attributes = append(attributes, attribute{uri: uri, name: rawName(attr.Name), value: attr.Value})
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		if attributes[i].uri != attributes[j].uri {
+			return attributes[i].uri < attributes[j].uri
+		}
+		return localName(attributes[i].name) < localName(attributes[j].name)
+	})
+
+	w.WriteByte('<')
+	w.WriteString(rawName(n.name))
+	for _, d := range declarations {
+		if d.prefix == "" {
+			w.WriteString(` xmlns="`)
+		} else {
+			w.WriteString(" xmlns:" + d.prefix + `="`)
+		}
+		escapeC14NAttr(w, d.uri)
+		w.WriteByte('"')
+	}
+	for _, a := range attributes {
+		w.WriteString(" " + a.name + `="`)
+		escapeC14NAttr(w, a.value)
+		w.WriteByte('"')
+	}
+	w.WriteByte('>')
+
+	for _, child := range n.children {
+		switch {
+		case child.isText:
+			escapeC14NText(w, child.text)
+		case child.procInst != nil:
+			writeProcInst(w, child.procInst)
+		default:
+			if err := canonicalizeElement(w, child, scope, childRendered); err != nil {
+				return err
+			}
+		}
+	}
+
+	w.WriteString("</" + rawName(n.name) + ">")
+	return nil
+}
+
+func writeProcInst(w *bytes.Buffer, pi *xml.ProcInst) {
+	w.WriteString("<?" + pi.Target)
+	if inst := strings.TrimLeft(string(pi.Inst), " \t\r\n"); inst != "" {
+		w.WriteString(" " + inst)
+	}
+	w.WriteString("?>")
+}
+
+// escapeC14NText escapes character data as canonical XML requires
+func escapeC14NText(w *bytes.Buffer, s string) {
+	for _, r := range s {
+		switch r {
+		case '&':
+			w.WriteString("&amp;")
+		case '<':
+			w.WriteString("&lt;")
+		case '>':
+			w.WriteString("&gt;")
+		case '\r':
+			w.WriteString("&#xD;")
+		default:
+			w.WriteRune(r)
+		}
+	}
+}
+
+// escapeC14NAttr escapes an attribute value as canonical XML requires
+func escapeC14NAttr(w *bytes.Buffer, s string) {
+	for _, r := range s {
+		switch r {
+		case '&':
+			w.WriteString("&amp;")
+		case '<':
+			w.WriteString("&lt;")
+		case '"':
+			w.WriteString("&quot;")
+		case '\t':
+			w.WriteString("&#x9;")
+		case '\n':
+			w.WriteString("&#xA;")
+		case '\r':
+			w.WriteString("&#xD;")
+		default:
+			w.WriteRune(r)
+		}
+	}
+}
+
+// rawName formats a raw name as prefix:local
+func rawName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+func localName(name string) string {
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+func copyMap(m map[string]string) map[string]string {
+	out := make(map[string]string, len(m)+1)
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,217 @@
+package ddex
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestCanonicalize checks the examples of Canonical XML 1.0 section 3, as their exclusive
+// canonical form. Parts that need a DTD (default attributes, entity declarations and
+// attribute types) are left out, as Canonicalize doesn't process DTDs.
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "3.1 PIs, Comments, and Outside of Document Element",
+			in: "<?xml version=\"1.0\"?>\n\n" +
+				"<?xml-stylesheet   href=\"doc.xsl\"\n   type=\"text/xsl\"   ?>\n\n" +
+				"<!DOCTYPE doc SYSTEM \"doc.dtd\">\n\n" +
+				"<doc>Hello, world!<!-- Comment 1 --></doc>\n\n" +
+				"<?pi-without-data     ?>\n\n" +
+				"<!-- Comment 2 -->\n\n" +
+				"<!-- Comment 3 -->\n",
+			want: "<?xml-stylesheet href=\"doc.xsl\"\n   type=\"text/xsl\"   ?>\n" +
+				"<doc>Hello, world!</doc>\n" +
+				"<?pi-without-data?>",
+		},
+		{
+			name: "3.2 Whitespace in Document Content",
+			in: "<doc>\n" +
+				"   <clean>   </clean>\n" +
+				"   <dirty>   A   B   </dirty>\n" +
+				"   <mixed>\n" +
+				"      A\n" +
+				"      <clean>   </clean>\n" +
+				"      B\n" +
+				"      <dirty>   A   B   </dirty>\n" +
+				"      C\n" +
+				"   </mixed>\n" +
+				"</doc>",
+			want: "<doc>\n" +
+				"   <clean>   </clean>\n" +
+				"   <dirty>   A   B   </dirty>\n" +
+				"   <mixed>\n" +
+				"      A\n" +
+				"      <clean>   </clean>\n" +
+				"      B\n" +
+				"      <dirty>   A   B   </dirty>\n" +
+				"      C\n" +
+				"   </mixed>\n" +
+				"</doc>",
+		},
+		{
+			name: "3.3 Start and End Tags",
+			in: "<doc>\n" +
+				"   <e1   />\n" +
+				"   <e2   ></e2>\n" +
+				"   <e3   name = \"elem3\"   id=\"elem3\"   />\n" +
+				"   <e4   name=\"elem4\"   id=\"elem4\"   ></e4>\n" +
+				"   <e5 a:attr=\"out\" b:attr=\"sorted\" attr2=\"all\" attr=\"I'm\"\n" +
+				"      xmlns:b=\"http://www.ietf.org\"\n" +
+				"      xmlns:a=\"http://www.w3.org\"\n" +
+				"      xmlns=\"http://example.org\"/>\n" +
+				"   <e6 xmlns=\"\" xmlns:a=\"http://www.w3.org\">\n" +
+				"      <e7 xmlns=\"http://www.ietf.org\">\n" +
+				"         <e8 xmlns=\"\" xmlns:a=\"http://www.w3.org\">\n" +
+				"            <e9 xmlns=\"\" xmlns:a=\"http://www.ietf.org\"/>\n" +
+				"         </e8>\n" +
+				"      </e7>\n" +
+				"   </e6>\n" +
+				"</doc>",
+			// Unlike inclusive C14N, the unused xmlns:a declarations of e6 to e9 are dropped
+			want: "<doc>\n" +
+				"   <e1></e1>\n" +
+				"   <e2></e2>\n" +
+				"   <e3 id=\"elem3\" name=\"elem3\"></e3>\n" +
+				"   <e4 id=\"elem4\" name=\"elem4\"></e4>\n" +
+				"   <e5 xmlns=\"http://example.org\" xmlns:a=\"http://www.w3.org\" xmlns:b=\"http://www.ietf.org\" attr=\"I'm\" attr2=\"all\" b:attr=\"sorted\" a:attr=\"out\"></e5>\n" +
+				"   <e6>\n" +
+				"      <e7 xmlns=\"http://www.ietf.org\">\n" +
+				"         <e8 xmlns=\"\">\n" +
+				"            <e9></e9>\n" +
+				"         </e8>\n" +
+				"      </e7>\n" +
+				"   </e6>\n" +
+				"</doc>",
+		},
+		{
+			name: "3.4 Character Modifications and Character References",
+			in: "<doc>\n" +
+				"   <text>First line&#x0d;&#10;Second line</text>\n" +
+				"   <value>&#x32;</value>\n" +
+				"   <compute><![CDATA[value>\"0\" && value<\"10\" ?\"valid\":\"error\"]]></compute>\n" +
+				"   <compute expr='value>\"0\" &amp;&amp; value&lt;\"10\" ?\"valid\":\"error\"'>valid</compute>\n" +
+				"   <norm attr=' &apos;   &#x20;&#13;&#xa;&#9;   &apos; '/>\n" +
+				"</doc>",
+			want: "<doc>\n" +
+				"   <text>First line&#xD;\nSecond line</text>\n" +
+				"   <value>2</value>\n" +
+				"   <compute>value&gt;\"0\" &amp;&amp; value&lt;\"10\" ?\"valid\":\"error\"</compute>\n" +
+				"   <compute expr=\"value>&quot;0&quot; &amp;&amp; value&lt;&quot;10&quot; ?&quot;valid&quot;:&quot;error&quot;\">valid</compute>\n" +
+				"   <norm attr=\" '    &#xD;&#xA;&#x9;   ' \"></norm>\n" +
+				"</doc>",
+		},
+		{
+			name: "3.6 UTF-8 Encoding",
+			in:   "<doc>&#169;</doc>",
+			want: "<doc>\u00a9</doc>",
+		},
+		{
+			name: "attribute-value normalization",
+			in:   "<doc a=\"1\r\n2\" b='3\t4\n5&#xA;6' c=\">\r\"><!-- a=\"\n\" --><?pi x=\"\n\"?><e d=\"\n\"/>x\r\ny\rz</doc>",
+			want: "<doc a=\"1 2\" b=\"3 4 5&#xA;6\" c=\"> \"><?pi x=\"\n\"?><e d=\" \"></e>x\ny\nz</doc>",
+		},
+		{
+			name: "namespace declared once on the outermost user",
+			in: `<a:root xmlns:a="urn:a" xmlns:b="urn:b" xmlns:unused="urn:unused">` +
+				`<b:child b:attr="1"><b:leaf/></b:child><a:child xmlns:a="urn:a"/></a:root>`,
+			want: `<a:root xmlns:a="urn:a">` +
+				`<b:child xmlns:b="urn:b" b:attr="1"><b:leaf></b:leaf></b:child><a:child></a:child></a:root>`,
+		},
+	}
+	for _, tt := range tests {
+		got, err := Canonicalize([]byte(tt.in))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s:\ngot  %q\nwant %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestCanonicalizeSubtree checks the example of Exclusive XML Canonicalization 1.0
+// section 2.2: the exclusive form of n1:elem2 is the same in both documents, although the
+// inclusive forms differ by the namespaces inherited from the apex elements.
+func TestCanonicalizeSubtree(t *testing.T) {
+	documents := []string{
+		"<n0:local xmlns:n0=\"foo:bar\" xmlns:n3=\"ftp://example.org\">\n" +
+			"  <n1:elem2 xmlns:n1=\"http://example.net\" xml:lang=\"en\">\n" +
+			"     <n3:stuff xmlns:n3=\"ftp://example.org\"/>\n" +
+			"  </n1:elem2>\n" +
+			"</n0:local>",
+		"<n2:pdu xmlns:n1=\"http://example.com\"\n" +
+			"           xmlns:n2=\"http://foo.example\"\n" +
+			"           xml:lang=\"fr\"\n" +
+			"           xml:space=\"retain\">\n" +
+			"  <n1:elem2 xmlns:n1=\"http://example.net\" xml:lang=\"en\">\n" +
+			"     <n3:stuff xmlns:n3=\"ftp://example.org\"/>\n" +
+			"  </n1:elem2>\n" +
+			"</n2:pdu>",
+	}
+	want := "<n1:elem2 xmlns:n1=\"http://example.net\" xml:lang=\"en\">\n" +
+		"     <n3:stuff xmlns:n3=\"ftp://example.org\"></n3:stuff>\n" +
+		"  </n1:elem2>"
+
+	for i, in := range documents {
+		document, err := parseC14N([]byte(in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var elem2 *c14nNode
+		for _, child := range document.root().children {
+			if child.name.Local == "elem2" {
+				elem2 = child
+			}
+		}
+		if elem2 == nil {
+			t.Fatalf("document %d: no n1:elem2", i)
+		}
+		var buf bytes.Buffer
+		if err := canonicalizeSubtree(&buf, elem2); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != want {
+			t.Errorf("document %d:\ngot  %q\nwant %q", i, got, want)
+		}
+	}
+}
+
+func TestCanonicalizeErrors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"<!-- only a comment -->",
+		"<a><b></a>",
+		"<a>",
+		"<p:a/>",
+		`<a p:attr="1"/>`,
+	} {
+		if got, err := Canonicalize([]byte(in)); err == nil {
+			t.Errorf("Canonicalize(%q) = %q, want an error", in, got)
+		}
+	}
+}
+
+func TestCanonicalChecksum(t *testing.T) {
+	a := `<?xml version="1.0" encoding="UTF-8"?><r xmlns:x="urn:x" b='2' a="1"><x:e/></r>`
+	b := `<r a="1" b="2"><x:e xmlns:x="urn:x"></x:e></r>`
+	sumA, err := CanonicalChecksum([]byte(a))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sumB, err := CanonicalChecksum([]byte(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sumA != sumB {
+		t.Errorf("checksums differ: %s and %s", sumA, sumB)
+	}
+	// SHA-256 of <r a="1" b="2"><x:e xmlns:x="urn:x"></x:e></r>
+	if want := "b8d316542399f20a7d8f456ea769dc97de1fb1c1d36ade96584be9345e77253d"; sumA != want {
+		t.Errorf("checksum = %s, want %s", sumA, want)
+	}
+}
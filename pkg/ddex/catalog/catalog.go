@@ -0,0 +1,530 @@
+// Package catalog indexes the ERN messages of a whole catalog: every release and resource
+// of the messages under a directory, by ICPN, GRid, catalog number and ISRC. The index
+// answers which files deliver an identifier, finds identifiers delivered more than once,
+// and summarizes the catalog for reports.
+//
+//	index, err := catalog.Build("deliveries")
+//	for _, release := range index.ReleasesByICPN("123456789012") {
+//		fmt.Println(release.File.Path, release.Title)
+//	}
+//	for _, duplicate := range index.Duplicates() {
+//		fmt.Println(duplicate.Kind, duplicate.Value, duplicate.Files())
+//	}
+//
+// The index holds a summary of each release and resource, not the messages, so it stays
+// small for catalogs of many thousands of files.
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// Kinds of identifiers releases and resources are indexed by
+const (
+	KindICPN          = "ICPN"
+	KindGRid          = "GRid"
+	KindCatalogNumber = "CatalogNumber"
+	KindISRC          = "ISRC"
+)
+
+// File is an indexed message file
+type File struct {
+	Path               string
+	MessageId          string
+	SenderDPID         string
+	MessageControlType string
+	CreatedAt          time.Time // MessageCreatedDateTime; zero without one
+	Releases           []*Release
+	Resources          []*Resource
+}
+
+// Release is an indexed release
+type Release struct {
+	File          *File `json:"-"`
+	Reference     string
+	IsMain        bool
+	ICPN          string
+	GRid          string
+	CatalogNumber string
+	Title         string
+	Artist        string
+	ReleaseType   string
+	ReleaseDate   string   // GlobalReleaseDate, or the ReleaseDate of the first territory
+	ISRCs         []string // Of its sound recordings and videos, in release order
+}
+
+// Resource is an indexed resource
+type Resource struct {
+	File      *File `json:"-"`
+	Reference string
+	Type      string // SoundRecording, Video, Image or Text
+	ISRC      string // Of sound recordings and videos
+	Title     string
+	Artist    string
+	Duration  string
+}
+
+// FileError is a file that could not be indexed
+type FileError struct {
+	Path string
+	Err  error
+}
+
+func (e *FileError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
+
+// Index is an in-memory index of the releases and resources of message files
+type Index struct {
+	Files   []*File
+	Errors  []*FileError // Files that aren't readable or valid messages
+	Skipped []string     // Empty files and XML files of other messages
+
+	releases  map[key][]*Release
+	resources map[key][]*Resource
+}
+
+// key is a normalized identifier of a kind
+type key struct {
+	kind, value string
+}
+
+// New returns an empty index
+func New() *Index {
+	return &Index{
+		releases:  make(map[key][]*Release),
+		resources: make(map[key][]*Resource),
+	}
+}
+
+// Build indexes the messages under dir
+func Build(dir string) (*Index, error) {
+	index := New()
+	if err := index.AddDir(dir); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// AddDir indexes the .xml and .xml.gz files under dir, skipping hidden directories. Files
+// that aren't NewReleaseMessages are added to Skipped, and files that fail to parse to
+// Errors; only errors walking the directory are returned.
+func (idx *Index) AddDir(dir string) error {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := entry.Name()
+		if entry.IsDir() {
+			if path != dir && strings.HasPrefix(name, ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		lower := strings.ToLower(name)
+		if strings.HasSuffix(lower, ".xml") || strings.HasSuffix(lower, ".xml.gz") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		if err := idx.AddFile(path); err != nil {
+			if fileErr, ok := err.(*FileError); ok {
+				idx.Errors = append(idx.Errors, fileErr)
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// AddFile indexes a message file. An empty file, such as a BatchComplete marker, or one
+// whose root element isn't NewReleaseMessage is added to Skipped; a file that can't be
+// read or parsed is returned as a *FileError.
+func (idx *Index) AddFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return &FileError{Path: path, Err: err}
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		idx.Skipped = append(idx.Skipped, path)
+		return nil
+	}
+	root, err := rootElement(data)
+	if err != nil {
+		return &FileError{Path: path, Err: err}
+	}
+	if root != "NewReleaseMessage" {
+		idx.Skipped = append(idx.Skipped, path)
+		return nil
+	}
+	message, err := ddex.FromXML(data)
+	if err != nil {
+		return &FileError{Path: path, Err: err}
+	}
+	idx.Add(path, message)
+	return nil
+}
+
+// rootElement returns the local name of the root element of XML data, which may be
+// gzip-compressed
+func rootElement(data []byte) (string, error) {
+	var r io.Reader = bytes.NewReader(data)
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return "", err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	decoder := xml.NewDecoder(bufio.NewReader(r))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local, nil
+		}
+	}
+}
+
+// Add indexes a message read from path and returns its entry
+func (idx *Index) Add(path string, message *ddex.NewReleaseMessage) *File {
+	file := &File{Path: path}
+	if header := message.MessageHeader; header != nil {
+		file.MessageId = header.MessageId
+		file.MessageControlType = header.MessageControlType
+		if header.MessageSender != nil {
+			file.SenderDPID = ddex.PartyDPID(header.MessageSender.PartyId)
+		}
+		if header.MessageCreatedDateTime != nil {
+			file.CreatedAt = header.MessageCreatedDateTime.Time
+		}
+	}
+
+	isrcs := make(map[string]string) // By ResourceReference
+	addResource := func(resource *Resource) {
+		resource.File = file
+		file.Resources = append(file.Resources, resource)
+		if resource.ISRC != "" {
+			isrcs[resource.Reference] = resource.ISRC
+			k := key{KindISRC, ddex.NormalizeISRC(resource.ISRC)}
+			idx.resources[k] = append(idx.resources[k], resource)
+		}
+	}
+	if list := message.ResourceList; list != nil {
+		for _, recording := range list.SoundRecording {
+			resource := &Resource{
+				Reference: recording.ResourceReference,
+				Type:      "SoundRecording",
+				Title:     title(recording.ReferenceTitle),
+				Duration:  recording.Duration,
+			}
+			for _, id := range recording.SoundRecordingId {
+				if id.ISRC != "" {
+					resource.ISRC = id.ISRC
+					break
+				}
+			}
+			if len(recording.SoundRecordingDetailsByTerritory) > 0 {
+				details := recording.SoundRecordingDetailsByTerritory[0]
+				resource.Artist = artist(details.DisplayArtistName, details.DisplayArtist)
+			}
+			addResource(resource)
+		}
+		for _, video := range list.Video {
+			resource := &Resource{
+				Reference: video.ResourceReference,
+				Type:      "Video",
+				Title:     title(video.ReferenceTitle),
+				Duration:  video.Duration,
+			}
+			if video.VideoId != nil {
+				resource.ISRC = video.VideoId.ISRC
+			}
+			if len(video.VideoDetailsByTerritory) > 0 {
+				details := video.VideoDetailsByTerritory[0]
+				resource.Artist = artist(details.DisplayArtistName, details.DisplayArtist)
+			}
+			addResource(resource)
+		}
+		for _, image := range list.Image {
+			addResource(&Resource{Reference: image.ResourceReference, Type: "Image"})
+		}
+		for _, text := range list.Text {
+			addResource(&Resource{Reference: text.ResourceReference, Type: "Text"})
+		}
+	}
+
+	if message.ReleaseList != nil {
+		for _, r := range message.ReleaseList.Release {
+			release := &Release{
+				File:      file,
+				Reference: r.ReleaseReference,
+				IsMain:    r.IsMainRelease,
+				Title:     title(r.ReferenceTitle),
+			}
+			for _, id := range r.ReleaseId {
+				release.ICPN = ddex.FirstNonEmpty(release.ICPN, id.ICPN)
+				release.GRid = ddex.FirstNonEmpty(release.GRid, id.GRid)
+				if id.CatalogNumber != nil {
+					release.CatalogNumber = ddex.FirstNonEmpty(release.CatalogNumber, id.CatalogNumber.Value)
+				}
+			}
+			var types []string
+			for _, releaseType := range r.ReleaseType {
+				types = append(types, releaseType.Value)
+			}
+			release.ReleaseType = strings.Join(types, ", ")
+			if r.GlobalReleaseDate != nil {
+				release.ReleaseDate = r.GlobalReleaseDate.Value
+			}
+			if len(r.ReleaseDetailsByTerritory) > 0 {
+				details := r.ReleaseDetailsByTerritory[0]
+				release.Artist = artist(details.DisplayArtistName, details.DisplayArtist)
+				if release.ReleaseDate == "" && details.ReleaseDate != nil {
+					release.ReleaseDate = details.ReleaseDate.Value
+				}
+			}
+			if list := r.ReleaseResourceReferenceList; list != nil {
+				for _, ref := range list.ReleaseResourceReference {
+					if isrc, ok := isrcs[ref.Value]; ok {
+						release.ISRCs = append(release.ISRCs, isrc)
+					}
+				}
+			}
+
+			file.Releases = append(file.Releases, release)
+			for _, k := range []key{
+				{KindICPN, normalizeICPN(release.ICPN)},
+				{KindGRid, release.GRid},
+				{KindCatalogNumber, release.CatalogNumber},
+			} {
+				if k.value != "" {
+					idx.releases[k] = append(idx.releases[k], release)
+				}
+			}
+			for _, isrc := range release.ISRCs {
+				k := key{KindISRC, ddex.NormalizeISRC(isrc)}
+				idx.releases[k] = append(idx.releases[k], release)
+			}
+		}
+	}
+
+	idx.Files = append(idx.Files, file)
+	return file
+}
+
+// ReleasesByICPN returns the releases with an ICPN, in the order they were indexed. A
+// 12-digit UPC matches the same code as a 13-digit EAN with a leading zero.
+func (idx *Index) ReleasesByICPN(icpn string) []*Release {
+	return idx.Releases(KindICPN, icpn)
+}
+
+// ReleasesByGRid returns the releases with a GRid, in the order they were indexed
+func (idx *Index) ReleasesByGRid(grid string) []*Release {
+	return idx.Releases(KindGRid, grid)
+}
+
+// ReleasesContaining returns the releases with a sound recording or video of an ISRC, in
+// the order they were indexed
+func (idx *Index) ReleasesContaining(isrc string) []*Release {
+	return idx.Releases(KindISRC, isrc)
+}
+
+// ResourcesByISRC returns the sound recordings and videos with an ISRC, in the order they
+// were indexed. ISRCs match regardless of case and hyphens.
+func (idx *Index) ResourcesByISRC(isrc string) []*Resource {
+	return idx.resources[key{KindISRC, ddex.NormalizeISRC(isrc)}]
+}
+
+// Releases returns the releases with an identifier of a kind: KindICPN, KindGRid,
+// KindCatalogNumber or KindISRC (of their resources)
+func (idx *Index) Releases(kind, value string) []*Release {
+	return idx.releases[normalize(kind, value)]
+}
+
+func normalize(kind, value string) key {
+	switch kind {
+	case KindICPN:
+		value = normalizeICPN(value)
+	case KindISRC:
+		value = ddex.NormalizeISRC(value)
+	}
+	return key{kind, value}
+}
+
+// normalizeICPN returns a 12-digit UPC as the equivalent EAN-13
+func normalizeICPN(icpn string) string {
+	if len(icpn) == 12 {
+		return "0" + icpn
+	}
+	return icpn
+}
+
+// Duplicate is an identifier delivered by more than one release or resource
+type Duplicate struct {
+	Kind      string
+	Value     string     // As in the first release or resource with it
+	Releases  []*Release // Releases with an ICPN, GRid or catalog number
+	Resources []*Resource
+}
+
+// Files returns the paths of the files with the identifier, without repeats
+func (d Duplicate) Files() []string {
+	seen := make(map[string]bool)
+	var paths []string
+	add := func(file *File) {
+		if !seen[file.Path] {
+			seen[file.Path] = true
+			paths = append(paths, file.Path)
+		}
+	}
+	for _, release := range d.Releases {
+		add(release.File)
+	}
+	for _, resource := range d.Resources {
+		add(resource.File)
+	}
+	return paths
+}
+
+// Duplicates returns the ICPNs, GRids and catalog numbers of more than one release and the
+// ISRCs of more than one resource, sorted by kind and value. They are redeliveries of the
+// same release or track, or identifiers reused by mistake; the entries' titles, artists
+// and files tell the two apart. ISRCs shared by several releases of one file, such as an
+// album and its single, are not duplicates.
+func (idx *Index) Duplicates() []Duplicate {
+	var duplicates []Duplicate
+	for k, releases := range idx.releases {
+		if k.kind != KindISRC && len(releases) > 1 {
+			duplicates = append(duplicates, Duplicate{Kind: k.kind, Value: releaseValue(releases[0], k.kind), Releases: releases})
+		}
+	}
+	for k, resources := range idx.resources {
+		if len(resources) > 1 {
+			duplicates = append(duplicates, Duplicate{Kind: k.kind, Value: resources[0].ISRC, Resources: resources})
+		}
+	}
+	sort.Slice(duplicates, func(i, j int) bool {
+		if duplicates[i].Kind != duplicates[j].Kind {
+			return duplicates[i].Kind < duplicates[j].Kind
+		}
+		return duplicates[i].Value < duplicates[j].Value
+	})
+	return duplicates
+}
+
+func releaseValue(release *Release, kind string) string {
+	switch kind {
+	case KindICPN:
+		return release.ICPN
+	case KindGRid:
+		return release.GRid
+	}
+	return release.CatalogNumber
+}
+
+// Stats counts the contents of an index
+type Stats struct {
+	Files      int
+	Errors     int
+	Skipped    int
+	Releases   int
+	Resources  int            // Of all types
+	ByType     map[string]int // Resources by type
+	ICPNs      int            // Distinct
+	ISRCs      int            // Distinct, of resources
+	Duplicates int
+}
+
+// Stats counts the files, releases, resources and distinct identifiers of the index
+func (idx *Index) Stats() Stats {
+	stats := Stats{
+		Files:      len(idx.Files),
+		Errors:     len(idx.Errors),
+		Skipped:    len(idx.Skipped),
+		ByType:     make(map[string]int),
+		ISRCs:      len(idx.resources),
+		Duplicates: len(idx.Duplicates()),
+	}
+	for _, file := range idx.Files {
+		stats.Releases += len(file.Releases)
+		stats.Resources += len(file.Resources)
+		for _, resource := range file.Resources {
+			stats.ByType[resource.Type]++
+		}
+	}
+	for k := range idx.releases {
+		if k.kind == KindICPN {
+			stats.ICPNs++
+		}
+	}
+	return stats
+}
+
+// WriteCSV writes a row per release for spreadsheets: its file, identifiers, title,
+// artist, type, release date and ISRCs separated by spaces, after a header row
+func (idx *Index) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"File", "MessageId", "ReleaseReference", "ICPN", "GRid", "CatalogNumber",
+		"Title", "Artist", "ReleaseType", "ReleaseDate", "ISRCs"})
+	for _, file := range idx.Files {
+		for _, release := range file.Releases {
+			cw.Write([]string{file.Path, file.MessageId, release.Reference, release.ICPN, release.GRid,
+				release.CatalogNumber, release.Title, release.Artist, release.ReleaseType, release.ReleaseDate,
+				strings.Join(release.ISRCs, " ")})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func title(title *ddex.ReferenceTitle) string {
+	if title == nil {
+		return ""
+	}
+	if title.SubTitle != "" {
+		return title.TitleText + " (" + title.SubTitle + ")"
+	}
+	return title.TitleText
+}
+
+// artist returns the display artist name, or the names of the display artists
+func artist(names []ddex.DisplayArtistName, artists []ddex.DisplayArtist) string {
+	if len(names) > 0 {
+		return names[0].Value
+	}
+	var parts []string
+	for _, artist := range artists {
+		if len(artist.PartyName) > 0 {
+			parts = append(parts, artist.PartyName[0].FullName)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
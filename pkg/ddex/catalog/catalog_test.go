@@ -0,0 +1,112 @@
+package catalog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// testRelease is a release of a test message: its ICPN and the ISRCs of its recordings
+type testRelease struct {
+	icpn  string
+	isrcs []string
+}
+
+// testMessage returns a message with a sound recording for each distinct ISRC of its
+// releases, which list them in their ReleaseResourceReferenceList
+func testMessage(releases ...testRelease) *ddex.NewReleaseMessage {
+	message := &ddex.NewReleaseMessage{
+		ResourceList: &ddex.ResourceList{},
+		ReleaseList:  &ddex.ReleaseList{},
+	}
+	refs := make(map[string]string)
+	for i, r := range releases {
+		release := ddex.Release{
+			ReleaseReference:             "R" + string(rune('0'+i)),
+			IsMainRelease:                i == 0,
+			ReleaseId:                    []ddex.ReleaseId{{ICPN: r.icpn}},
+			ReleaseResourceReferenceList: &ddex.ReleaseResourceReferenceList{},
+		}
+		for _, isrc := range r.isrcs {
+			ref, ok := refs[isrc]
+			if !ok {
+				ref = "A" + string(rune('1'+len(refs)))
+				refs[isrc] = ref
+				message.ResourceList.SoundRecording = append(message.ResourceList.SoundRecording, ddex.SoundRecording{
+					ResourceReference: ref,
+					SoundRecordingId:  []ddex.SoundRecordingId{{ISRC: isrc}},
+				})
+			}
+			list := release.ReleaseResourceReferenceList
+			list.ReleaseResourceReference = append(list.ReleaseResourceReference, ddex.ReleaseResourceReference{Value: ref})
+		}
+		message.ReleaseList.Release = append(message.ReleaseList.Release, release)
+	}
+	return message
+}
+
+func TestIndexAddDuplicates(t *testing.T) {
+	tests := []struct {
+		name  string
+		files [][]testRelease
+		want  []string // Kind, value and files of each duplicate
+	}{
+		{
+			name: "distinct",
+			files: [][]testRelease{
+				{{"0123456789012", []string{"USRC12400001"}}},
+				{{"0123456789029", []string{"USRC12400002"}}},
+			},
+		},
+		{
+			name: "redelivery",
+			files: [][]testRelease{
+				{{"0123456789012", []string{"USRC12400001"}}},
+				{{"0123456789012", []string{"USRC12400001"}}},
+			},
+			want: []string{"ICPN 0123456789012 f0 f1", "ISRC USRC12400001 f0 f1"},
+		},
+		{
+			name: "UPC and EAN of the same code",
+			files: [][]testRelease{
+				{{"123456789012", nil}},
+				{{"0123456789012", nil}},
+			},
+			want: []string{"ICPN 123456789012 f0 f1"},
+		},
+		{
+			name: "ISRC with hyphens and in lower case",
+			files: [][]testRelease{
+				{{"0123456789012", []string{"USRC12400001"}}},
+				{{"0123456789029", []string{"us-rc1-24-00001"}}},
+			},
+			want: []string{"ISRC USRC12400001 f0 f1"},
+		},
+		{
+			name: "album and single in one file",
+			files: [][]testRelease{
+				{{"0123456789012", []string{"USRC12400001", "USRC12400002"}}, {"0123456789029", []string{"USRC12400001"}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := New()
+			for i, releases := range tt.files {
+				index.Add("f"+string(rune('0'+i)), testMessage(releases...))
+			}
+			var got []string
+			for _, duplicate := range index.Duplicates() {
+				got = append(got, duplicate.Kind+" "+duplicate.Value+" "+strings.Join(duplicate.Files(), " "))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Duplicates = %q, want %q", got, tt.want)
+			}
+			if stats := index.Stats(); stats.Duplicates != len(tt.want) {
+				t.Errorf("Stats().Duplicates = %d, want %d", stats.Duplicates, len(tt.want))
+			}
+		})
+	}
+}
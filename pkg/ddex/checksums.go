@@ -0,0 +1,147 @@
+package ddex
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ChecksumAlgorithm selects the hash of a ChecksumManifest
+type ChecksumAlgorithm string
+
+const (
+	ChecksumMD5    ChecksumAlgorithm = "MD5"
+	ChecksumSHA256 ChecksumAlgorithm = "SHA-256"
+)
+
+// ParseChecksumAlgorithm returns the algorithm named by s, e.g. "md5", "sha256" or "SHA-256"
+func ParseChecksumAlgorithm(s string) (ChecksumAlgorithm, error) {
+	switch strings.ToLower(strings.ReplaceAll(s, "-", "")) {
+	case "md5":
+		return ChecksumMD5, nil
+	case "sha256":
+		return ChecksumSHA256, nil
+	}
+	return "", fmt.Errorf("unknown checksum algorithm %q, expected MD5 or SHA-256", s)
+}
+
+// Extension returns the file extension of manifests using the algorithm: md5 or sha256
+func (a ChecksumAlgorithm) Extension() string {
+	return strings.ToLower(strings.ReplaceAll(string(a), "-", ""))
+}
+
+func (a ChecksumAlgorithm) newHash() (hash.Hash, error) {
+	switch a {
+	case ChecksumMD5:
+		return md5.New(), nil
+	case ChecksumSHA256:
+		return sha256.New(), nil
+	}
+	return nil, fmt.Errorf("unknown checksum algorithm %q", string(a))
+}
+
+// ChecksumManifestFileName returns the name of the checksum manifest of a batch:
+// Checksums_<batch>.md5 or Checksums_<batch>.sha256
+func ChecksumManifestFileName(batchId string, algorithm ChecksumAlgorithm) string {
+	return "Checksums_" + batchId + "." + algorithm.Extension()
+}
+
+// ChecksumEntry is a file listed in a ChecksumManifest
+type ChecksumEntry struct {
+	Path string // Slash-separated and relative to the batch folder
+	Sum  string // Hex-encoded
+}
+
+// ChecksumManifest is the sidecar file some ingestion endpoints require with a batch,
+// listing the checksum of every resource file and message in it. It is written in the
+// format of md5sum and sha256sum, so `sha256sum -c` can check a batch folder against it.
+type ChecksumManifest struct {
+	Algorithm ChecksumAlgorithm
+	Entries   []ChecksumEntry
+}
+
+// NewChecksumManifest returns an empty manifest using algorithm
+func NewChecksumManifest(algorithm ChecksumAlgorithm) (*ChecksumManifest, error) {
+	if _, err := algorithm.newHash(); err != nil {
+		return nil, err
+	}
+	return &ChecksumManifest{Algorithm: algorithm}, nil
+}
+
+// Add hashes the content read from r and lists it as path
+func (m *ChecksumManifest) Add(path string, r io.Reader) error {
+	h, err := m.Algorithm.newHash()
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		return fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+	m.Entries = append(m.Entries, ChecksumEntry{Path: path, Sum: hex.EncodeToString(h.Sum(nil))})
+	return nil
+}
+
+// AddFile hashes the local file and lists it as path
+func (m *ChecksumManifest) AddFile(path, localPath string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return m.Add(path, f)
+}
+
+// Bytes returns the manifest file: a "<sum>  <path>" line per entry
+func (m *ChecksumManifest) Bytes() []byte {
+	var buf bytes.Buffer
+	for _, entry := range m.Entries {
+		fmt.Fprintf(&buf, "%s  %s\n", entry.Sum, entry.Path)
+	}
+	return buf.Bytes()
+}
+
+// ChecksumManifestForDir lists every file below a batch folder written with
+// WriteToDelivery or a Packager, in lexical order, except its BatchComplete file and
+// checksum manifests
+func ChecksumManifestForDir(dir string, algorithm ChecksumAlgorithm) (*ChecksumManifest, error) {
+	manifest, err := NewChecksumManifest(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if isBatchSidecar(rel) {
+			return nil
+		}
+		return manifest.AddFile(rel, path)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// isBatchSidecar reports whether a path relative to a batch folder is its BatchComplete
+// file or a checksum manifest
+func isBatchSidecar(rel string) bool {
+	if strings.Contains(rel, "/") {
+		return false
+	}
+	return strings.HasPrefix(rel, "BatchComplete_") || strings.HasPrefix(rel, "Checksums_")
+}
@@ -0,0 +1,41 @@
+package ddex
+
+import "time"
+
+// Clock tells a Builder the time to stamp messages with
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to the Clock interface
+type ClockFunc func() time.Time
+
+// Now calls f
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// FixedClock returns a Clock that always reports t, for tests and replays that must
+// produce the same message on every run
+func FixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time { return t })
+}
+
+// WithClock makes the builder take the MessageCreatedDateTime and the timestamps of
+// WithAutoGeneratedIDs from clock instead of the system time. A header set before is
+// restamped with the clock's time.
+func (b *Builder) WithClock(clock Clock) *Builder {
+	b.clock = clock
+	if b.Message.MessageHeader != nil && b.Message.MessageHeader.MessageCreatedDateTime != nil {
+		b.Message.MessageHeader.MessageCreatedDateTime = &DateTime{Time: b.now()}
+	}
+	return b
+}
+
+// now returns the time of the builder's clock, or the system time
+func (b *Builder) now() time.Time {
+	if b.clock == nil {
+		return time.Now()
+	}
+	return b.clock.Now()
+}
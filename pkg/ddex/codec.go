@@ -0,0 +1,431 @@
+package ddex
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Codec converts between a Manifest and the messages of one or more ERN versions. A
+// Manifest is a summary of a release, not a lossless model of a message: decoding keeps
+// only what it has fields for (see ManifestFromMessage), so a codec suits importing and
+// mapping release metadata, not passing messages through. ERN38 is the only codec in this
+// package; there is none for ERN 4.x. Validate, Diff and the other checks work on the ERN
+// 3.8 NewReleaseMessage, not on manifests. RegisterCodec adds codecs for other versions.
+// There is no version-agnostic model of a message yet.
+type Codec interface {
+	// Supports reports whether the codec reads messages of version, a
+	// MessageSchemaVersionId such as "ern/382" (see DetectVersion)
+	Supports(version string) bool
+	// Decode reads a message into a manifest
+	Decode(data []byte) (*Manifest, error)
+	// Encode writes a manifest as a message
+	Encode(m *Manifest) ([]byte, error)
+}
+
+// ERN38 is the codec for ERN 3.8 messages: Decode uses FromXML and ManifestFromMessage,
+// Encode uses Manifest.Builder
+var ERN38 Codec = ern38Codec{}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = []Codec{ERN38}
+)
+
+// RegisterCodec adds a codec. Codecs registered later take precedence for the versions
+// they support, so a codec can replace ERN38.
+func RegisterCodec(codec Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	codecs = append(codecs, codec)
+}
+
+// CodecFor returns the codec for an ERN version, or an *ErrUnsupportedVersion if no codec
+// supports it. Messages that declare no version are read as ERN 3.8.
+func CodecFor(version string) (Codec, error) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	for i := len(codecs) - 1; i >= 0; i-- {
+		if codecs[i].Supports(version) {
+			return codecs[i], nil
+		}
+	}
+	return nil, &ErrUnsupportedVersion{Version: version}
+}
+
+// DecodeManifest reads a message of any version with a codec into a manifest, choosing the
+// codec with DetectVersion
+func DecodeManifest(data []byte) (*Manifest, error) {
+	version, err := DetectVersion(data)
+	if err != nil {
+		return nil, err
+	}
+	codec, err := CodecFor(version)
+	if err != nil {
+		return nil, err
+	}
+	return codec.Decode(data)
+}
+
+// ern38Codec is the Codec for the ERN 3.8 structs of this package
+type ern38Codec struct{}
+
+func (ern38Codec) Supports(version string) bool {
+	return checkVersion(version) == nil
+}
+
+func (ern38Codec) Decode(data []byte) (*Manifest, error) {
+	message, err := FromXML(data)
+	if err != nil {
+		return nil, err
+	}
+	return ManifestFromMessage(message)
+}
+
+func (ern38Codec) Encode(m *Manifest) ([]byte, error) {
+	b, err := m.Builder()
+	if err != nil {
+		return nil, err
+	}
+	return b.ToXML()
+}
+
+// ManifestFromMessage describes the main release of a message (the first release if none
+// is marked as main) as a manifest: the message header, the release with the sound
+// recordings and videos it contains as tracks and its images as assets, in the order of
+// its resource group, and the deals for it. Where the message has several
+// DetailsByTerritory entries, the first one is used. Everything a Manifest has no field
+// for, such as technical details other than the file name, is left out.
+func ManifestFromMessage(message *NewReleaseMessage) (*Manifest, error) {
+	if message.ReleaseList == nil || len(message.ReleaseList.Release) == 0 {
+		return nil, fmt.Errorf("message has no release")
+	}
+	release := &message.ReleaseList.Release[0]
+	for i := range message.ReleaseList.Release {
+		if message.ReleaseList.Release[i].IsMainRelease {
+			release = &message.ReleaseList.Release[i]
+			break
+		}
+	}
+
+	m := &Manifest{}
+	if header := message.MessageHeader; header != nil {
+		m.Message.MessageId = header.MessageId
+		m.Message.ThreadId = header.MessageThreadId
+		m.Message.ControlType = header.MessageControlType
+		if header.MessageSender != nil {
+			m.Message.Sender = manifestParty(header.MessageSender.PartyId, header.MessageSender.PartyName)
+		}
+		if header.SentOnBehalfOf != nil {
+			party := manifestParty(header.SentOnBehalfOf.PartyId, header.SentOnBehalfOf.PartyName)
+			m.Message.OnBehalfOf = &party
+		}
+		for _, recipient := range header.MessageRecipient {
+			if recipient != nil {
+				m.Message.Recipients = append(m.Message.Recipients, manifestParty(recipient.PartyId, recipient.PartyName))
+			}
+		}
+	}
+
+	m.Release = manifestRelease(release, message.LanguageAndScriptCode)
+	index := message.Index()
+	for _, ref := range releaseResourceOrder(release) {
+		resource, ok := index.Resources[ref]
+		if !ok {
+			continue
+		}
+		switch {
+		case resource.SoundRecording != nil:
+			m.Release.Tracks = append(m.Release.Tracks, manifestSoundRecording(resource.SoundRecording))
+		case resource.Video != nil:
+			m.Release.Tracks = append(m.Release.Tracks, manifestVideo(resource.Video))
+		case resource.Image != nil:
+			m.Release.Assets = append(m.Release.Assets, manifestImage(resource.Image))
+		}
+	}
+
+	if message.DealList != nil {
+		for _, releaseDeal := range message.DealList.ReleaseDeal {
+			if releaseDeal.DealReleaseReference != release.ReleaseReference {
+				continue
+			}
+			for _, deal := range releaseDeal.Deal {
+				if deal.DealTerms != nil {
+					m.Deals = append(m.Deals, manifestDeal(deal.DealTerms))
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// manifestRelease describes the release-level metadata of a release
+func manifestRelease(release *Release, language string) ManifestRelease {
+	mr := ManifestRelease{Reference: release.ReleaseReference}
+	if release.ReferenceTitle != nil {
+		mr.Title = release.ReferenceTitle.TitleText
+		mr.Subtitle = release.ReferenceTitle.SubTitle
+	}
+	if len(release.ReleaseType) > 0 {
+		mr.Type = release.ReleaseType[0].Value
+	}
+	for _, id := range release.ReleaseId {
+		if id.ICPN != "" && mr.ICPN == "" {
+			mr.ICPN = id.ICPN
+		}
+		if id.GRid != "" && mr.GRid == "" {
+			mr.GRid = id.GRid
+		}
+		if id.CatalogNumber != nil && mr.CatalogNumber == "" {
+			mr.CatalogNumber = id.CatalogNumber.Value
+		}
+	}
+	mr.PLine = manifestPLine(release.PLine)
+	if len(release.CLine) > 0 {
+		mr.CLine = &ManifestLine{Year: release.CLine[0].Year, Text: release.CLine[0].CLineText}
+	}
+	if release.GlobalReleaseDate != nil {
+		mr.ReleaseDate = release.GlobalReleaseDate.Value
+	}
+	if release.GlobalOriginalReleaseDate != nil {
+		mr.OriginalReleaseDate = release.GlobalOriginalReleaseDate.Value
+	}
+	mr.Language = language
+
+	if len(release.ReleaseDetailsByTerritory) == 0 {
+		return mr
+	}
+	details := release.ReleaseDetailsByTerritory[0]
+	mr.Territories = details.TerritoryCode
+	if details.LanguageAndScriptCode != "" {
+		mr.Language = details.LanguageAndScriptCode
+	}
+	if mr.Type == "" && len(details.ReleaseType) > 0 {
+		mr.Type = details.ReleaseType[0].Value
+	}
+	if len(details.DisplayArtistName) > 0 {
+		mr.DisplayArtist = details.DisplayArtistName[0].Value
+	}
+	mr.Artists = manifestArtists(details.DisplayArtist)
+	if len(details.LabelName) > 0 {
+		mr.Label = details.LabelName[0].Value
+	}
+	if len(details.Genre) > 0 {
+		mr.Genre = details.Genre[0].GenreText
+		mr.SubGenre = details.Genre[0].SubGenre
+	}
+	if details.ReleaseDate != nil {
+		mr.ReleaseDate = details.ReleaseDate.Value
+	}
+	if details.OriginalReleaseDate != nil {
+		mr.OriginalReleaseDate = details.OriginalReleaseDate.Value
+	}
+	if len(details.ParentalWarningType) > 0 {
+		mr.ParentalWarning = details.ParentalWarningType[0].Value
+	}
+	if mr.PLine == nil {
+		mr.PLine = manifestPLine(details.PLine)
+	}
+	if mr.CLine == nil && len(details.CLine) > 0 {
+		mr.CLine = &ManifestLine{Year: details.CLine[0].Year, Text: details.CLine[0].CLineText}
+	}
+	if details.MarketingComment != nil {
+		mr.MarketingComment = details.MarketingComment.Value
+	}
+	for _, keyword := range details.Keywords {
+		mr.Keywords = append(mr.Keywords, keyword.Value)
+	}
+	return mr
+}
+
+// releaseResourceOrder returns the resources of a release in the order of its first
+// resource group, followed by those only its ReleaseResourceReferenceList lists
+func releaseResourceOrder(release *Release) []string {
+	var refs []string
+	seen := make(map[string]bool)
+	add := func(ref string) {
+		if ref != "" && !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+	for _, details := range release.ReleaseDetailsByTerritory {
+		if len(details.ResourceGroup) == 0 {
+			continue
+		}
+		for _, item := range details.ResourceGroup[0].ResourceGroupContentItem {
+			add(item.ReleaseResourceReference.Value)
+		}
+		break
+	}
+	if release.ReleaseResourceReferenceList != nil {
+		for _, ref := range release.ReleaseResourceReferenceList.ReleaseResourceReference {
+			add(ref.Value)
+		}
+	}
+	return refs
+}
+
+// manifestSoundRecording describes a sound recording as a track
+func manifestSoundRecording(sr *SoundRecording) ManifestTrack {
+	track := ManifestTrack{
+		Reference: sr.ResourceReference,
+		Kind:      ManifestTrackSoundRecording,
+		Type:      sr.SoundRecordingType,
+		Duration:  sr.Duration,
+	}
+	if sr.ReferenceTitle != nil {
+		track.Title, track.Subtitle = sr.ReferenceTitle.TitleText, sr.ReferenceTitle.SubTitle
+	}
+	for _, id := range sr.SoundRecordingId {
+		if id.ISRC != "" {
+			track.ISRC = id.ISRC
+			break
+		}
+	}
+	if len(sr.SoundRecordingDetailsByTerritory) > 0 {
+		details := sr.SoundRecordingDetailsByTerritory[0]
+		var file *File
+		if len(details.TechnicalSoundRecordingDetails) > 0 {
+			file = details.TechnicalSoundRecordingDetails[0].File
+		}
+		setManifestTrackDetails(&track, details.DisplayArtistName, details.DisplayArtist, details.ResourceContributor,
+			details.LabelName, details.PLine, details.Genre, details.ParentalWarningType, file)
+	}
+	return track
+}
+
+// manifestVideo describes a video as a track
+func manifestVideo(v *Video) ManifestTrack {
+	track := ManifestTrack{
+		Reference: v.ResourceReference,
+		Kind:      ManifestTrackVideo,
+		Duration:  v.Duration,
+	}
+	if v.VideoType != nil {
+		track.Type = v.VideoType.Value
+	}
+	if v.ReferenceTitle != nil {
+		track.Title, track.Subtitle = v.ReferenceTitle.TitleText, v.ReferenceTitle.SubTitle
+	}
+	if v.VideoId != nil {
+		track.ISRC = v.VideoId.ISRC
+	}
+	if len(v.VideoDetailsByTerritory) > 0 {
+		details := v.VideoDetailsByTerritory[0]
+		var file *File
+		if len(details.TechnicalVideoDetails) > 0 {
+			file = details.TechnicalVideoDetails[0].File
+		}
+		setManifestTrackDetails(&track, details.DisplayArtistName, details.DisplayArtist, details.ResourceContributor,
+			details.LabelName, details.PLine, details.Genre, details.ParentalWarningType, file)
+	}
+	return track
+}
+
+// setManifestTrackDetails sets the track fields kept in a DetailsByTerritory entry
+func setManifestTrackDetails(track *ManifestTrack, names []DisplayArtistName, artists []DisplayArtist, contributors []ResourceContributor,
+	labels []LabelName, pLines []PLine, genres []Genre, warnings []string, file *File) {
+	if len(names) > 0 {
+		track.DisplayArtist = names[0].Value
+	}
+	track.Artists = manifestArtists(artists)
+	for _, contributor := range contributors {
+		track.Contributors = append(track.Contributors, ManifestArtist{
+			Name:  partyFullName(contributor.PartyName),
+			Roles: contributor.ResourceContributorRole,
+		})
+	}
+	if len(labels) > 0 {
+		track.Label = labels[0].Value
+	}
+	track.PLine = manifestPLine(pLines)
+	if len(genres) > 0 {
+		track.Genre = genres[0].GenreText
+	}
+	if len(warnings) > 0 {
+		track.ParentalWarning = warnings[0]
+	}
+	if file != nil {
+		track.File = file.FileName
+	}
+}
+
+// manifestImage describes an image as an asset
+func manifestImage(image *Image) ManifestAsset {
+	asset := ManifestAsset{Reference: image.ResourceReference}
+	if image.ImageType != nil {
+		asset.Type = image.ImageType.Value
+	}
+	for _, id := range image.ImageId {
+		if len(id.ProprietaryId) > 0 {
+			asset.IdNamespace = id.ProprietaryId[0].Namespace
+			asset.ProprietaryId = id.ProprietaryId[0].Value
+			break
+		}
+	}
+	for _, details := range image.ImageDetailsByTerritory {
+		if len(details.TechnicalImageDetails) > 0 && details.TechnicalImageDetails[0].File != nil {
+			asset.File = details.TechnicalImageDetails[0].File.FileName
+			break
+		}
+	}
+	return asset
+}
+
+// manifestDeal describes the terms of a deal
+func manifestDeal(terms *DealTerms) ManifestDeal {
+	deal := ManifestDeal{
+		Territories:      terms.TerritoryCode,
+		CommercialModels: terms.CommercialModelType,
+		TakeDown:         terms.TakeDown != nil && *terms.TakeDown,
+	}
+	for _, usage := range terms.Usage {
+		deal.UseTypes = append(deal.UseTypes, usage.UseType...)
+	}
+	for _, policy := range terms.RightsClaimPolicy {
+		deal.RightsClaimPolicies = append(deal.RightsClaimPolicies, policy.RightsClaimPolicyType)
+	}
+	if len(terms.ValidityPeriod) > 0 {
+		deal.StartDate = terms.ValidityPeriod[0].StartDate
+		deal.EndDate = terms.ValidityPeriod[0].EndDate
+	}
+	return deal
+}
+
+// manifestParty describes a message header party by its first ID and name
+func manifestParty(ids []PartyID, names []Name) ManifestParty {
+	var party ManifestParty
+	if len(ids) > 0 {
+		party.DPID = ids[0].Value
+	}
+	if len(names) > 0 {
+		party.Name = names[0].FullName
+	}
+	return party
+}
+
+// manifestArtists describes display artists by name and roles
+func manifestArtists(artists []DisplayArtist) []ManifestArtist {
+	var described []ManifestArtist
+	for _, artist := range artists {
+		described = append(described, ManifestArtist{Name: partyFullName(artist.PartyName), Roles: artist.ArtistRole})
+	}
+	return described
+}
+
+// manifestPLine describes the first P line, if any
+func manifestPLine(lines []PLine) *ManifestLine {
+	if len(lines) == 0 {
+		return nil
+	}
+	return &ManifestLine{Year: lines[0].Year, Text: lines[0].PLineText}
+}
+
+// partyFullName returns the first full name of a party
+func partyFullName(names []PartyName) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return names[0].FullName
+}
@@ -0,0 +1,20 @@
+package ddex
+
+import (
+	"context"
+	"io"
+)
+
+// contextReader fails reads once its context is done, so a decoder or copy reading through
+// it stops within one buffer of the cancellation
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (cr *contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
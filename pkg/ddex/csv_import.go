@@ -0,0 +1,319 @@
+package ddex
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CSV catalog layout
+//
+// The first row is a header naming the columns; column order is free and names are
+// case-insensitive. Each following row describes one track. Release-level columns are
+// repeated on every track row and read from the first row of each release. Rows are
+// grouped into releases by icpn, falling back to release_reference and then release_title.
+// List values (territories, roles, commercial models, ...) are separated by "|".
+//
+// Release-level columns:
+//
+//	release_reference, icpn, grid, catalog_number, release_type, release_title,
+//	release_subtitle, release_artist, label, genre, sub_genre, release_date,
+//	original_release_date, release_parental_warning, pline_year, pline_text,
+//	cline_year, cline_text, territories, cover_file, cover_id
+//
+// Deal columns (optional, release-level; CSVImportOptions.Deals is used when absent):
+//
+//	deal_territories, commercial_models, use_types, deal_start_date, deal_end_date
+//
+// Track-level columns:
+//
+//	track_number, track_reference, kind, track_type, track_title, track_subtitle,
+//	isrc, duration, track_artist, track_file, track_parental_warning, contributors
+//
+// contributors is a "|"-separated list of "Name:Role;Role" entries,
+// e.g. "Jane Doe:Composer;Lyricist|John Roe:Producer".
+
+// CSVImportOptions configures ImportCSV
+type CSVImportOptions struct {
+	// Message is applied to every generated message. MessageId is always generated per
+	// message; ThreadId is generated once and shared by the batch if empty.
+	Message ManifestMessage
+
+	// Deals are used for releases whose rows carry no deal columns
+	Deals []ManifestDeal
+}
+
+// csvRow gives access to a CSV record by header name
+type csvRow struct {
+	line    int
+	columns map[string]int
+	record  []string
+}
+
+func (r csvRow) get(name string) string {
+	if i, ok := r.columns[name]; ok && i < len(r.record) {
+		return strings.TrimSpace(r.record[i])
+	}
+	return ""
+}
+
+func (r csvRow) list(name string) []string {
+	value := r.get(name)
+	if value == "" {
+		return nil
+	}
+	var items []string
+	for _, item := range strings.Split(value, "|") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func (r csvRow) int(name string) (int, error) {
+	value := r.get(name)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("line %d: %s must be a number, got %q", r.line, name, value)
+	}
+	return n, nil
+}
+
+// ParseCSVManifests reads a catalog CSV and returns one Manifest per release, in the
+// order releases first appear in the file
+func ParseCSVManifests(r io.Reader, opts CSVImportOptions) ([]*Manifest, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("CSV is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		// Spreadsheet exports often start with a UTF-8 byte order mark
+		name = strings.TrimPrefix(name, "\ufeff")
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["track_title"]; !ok {
+		return nil, fmt.Errorf("CSV header is missing the track_title column")
+	}
+
+	threadId := opts.Message.ThreadId
+	if threadId == "" {
+		threadId = GenerateThreadID("")
+	}
+
+	var manifests []*Manifest
+	byKey := make(map[string]*Manifest)
+	trackNumbers := make(map[*Manifest][]int)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		// the line the row starts on, counting the lines of quoted fields with line breaks
+		line, _ := reader.FieldPos(0)
+
+		row := csvRow{line: line, columns: columns, record: record}
+
+		key := row.get("icpn")
+		if key == "" {
+			key = row.get("release_reference")
+		}
+		if key == "" {
+			key = row.get("release_title")
+		}
+		if key == "" {
+			return nil, fmt.Errorf("line %d: one of icpn, release_reference or release_title is required", line)
+		}
+
+		manifest, ok := byKey[key]
+		if !ok {
+			manifest, err = csvReleaseManifest(row, opts, threadId)
+			if err != nil {
+				return nil, err
+			}
+			byKey[key] = manifest
+			manifests = append(manifests, manifest)
+		}
+
+		track, err := csvTrack(row)
+		if err != nil {
+			return nil, err
+		}
+		number, err := row.int("track_number")
+		if err != nil {
+			return nil, err
+		}
+		manifest.Release.Tracks = append(manifest.Release.Tracks, track)
+		trackNumbers[manifest] = append(trackNumbers[manifest], number)
+	}
+
+	for _, manifest := range manifests {
+		sortTracksByNumber(manifest.Release.Tracks, trackNumbers[manifest])
+	}
+
+	return manifests, nil
+}
+
+// ImportCSV reads a catalog CSV and builds one NewReleaseMessage per release
+func ImportCSV(r io.Reader, opts CSVImportOptions) ([]*NewReleaseMessage, error) {
+	manifests, err := ParseCSVManifests(r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*NewReleaseMessage, 0, len(manifests))
+	for _, manifest := range manifests {
+		builder, err := manifest.Builder()
+		if err != nil {
+			return nil, fmt.Errorf("release %q: %w", manifest.Release.Title, err)
+		}
+		messages = append(messages, builder.Build())
+	}
+
+	return messages, nil
+}
+
+// csvReleaseManifest creates the manifest for a release from its first row
+func csvReleaseManifest(row csvRow, opts CSVImportOptions, threadId string) (*Manifest, error) {
+	message := opts.Message
+	message.MessageId = ""
+	message.ThreadId = threadId
+
+	release := ManifestRelease{
+		Reference:           row.get("release_reference"),
+		Type:                row.get("release_type"),
+		Title:               row.get("release_title"),
+		Subtitle:            row.get("release_subtitle"),
+		ICPN:                row.get("icpn"),
+		GRid:                row.get("grid"),
+		CatalogNumber:       row.get("catalog_number"),
+		Territories:         row.list("territories"),
+		DisplayArtist:       row.get("release_artist"),
+		Label:               row.get("label"),
+		Genre:               row.get("genre"),
+		SubGenre:            row.get("sub_genre"),
+		ReleaseDate:         row.get("release_date"),
+		OriginalReleaseDate: row.get("original_release_date"),
+		ParentalWarning:     row.get("release_parental_warning"),
+	}
+
+	for _, line := range []struct {
+		yearColumn, textColumn string
+		target                 **ManifestLine
+	}{
+		{"pline_year", "pline_text", &release.PLine},
+		{"cline_year", "cline_text", &release.CLine},
+	} {
+		if text := row.get(line.textColumn); text != "" {
+			year, err := row.int(line.yearColumn)
+			if err != nil {
+				return nil, err
+			}
+			*line.target = &ManifestLine{Year: year, Text: text}
+		}
+	}
+
+	if cover := row.get("cover_file"); cover != "" {
+		release.Assets = append(release.Assets, ManifestAsset{
+			Type:          "FrontCoverImage",
+			File:          cover,
+			ProprietaryId: row.get("cover_id"),
+		})
+	}
+
+	deals := opts.Deals
+	dealTerritories := row.list("deal_territories")
+	commercialModels := row.list("commercial_models")
+	useTypes := row.list("use_types")
+	if len(dealTerritories) > 0 || len(commercialModels) > 0 || len(useTypes) > 0 {
+		deals = []ManifestDeal{{
+			Territories:      dealTerritories,
+			CommercialModels: commercialModels,
+			UseTypes:         useTypes,
+			StartDate:        row.get("deal_start_date"),
+			EndDate:          row.get("deal_end_date"),
+		}}
+	}
+
+	return &Manifest{
+		Message: message,
+		Release: release,
+		Deals:   deals,
+	}, nil
+}
+
+// csvTrack reads the track-level columns of a row
+func csvTrack(row csvRow) (ManifestTrack, error) {
+	track := ManifestTrack{
+		Reference:       row.get("track_reference"),
+		Kind:            row.get("kind"),
+		Type:            row.get("track_type"),
+		Title:           row.get("track_title"),
+		Subtitle:        row.get("track_subtitle"),
+		ISRC:            row.get("isrc"),
+		Duration:        row.get("duration"),
+		DisplayArtist:   row.get("track_artist"),
+		File:            row.get("track_file"),
+		ParentalWarning: row.get("track_parental_warning"),
+	}
+
+	if track.Title == "" {
+		return track, fmt.Errorf("line %d: track_title is required", row.line)
+	}
+
+	for _, entry := range row.list("contributors") {
+		name, roles, found := strings.Cut(entry, ":")
+		if !found || strings.TrimSpace(roles) == "" {
+			return track, fmt.Errorf("line %d: contributor %q must be formatted as Name:Role;Role", row.line, entry)
+		}
+		contributor := ManifestArtist{Name: strings.TrimSpace(name)}
+		for _, role := range strings.Split(roles, ";") {
+			if role = strings.TrimSpace(role); role != "" {
+				contributor.Roles = append(contributor.Roles, role)
+			}
+		}
+		track.Contributors = append(track.Contributors, contributor)
+	}
+
+	return track, nil
+}
+
+// sortTracksByNumber orders tracks by their track_number, keeping file order for equal
+// numbers. Rows without a number follow the numbered ones, in file order.
+func sortTracksByNumber(tracks []ManifestTrack, numbers []int) {
+	indexes := make([]int, len(tracks))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		na, nb := numbers[indexes[a]], numbers[indexes[b]]
+		if na == 0 || nb == 0 {
+			return na != 0 && nb == 0
+		}
+		return na < nb
+	})
+
+	sorted := make([]ManifestTrack, len(tracks))
+	for i, index := range indexes {
+		sorted[i] = tracks[index]
+	}
+	copy(tracks, sorted)
+}
@@ -0,0 +1,66 @@
+package ddex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSortTracksByNumber(t *testing.T) {
+	tests := []struct {
+		numbers []int
+		want    []string
+	}{
+		{[]int{3, 1, 2}, []string{"b", "c", "a"}},
+		{[]int{2, 0, 1}, []string{"c", "a", "b"}},
+		{[]int{0, 3, 0, 1, 2}, []string{"d", "e", "b", "a", "c"}},
+		{[]int{0, 0, 0}, []string{"a", "b", "c"}},
+		{[]int{2, 1, 2}, []string{"b", "a", "c"}},
+	}
+	for _, tt := range tests {
+		var tracks []ManifestTrack
+		for i := range tt.numbers {
+			tracks = append(tracks, ManifestTrack{Title: string(rune('a' + i))})
+		}
+		sortTracksByNumber(tracks, tt.numbers)
+		var got []string
+		for _, track := range tracks {
+			got = append(got, track.Title)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("sortTracksByNumber(%v) = %v, want %v", tt.numbers, got, tt.want)
+		}
+	}
+}
+
+func TestParseCSVManifestsErrorLine(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+		want string
+	}{
+		{
+			name: "single-line rows",
+			csv:  "icpn,track_title\n0123456789012,One\n0123456789012,\n",
+			want: "line 3: track_title is required",
+		},
+		{
+			name: "after a multi-line field",
+			csv:  "icpn,track_title,marketing_comment\n0123456789012,One,\"first\nsecond\nthird\"\n0123456789012,,\n",
+			want: "line 5: track_title is required",
+		},
+		{
+			name: "multi-line row",
+			csv:  "icpn,track_title,marketing_comment\n0123456789012,One,\n0123456789012,,\"first\nsecond\"\n",
+			want: "line 3: track_title is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSVManifests(strings.NewReader(tt.csv), CSVImportOptions{})
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
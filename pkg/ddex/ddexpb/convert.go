@@ -0,0 +1,6019 @@
+// Code generated by protogen from package ddex. DO NOT EDIT.
+
+package ddexpb
+
+import "github.com/manosdetijera/ddex/pkg/ddex"
+
+// FromNewReleaseMessage converts a ddex.NewReleaseMessage to its protobuf message
+func FromNewReleaseMessage(in *ddex.NewReleaseMessage) *NewReleaseMessage {
+	if in == nil {
+		return nil
+	}
+	out := &NewReleaseMessage{}
+	out.XmlnsErn = in.XmlnsErn
+	out.XmlnsXsi = in.XmlnsXsi
+	out.XsiSchemaLocation = in.XsiSchemaLocation
+	out.MessageSchemaVersionId = in.MessageSchemaVersionId
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.OtherAttr = fromXMLAttrs(in.OtherAttr)
+	out.MessageHeader = FromMessageHeader(in.MessageHeader)
+	out.UpdateIndicator = in.UpdateIndicator
+	out.ResourceList = FromResourceList(in.ResourceList)
+	out.CollectionList = FromCollectionList(in.CollectionList)
+	out.ReleaseList = FromReleaseList(in.ReleaseList)
+	out.DealList = FromDealList(in.DealList)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToNewReleaseMessage converts a NewReleaseMessage message to a ddex.NewReleaseMessage
+func ToNewReleaseMessage(in *NewReleaseMessage) (*ddex.NewReleaseMessage, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.NewReleaseMessage{}
+	var err error
+	out.XmlnsErn = in.XmlnsErn
+	out.XmlnsXsi = in.XmlnsXsi
+	out.XsiSchemaLocation = in.XsiSchemaLocation
+	out.MessageSchemaVersionId = in.MessageSchemaVersionId
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.OtherAttr = toXMLAttrs(in.OtherAttr)
+	if out.MessageHeader, err = ToMessageHeader(in.MessageHeader); err != nil {
+		return nil, wrapField("MessageHeader", err)
+	}
+	out.UpdateIndicator = in.UpdateIndicator
+	if out.ResourceList, err = ToResourceList(in.ResourceList); err != nil {
+		return nil, wrapField("ResourceList", err)
+	}
+	if out.CollectionList, err = ToCollectionList(in.CollectionList); err != nil {
+		return nil, wrapField("CollectionList", err)
+	}
+	if out.ReleaseList, err = ToReleaseList(in.ReleaseList); err != nil {
+		return nil, wrapField("ReleaseList", err)
+	}
+	if out.DealList, err = ToDealList(in.DealList); err != nil {
+		return nil, wrapField("DealList", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromMessageHeader converts a ddex.MessageHeader to its protobuf message
+func FromMessageHeader(in *ddex.MessageHeader) *MessageHeader {
+	if in == nil {
+		return nil
+	}
+	out := &MessageHeader{}
+	out.MessageThreadId = in.MessageThreadId
+	out.MessageId = in.MessageId
+	out.MessageFileName = in.MessageFileName
+	out.MessageSender = FromMessageSender(in.MessageSender)
+	out.SentOnBehalfOf = FromSentOnBehalfOf(in.SentOnBehalfOf)
+	for _, v := range in.MessageRecipient {
+		out.MessageRecipient = append(out.MessageRecipient, FromMessageRecipient(v))
+	}
+	out.MessageCreatedDateTime = fromDateTime(in.MessageCreatedDateTime)
+	out.MessageAuditTrail = FromMessageAuditTrail(in.MessageAuditTrail)
+	out.Comment = in.Comment
+	out.MessageControlType = in.MessageControlType
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToMessageHeader converts a MessageHeader message to a ddex.MessageHeader
+func ToMessageHeader(in *MessageHeader) (*ddex.MessageHeader, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.MessageHeader{}
+	var err error
+	out.MessageThreadId = in.MessageThreadId
+	out.MessageId = in.MessageId
+	out.MessageFileName = in.MessageFileName
+	if out.MessageSender, err = ToMessageSender(in.MessageSender); err != nil {
+		return nil, wrapField("MessageSender", err)
+	}
+	if out.SentOnBehalfOf, err = ToSentOnBehalfOf(in.SentOnBehalfOf); err != nil {
+		return nil, wrapField("SentOnBehalfOf", err)
+	}
+	if len(in.MessageRecipient) > 0 {
+		out.MessageRecipient = make([]*ddex.MessageRecipient, len(in.MessageRecipient))
+		for i, m := range in.MessageRecipient {
+			v, err := ToMessageRecipient(m)
+			if err != nil {
+				return nil, wrapIndex("MessageRecipient", i, err)
+			}
+			out.MessageRecipient[i] = v
+		}
+	}
+	if out.MessageCreatedDateTime, err = toDateTime(in.MessageCreatedDateTime); err != nil {
+		return nil, wrapField("MessageCreatedDateTime", err)
+	}
+	if out.MessageAuditTrail, err = ToMessageAuditTrail(in.MessageAuditTrail); err != nil {
+		return nil, wrapField("MessageAuditTrail", err)
+	}
+	out.Comment = in.Comment
+	out.MessageControlType = in.MessageControlType
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromResourceList converts a ddex.ResourceList to its protobuf message
+func FromResourceList(in *ddex.ResourceList) *ResourceList {
+	if in == nil {
+		return nil
+	}
+	out := &ResourceList{}
+	for i := range in.SoundRecording {
+		out.SoundRecording = append(out.SoundRecording, FromSoundRecording(&in.SoundRecording[i]))
+	}
+	for i := range in.Video {
+		out.Video = append(out.Video, FromVideo(&in.Video[i]))
+	}
+	for i := range in.Image {
+		out.Image = append(out.Image, FromImage(&in.Image[i]))
+	}
+	for i := range in.Text {
+		out.Text = append(out.Text, FromText(&in.Text[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToResourceList converts a ResourceList message to a ddex.ResourceList
+func ToResourceList(in *ResourceList) (*ddex.ResourceList, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ResourceList{}
+	if len(in.SoundRecording) > 0 {
+		out.SoundRecording = make([]ddex.SoundRecording, len(in.SoundRecording))
+		for i, m := range in.SoundRecording {
+			v, err := ToSoundRecording(m)
+			if err != nil {
+				return nil, wrapIndex("SoundRecording", i, err)
+			}
+			if v != nil {
+				out.SoundRecording[i] = *v
+			}
+		}
+	}
+	if len(in.Video) > 0 {
+		out.Video = make([]ddex.Video, len(in.Video))
+		for i, m := range in.Video {
+			v, err := ToVideo(m)
+			if err != nil {
+				return nil, wrapIndex("Video", i, err)
+			}
+			if v != nil {
+				out.Video[i] = *v
+			}
+		}
+	}
+	if len(in.Image) > 0 {
+		out.Image = make([]ddex.Image, len(in.Image))
+		for i, m := range in.Image {
+			v, err := ToImage(m)
+			if err != nil {
+				return nil, wrapIndex("Image", i, err)
+			}
+			if v != nil {
+				out.Image[i] = *v
+			}
+		}
+	}
+	if len(in.Text) > 0 {
+		out.Text = make([]ddex.Text, len(in.Text))
+		for i, m := range in.Text {
+			v, err := ToText(m)
+			if err != nil {
+				return nil, wrapIndex("Text", i, err)
+			}
+			if v != nil {
+				out.Text[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromCollectionList converts a ddex.CollectionList to its protobuf message
+func FromCollectionList(in *ddex.CollectionList) *CollectionList {
+	if in == nil {
+		return nil
+	}
+	out := &CollectionList{}
+	for i := range in.Collection {
+		out.Collection = append(out.Collection, FromCollection(&in.Collection[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToCollectionList converts a CollectionList message to a ddex.CollectionList
+func ToCollectionList(in *CollectionList) (*ddex.CollectionList, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.CollectionList{}
+	if len(in.Collection) > 0 {
+		out.Collection = make([]ddex.Collection, len(in.Collection))
+		for i, m := range in.Collection {
+			v, err := ToCollection(m)
+			if err != nil {
+				return nil, wrapIndex("Collection", i, err)
+			}
+			if v != nil {
+				out.Collection[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromReleaseList converts a ddex.ReleaseList to its protobuf message
+func FromReleaseList(in *ddex.ReleaseList) *ReleaseList {
+	if in == nil {
+		return nil
+	}
+	out := &ReleaseList{}
+	for i := range in.Release {
+		out.Release = append(out.Release, FromRelease(&in.Release[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToReleaseList converts a ReleaseList message to a ddex.ReleaseList
+func ToReleaseList(in *ReleaseList) (*ddex.ReleaseList, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ReleaseList{}
+	if len(in.Release) > 0 {
+		out.Release = make([]ddex.Release, len(in.Release))
+		for i, m := range in.Release {
+			v, err := ToRelease(m)
+			if err != nil {
+				return nil, wrapIndex("Release", i, err)
+			}
+			if v != nil {
+				out.Release[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromDealList converts a ddex.DealList to its protobuf message
+func FromDealList(in *ddex.DealList) *DealList {
+	if in == nil {
+		return nil
+	}
+	out := &DealList{}
+	for i := range in.ReleaseDeal {
+		out.ReleaseDeal = append(out.ReleaseDeal, FromReleaseDeal(&in.ReleaseDeal[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToDealList converts a DealList message to a ddex.DealList
+func ToDealList(in *DealList) (*ddex.DealList, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.DealList{}
+	if len(in.ReleaseDeal) > 0 {
+		out.ReleaseDeal = make([]ddex.ReleaseDeal, len(in.ReleaseDeal))
+		for i, m := range in.ReleaseDeal {
+			v, err := ToReleaseDeal(m)
+			if err != nil {
+				return nil, wrapIndex("ReleaseDeal", i, err)
+			}
+			if v != nil {
+				out.ReleaseDeal[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromRawElement converts a ddex.RawElement to its protobuf message
+func FromRawElement(in *ddex.RawElement) *RawElement {
+	if in == nil {
+		return nil
+	}
+	out := &RawElement{}
+	out.Name = fromXMLName(in.Name)
+	out.Attr = fromXMLAttrs(in.Attr)
+	out.InnerXml = in.InnerXML
+	return out
+}
+
+// ToRawElement converts a RawElement message to a ddex.RawElement
+func ToRawElement(in *RawElement) (*ddex.RawElement, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.RawElement{}
+	out.Name = toXMLName(in.Name)
+	out.Attr = toXMLAttrs(in.Attr)
+	out.InnerXML = in.InnerXml
+	return out, nil
+}
+
+// FromMessageSender converts a ddex.MessageSender to its protobuf message
+func FromMessageSender(in *ddex.MessageSender) *MessageSender {
+	if in == nil {
+		return nil
+	}
+	out := &MessageSender{}
+	for i := range in.PartyId {
+		out.PartyId = append(out.PartyId, FromPartyID(&in.PartyId[i]))
+	}
+	for i := range in.PartyName {
+		out.PartyName = append(out.PartyName, FromName(&in.PartyName[i]))
+	}
+	out.TradingName = in.TradingName
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToMessageSender converts a MessageSender message to a ddex.MessageSender
+func ToMessageSender(in *MessageSender) (*ddex.MessageSender, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.MessageSender{}
+	if len(in.PartyId) > 0 {
+		out.PartyId = make([]ddex.PartyID, len(in.PartyId))
+		for i, m := range in.PartyId {
+			v, err := ToPartyID(m)
+			if err != nil {
+				return nil, wrapIndex("PartyId", i, err)
+			}
+			if v != nil {
+				out.PartyId[i] = *v
+			}
+		}
+	}
+	if len(in.PartyName) > 0 {
+		out.PartyName = make([]ddex.Name, len(in.PartyName))
+		for i, m := range in.PartyName {
+			v, err := ToName(m)
+			if err != nil {
+				return nil, wrapIndex("PartyName", i, err)
+			}
+			if v != nil {
+				out.PartyName[i] = *v
+			}
+		}
+	}
+	out.TradingName = in.TradingName
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromSentOnBehalfOf converts a ddex.SentOnBehalfOf to its protobuf message
+func FromSentOnBehalfOf(in *ddex.SentOnBehalfOf) *SentOnBehalfOf {
+	if in == nil {
+		return nil
+	}
+	out := &SentOnBehalfOf{}
+	for i := range in.PartyId {
+		out.PartyId = append(out.PartyId, FromPartyID(&in.PartyId[i]))
+	}
+	for i := range in.PartyName {
+		out.PartyName = append(out.PartyName, FromName(&in.PartyName[i]))
+	}
+	out.TradingName = in.TradingName
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToSentOnBehalfOf converts a SentOnBehalfOf message to a ddex.SentOnBehalfOf
+func ToSentOnBehalfOf(in *SentOnBehalfOf) (*ddex.SentOnBehalfOf, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.SentOnBehalfOf{}
+	if len(in.PartyId) > 0 {
+		out.PartyId = make([]ddex.PartyID, len(in.PartyId))
+		for i, m := range in.PartyId {
+			v, err := ToPartyID(m)
+			if err != nil {
+				return nil, wrapIndex("PartyId", i, err)
+			}
+			if v != nil {
+				out.PartyId[i] = *v
+			}
+		}
+	}
+	if len(in.PartyName) > 0 {
+		out.PartyName = make([]ddex.Name, len(in.PartyName))
+		for i, m := range in.PartyName {
+			v, err := ToName(m)
+			if err != nil {
+				return nil, wrapIndex("PartyName", i, err)
+			}
+			if v != nil {
+				out.PartyName[i] = *v
+			}
+		}
+	}
+	out.TradingName = in.TradingName
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromMessageRecipient converts a ddex.MessageRecipient to its protobuf message
+func FromMessageRecipient(in *ddex.MessageRecipient) *MessageRecipient {
+	if in == nil {
+		return nil
+	}
+	out := &MessageRecipient{}
+	for i := range in.PartyId {
+		out.PartyId = append(out.PartyId, FromPartyID(&in.PartyId[i]))
+	}
+	for i := range in.PartyName {
+		out.PartyName = append(out.PartyName, FromName(&in.PartyName[i]))
+	}
+	out.TradingName = in.TradingName
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToMessageRecipient converts a MessageRecipient message to a ddex.MessageRecipient
+func ToMessageRecipient(in *MessageRecipient) (*ddex.MessageRecipient, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.MessageRecipient{}
+	if len(in.PartyId) > 0 {
+		out.PartyId = make([]ddex.PartyID, len(in.PartyId))
+		for i, m := range in.PartyId {
+			v, err := ToPartyID(m)
+			if err != nil {
+				return nil, wrapIndex("PartyId", i, err)
+			}
+			if v != nil {
+				out.PartyId[i] = *v
+			}
+		}
+	}
+	if len(in.PartyName) > 0 {
+		out.PartyName = make([]ddex.Name, len(in.PartyName))
+		for i, m := range in.PartyName {
+			v, err := ToName(m)
+			if err != nil {
+				return nil, wrapIndex("PartyName", i, err)
+			}
+			if v != nil {
+				out.PartyName[i] = *v
+			}
+		}
+	}
+	out.TradingName = in.TradingName
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromMessageAuditTrail converts a ddex.MessageAuditTrail to its protobuf message
+func FromMessageAuditTrail(in *ddex.MessageAuditTrail) *MessageAuditTrail {
+	if in == nil {
+		return nil
+	}
+	out := &MessageAuditTrail{}
+	for i := range in.MessageAuditTrailEvent {
+		out.MessageAuditTrailEvent = append(out.MessageAuditTrailEvent, FromMessageAuditTrailEvent(&in.MessageAuditTrailEvent[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToMessageAuditTrail converts a MessageAuditTrail message to a ddex.MessageAuditTrail
+func ToMessageAuditTrail(in *MessageAuditTrail) (*ddex.MessageAuditTrail, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.MessageAuditTrail{}
+	if len(in.MessageAuditTrailEvent) > 0 {
+		out.MessageAuditTrailEvent = make([]ddex.MessageAuditTrailEvent, len(in.MessageAuditTrailEvent))
+		for i, m := range in.MessageAuditTrailEvent {
+			v, err := ToMessageAuditTrailEvent(m)
+			if err != nil {
+				return nil, wrapIndex("MessageAuditTrailEvent", i, err)
+			}
+			if v != nil {
+				out.MessageAuditTrailEvent[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromSoundRecording converts a ddex.SoundRecording to its protobuf message
+func FromSoundRecording(in *ddex.SoundRecording) *SoundRecording {
+	if in == nil {
+		return nil
+	}
+	out := &SoundRecording{}
+	out.IsUpdated = clonePtr(in.IsUpdated)
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.SoundRecordingType = in.SoundRecordingType
+	out.IsArtistRelated = clonePtr(in.IsArtistRelated)
+	for i := range in.SoundRecordingId {
+		out.SoundRecordingId = append(out.SoundRecordingId, FromSoundRecordingId(&in.SoundRecordingId[i]))
+	}
+	for i := range in.IndirectSoundRecordingId {
+		out.IndirectSoundRecordingId = append(out.IndirectSoundRecordingId, FromMusicalWorkId(&in.IndirectSoundRecordingId[i]))
+	}
+	out.ResourceReference = in.ResourceReference
+	out.ReferenceTitle = FromReferenceTitle(in.ReferenceTitle)
+	out.InstrumentationDescription = FromDescription(in.InstrumentationDescription)
+	out.IsMedley = clonePtr(in.IsMedley)
+	out.IsPotpourri = clonePtr(in.IsPotpourri)
+	out.IsInstrumental = clonePtr(in.IsInstrumental)
+	out.IsBackground = clonePtr(in.IsBackground)
+	out.IsHiddenResource = clonePtr(in.IsHiddenResource)
+	out.IsBonusResource = clonePtr(in.IsBonusResource)
+	out.HasPreOrderFulfillment = clonePtr(in.HasPreOrderFulfillment)
+	out.IsRemastered = clonePtr(in.IsRemastered)
+	out.NoSilenceBefore = clonePtr(in.NoSilenceBefore)
+	out.NoSilenceAfter = clonePtr(in.NoSilenceAfter)
+	out.PerformerInformationRequired = clonePtr(in.PerformerInformationRequired)
+	out.LanguageOfPerformance = cloneSlice(in.LanguageOfPerformance)
+	out.Duration = in.Duration
+	out.RightsAgreementId = FromRightsAgreementId(in.RightsAgreementId)
+	out.ResourceMusicalWorkReferenceList = FromResourceMusicalWorkReferenceList(in.ResourceMusicalWorkReferenceList)
+	out.ResourceContainedResourceReferenceList = FromResourceContainedResourceReferenceList(in.ResourceContainedResourceReferenceList)
+	out.CreationDate = FromEventDate(in.CreationDate)
+	out.MasteredDate = FromEventDate(in.MasteredDate)
+	out.RemasteredDate = FromEventDate(in.RemasteredDate)
+	for i := range in.SoundRecordingDetailsByTerritory {
+		out.SoundRecordingDetailsByTerritory = append(out.SoundRecordingDetailsByTerritory, FromSoundRecordingDetailsByTerritory(&in.SoundRecordingDetailsByTerritory[i]))
+	}
+	out.TerritoryOfCommissioning = in.TerritoryOfCommissioning
+	out.NumberOfFeaturedArtists = fromIntPtr(in.NumberOfFeaturedArtists)
+	out.NumberOfNonFeaturedArtists = fromIntPtr(in.NumberOfNonFeaturedArtists)
+	out.NumberOfContractedArtists = fromIntPtr(in.NumberOfContractedArtists)
+	out.NumberOfNonContractedArtists = fromIntPtr(in.NumberOfNonContractedArtists)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToSoundRecording converts a SoundRecording message to a ddex.SoundRecording
+func ToSoundRecording(in *SoundRecording) (*ddex.SoundRecording, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.SoundRecording{}
+	var err error
+	out.IsUpdated = clonePtr(in.IsUpdated)
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.SoundRecordingType = in.SoundRecordingType
+	out.IsArtistRelated = clonePtr(in.IsArtistRelated)
+	if len(in.SoundRecordingId) > 0 {
+		out.SoundRecordingId = make([]ddex.SoundRecordingId, len(in.SoundRecordingId))
+		for i, m := range in.SoundRecordingId {
+			v, err := ToSoundRecordingId(m)
+			if err != nil {
+				return nil, wrapIndex("SoundRecordingId", i, err)
+			}
+			if v != nil {
+				out.SoundRecordingId[i] = *v
+			}
+		}
+	}
+	if len(in.IndirectSoundRecordingId) > 0 {
+		out.IndirectSoundRecordingId = make([]ddex.MusicalWorkId, len(in.IndirectSoundRecordingId))
+		for i, m := range in.IndirectSoundRecordingId {
+			v, err := ToMusicalWorkId(m)
+			if err != nil {
+				return nil, wrapIndex("IndirectSoundRecordingId", i, err)
+			}
+			if v != nil {
+				out.IndirectSoundRecordingId[i] = *v
+			}
+		}
+	}
+	out.ResourceReference = in.ResourceReference
+	if out.ReferenceTitle, err = ToReferenceTitle(in.ReferenceTitle); err != nil {
+		return nil, wrapField("ReferenceTitle", err)
+	}
+	if out.InstrumentationDescription, err = ToDescription(in.InstrumentationDescription); err != nil {
+		return nil, wrapField("InstrumentationDescription", err)
+	}
+	out.IsMedley = clonePtr(in.IsMedley)
+	out.IsPotpourri = clonePtr(in.IsPotpourri)
+	out.IsInstrumental = clonePtr(in.IsInstrumental)
+	out.IsBackground = clonePtr(in.IsBackground)
+	out.IsHiddenResource = clonePtr(in.IsHiddenResource)
+	out.IsBonusResource = clonePtr(in.IsBonusResource)
+	out.HasPreOrderFulfillment = clonePtr(in.HasPreOrderFulfillment)
+	out.IsRemastered = clonePtr(in.IsRemastered)
+	out.NoSilenceBefore = clonePtr(in.NoSilenceBefore)
+	out.NoSilenceAfter = clonePtr(in.NoSilenceAfter)
+	out.PerformerInformationRequired = clonePtr(in.PerformerInformationRequired)
+	out.LanguageOfPerformance = cloneSlice(in.LanguageOfPerformance)
+	out.Duration = in.Duration
+	if out.RightsAgreementId, err = ToRightsAgreementId(in.RightsAgreementId); err != nil {
+		return nil, wrapField("RightsAgreementId", err)
+	}
+	if out.ResourceMusicalWorkReferenceList, err = ToResourceMusicalWorkReferenceList(in.ResourceMusicalWorkReferenceList); err != nil {
+		return nil, wrapField("ResourceMusicalWorkReferenceList", err)
+	}
+	if out.ResourceContainedResourceReferenceList, err = ToResourceContainedResourceReferenceList(in.ResourceContainedResourceReferenceList); err != nil {
+		return nil, wrapField("ResourceContainedResourceReferenceList", err)
+	}
+	if out.CreationDate, err = ToEventDate(in.CreationDate); err != nil {
+		return nil, wrapField("CreationDate", err)
+	}
+	if out.MasteredDate, err = ToEventDate(in.MasteredDate); err != nil {
+		return nil, wrapField("MasteredDate", err)
+	}
+	if out.RemasteredDate, err = ToEventDate(in.RemasteredDate); err != nil {
+		return nil, wrapField("RemasteredDate", err)
+	}
+	if len(in.SoundRecordingDetailsByTerritory) > 0 {
+		out.SoundRecordingDetailsByTerritory = make([]ddex.SoundRecordingDetailsByTerritory, len(in.SoundRecordingDetailsByTerritory))
+		for i, m := range in.SoundRecordingDetailsByTerritory {
+			v, err := ToSoundRecordingDetailsByTerritory(m)
+			if err != nil {
+				return nil, wrapIndex("SoundRecordingDetailsByTerritory", i, err)
+			}
+			if v != nil {
+				out.SoundRecordingDetailsByTerritory[i] = *v
+			}
+		}
+	}
+	out.TerritoryOfCommissioning = in.TerritoryOfCommissioning
+	out.NumberOfFeaturedArtists = toIntPtr(in.NumberOfFeaturedArtists)
+	out.NumberOfNonFeaturedArtists = toIntPtr(in.NumberOfNonFeaturedArtists)
+	out.NumberOfContractedArtists = toIntPtr(in.NumberOfContractedArtists)
+	out.NumberOfNonContractedArtists = toIntPtr(in.NumberOfNonContractedArtists)
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromVideo converts a ddex.Video to its protobuf message
+func FromVideo(in *ddex.Video) *Video {
+	if in == nil {
+		return nil
+	}
+	out := &Video{}
+	out.IsUpdated = clonePtr(in.IsUpdated)
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.VideoType = FromVideoType(in.VideoType)
+	out.IsArtistRelated = clonePtr(in.IsArtistRelated)
+	out.VideoId = FromVideoId(in.VideoId)
+	for i := range in.IndirectVideoId {
+		out.IndirectVideoId = append(out.IndirectVideoId, FromMusicalWorkId(&in.IndirectVideoId[i]))
+	}
+	out.ResourceReference = in.ResourceReference
+	for i := range in.VideoCueSheetReference {
+		out.VideoCueSheetReference = append(out.VideoCueSheetReference, FromVideoCueSheetReference(&in.VideoCueSheetReference[i]))
+	}
+	out.ReasonForCueSheetAbsence = FromReason(in.ReasonForCueSheetAbsence)
+	out.ReferenceTitle = FromReferenceTitle(in.ReferenceTitle)
+	for i := range in.Title {
+		out.Title = append(out.Title, FromTitle(&in.Title[i]))
+	}
+	out.InstrumentationDescription = FromDescription(in.InstrumentationDescription)
+	out.IsMedley = clonePtr(in.IsMedley)
+	out.IsPotpourri = clonePtr(in.IsPotpourri)
+	out.IsInstrumental = clonePtr(in.IsInstrumental)
+	out.IsBackground = clonePtr(in.IsBackground)
+	out.IsHiddenResource = clonePtr(in.IsHiddenResource)
+	out.IsBonusResource = clonePtr(in.IsBonusResource)
+	out.HasPreOrderFulfillment = clonePtr(in.HasPreOrderFulfillment)
+	out.IsRemastered = clonePtr(in.IsRemastered)
+	out.NoSilenceBefore = clonePtr(in.NoSilenceBefore)
+	out.NoSilenceAfter = clonePtr(in.NoSilenceAfter)
+	out.PerformerInformationRequired = clonePtr(in.PerformerInformationRequired)
+	out.LanguageOfPerformance = cloneSlice(in.LanguageOfPerformance)
+	out.LanguageOfDubbing = cloneSlice(in.LanguageOfDubbing)
+	out.SubTitleLanguage = cloneSlice(in.SubTitleLanguage)
+	out.Duration = in.Duration
+	out.RightsAgreementId = FromRightsAgreementId(in.RightsAgreementId)
+	out.VideoCollectionReferenceList = FromSoundRecordingCollectionReferenceList(in.VideoCollectionReferenceList)
+	out.ResourceMusicalWorkReferenceList = FromResourceMusicalWorkReferenceList(in.ResourceMusicalWorkReferenceList)
+	out.ResourceContainedResourceReferenceList = FromResourceContainedResourceReferenceList(in.ResourceContainedResourceReferenceList)
+	out.CreationDate = FromEventDate(in.CreationDate)
+	out.MasteredDate = FromEventDate(in.MasteredDate)
+	out.RemasteredDate = FromEventDate(in.RemasteredDate)
+	for i := range in.VideoDetailsByTerritory {
+		out.VideoDetailsByTerritory = append(out.VideoDetailsByTerritory, FromVideoDetailsByTerritory(&in.VideoDetailsByTerritory[i]))
+	}
+	out.TerritoryOfCommissioning = in.TerritoryOfCommissioning
+	out.NumberOfFeaturedArtists = fromIntPtr(in.NumberOfFeaturedArtists)
+	out.NumberOfNonFeaturedArtists = fromIntPtr(in.NumberOfNonFeaturedArtists)
+	out.NumberOfContractedArtists = fromIntPtr(in.NumberOfContractedArtists)
+	out.NumberOfNonContractedArtists = fromIntPtr(in.NumberOfNonContractedArtists)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToVideo converts a Video message to a ddex.Video
+func ToVideo(in *Video) (*ddex.Video, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Video{}
+	var err error
+	out.IsUpdated = clonePtr(in.IsUpdated)
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	if out.VideoType, err = ToVideoType(in.VideoType); err != nil {
+		return nil, wrapField("VideoType", err)
+	}
+	out.IsArtistRelated = clonePtr(in.IsArtistRelated)
+	if out.VideoId, err = ToVideoId(in.VideoId); err != nil {
+		return nil, wrapField("VideoId", err)
+	}
+	if len(in.IndirectVideoId) > 0 {
+		out.IndirectVideoId = make([]ddex.MusicalWorkId, len(in.IndirectVideoId))
+		for i, m := range in.IndirectVideoId {
+			v, err := ToMusicalWorkId(m)
+			if err != nil {
+				return nil, wrapIndex("IndirectVideoId", i, err)
+			}
+			if v != nil {
+				out.IndirectVideoId[i] = *v
+			}
+		}
+	}
+	out.ResourceReference = in.ResourceReference
+	if len(in.VideoCueSheetReference) > 0 {
+		out.VideoCueSheetReference = make([]ddex.VideoCueSheetReference, len(in.VideoCueSheetReference))
+		for i, m := range in.VideoCueSheetReference {
+			v, err := ToVideoCueSheetReference(m)
+			if err != nil {
+				return nil, wrapIndex("VideoCueSheetReference", i, err)
+			}
+			if v != nil {
+				out.VideoCueSheetReference[i] = *v
+			}
+		}
+	}
+	if out.ReasonForCueSheetAbsence, err = ToReason(in.ReasonForCueSheetAbsence); err != nil {
+		return nil, wrapField("ReasonForCueSheetAbsence", err)
+	}
+	if out.ReferenceTitle, err = ToReferenceTitle(in.ReferenceTitle); err != nil {
+		return nil, wrapField("ReferenceTitle", err)
+	}
+	if len(in.Title) > 0 {
+		out.Title = make([]ddex.Title, len(in.Title))
+		for i, m := range in.Title {
+			v, err := ToTitle(m)
+			if err != nil {
+				return nil, wrapIndex("Title", i, err)
+			}
+			if v != nil {
+				out.Title[i] = *v
+			}
+		}
+	}
+	if out.InstrumentationDescription, err = ToDescription(in.InstrumentationDescription); err != nil {
+		return nil, wrapField("InstrumentationDescription", err)
+	}
+	out.IsMedley = clonePtr(in.IsMedley)
+	out.IsPotpourri = clonePtr(in.IsPotpourri)
+	out.IsInstrumental = clonePtr(in.IsInstrumental)
+	out.IsBackground = clonePtr(in.IsBackground)
+	out.IsHiddenResource = clonePtr(in.IsHiddenResource)
+	out.IsBonusResource = clonePtr(in.IsBonusResource)
+	out.HasPreOrderFulfillment = clonePtr(in.HasPreOrderFulfillment)
+	out.IsRemastered = clonePtr(in.IsRemastered)
+	out.NoSilenceBefore = clonePtr(in.NoSilenceBefore)
+	out.NoSilenceAfter = clonePtr(in.NoSilenceAfter)
+	out.PerformerInformationRequired = clonePtr(in.PerformerInformationRequired)
+	out.LanguageOfPerformance = cloneSlice(in.LanguageOfPerformance)
+	out.LanguageOfDubbing = cloneSlice(in.LanguageOfDubbing)
+	out.SubTitleLanguage = cloneSlice(in.SubTitleLanguage)
+	out.Duration = in.Duration
+	if out.RightsAgreementId, err = ToRightsAgreementId(in.RightsAgreementId); err != nil {
+		return nil, wrapField("RightsAgreementId", err)
+	}
+	if out.VideoCollectionReferenceList, err = ToSoundRecordingCollectionReferenceList(in.VideoCollectionReferenceList); err != nil {
+		return nil, wrapField("VideoCollectionReferenceList", err)
+	}
+	if out.ResourceMusicalWorkReferenceList, err = ToResourceMusicalWorkReferenceList(in.ResourceMusicalWorkReferenceList); err != nil {
+		return nil, wrapField("ResourceMusicalWorkReferenceList", err)
+	}
+	if out.ResourceContainedResourceReferenceList, err = ToResourceContainedResourceReferenceList(in.ResourceContainedResourceReferenceList); err != nil {
+		return nil, wrapField("ResourceContainedResourceReferenceList", err)
+	}
+	if out.CreationDate, err = ToEventDate(in.CreationDate); err != nil {
+		return nil, wrapField("CreationDate", err)
+	}
+	if out.MasteredDate, err = ToEventDate(in.MasteredDate); err != nil {
+		return nil, wrapField("MasteredDate", err)
+	}
+	if out.RemasteredDate, err = ToEventDate(in.RemasteredDate); err != nil {
+		return nil, wrapField("RemasteredDate", err)
+	}
+	if len(in.VideoDetailsByTerritory) > 0 {
+		out.VideoDetailsByTerritory = make([]ddex.VideoDetailsByTerritory, len(in.VideoDetailsByTerritory))
+		for i, m := range in.VideoDetailsByTerritory {
+			v, err := ToVideoDetailsByTerritory(m)
+			if err != nil {
+				return nil, wrapIndex("VideoDetailsByTerritory", i, err)
+			}
+			if v != nil {
+				out.VideoDetailsByTerritory[i] = *v
+			}
+		}
+	}
+	out.TerritoryOfCommissioning = in.TerritoryOfCommissioning
+	out.NumberOfFeaturedArtists = toIntPtr(in.NumberOfFeaturedArtists)
+	out.NumberOfNonFeaturedArtists = toIntPtr(in.NumberOfNonFeaturedArtists)
+	out.NumberOfContractedArtists = toIntPtr(in.NumberOfContractedArtists)
+	out.NumberOfNonContractedArtists = toIntPtr(in.NumberOfNonContractedArtists)
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromImage converts a ddex.Image to its protobuf message
+func FromImage(in *ddex.Image) *Image {
+	if in == nil {
+		return nil
+	}
+	out := &Image{}
+	out.IsUpdated = clonePtr(in.IsUpdated)
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.ImageType = FromImageType(in.ImageType)
+	out.IsArtistRelated = clonePtr(in.IsArtistRelated)
+	for i := range in.ImageId {
+		out.ImageId = append(out.ImageId, FromImageId(&in.ImageId[i]))
+	}
+	out.ResourceReference = in.ResourceReference
+	for i := range in.Title {
+		out.Title = append(out.Title, FromTitle(&in.Title[i]))
+	}
+	out.CreationDate = FromEventDate(in.CreationDate)
+	for i := range in.ImageDetailsByTerritory {
+		out.ImageDetailsByTerritory = append(out.ImageDetailsByTerritory, FromImageDetailsByTerritory(&in.ImageDetailsByTerritory[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToImage converts a Image message to a ddex.Image
+func ToImage(in *Image) (*ddex.Image, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Image{}
+	var err error
+	out.IsUpdated = clonePtr(in.IsUpdated)
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	if out.ImageType, err = ToImageType(in.ImageType); err != nil {
+		return nil, wrapField("ImageType", err)
+	}
+	out.IsArtistRelated = clonePtr(in.IsArtistRelated)
+	if len(in.ImageId) > 0 {
+		out.ImageId = make([]ddex.ImageId, len(in.ImageId))
+		for i, m := range in.ImageId {
+			v, err := ToImageId(m)
+			if err != nil {
+				return nil, wrapIndex("ImageId", i, err)
+			}
+			if v != nil {
+				out.ImageId[i] = *v
+			}
+		}
+	}
+	out.ResourceReference = in.ResourceReference
+	if len(in.Title) > 0 {
+		out.Title = make([]ddex.Title, len(in.Title))
+		for i, m := range in.Title {
+			v, err := ToTitle(m)
+			if err != nil {
+				return nil, wrapIndex("Title", i, err)
+			}
+			if v != nil {
+				out.Title[i] = *v
+			}
+		}
+	}
+	if out.CreationDate, err = ToEventDate(in.CreationDate); err != nil {
+		return nil, wrapField("CreationDate", err)
+	}
+	if len(in.ImageDetailsByTerritory) > 0 {
+		out.ImageDetailsByTerritory = make([]ddex.ImageDetailsByTerritory, len(in.ImageDetailsByTerritory))
+		for i, m := range in.ImageDetailsByTerritory {
+			v, err := ToImageDetailsByTerritory(m)
+			if err != nil {
+				return nil, wrapIndex("ImageDetailsByTerritory", i, err)
+			}
+			if v != nil {
+				out.ImageDetailsByTerritory[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromText converts a ddex.Text to its protobuf message
+func FromText(in *ddex.Text) *Text {
+	if in == nil {
+		return nil
+	}
+	out := &Text{}
+	out.ResourceReference = in.ResourceReference
+	out.Type = in.Type
+	for i := range in.ResourceId {
+		out.ResourceId = append(out.ResourceId, FromResourceID(&in.ResourceId[i]))
+	}
+	out.DisplayTitleText = FromDisplayTitleText(in.DisplayTitleText)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToText converts a Text message to a ddex.Text
+func ToText(in *Text) (*ddex.Text, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Text{}
+	var err error
+	out.ResourceReference = in.ResourceReference
+	out.Type = in.Type
+	if len(in.ResourceId) > 0 {
+		out.ResourceId = make([]ddex.ResourceID, len(in.ResourceId))
+		for i, m := range in.ResourceId {
+			v, err := ToResourceID(m)
+			if err != nil {
+				return nil, wrapIndex("ResourceId", i, err)
+			}
+			if v != nil {
+				out.ResourceId[i] = *v
+			}
+		}
+	}
+	if out.DisplayTitleText, err = ToDisplayTitleText(in.DisplayTitleText); err != nil {
+		return nil, wrapField("DisplayTitleText", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromCollection converts a ddex.Collection to its protobuf message
+func FromCollection(in *ddex.Collection) *Collection {
+	if in == nil {
+		return nil
+	}
+	out := &Collection{}
+	out.CollectionReference = in.CollectionReference
+	out.CollectionType = in.CollectionType
+	for i := range in.CollectionId {
+		out.CollectionId = append(out.CollectionId, FromReleaseId(&in.CollectionId[i]))
+	}
+	for i := range in.DisplayTitleText {
+		out.DisplayTitleText = append(out.DisplayTitleText, FromTitleText(&in.DisplayTitleText[i]))
+	}
+	out.DisplayArtistName = cloneSlice(in.DisplayArtistName)
+	for i := range in.DisplayArtist {
+		out.DisplayArtist = append(out.DisplayArtist, FromDisplayArtist(&in.DisplayArtist[i]))
+	}
+	for i := range in.CollectionDetailsByTerritory {
+		out.CollectionDetailsByTerritory = append(out.CollectionDetailsByTerritory, FromCollectionDetailsByTerritory(&in.CollectionDetailsByTerritory[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToCollection converts a Collection message to a ddex.Collection
+func ToCollection(in *Collection) (*ddex.Collection, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Collection{}
+	out.CollectionReference = in.CollectionReference
+	out.CollectionType = in.CollectionType
+	if len(in.CollectionId) > 0 {
+		out.CollectionId = make([]ddex.ReleaseId, len(in.CollectionId))
+		for i, m := range in.CollectionId {
+			v, err := ToReleaseId(m)
+			if err != nil {
+				return nil, wrapIndex("CollectionId", i, err)
+			}
+			if v != nil {
+				out.CollectionId[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayTitleText) > 0 {
+		out.DisplayTitleText = make([]ddex.TitleText, len(in.DisplayTitleText))
+		for i, m := range in.DisplayTitleText {
+			v, err := ToTitleText(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayTitleText", i, err)
+			}
+			if v != nil {
+				out.DisplayTitleText[i] = *v
+			}
+		}
+	}
+	out.DisplayArtistName = cloneSlice(in.DisplayArtistName)
+	if len(in.DisplayArtist) > 0 {
+		out.DisplayArtist = make([]ddex.DisplayArtist, len(in.DisplayArtist))
+		for i, m := range in.DisplayArtist {
+			v, err := ToDisplayArtist(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayArtist", i, err)
+			}
+			if v != nil {
+				out.DisplayArtist[i] = *v
+			}
+		}
+	}
+	if len(in.CollectionDetailsByTerritory) > 0 {
+		out.CollectionDetailsByTerritory = make([]ddex.CollectionDetailsByTerritory, len(in.CollectionDetailsByTerritory))
+		for i, m := range in.CollectionDetailsByTerritory {
+			v, err := ToCollectionDetailsByTerritory(m)
+			if err != nil {
+				return nil, wrapIndex("CollectionDetailsByTerritory", i, err)
+			}
+			if v != nil {
+				out.CollectionDetailsByTerritory[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromRelease converts a ddex.Release to its protobuf message
+func FromRelease(in *ddex.Release) *Release {
+	if in == nil {
+		return nil
+	}
+	out := &Release{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.IsMainRelease = in.IsMainRelease
+	for i := range in.ReleaseId {
+		out.ReleaseId = append(out.ReleaseId, FromReleaseId(&in.ReleaseId[i]))
+	}
+	out.ReleaseReference = in.ReleaseReference
+	for i := range in.ExternalResourceLink {
+		out.ExternalResourceLink = append(out.ExternalResourceLink, FromExternalResourceLink(&in.ExternalResourceLink[i]))
+	}
+	for i := range in.DisplayTitleText {
+		out.DisplayTitleText = append(out.DisplayTitleText, FromDisplayTitleText(&in.DisplayTitleText[i]))
+	}
+	for i := range in.DisplayTitle {
+		out.DisplayTitle = append(out.DisplayTitle, FromDisplayTitle(&in.DisplayTitle[i]))
+	}
+	for i := range in.AdditionalTitle {
+		out.AdditionalTitle = append(out.AdditionalTitle, FromAdditionalTitle(&in.AdditionalTitle[i]))
+	}
+	out.ReferenceTitle = FromReferenceTitle(in.ReferenceTitle)
+	out.ReleaseResourceReferenceList = FromReleaseResourceReferenceList(in.ReleaseResourceReferenceList)
+	out.ReleaseCollectionReferenceList = FromReleaseCollectionReferenceList(in.ReleaseCollectionReferenceList)
+	for i := range in.RelatedResource {
+		out.RelatedResource = append(out.RelatedResource, FromRelatedResource(&in.RelatedResource[i]))
+	}
+	out.IsCompilation = clonePtr(in.IsCompilation)
+	for i := range in.ReleaseType {
+		out.ReleaseType = append(out.ReleaseType, FromReleaseType(&in.ReleaseType[i]))
+	}
+	for i := range in.ReleaseDetailsByTerritory {
+		out.ReleaseDetailsByTerritory = append(out.ReleaseDetailsByTerritory, FromReleaseDetailsByTerritory(&in.ReleaseDetailsByTerritory[i]))
+	}
+	out.LanguageOfPerformance = cloneSlice(in.LanguageOfPerformance)
+	out.LanguageOfDubbing = cloneSlice(in.LanguageOfDubbing)
+	out.SubTitleLanguage = cloneSlice(in.SubTitleLanguage)
+	out.Duration = in.Duration
+	for i := range in.PLine {
+		out.PLine = append(out.PLine, FromPLine(&in.PLine[i]))
+	}
+	for i := range in.CLine {
+		out.CLine = append(out.CLine, FromCLine(&in.CLine[i]))
+	}
+	out.GlobalReleaseDate = FromEventDate(in.GlobalReleaseDate)
+	out.GlobalOriginalReleaseDate = FromEventDate(in.GlobalOriginalReleaseDate)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToRelease converts a Release message to a ddex.Release
+func ToRelease(in *Release) (*ddex.Release, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Release{}
+	var err error
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.IsMainRelease = in.IsMainRelease
+	if len(in.ReleaseId) > 0 {
+		out.ReleaseId = make([]ddex.ReleaseId, len(in.ReleaseId))
+		for i, m := range in.ReleaseId {
+			v, err := ToReleaseId(m)
+			if err != nil {
+				return nil, wrapIndex("ReleaseId", i, err)
+			}
+			if v != nil {
+				out.ReleaseId[i] = *v
+			}
+		}
+	}
+	out.ReleaseReference = in.ReleaseReference
+	if len(in.ExternalResourceLink) > 0 {
+		out.ExternalResourceLink = make([]ddex.ExternalResourceLink, len(in.ExternalResourceLink))
+		for i, m := range in.ExternalResourceLink {
+			v, err := ToExternalResourceLink(m)
+			if err != nil {
+				return nil, wrapIndex("ExternalResourceLink", i, err)
+			}
+			if v != nil {
+				out.ExternalResourceLink[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayTitleText) > 0 {
+		out.DisplayTitleText = make([]ddex.DisplayTitleText, len(in.DisplayTitleText))
+		for i, m := range in.DisplayTitleText {
+			v, err := ToDisplayTitleText(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayTitleText", i, err)
+			}
+			if v != nil {
+				out.DisplayTitleText[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayTitle) > 0 {
+		out.DisplayTitle = make([]ddex.DisplayTitle, len(in.DisplayTitle))
+		for i, m := range in.DisplayTitle {
+			v, err := ToDisplayTitle(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayTitle", i, err)
+			}
+			if v != nil {
+				out.DisplayTitle[i] = *v
+			}
+		}
+	}
+	if len(in.AdditionalTitle) > 0 {
+		out.AdditionalTitle = make([]ddex.AdditionalTitle, len(in.AdditionalTitle))
+		for i, m := range in.AdditionalTitle {
+			v, err := ToAdditionalTitle(m)
+			if err != nil {
+				return nil, wrapIndex("AdditionalTitle", i, err)
+			}
+			if v != nil {
+				out.AdditionalTitle[i] = *v
+			}
+		}
+	}
+	if out.ReferenceTitle, err = ToReferenceTitle(in.ReferenceTitle); err != nil {
+		return nil, wrapField("ReferenceTitle", err)
+	}
+	if out.ReleaseResourceReferenceList, err = ToReleaseResourceReferenceList(in.ReleaseResourceReferenceList); err != nil {
+		return nil, wrapField("ReleaseResourceReferenceList", err)
+	}
+	if out.ReleaseCollectionReferenceList, err = ToReleaseCollectionReferenceList(in.ReleaseCollectionReferenceList); err != nil {
+		return nil, wrapField("ReleaseCollectionReferenceList", err)
+	}
+	if len(in.RelatedResource) > 0 {
+		out.RelatedResource = make([]ddex.RelatedResource, len(in.RelatedResource))
+		for i, m := range in.RelatedResource {
+			v, err := ToRelatedResource(m)
+			if err != nil {
+				return nil, wrapIndex("RelatedResource", i, err)
+			}
+			if v != nil {
+				out.RelatedResource[i] = *v
+			}
+		}
+	}
+	out.IsCompilation = clonePtr(in.IsCompilation)
+	if len(in.ReleaseType) > 0 {
+		out.ReleaseType = make([]ddex.ReleaseType, len(in.ReleaseType))
+		for i, m := range in.ReleaseType {
+			v, err := ToReleaseType(m)
+			if err != nil {
+				return nil, wrapIndex("ReleaseType", i, err)
+			}
+			if v != nil {
+				out.ReleaseType[i] = *v
+			}
+		}
+	}
+	if len(in.ReleaseDetailsByTerritory) > 0 {
+		out.ReleaseDetailsByTerritory = make([]ddex.ReleaseDetailsByTerritory, len(in.ReleaseDetailsByTerritory))
+		for i, m := range in.ReleaseDetailsByTerritory {
+			v, err := ToReleaseDetailsByTerritory(m)
+			if err != nil {
+				return nil, wrapIndex("ReleaseDetailsByTerritory", i, err)
+			}
+			if v != nil {
+				out.ReleaseDetailsByTerritory[i] = *v
+			}
+		}
+	}
+	out.LanguageOfPerformance = cloneSlice(in.LanguageOfPerformance)
+	out.LanguageOfDubbing = cloneSlice(in.LanguageOfDubbing)
+	out.SubTitleLanguage = cloneSlice(in.SubTitleLanguage)
+	out.Duration = in.Duration
+	if len(in.PLine) > 0 {
+		out.PLine = make([]ddex.PLine, len(in.PLine))
+		for i, m := range in.PLine {
+			v, err := ToPLine(m)
+			if err != nil {
+				return nil, wrapIndex("PLine", i, err)
+			}
+			if v != nil {
+				out.PLine[i] = *v
+			}
+		}
+	}
+	if len(in.CLine) > 0 {
+		out.CLine = make([]ddex.CLine, len(in.CLine))
+		for i, m := range in.CLine {
+			v, err := ToCLine(m)
+			if err != nil {
+				return nil, wrapIndex("CLine", i, err)
+			}
+			if v != nil {
+				out.CLine[i] = *v
+			}
+		}
+	}
+	if out.GlobalReleaseDate, err = ToEventDate(in.GlobalReleaseDate); err != nil {
+		return nil, wrapField("GlobalReleaseDate", err)
+	}
+	if out.GlobalOriginalReleaseDate, err = ToEventDate(in.GlobalOriginalReleaseDate); err != nil {
+		return nil, wrapField("GlobalOriginalReleaseDate", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromReleaseDeal converts a ddex.ReleaseDeal to its protobuf message
+func FromReleaseDeal(in *ddex.ReleaseDeal) *ReleaseDeal {
+	if in == nil {
+		return nil
+	}
+	out := &ReleaseDeal{}
+	out.DealReleaseReference = in.DealReleaseReference
+	for i := range in.Deal {
+		out.Deal = append(out.Deal, FromDeal(&in.Deal[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToReleaseDeal converts a ReleaseDeal message to a ddex.ReleaseDeal
+func ToReleaseDeal(in *ReleaseDeal) (*ddex.ReleaseDeal, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ReleaseDeal{}
+	out.DealReleaseReference = in.DealReleaseReference
+	if len(in.Deal) > 0 {
+		out.Deal = make([]ddex.Deal, len(in.Deal))
+		for i, m := range in.Deal {
+			v, err := ToDeal(m)
+			if err != nil {
+				return nil, wrapIndex("Deal", i, err)
+			}
+			if v != nil {
+				out.Deal[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromPartyID converts a ddex.PartyID to its protobuf message
+func FromPartyID(in *ddex.PartyID) *PartyID {
+	if in == nil {
+		return nil
+	}
+	out := &PartyID{}
+	out.Value = in.Value
+	out.Namespace = in.Namespace
+	return out
+}
+
+// ToPartyID converts a PartyID message to a ddex.PartyID
+func ToPartyID(in *PartyID) (*ddex.PartyID, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.PartyID{}
+	out.Value = in.Value
+	out.Namespace = in.Namespace
+	return out, nil
+}
+
+// FromName converts a ddex.Name to its protobuf message
+func FromName(in *ddex.Name) *Name {
+	if in == nil {
+		return nil
+	}
+	out := &Name{}
+	out.FullName = in.FullName
+	out.FullNameAscii = in.FullNameAscii
+	out.LanguageCode = in.LanguageCode
+	out.NameType = in.NameType
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToName converts a Name message to a ddex.Name
+func ToName(in *Name) (*ddex.Name, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Name{}
+	out.FullName = in.FullName
+	out.FullNameAscii = in.FullNameAscii
+	out.LanguageCode = in.LanguageCode
+	out.NameType = in.NameType
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromMessageAuditTrailEvent converts a ddex.MessageAuditTrailEvent to its protobuf message
+func FromMessageAuditTrailEvent(in *ddex.MessageAuditTrailEvent) *MessageAuditTrailEvent {
+	if in == nil {
+		return nil
+	}
+	out := &MessageAuditTrailEvent{}
+	out.MessagingPartyReference = in.MessagingPartyReference
+	out.MessageAuditTrailEventDateTime = fromDateTime(in.MessageAuditTrailEventDateTime)
+	out.MessageAuditTrailEventTypeCode = in.MessageAuditTrailEventTypeCode
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToMessageAuditTrailEvent converts a MessageAuditTrailEvent message to a ddex.MessageAuditTrailEvent
+func ToMessageAuditTrailEvent(in *MessageAuditTrailEvent) (*ddex.MessageAuditTrailEvent, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.MessageAuditTrailEvent{}
+	var err error
+	out.MessagingPartyReference = in.MessagingPartyReference
+	if out.MessageAuditTrailEventDateTime, err = toDateTime(in.MessageAuditTrailEventDateTime); err != nil {
+		return nil, wrapField("MessageAuditTrailEventDateTime", err)
+	}
+	out.MessageAuditTrailEventTypeCode = in.MessageAuditTrailEventTypeCode
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromSoundRecordingId converts a ddex.SoundRecordingId to its protobuf message
+func FromSoundRecordingId(in *ddex.SoundRecordingId) *SoundRecordingId {
+	if in == nil {
+		return nil
+	}
+	out := &SoundRecordingId{}
+	out.Isrc = in.ISRC
+	out.CatalogNumber = FromCatalogNumber(in.CatalogNumber)
+	for i := range in.ProprietaryId {
+		out.ProprietaryId = append(out.ProprietaryId, FromProprietaryId(&in.ProprietaryId[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToSoundRecordingId converts a SoundRecordingId message to a ddex.SoundRecordingId
+func ToSoundRecordingId(in *SoundRecordingId) (*ddex.SoundRecordingId, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.SoundRecordingId{}
+	var err error
+	out.ISRC = in.Isrc
+	if out.CatalogNumber, err = ToCatalogNumber(in.CatalogNumber); err != nil {
+		return nil, wrapField("CatalogNumber", err)
+	}
+	if len(in.ProprietaryId) > 0 {
+		out.ProprietaryId = make([]ddex.ProprietaryId, len(in.ProprietaryId))
+		for i, m := range in.ProprietaryId {
+			v, err := ToProprietaryId(m)
+			if err != nil {
+				return nil, wrapIndex("ProprietaryId", i, err)
+			}
+			if v != nil {
+				out.ProprietaryId[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromMusicalWorkId converts a ddex.MusicalWorkId to its protobuf message
+func FromMusicalWorkId(in *ddex.MusicalWorkId) *MusicalWorkId {
+	if in == nil {
+		return nil
+	}
+	out := &MusicalWorkId{}
+	out.Iswc = in.ISWC
+	for i := range in.ProprietaryId {
+		out.ProprietaryId = append(out.ProprietaryId, FromProprietaryId(&in.ProprietaryId[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToMusicalWorkId converts a MusicalWorkId message to a ddex.MusicalWorkId
+func ToMusicalWorkId(in *MusicalWorkId) (*ddex.MusicalWorkId, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.MusicalWorkId{}
+	out.ISWC = in.Iswc
+	if len(in.ProprietaryId) > 0 {
+		out.ProprietaryId = make([]ddex.ProprietaryId, len(in.ProprietaryId))
+		for i, m := range in.ProprietaryId {
+			v, err := ToProprietaryId(m)
+			if err != nil {
+				return nil, wrapIndex("ProprietaryId", i, err)
+			}
+			if v != nil {
+				out.ProprietaryId[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromReferenceTitle converts a ddex.ReferenceTitle to its protobuf message
+func FromReferenceTitle(in *ddex.ReferenceTitle) *ReferenceTitle {
+	if in == nil {
+		return nil
+	}
+	out := &ReferenceTitle{}
+	out.TitleText = in.TitleText
+	out.SubTitle = in.SubTitle
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToReferenceTitle converts a ReferenceTitle message to a ddex.ReferenceTitle
+func ToReferenceTitle(in *ReferenceTitle) (*ddex.ReferenceTitle, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ReferenceTitle{}
+	out.TitleText = in.TitleText
+	out.SubTitle = in.SubTitle
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromDescription converts a ddex.Description to its protobuf message
+func FromDescription(in *ddex.Description) *Description {
+	if in == nil {
+		return nil
+	}
+	out := &Description{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.Value = in.Value
+	return out
+}
+
+// ToDescription converts a Description message to a ddex.Description
+func ToDescription(in *Description) (*ddex.Description, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Description{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.Value = in.Value
+	return out, nil
+}
+
+// FromRightsAgreementId converts a ddex.RightsAgreementId to its protobuf message
+func FromRightsAgreementId(in *ddex.RightsAgreementId) *RightsAgreementId {
+	if in == nil {
+		return nil
+	}
+	out := &RightsAgreementId{}
+	out.Mwli = in.MWLI
+	for i := range in.ProprietaryId {
+		out.ProprietaryId = append(out.ProprietaryId, FromProprietaryId(&in.ProprietaryId[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToRightsAgreementId converts a RightsAgreementId message to a ddex.RightsAgreementId
+func ToRightsAgreementId(in *RightsAgreementId) (*ddex.RightsAgreementId, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.RightsAgreementId{}
+	out.MWLI = in.Mwli
+	if len(in.ProprietaryId) > 0 {
+		out.ProprietaryId = make([]ddex.ProprietaryId, len(in.ProprietaryId))
+		for i, m := range in.ProprietaryId {
+			v, err := ToProprietaryId(m)
+			if err != nil {
+				return nil, wrapIndex("ProprietaryId", i, err)
+			}
+			if v != nil {
+				out.ProprietaryId[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromResourceMusicalWorkReferenceList converts a ddex.ResourceMusicalWorkReferenceList to its protobuf message
+func FromResourceMusicalWorkReferenceList(in *ddex.ResourceMusicalWorkReferenceList) *ResourceMusicalWorkReferenceList {
+	if in == nil {
+		return nil
+	}
+	out := &ResourceMusicalWorkReferenceList{}
+	for i := range in.ResourceMusicalWorkReference {
+		out.ResourceMusicalWorkReference = append(out.ResourceMusicalWorkReference, FromResourceMusicalWorkReference(&in.ResourceMusicalWorkReference[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToResourceMusicalWorkReferenceList converts a ResourceMusicalWorkReferenceList message to a ddex.ResourceMusicalWorkReferenceList
+func ToResourceMusicalWorkReferenceList(in *ResourceMusicalWorkReferenceList) (*ddex.ResourceMusicalWorkReferenceList, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ResourceMusicalWorkReferenceList{}
+	if len(in.ResourceMusicalWorkReference) > 0 {
+		out.ResourceMusicalWorkReference = make([]ddex.ResourceMusicalWorkReference, len(in.ResourceMusicalWorkReference))
+		for i, m := range in.ResourceMusicalWorkReference {
+			v, err := ToResourceMusicalWorkReference(m)
+			if err != nil {
+				return nil, wrapIndex("ResourceMusicalWorkReference", i, err)
+			}
+			if v != nil {
+				out.ResourceMusicalWorkReference[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromResourceContainedResourceReferenceList converts a ddex.ResourceContainedResourceReferenceList to its protobuf message
+func FromResourceContainedResourceReferenceList(in *ddex.ResourceContainedResourceReferenceList) *ResourceContainedResourceReferenceList {
+	if in == nil {
+		return nil
+	}
+	out := &ResourceContainedResourceReferenceList{}
+	for i := range in.ResourceContainedResourceReference {
+		out.ResourceContainedResourceReference = append(out.ResourceContainedResourceReference, FromResourceContainedResourceReference(&in.ResourceContainedResourceReference[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToResourceContainedResourceReferenceList converts a ResourceContainedResourceReferenceList message to a ddex.ResourceContainedResourceReferenceList
+func ToResourceContainedResourceReferenceList(in *ResourceContainedResourceReferenceList) (*ddex.ResourceContainedResourceReferenceList, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ResourceContainedResourceReferenceList{}
+	if len(in.ResourceContainedResourceReference) > 0 {
+		out.ResourceContainedResourceReference = make([]ddex.ResourceContainedResourceReference, len(in.ResourceContainedResourceReference))
+		for i, m := range in.ResourceContainedResourceReference {
+			v, err := ToResourceContainedResourceReference(m)
+			if err != nil {
+				return nil, wrapIndex("ResourceContainedResourceReference", i, err)
+			}
+			if v != nil {
+				out.ResourceContainedResourceReference[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromEventDate converts a ddex.EventDate to its protobuf message
+func FromEventDate(in *ddex.EventDate) *EventDate {
+	if in == nil {
+		return nil
+	}
+	out := &EventDate{}
+	out.Value = in.Value
+	out.IsApproximate = in.IsApproximate
+	out.IsBefore = in.IsBefore
+	out.IsAfter = in.IsAfter
+	out.TerritoryCode = in.TerritoryCode
+	out.LocationDescription = in.LocationDescription
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out
+}
+
+// ToEventDate converts a EventDate message to a ddex.EventDate
+func ToEventDate(in *EventDate) (*ddex.EventDate, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.EventDate{}
+	out.Value = in.Value
+	out.IsApproximate = in.IsApproximate
+	out.IsBefore = in.IsBefore
+	out.IsAfter = in.IsAfter
+	out.TerritoryCode = in.TerritoryCode
+	out.LocationDescription = in.LocationDescription
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out, nil
+}
+
+// FromSoundRecordingDetailsByTerritory converts a ddex.SoundRecordingDetailsByTerritory to its protobuf message
+func FromSoundRecordingDetailsByTerritory(in *ddex.SoundRecordingDetailsByTerritory) *SoundRecordingDetailsByTerritory {
+	if in == nil {
+		return nil
+	}
+	out := &SoundRecordingDetailsByTerritory{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TerritoryCode = cloneSlice(in.TerritoryCode)
+	out.ExcludedTerritoryCode = cloneSlice(in.ExcludedTerritoryCode)
+	for i := range in.Title {
+		out.Title = append(out.Title, FromTitle(&in.Title[i]))
+	}
+	for i := range in.DisplayArtist {
+		out.DisplayArtist = append(out.DisplayArtist, FromDisplayArtist(&in.DisplayArtist[i]))
+	}
+	for i := range in.DisplayConductor {
+		out.DisplayConductor = append(out.DisplayConductor, FromDisplayConductor(&in.DisplayConductor[i]))
+	}
+	for i := range in.ResourceContributor {
+		out.ResourceContributor = append(out.ResourceContributor, FromResourceContributor(&in.ResourceContributor[i]))
+	}
+	for i := range in.IndirectResourceContributor {
+		out.IndirectResourceContributor = append(out.IndirectResourceContributor, FromIndirectResourceContributor(&in.IndirectResourceContributor[i]))
+	}
+	out.RightsAgreementId = FromRightsAgreementId(in.RightsAgreementId)
+	for i := range in.DisplayArtistName {
+		out.DisplayArtistName = append(out.DisplayArtistName, FromDisplayArtistName(&in.DisplayArtistName[i]))
+	}
+	for i := range in.LabelName {
+		out.LabelName = append(out.LabelName, FromLabelName(&in.LabelName[i]))
+	}
+	for i := range in.RightsController {
+		out.RightsController = append(out.RightsController, FromRightsController(&in.RightsController[i]))
+	}
+	out.RemasteredDate = FromEventDate(in.RemasteredDate)
+	out.ResourceReleaseDate = FromEventDate(in.ResourceReleaseDate)
+	out.OriginalResourceReleaseDate = FromEventDate(in.OriginalResourceReleaseDate)
+	for i := range in.PLine {
+		out.PLine = append(out.PLine, FromPLine(&in.PLine[i]))
+	}
+	out.CourtesyLine = FromCourtesyLine(in.CourtesyLine)
+	out.SequenceNumber = fromIntPtr(in.SequenceNumber)
+	for i := range in.HostSoundCarrier {
+		out.HostSoundCarrier = append(out.HostSoundCarrier, FromHostSoundCarrier(&in.HostSoundCarrier[i]))
+	}
+	out.MarketingComment = FromComment(in.MarketingComment)
+	for i := range in.Genre {
+		out.Genre = append(out.Genre, FromGenre(&in.Genre[i]))
+	}
+	out.ParentalWarningType = cloneSlice(in.ParentalWarningType)
+	for i := range in.AvRating {
+		out.AvRating = append(out.AvRating, FromAvRating(&in.AvRating[i]))
+	}
+	for i := range in.TechnicalSoundRecordingDetails {
+		out.TechnicalSoundRecordingDetails = append(out.TechnicalSoundRecordingDetails, FromTechnicalSoundRecordingDetails(&in.TechnicalSoundRecordingDetails[i]))
+	}
+	out.FulfillmentDate = FromFulfillmentDate(in.FulfillmentDate)
+	for i := range in.Keywords {
+		out.Keywords = append(out.Keywords, FromKeywords(&in.Keywords[i]))
+	}
+	out.Synopsis = FromSynopsis(in.Synopsis)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToSoundRecordingDetailsByTerritory converts a SoundRecordingDetailsByTerritory message to a ddex.SoundRecordingDetailsByTerritory
+func ToSoundRecordingDetailsByTerritory(in *SoundRecordingDetailsByTerritory) (*ddex.SoundRecordingDetailsByTerritory, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.SoundRecordingDetailsByTerritory{}
+	var err error
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TerritoryCode = cloneSlice(in.TerritoryCode)
+	out.ExcludedTerritoryCode = cloneSlice(in.ExcludedTerritoryCode)
+	if len(in.Title) > 0 {
+		out.Title = make([]ddex.Title, len(in.Title))
+		for i, m := range in.Title {
+			v, err := ToTitle(m)
+			if err != nil {
+				return nil, wrapIndex("Title", i, err)
+			}
+			if v != nil {
+				out.Title[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayArtist) > 0 {
+		out.DisplayArtist = make([]ddex.DisplayArtist, len(in.DisplayArtist))
+		for i, m := range in.DisplayArtist {
+			v, err := ToDisplayArtist(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayArtist", i, err)
+			}
+			if v != nil {
+				out.DisplayArtist[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayConductor) > 0 {
+		out.DisplayConductor = make([]ddex.DisplayConductor, len(in.DisplayConductor))
+		for i, m := range in.DisplayConductor {
+			v, err := ToDisplayConductor(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayConductor", i, err)
+			}
+			if v != nil {
+				out.DisplayConductor[i] = *v
+			}
+		}
+	}
+	if len(in.ResourceContributor) > 0 {
+		out.ResourceContributor = make([]ddex.ResourceContributor, len(in.ResourceContributor))
+		for i, m := range in.ResourceContributor {
+			v, err := ToResourceContributor(m)
+			if err != nil {
+				return nil, wrapIndex("ResourceContributor", i, err)
+			}
+			if v != nil {
+				out.ResourceContributor[i] = *v
+			}
+		}
+	}
+	if len(in.IndirectResourceContributor) > 0 {
+		out.IndirectResourceContributor = make([]ddex.IndirectResourceContributor, len(in.IndirectResourceContributor))
+		for i, m := range in.IndirectResourceContributor {
+			v, err := ToIndirectResourceContributor(m)
+			if err != nil {
+				return nil, wrapIndex("IndirectResourceContributor", i, err)
+			}
+			if v != nil {
+				out.IndirectResourceContributor[i] = *v
+			}
+		}
+	}
+	if out.RightsAgreementId, err = ToRightsAgreementId(in.RightsAgreementId); err != nil {
+		return nil, wrapField("RightsAgreementId", err)
+	}
+	if len(in.DisplayArtistName) > 0 {
+		out.DisplayArtistName = make([]ddex.DisplayArtistName, len(in.DisplayArtistName))
+		for i, m := range in.DisplayArtistName {
+			v, err := ToDisplayArtistName(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayArtistName", i, err)
+			}
+			if v != nil {
+				out.DisplayArtistName[i] = *v
+			}
+		}
+	}
+	if len(in.LabelName) > 0 {
+		out.LabelName = make([]ddex.LabelName, len(in.LabelName))
+		for i, m := range in.LabelName {
+			v, err := ToLabelName(m)
+			if err != nil {
+				return nil, wrapIndex("LabelName", i, err)
+			}
+			if v != nil {
+				out.LabelName[i] = *v
+			}
+		}
+	}
+	if len(in.RightsController) > 0 {
+		out.RightsController = make([]ddex.RightsController, len(in.RightsController))
+		for i, m := range in.RightsController {
+			v, err := ToRightsController(m)
+			if err != nil {
+				return nil, wrapIndex("RightsController", i, err)
+			}
+			if v != nil {
+				out.RightsController[i] = *v
+			}
+		}
+	}
+	if out.RemasteredDate, err = ToEventDate(in.RemasteredDate); err != nil {
+		return nil, wrapField("RemasteredDate", err)
+	}
+	if out.ResourceReleaseDate, err = ToEventDate(in.ResourceReleaseDate); err != nil {
+		return nil, wrapField("ResourceReleaseDate", err)
+	}
+	if out.OriginalResourceReleaseDate, err = ToEventDate(in.OriginalResourceReleaseDate); err != nil {
+		return nil, wrapField("OriginalResourceReleaseDate", err)
+	}
+	if len(in.PLine) > 0 {
+		out.PLine = make([]ddex.PLine, len(in.PLine))
+		for i, m := range in.PLine {
+			v, err := ToPLine(m)
+			if err != nil {
+				return nil, wrapIndex("PLine", i, err)
+			}
+			if v != nil {
+				out.PLine[i] = *v
+			}
+		}
+	}
+	if out.CourtesyLine, err = ToCourtesyLine(in.CourtesyLine); err != nil {
+		return nil, wrapField("CourtesyLine", err)
+	}
+	out.SequenceNumber = toIntPtr(in.SequenceNumber)
+	if len(in.HostSoundCarrier) > 0 {
+		out.HostSoundCarrier = make([]ddex.HostSoundCarrier, len(in.HostSoundCarrier))
+		for i, m := range in.HostSoundCarrier {
+			v, err := ToHostSoundCarrier(m)
+			if err != nil {
+				return nil, wrapIndex("HostSoundCarrier", i, err)
+			}
+			if v != nil {
+				out.HostSoundCarrier[i] = *v
+			}
+		}
+	}
+	if out.MarketingComment, err = ToComment(in.MarketingComment); err != nil {
+		return nil, wrapField("MarketingComment", err)
+	}
+	if len(in.Genre) > 0 {
+		out.Genre = make([]ddex.Genre, len(in.Genre))
+		for i, m := range in.Genre {
+			v, err := ToGenre(m)
+			if err != nil {
+				return nil, wrapIndex("Genre", i, err)
+			}
+			if v != nil {
+				out.Genre[i] = *v
+			}
+		}
+	}
+	out.ParentalWarningType = cloneSlice(in.ParentalWarningType)
+	if len(in.AvRating) > 0 {
+		out.AvRating = make([]ddex.AvRating, len(in.AvRating))
+		for i, m := range in.AvRating {
+			v, err := ToAvRating(m)
+			if err != nil {
+				return nil, wrapIndex("AvRating", i, err)
+			}
+			if v != nil {
+				out.AvRating[i] = *v
+			}
+		}
+	}
+	if len(in.TechnicalSoundRecordingDetails) > 0 {
+		out.TechnicalSoundRecordingDetails = make([]ddex.TechnicalSoundRecordingDetails, len(in.TechnicalSoundRecordingDetails))
+		for i, m := range in.TechnicalSoundRecordingDetails {
+			v, err := ToTechnicalSoundRecordingDetails(m)
+			if err != nil {
+				return nil, wrapIndex("TechnicalSoundRecordingDetails", i, err)
+			}
+			if v != nil {
+				out.TechnicalSoundRecordingDetails[i] = *v
+			}
+		}
+	}
+	if out.FulfillmentDate, err = ToFulfillmentDate(in.FulfillmentDate); err != nil {
+		return nil, wrapField("FulfillmentDate", err)
+	}
+	if len(in.Keywords) > 0 {
+		out.Keywords = make([]ddex.Keywords, len(in.Keywords))
+		for i, m := range in.Keywords {
+			v, err := ToKeywords(m)
+			if err != nil {
+				return nil, wrapIndex("Keywords", i, err)
+			}
+			if v != nil {
+				out.Keywords[i] = *v
+			}
+		}
+	}
+	if out.Synopsis, err = ToSynopsis(in.Synopsis); err != nil {
+		return nil, wrapField("Synopsis", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromVideoType converts a ddex.VideoType to its protobuf message
+func FromVideoType(in *ddex.VideoType) *VideoType {
+	if in == nil {
+		return nil
+	}
+	out := &VideoType{}
+	out.Value = in.Value
+	return out
+}
+
+// ToVideoType converts a VideoType message to a ddex.VideoType
+func ToVideoType(in *VideoType) (*ddex.VideoType, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.VideoType{}
+	out.Value = in.Value
+	return out, nil
+}
+
+// FromVideoId converts a ddex.VideoId to its protobuf message
+func FromVideoId(in *ddex.VideoId) *VideoId {
+	if in == nil {
+		return nil
+	}
+	out := &VideoId{}
+	out.Isrc = in.ISRC
+	for i := range in.ProprietaryId {
+		out.ProprietaryId = append(out.ProprietaryId, FromProprietaryId(&in.ProprietaryId[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToVideoId converts a VideoId message to a ddex.VideoId
+func ToVideoId(in *VideoId) (*ddex.VideoId, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.VideoId{}
+	out.ISRC = in.Isrc
+	if len(in.ProprietaryId) > 0 {
+		out.ProprietaryId = make([]ddex.ProprietaryId, len(in.ProprietaryId))
+		for i, m := range in.ProprietaryId {
+			v, err := ToProprietaryId(m)
+			if err != nil {
+				return nil, wrapIndex("ProprietaryId", i, err)
+			}
+			if v != nil {
+				out.ProprietaryId[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromVideoCueSheetReference converts a ddex.VideoCueSheetReference to its protobuf message
+func FromVideoCueSheetReference(in *ddex.VideoCueSheetReference) *VideoCueSheetReference {
+	if in == nil {
+		return nil
+	}
+	out := &VideoCueSheetReference{}
+	out.Value = in.Value
+	return out
+}
+
+// ToVideoCueSheetReference converts a VideoCueSheetReference message to a ddex.VideoCueSheetReference
+func ToVideoCueSheetReference(in *VideoCueSheetReference) (*ddex.VideoCueSheetReference, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.VideoCueSheetReference{}
+	out.Value = in.Value
+	return out, nil
+}
+
+// FromReason converts a ddex.Reason to its protobuf message
+func FromReason(in *ddex.Reason) *Reason {
+	if in == nil {
+		return nil
+	}
+	out := &Reason{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.Value = in.Value
+	return out
+}
+
+// ToReason converts a Reason message to a ddex.Reason
+func ToReason(in *Reason) (*ddex.Reason, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Reason{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.Value = in.Value
+	return out, nil
+}
+
+// FromTitle converts a ddex.Title to its protobuf message
+func FromTitle(in *ddex.Title) *Title {
+	if in == nil {
+		return nil
+	}
+	out := &Title{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TitleType = in.TitleType
+	out.TitleText = in.TitleText
+	out.SubTitle = in.SubTitle
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToTitle converts a Title message to a ddex.Title
+func ToTitle(in *Title) (*ddex.Title, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Title{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TitleType = in.TitleType
+	out.TitleText = in.TitleText
+	out.SubTitle = in.SubTitle
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromSoundRecordingCollectionReferenceList converts a ddex.SoundRecordingCollectionReferenceList to its protobuf message
+func FromSoundRecordingCollectionReferenceList(in *ddex.SoundRecordingCollectionReferenceList) *SoundRecordingCollectionReferenceList {
+	if in == nil {
+		return nil
+	}
+	out := &SoundRecordingCollectionReferenceList{}
+	for i := range in.SoundRecordingCollectionReference {
+		out.SoundRecordingCollectionReference = append(out.SoundRecordingCollectionReference, FromSoundRecordingCollectionReference(&in.SoundRecordingCollectionReference[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToSoundRecordingCollectionReferenceList converts a SoundRecordingCollectionReferenceList message to a ddex.SoundRecordingCollectionReferenceList
+func ToSoundRecordingCollectionReferenceList(in *SoundRecordingCollectionReferenceList) (*ddex.SoundRecordingCollectionReferenceList, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.SoundRecordingCollectionReferenceList{}
+	if len(in.SoundRecordingCollectionReference) > 0 {
+		out.SoundRecordingCollectionReference = make([]ddex.SoundRecordingCollectionReference, len(in.SoundRecordingCollectionReference))
+		for i, m := range in.SoundRecordingCollectionReference {
+			v, err := ToSoundRecordingCollectionReference(m)
+			if err != nil {
+				return nil, wrapIndex("SoundRecordingCollectionReference", i, err)
+			}
+			if v != nil {
+				out.SoundRecordingCollectionReference[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromVideoDetailsByTerritory converts a ddex.VideoDetailsByTerritory to its protobuf message
+func FromVideoDetailsByTerritory(in *ddex.VideoDetailsByTerritory) *VideoDetailsByTerritory {
+	if in == nil {
+		return nil
+	}
+	out := &VideoDetailsByTerritory{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TerritoryCode = cloneSlice(in.TerritoryCode)
+	out.ExcludedTerritoryCode = cloneSlice(in.ExcludedTerritoryCode)
+	for i := range in.Title {
+		out.Title = append(out.Title, FromTitle(&in.Title[i]))
+	}
+	for i := range in.DisplayArtist {
+		out.DisplayArtist = append(out.DisplayArtist, FromDisplayArtist(&in.DisplayArtist[i]))
+	}
+	for i := range in.DisplayConductor {
+		out.DisplayConductor = append(out.DisplayConductor, FromDisplayConductor(&in.DisplayConductor[i]))
+	}
+	for i := range in.ResourceContributor {
+		out.ResourceContributor = append(out.ResourceContributor, FromResourceContributor(&in.ResourceContributor[i]))
+	}
+	for i := range in.IndirectResourceContributor {
+		out.IndirectResourceContributor = append(out.IndirectResourceContributor, FromIndirectResourceContributor(&in.IndirectResourceContributor[i]))
+	}
+	out.RightsAgreementId = FromRightsAgreementId(in.RightsAgreementId)
+	for i := range in.DisplayArtistName {
+		out.DisplayArtistName = append(out.DisplayArtistName, FromDisplayArtistName(&in.DisplayArtistName[i]))
+	}
+	for i := range in.LabelName {
+		out.LabelName = append(out.LabelName, FromLabelName(&in.LabelName[i]))
+	}
+	for i := range in.RightsController {
+		out.RightsController = append(out.RightsController, FromRightsController(&in.RightsController[i]))
+	}
+	out.RemasteredDate = FromEventDate(in.RemasteredDate)
+	out.ResourceReleaseDate = FromEventDate(in.ResourceReleaseDate)
+	out.OriginalResourceReleaseDate = FromEventDate(in.OriginalResourceReleaseDate)
+	for i := range in.PLine {
+		out.PLine = append(out.PLine, FromPLine(&in.PLine[i]))
+	}
+	for i := range in.CLine {
+		out.CLine = append(out.CLine, FromCLine(&in.CLine[i]))
+	}
+	out.CourtesyLine = FromCourtesyLine(in.CourtesyLine)
+	out.SequenceNumber = fromIntPtr(in.SequenceNumber)
+	for i := range in.HostSoundCarrier {
+		out.HostSoundCarrier = append(out.HostSoundCarrier, FromHostSoundCarrier(&in.HostSoundCarrier[i]))
+	}
+	out.MarketingComment = FromComment(in.MarketingComment)
+	for i := range in.Genre {
+		out.Genre = append(out.Genre, FromGenre(&in.Genre[i]))
+	}
+	out.ParentalWarningType = cloneSlice(in.ParentalWarningType)
+	for i := range in.AvRating {
+		out.AvRating = append(out.AvRating, FromAvRating(&in.AvRating[i]))
+	}
+	for i := range in.TechnicalVideoDetails {
+		out.TechnicalVideoDetails = append(out.TechnicalVideoDetails, FromTechnicalVideoDetails(&in.TechnicalVideoDetails[i]))
+	}
+	for i := range in.Character {
+		out.Character = append(out.Character, FromCharacter(&in.Character[i]))
+	}
+	out.FulfillmentDate = FromFulfillmentDate(in.FulfillmentDate)
+	for i := range in.Keywords {
+		out.Keywords = append(out.Keywords, FromKeywords(&in.Keywords[i]))
+	}
+	out.Synopsis = FromSynopsis(in.Synopsis)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToVideoDetailsByTerritory converts a VideoDetailsByTerritory message to a ddex.VideoDetailsByTerritory
+func ToVideoDetailsByTerritory(in *VideoDetailsByTerritory) (*ddex.VideoDetailsByTerritory, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.VideoDetailsByTerritory{}
+	var err error
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TerritoryCode = cloneSlice(in.TerritoryCode)
+	out.ExcludedTerritoryCode = cloneSlice(in.ExcludedTerritoryCode)
+	if len(in.Title) > 0 {
+		out.Title = make([]ddex.Title, len(in.Title))
+		for i, m := range in.Title {
+			v, err := ToTitle(m)
+			if err != nil {
+				return nil, wrapIndex("Title", i, err)
+			}
+			if v != nil {
+				out.Title[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayArtist) > 0 {
+		out.DisplayArtist = make([]ddex.DisplayArtist, len(in.DisplayArtist))
+		for i, m := range in.DisplayArtist {
+			v, err := ToDisplayArtist(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayArtist", i, err)
+			}
+			if v != nil {
+				out.DisplayArtist[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayConductor) > 0 {
+		out.DisplayConductor = make([]ddex.DisplayConductor, len(in.DisplayConductor))
+		for i, m := range in.DisplayConductor {
+			v, err := ToDisplayConductor(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayConductor", i, err)
+			}
+			if v != nil {
+				out.DisplayConductor[i] = *v
+			}
+		}
+	}
+	if len(in.ResourceContributor) > 0 {
+		out.ResourceContributor = make([]ddex.ResourceContributor, len(in.ResourceContributor))
+		for i, m := range in.ResourceContributor {
+			v, err := ToResourceContributor(m)
+			if err != nil {
+				return nil, wrapIndex("ResourceContributor", i, err)
+			}
+			if v != nil {
+				out.ResourceContributor[i] = *v
+			}
+		}
+	}
+	if len(in.IndirectResourceContributor) > 0 {
+		out.IndirectResourceContributor = make([]ddex.IndirectResourceContributor, len(in.IndirectResourceContributor))
+		for i, m := range in.IndirectResourceContributor {
+			v, err := ToIndirectResourceContributor(m)
+			if err != nil {
+				return nil, wrapIndex("IndirectResourceContributor", i, err)
+			}
+			if v != nil {
+				out.IndirectResourceContributor[i] = *v
+			}
+		}
+	}
+	if out.RightsAgreementId, err = ToRightsAgreementId(in.RightsAgreementId); err != nil {
+		return nil, wrapField("RightsAgreementId", err)
+	}
+	if len(in.DisplayArtistName) > 0 {
+		out.DisplayArtistName = make([]ddex.DisplayArtistName, len(in.DisplayArtistName))
+		for i, m := range in.DisplayArtistName {
+			v, err := ToDisplayArtistName(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayArtistName", i, err)
+			}
+			if v != nil {
+				out.DisplayArtistName[i] = *v
+			}
+		}
+	}
+	if len(in.LabelName) > 0 {
+		out.LabelName = make([]ddex.LabelName, len(in.LabelName))
+		for i, m := range in.LabelName {
+			v, err := ToLabelName(m)
+			if err != nil {
+				return nil, wrapIndex("LabelName", i, err)
+			}
+			if v != nil {
+				out.LabelName[i] = *v
+			}
+		}
+	}
+	if len(in.RightsController) > 0 {
+		out.RightsController = make([]ddex.RightsController, len(in.RightsController))
+		for i, m := range in.RightsController {
+			v, err := ToRightsController(m)
+			if err != nil {
+				return nil, wrapIndex("RightsController", i, err)
+			}
+			if v != nil {
+				out.RightsController[i] = *v
+			}
+		}
+	}
+	if out.RemasteredDate, err = ToEventDate(in.RemasteredDate); err != nil {
+		return nil, wrapField("RemasteredDate", err)
+	}
+	if out.ResourceReleaseDate, err = ToEventDate(in.ResourceReleaseDate); err != nil {
+		return nil, wrapField("ResourceReleaseDate", err)
+	}
+	if out.OriginalResourceReleaseDate, err = ToEventDate(in.OriginalResourceReleaseDate); err != nil {
+		return nil, wrapField("OriginalResourceReleaseDate", err)
+	}
+	if len(in.PLine) > 0 {
+		out.PLine = make([]ddex.PLine, len(in.PLine))
+		for i, m := range in.PLine {
+			v, err := ToPLine(m)
+			if err != nil {
+				return nil, wrapIndex("PLine", i, err)
+			}
+			if v != nil {
+				out.PLine[i] = *v
+			}
+		}
+	}
+	if len(in.CLine) > 0 {
+		out.CLine = make([]ddex.CLine, len(in.CLine))
+		for i, m := range in.CLine {
+			v, err := ToCLine(m)
+			if err != nil {
+				return nil, wrapIndex("CLine", i, err)
+			}
+			if v != nil {
+				out.CLine[i] = *v
+			}
+		}
+	}
+	if out.CourtesyLine, err = ToCourtesyLine(in.CourtesyLine); err != nil {
+		return nil, wrapField("CourtesyLine", err)
+	}
+	out.SequenceNumber = toIntPtr(in.SequenceNumber)
+	if len(in.HostSoundCarrier) > 0 {
+		out.HostSoundCarrier = make([]ddex.HostSoundCarrier, len(in.HostSoundCarrier))
+		for i, m := range in.HostSoundCarrier {
+			v, err := ToHostSoundCarrier(m)
+			if err != nil {
+				return nil, wrapIndex("HostSoundCarrier", i, err)
+			}
+			if v != nil {
+				out.HostSoundCarrier[i] = *v
+			}
+		}
+	}
+	if out.MarketingComment, err = ToComment(in.MarketingComment); err != nil {
+		return nil, wrapField("MarketingComment", err)
+	}
+	if len(in.Genre) > 0 {
+		out.Genre = make([]ddex.Genre, len(in.Genre))
+		for i, m := range in.Genre {
+			v, err := ToGenre(m)
+			if err != nil {
+				return nil, wrapIndex("Genre", i, err)
+			}
+			if v != nil {
+				out.Genre[i] = *v
+			}
+		}
+	}
+	out.ParentalWarningType = cloneSlice(in.ParentalWarningType)
+	if len(in.AvRating) > 0 {
+		out.AvRating = make([]ddex.AvRating, len(in.AvRating))
+		for i, m := range in.AvRating {
+			v, err := ToAvRating(m)
+			if err != nil {
+				return nil, wrapIndex("AvRating", i, err)
+			}
+			if v != nil {
+				out.AvRating[i] = *v
+			}
+		}
+	}
+	if len(in.TechnicalVideoDetails) > 0 {
+		out.TechnicalVideoDetails = make([]ddex.TechnicalVideoDetails, len(in.TechnicalVideoDetails))
+		for i, m := range in.TechnicalVideoDetails {
+			v, err := ToTechnicalVideoDetails(m)
+			if err != nil {
+				return nil, wrapIndex("TechnicalVideoDetails", i, err)
+			}
+			if v != nil {
+				out.TechnicalVideoDetails[i] = *v
+			}
+		}
+	}
+	if len(in.Character) > 0 {
+		out.Character = make([]ddex.Character, len(in.Character))
+		for i, m := range in.Character {
+			v, err := ToCharacter(m)
+			if err != nil {
+				return nil, wrapIndex("Character", i, err)
+			}
+			if v != nil {
+				out.Character[i] = *v
+			}
+		}
+	}
+	if out.FulfillmentDate, err = ToFulfillmentDate(in.FulfillmentDate); err != nil {
+		return nil, wrapField("FulfillmentDate", err)
+	}
+	if len(in.Keywords) > 0 {
+		out.Keywords = make([]ddex.Keywords, len(in.Keywords))
+		for i, m := range in.Keywords {
+			v, err := ToKeywords(m)
+			if err != nil {
+				return nil, wrapIndex("Keywords", i, err)
+			}
+			if v != nil {
+				out.Keywords[i] = *v
+			}
+		}
+	}
+	if out.Synopsis, err = ToSynopsis(in.Synopsis); err != nil {
+		return nil, wrapField("Synopsis", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromImageType converts a ddex.ImageType to its protobuf message
+func FromImageType(in *ddex.ImageType) *ImageType {
+	if in == nil {
+		return nil
+	}
+	out := &ImageType{}
+	out.Value = in.Value
+	return out
+}
+
+// ToImageType converts a ImageType message to a ddex.ImageType
+func ToImageType(in *ImageType) (*ddex.ImageType, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ImageType{}
+	out.Value = in.Value
+	return out, nil
+}
+
+// FromImageId converts a ddex.ImageId to its protobuf message
+func FromImageId(in *ddex.ImageId) *ImageId {
+	if in == nil {
+		return nil
+	}
+	out := &ImageId{}
+	for i := range in.ProprietaryId {
+		out.ProprietaryId = append(out.ProprietaryId, FromProprietaryId(&in.ProprietaryId[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToImageId converts a ImageId message to a ddex.ImageId
+func ToImageId(in *ImageId) (*ddex.ImageId, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ImageId{}
+	if len(in.ProprietaryId) > 0 {
+		out.ProprietaryId = make([]ddex.ProprietaryId, len(in.ProprietaryId))
+		for i, m := range in.ProprietaryId {
+			v, err := ToProprietaryId(m)
+			if err != nil {
+				return nil, wrapIndex("ProprietaryId", i, err)
+			}
+			if v != nil {
+				out.ProprietaryId[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromImageDetailsByTerritory converts a ddex.ImageDetailsByTerritory to its protobuf message
+func FromImageDetailsByTerritory(in *ddex.ImageDetailsByTerritory) *ImageDetailsByTerritory {
+	if in == nil {
+		return nil
+	}
+	out := &ImageDetailsByTerritory{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TerritoryCode = cloneSlice(in.TerritoryCode)
+	out.ExcludedTerritoryCode = cloneSlice(in.ExcludedTerritoryCode)
+	for i := range in.Title {
+		out.Title = append(out.Title, FromTitle(&in.Title[i]))
+	}
+	for i := range in.ResourceContributor {
+		out.ResourceContributor = append(out.ResourceContributor, FromResourceContributor(&in.ResourceContributor[i]))
+	}
+	for i := range in.IndirectResourceContributor {
+		out.IndirectResourceContributor = append(out.IndirectResourceContributor, FromIndirectResourceContributor(&in.IndirectResourceContributor[i]))
+	}
+	for i := range in.DisplayArtistName {
+		out.DisplayArtistName = append(out.DisplayArtistName, FromDisplayArtistName(&in.DisplayArtistName[i]))
+	}
+	for i := range in.CLine {
+		out.CLine = append(out.CLine, FromCLine(&in.CLine[i]))
+	}
+	out.Description = FromDescription(in.Description)
+	out.CourtesyLine = FromCourtesyLine(in.CourtesyLine)
+	out.ResourceReleaseDate = FromEventDate(in.ResourceReleaseDate)
+	out.OriginalResourceReleaseDate = FromEventDate(in.OriginalResourceReleaseDate)
+	out.FulfillmentDate = FromFulfillmentDate(in.FulfillmentDate)
+	for i := range in.Keywords {
+		out.Keywords = append(out.Keywords, FromKeywords(&in.Keywords[i]))
+	}
+	out.Synopsis = FromSynopsis(in.Synopsis)
+	for i := range in.Genre {
+		out.Genre = append(out.Genre, FromGenre(&in.Genre[i]))
+	}
+	out.ParentalWarningType = cloneSlice(in.ParentalWarningType)
+	for i := range in.TechnicalImageDetails {
+		out.TechnicalImageDetails = append(out.TechnicalImageDetails, FromTechnicalImageDetails(&in.TechnicalImageDetails[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToImageDetailsByTerritory converts a ImageDetailsByTerritory message to a ddex.ImageDetailsByTerritory
+func ToImageDetailsByTerritory(in *ImageDetailsByTerritory) (*ddex.ImageDetailsByTerritory, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ImageDetailsByTerritory{}
+	var err error
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TerritoryCode = cloneSlice(in.TerritoryCode)
+	out.ExcludedTerritoryCode = cloneSlice(in.ExcludedTerritoryCode)
+	if len(in.Title) > 0 {
+		out.Title = make([]ddex.Title, len(in.Title))
+		for i, m := range in.Title {
+			v, err := ToTitle(m)
+			if err != nil {
+				return nil, wrapIndex("Title", i, err)
+			}
+			if v != nil {
+				out.Title[i] = *v
+			}
+		}
+	}
+	if len(in.ResourceContributor) > 0 {
+		out.ResourceContributor = make([]ddex.ResourceContributor, len(in.ResourceContributor))
+		for i, m := range in.ResourceContributor {
+			v, err := ToResourceContributor(m)
+			if err != nil {
+				return nil, wrapIndex("ResourceContributor", i, err)
+			}
+			if v != nil {
+				out.ResourceContributor[i] = *v
+			}
+		}
+	}
+	if len(in.IndirectResourceContributor) > 0 {
+		out.IndirectResourceContributor = make([]ddex.IndirectResourceContributor, len(in.IndirectResourceContributor))
+		for i, m := range in.IndirectResourceContributor {
+			v, err := ToIndirectResourceContributor(m)
+			if err != nil {
+				return nil, wrapIndex("IndirectResourceContributor", i, err)
+			}
+			if v != nil {
+				out.IndirectResourceContributor[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayArtistName) > 0 {
+		out.DisplayArtistName = make([]ddex.DisplayArtistName, len(in.DisplayArtistName))
+		for i, m := range in.DisplayArtistName {
+			v, err := ToDisplayArtistName(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayArtistName", i, err)
+			}
+			if v != nil {
+				out.DisplayArtistName[i] = *v
+			}
+		}
+	}
+	if len(in.CLine) > 0 {
+		out.CLine = make([]ddex.CLine, len(in.CLine))
+		for i, m := range in.CLine {
+			v, err := ToCLine(m)
+			if err != nil {
+				return nil, wrapIndex("CLine", i, err)
+			}
+			if v != nil {
+				out.CLine[i] = *v
+			}
+		}
+	}
+	if out.Description, err = ToDescription(in.Description); err != nil {
+		return nil, wrapField("Description", err)
+	}
+	if out.CourtesyLine, err = ToCourtesyLine(in.CourtesyLine); err != nil {
+		return nil, wrapField("CourtesyLine", err)
+	}
+	if out.ResourceReleaseDate, err = ToEventDate(in.ResourceReleaseDate); err != nil {
+		return nil, wrapField("ResourceReleaseDate", err)
+	}
+	if out.OriginalResourceReleaseDate, err = ToEventDate(in.OriginalResourceReleaseDate); err != nil {
+		return nil, wrapField("OriginalResourceReleaseDate", err)
+	}
+	if out.FulfillmentDate, err = ToFulfillmentDate(in.FulfillmentDate); err != nil {
+		return nil, wrapField("FulfillmentDate", err)
+	}
+	if len(in.Keywords) > 0 {
+		out.Keywords = make([]ddex.Keywords, len(in.Keywords))
+		for i, m := range in.Keywords {
+			v, err := ToKeywords(m)
+			if err != nil {
+				return nil, wrapIndex("Keywords", i, err)
+			}
+			if v != nil {
+				out.Keywords[i] = *v
+			}
+		}
+	}
+	if out.Synopsis, err = ToSynopsis(in.Synopsis); err != nil {
+		return nil, wrapField("Synopsis", err)
+	}
+	if len(in.Genre) > 0 {
+		out.Genre = make([]ddex.Genre, len(in.Genre))
+		for i, m := range in.Genre {
+			v, err := ToGenre(m)
+			if err != nil {
+				return nil, wrapIndex("Genre", i, err)
+			}
+			if v != nil {
+				out.Genre[i] = *v
+			}
+		}
+	}
+	out.ParentalWarningType = cloneSlice(in.ParentalWarningType)
+	if len(in.TechnicalImageDetails) > 0 {
+		out.TechnicalImageDetails = make([]ddex.TechnicalImageDetails, len(in.TechnicalImageDetails))
+		for i, m := range in.TechnicalImageDetails {
+			v, err := ToTechnicalImageDetails(m)
+			if err != nil {
+				return nil, wrapIndex("TechnicalImageDetails", i, err)
+			}
+			if v != nil {
+				out.TechnicalImageDetails[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromResourceID converts a ddex.ResourceID to its protobuf message
+func FromResourceID(in *ddex.ResourceID) *ResourceID {
+	if in == nil {
+		return nil
+	}
+	out := &ResourceID{}
+	out.Value = in.Value
+	out.Namespace = in.Namespace
+	return out
+}
+
+// ToResourceID converts a ResourceID message to a ddex.ResourceID
+func ToResourceID(in *ResourceID) (*ddex.ResourceID, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ResourceID{}
+	out.Value = in.Value
+	out.Namespace = in.Namespace
+	return out, nil
+}
+
+// FromDisplayTitleText converts a ddex.DisplayTitleText to its protobuf message
+func FromDisplayTitleText(in *ddex.DisplayTitleText) *DisplayTitleText {
+	if in == nil {
+		return nil
+	}
+	out := &DisplayTitleText{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out
+}
+
+// ToDisplayTitleText converts a DisplayTitleText message to a ddex.DisplayTitleText
+func ToDisplayTitleText(in *DisplayTitleText) (*ddex.DisplayTitleText, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.DisplayTitleText{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out, nil
+}
+
+// FromReleaseId converts a ddex.ReleaseId to its protobuf message
+func FromReleaseId(in *ddex.ReleaseId) *ReleaseId {
+	if in == nil {
+		return nil
+	}
+	out := &ReleaseId{}
+	out.GRid = in.GRid
+	out.Isrc = in.ISRC
+	out.Icpn = in.ICPN
+	out.Isan = in.ISAN
+	out.CatalogNumber = FromCatalogNumber(in.CatalogNumber)
+	for i := range in.ProprietaryId {
+		out.ProprietaryId = append(out.ProprietaryId, FromProprietaryId(&in.ProprietaryId[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToReleaseId converts a ReleaseId message to a ddex.ReleaseId
+func ToReleaseId(in *ReleaseId) (*ddex.ReleaseId, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ReleaseId{}
+	var err error
+	out.GRid = in.GRid
+	out.ISRC = in.Isrc
+	out.ICPN = in.Icpn
+	out.ISAN = in.Isan
+	if out.CatalogNumber, err = ToCatalogNumber(in.CatalogNumber); err != nil {
+		return nil, wrapField("CatalogNumber", err)
+	}
+	if len(in.ProprietaryId) > 0 {
+		out.ProprietaryId = make([]ddex.ProprietaryId, len(in.ProprietaryId))
+		for i, m := range in.ProprietaryId {
+			v, err := ToProprietaryId(m)
+			if err != nil {
+				return nil, wrapIndex("ProprietaryId", i, err)
+			}
+			if v != nil {
+				out.ProprietaryId[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromTitleText converts a ddex.TitleText to its protobuf message
+func FromTitleText(in *ddex.TitleText) *TitleText {
+	if in == nil {
+		return nil
+	}
+	out := &TitleText{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TitleType = in.TitleType
+	return out
+}
+
+// ToTitleText converts a TitleText message to a ddex.TitleText
+func ToTitleText(in *TitleText) (*ddex.TitleText, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.TitleText{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TitleType = in.TitleType
+	return out, nil
+}
+
+// FromDisplayArtist converts a ddex.DisplayArtist to its protobuf message
+func FromDisplayArtist(in *ddex.DisplayArtist) *DisplayArtist {
+	if in == nil {
+		return nil
+	}
+	out := &DisplayArtist{}
+	out.SequenceNumber = int64(in.SequenceNumber)
+	for i := range in.PartyName {
+		out.PartyName = append(out.PartyName, FromPartyName(&in.PartyName[i]))
+	}
+	for i := range in.PartyId {
+		out.PartyId = append(out.PartyId, FromPartyId(&in.PartyId[i]))
+	}
+	out.ArtistRole = cloneSlice(in.ArtistRole)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToDisplayArtist converts a DisplayArtist message to a ddex.DisplayArtist
+func ToDisplayArtist(in *DisplayArtist) (*ddex.DisplayArtist, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.DisplayArtist{}
+	out.SequenceNumber = int(in.SequenceNumber)
+	if len(in.PartyName) > 0 {
+		out.PartyName = make([]ddex.PartyName, len(in.PartyName))
+		for i, m := range in.PartyName {
+			v, err := ToPartyName(m)
+			if err != nil {
+				return nil, wrapIndex("PartyName", i, err)
+			}
+			if v != nil {
+				out.PartyName[i] = *v
+			}
+		}
+	}
+	if len(in.PartyId) > 0 {
+		out.PartyId = make([]ddex.PartyId, len(in.PartyId))
+		for i, m := range in.PartyId {
+			v, err := ToPartyId(m)
+			if err != nil {
+				return nil, wrapIndex("PartyId", i, err)
+			}
+			if v != nil {
+				out.PartyId[i] = *v
+			}
+		}
+	}
+	out.ArtistRole = cloneSlice(in.ArtistRole)
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromCollectionDetailsByTerritory converts a ddex.CollectionDetailsByTerritory to its protobuf message
+func FromCollectionDetailsByTerritory(in *ddex.CollectionDetailsByTerritory) *CollectionDetailsByTerritory {
+	if in == nil {
+		return nil
+	}
+	out := &CollectionDetailsByTerritory{}
+	out.TerritoryCode = in.TerritoryCode
+	for i := range in.DisplayTitleText {
+		out.DisplayTitleText = append(out.DisplayTitleText, FromTitleText(&in.DisplayTitleText[i]))
+	}
+	out.DisplayArtistName = cloneSlice(in.DisplayArtistName)
+	for i := range in.Genre {
+		out.Genre = append(out.Genre, FromGenre(&in.Genre[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToCollectionDetailsByTerritory converts a CollectionDetailsByTerritory message to a ddex.CollectionDetailsByTerritory
+func ToCollectionDetailsByTerritory(in *CollectionDetailsByTerritory) (*ddex.CollectionDetailsByTerritory, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.CollectionDetailsByTerritory{}
+	out.TerritoryCode = in.TerritoryCode
+	if len(in.DisplayTitleText) > 0 {
+		out.DisplayTitleText = make([]ddex.TitleText, len(in.DisplayTitleText))
+		for i, m := range in.DisplayTitleText {
+			v, err := ToTitleText(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayTitleText", i, err)
+			}
+			if v != nil {
+				out.DisplayTitleText[i] = *v
+			}
+		}
+	}
+	out.DisplayArtistName = cloneSlice(in.DisplayArtistName)
+	if len(in.Genre) > 0 {
+		out.Genre = make([]ddex.Genre, len(in.Genre))
+		for i, m := range in.Genre {
+			v, err := ToGenre(m)
+			if err != nil {
+				return nil, wrapIndex("Genre", i, err)
+			}
+			if v != nil {
+				out.Genre[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromExternalResourceLink converts a ddex.ExternalResourceLink to its protobuf message
+func FromExternalResourceLink(in *ddex.ExternalResourceLink) *ExternalResourceLink {
+	if in == nil {
+		return nil
+	}
+	out := &ExternalResourceLink{}
+	out.Url = in.URL
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToExternalResourceLink converts a ExternalResourceLink message to a ddex.ExternalResourceLink
+func ToExternalResourceLink(in *ExternalResourceLink) (*ddex.ExternalResourceLink, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ExternalResourceLink{}
+	out.URL = in.Url
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromDisplayTitle converts a ddex.DisplayTitle to its protobuf message
+func FromDisplayTitle(in *ddex.DisplayTitle) *DisplayTitle {
+	if in == nil {
+		return nil
+	}
+	out := &DisplayTitle{}
+	for i := range in.TitleText {
+		out.TitleText = append(out.TitleText, FromTitleText(&in.TitleText[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToDisplayTitle converts a DisplayTitle message to a ddex.DisplayTitle
+func ToDisplayTitle(in *DisplayTitle) (*ddex.DisplayTitle, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.DisplayTitle{}
+	if len(in.TitleText) > 0 {
+		out.TitleText = make([]ddex.TitleText, len(in.TitleText))
+		for i, m := range in.TitleText {
+			v, err := ToTitleText(m)
+			if err != nil {
+				return nil, wrapIndex("TitleText", i, err)
+			}
+			if v != nil {
+				out.TitleText[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromAdditionalTitle converts a ddex.AdditionalTitle to its protobuf message
+func FromAdditionalTitle(in *ddex.AdditionalTitle) *AdditionalTitle {
+	if in == nil {
+		return nil
+	}
+	out := &AdditionalTitle{}
+	out.TitleText = in.TitleText
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToAdditionalTitle converts a AdditionalTitle message to a ddex.AdditionalTitle
+func ToAdditionalTitle(in *AdditionalTitle) (*ddex.AdditionalTitle, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.AdditionalTitle{}
+	out.TitleText = in.TitleText
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromReleaseResourceReferenceList converts a ddex.ReleaseResourceReferenceList to its protobuf message
+func FromReleaseResourceReferenceList(in *ddex.ReleaseResourceReferenceList) *ReleaseResourceReferenceList {
+	if in == nil {
+		return nil
+	}
+	out := &ReleaseResourceReferenceList{}
+	for i := range in.ReleaseResourceReference {
+		out.ReleaseResourceReference = append(out.ReleaseResourceReference, FromReleaseResourceReference(&in.ReleaseResourceReference[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToReleaseResourceReferenceList converts a ReleaseResourceReferenceList message to a ddex.ReleaseResourceReferenceList
+func ToReleaseResourceReferenceList(in *ReleaseResourceReferenceList) (*ddex.ReleaseResourceReferenceList, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ReleaseResourceReferenceList{}
+	if len(in.ReleaseResourceReference) > 0 {
+		out.ReleaseResourceReference = make([]ddex.ReleaseResourceReference, len(in.ReleaseResourceReference))
+		for i, m := range in.ReleaseResourceReference {
+			v, err := ToReleaseResourceReference(m)
+			if err != nil {
+				return nil, wrapIndex("ReleaseResourceReference", i, err)
+			}
+			if v != nil {
+				out.ReleaseResourceReference[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromReleaseCollectionReferenceList converts a ddex.ReleaseCollectionReferenceList to its protobuf message
+func FromReleaseCollectionReferenceList(in *ddex.ReleaseCollectionReferenceList) *ReleaseCollectionReferenceList {
+	if in == nil {
+		return nil
+	}
+	out := &ReleaseCollectionReferenceList{}
+	out.ReleaseCollectionReference = cloneSlice(in.ReleaseCollectionReference)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToReleaseCollectionReferenceList converts a ReleaseCollectionReferenceList message to a ddex.ReleaseCollectionReferenceList
+func ToReleaseCollectionReferenceList(in *ReleaseCollectionReferenceList) (*ddex.ReleaseCollectionReferenceList, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ReleaseCollectionReferenceList{}
+	out.ReleaseCollectionReference = cloneSlice(in.ReleaseCollectionReference)
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromRelatedResource converts a ddex.RelatedResource to its protobuf message
+func FromRelatedResource(in *ddex.RelatedResource) *RelatedResource {
+	if in == nil {
+		return nil
+	}
+	out := &RelatedResource{}
+	out.ResourceRelationshipType = in.ResourceRelationshipType
+	out.ResourceRelatedResourceReference = in.ResourceRelatedResourceReference
+	out.ResourceId = FromResourceRelatedId(in.ResourceId)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToRelatedResource converts a RelatedResource message to a ddex.RelatedResource
+func ToRelatedResource(in *RelatedResource) (*ddex.RelatedResource, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.RelatedResource{}
+	var err error
+	out.ResourceRelationshipType = in.ResourceRelationshipType
+	out.ResourceRelatedResourceReference = in.ResourceRelatedResourceReference
+	if out.ResourceId, err = ToResourceRelatedId(in.ResourceId); err != nil {
+		return nil, wrapField("ResourceId", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromReleaseType converts a ddex.ReleaseType to its protobuf message
+func FromReleaseType(in *ddex.ReleaseType) *ReleaseType {
+	if in == nil {
+		return nil
+	}
+	out := &ReleaseType{}
+	out.Value = in.Value
+	return out
+}
+
+// ToReleaseType converts a ReleaseType message to a ddex.ReleaseType
+func ToReleaseType(in *ReleaseType) (*ddex.ReleaseType, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ReleaseType{}
+	out.Value = in.Value
+	return out, nil
+}
+
+// FromReleaseDetailsByTerritory converts a ddex.ReleaseDetailsByTerritory to its protobuf message
+func FromReleaseDetailsByTerritory(in *ddex.ReleaseDetailsByTerritory) *ReleaseDetailsByTerritory {
+	if in == nil {
+		return nil
+	}
+	out := &ReleaseDetailsByTerritory{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TerritoryCode = cloneSlice(in.TerritoryCode)
+	out.ExcludedTerritoryCode = cloneSlice(in.ExcludedTerritoryCode)
+	for i := range in.DisplayArtistName {
+		out.DisplayArtistName = append(out.DisplayArtistName, FromDisplayArtistName(&in.DisplayArtistName[i]))
+	}
+	for i := range in.LabelName {
+		out.LabelName = append(out.LabelName, FromLabelName(&in.LabelName[i]))
+	}
+	for i := range in.Title {
+		out.Title = append(out.Title, FromTitle(&in.Title[i]))
+	}
+	for i := range in.DisplayArtist {
+		out.DisplayArtist = append(out.DisplayArtist, FromDisplayArtist(&in.DisplayArtist[i]))
+	}
+	out.IsMultiArtistCompilation = in.IsMultiArtistCompilation
+	for i := range in.AdministratingRecordCompany {
+		out.AdministratingRecordCompany = append(out.AdministratingRecordCompany, FromAdministratingRecordCompany(&in.AdministratingRecordCompany[i]))
+	}
+	for i := range in.ReleaseType {
+		out.ReleaseType = append(out.ReleaseType, FromReleaseType(&in.ReleaseType[i]))
+	}
+	for i := range in.RelatedRelease {
+		out.RelatedRelease = append(out.RelatedRelease, FromRelatedRelease(&in.RelatedRelease[i]))
+	}
+	for i := range in.ParentalWarningType {
+		out.ParentalWarningType = append(out.ParentalWarningType, FromParentalWarningType(&in.ParentalWarningType[i]))
+	}
+	for i := range in.AvRating {
+		out.AvRating = append(out.AvRating, FromAvRating(&in.AvRating[i]))
+	}
+	out.MarketingComment = FromComment(in.MarketingComment)
+	for i := range in.ResourceGroup {
+		out.ResourceGroup = append(out.ResourceGroup, FromResourceGroup(&in.ResourceGroup[i]))
+	}
+	for i := range in.Genre {
+		out.Genre = append(out.Genre, FromGenre(&in.Genre[i]))
+	}
+	for i := range in.PLine {
+		out.PLine = append(out.PLine, FromPLine(&in.PLine[i]))
+	}
+	for i := range in.CLine {
+		out.CLine = append(out.CLine, FromCLine(&in.CLine[i]))
+	}
+	out.ReleaseDate = FromEventDate(in.ReleaseDate)
+	out.OriginalReleaseDate = FromEventDate(in.OriginalReleaseDate)
+	for i := range in.Keywords {
+		out.Keywords = append(out.Keywords, FromKeywords(&in.Keywords[i]))
+	}
+	out.Synopsis = FromSynopsis(in.Synopsis)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToReleaseDetailsByTerritory converts a ReleaseDetailsByTerritory message to a ddex.ReleaseDetailsByTerritory
+func ToReleaseDetailsByTerritory(in *ReleaseDetailsByTerritory) (*ddex.ReleaseDetailsByTerritory, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ReleaseDetailsByTerritory{}
+	var err error
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.TerritoryCode = cloneSlice(in.TerritoryCode)
+	out.ExcludedTerritoryCode = cloneSlice(in.ExcludedTerritoryCode)
+	if len(in.DisplayArtistName) > 0 {
+		out.DisplayArtistName = make([]ddex.DisplayArtistName, len(in.DisplayArtistName))
+		for i, m := range in.DisplayArtistName {
+			v, err := ToDisplayArtistName(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayArtistName", i, err)
+			}
+			if v != nil {
+				out.DisplayArtistName[i] = *v
+			}
+		}
+	}
+	if len(in.LabelName) > 0 {
+		out.LabelName = make([]ddex.LabelName, len(in.LabelName))
+		for i, m := range in.LabelName {
+			v, err := ToLabelName(m)
+			if err != nil {
+				return nil, wrapIndex("LabelName", i, err)
+			}
+			if v != nil {
+				out.LabelName[i] = *v
+			}
+		}
+	}
+	if len(in.Title) > 0 {
+		out.Title = make([]ddex.Title, len(in.Title))
+		for i, m := range in.Title {
+			v, err := ToTitle(m)
+			if err != nil {
+				return nil, wrapIndex("Title", i, err)
+			}
+			if v != nil {
+				out.Title[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayArtist) > 0 {
+		out.DisplayArtist = make([]ddex.DisplayArtist, len(in.DisplayArtist))
+		for i, m := range in.DisplayArtist {
+			v, err := ToDisplayArtist(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayArtist", i, err)
+			}
+			if v != nil {
+				out.DisplayArtist[i] = *v
+			}
+		}
+	}
+	out.IsMultiArtistCompilation = in.IsMultiArtistCompilation
+	if len(in.AdministratingRecordCompany) > 0 {
+		out.AdministratingRecordCompany = make([]ddex.AdministratingRecordCompany, len(in.AdministratingRecordCompany))
+		for i, m := range in.AdministratingRecordCompany {
+			v, err := ToAdministratingRecordCompany(m)
+			if err != nil {
+				return nil, wrapIndex("AdministratingRecordCompany", i, err)
+			}
+			if v != nil {
+				out.AdministratingRecordCompany[i] = *v
+			}
+		}
+	}
+	if len(in.ReleaseType) > 0 {
+		out.ReleaseType = make([]ddex.ReleaseType, len(in.ReleaseType))
+		for i, m := range in.ReleaseType {
+			v, err := ToReleaseType(m)
+			if err != nil {
+				return nil, wrapIndex("ReleaseType", i, err)
+			}
+			if v != nil {
+				out.ReleaseType[i] = *v
+			}
+		}
+	}
+	if len(in.RelatedRelease) > 0 {
+		out.RelatedRelease = make([]ddex.RelatedRelease, len(in.RelatedRelease))
+		for i, m := range in.RelatedRelease {
+			v, err := ToRelatedRelease(m)
+			if err != nil {
+				return nil, wrapIndex("RelatedRelease", i, err)
+			}
+			if v != nil {
+				out.RelatedRelease[i] = *v
+			}
+		}
+	}
+	if len(in.ParentalWarningType) > 0 {
+		out.ParentalWarningType = make([]ddex.ParentalWarningType, len(in.ParentalWarningType))
+		for i, m := range in.ParentalWarningType {
+			v, err := ToParentalWarningType(m)
+			if err != nil {
+				return nil, wrapIndex("ParentalWarningType", i, err)
+			}
+			if v != nil {
+				out.ParentalWarningType[i] = *v
+			}
+		}
+	}
+	if len(in.AvRating) > 0 {
+		out.AvRating = make([]ddex.AvRating, len(in.AvRating))
+		for i, m := range in.AvRating {
+			v, err := ToAvRating(m)
+			if err != nil {
+				return nil, wrapIndex("AvRating", i, err)
+			}
+			if v != nil {
+				out.AvRating[i] = *v
+			}
+		}
+	}
+	if out.MarketingComment, err = ToComment(in.MarketingComment); err != nil {
+		return nil, wrapField("MarketingComment", err)
+	}
+	if len(in.ResourceGroup) > 0 {
+		out.ResourceGroup = make([]ddex.ResourceGroup, len(in.ResourceGroup))
+		for i, m := range in.ResourceGroup {
+			v, err := ToResourceGroup(m)
+			if err != nil {
+				return nil, wrapIndex("ResourceGroup", i, err)
+			}
+			if v != nil {
+				out.ResourceGroup[i] = *v
+			}
+		}
+	}
+	if len(in.Genre) > 0 {
+		out.Genre = make([]ddex.Genre, len(in.Genre))
+		for i, m := range in.Genre {
+			v, err := ToGenre(m)
+			if err != nil {
+				return nil, wrapIndex("Genre", i, err)
+			}
+			if v != nil {
+				out.Genre[i] = *v
+			}
+		}
+	}
+	if len(in.PLine) > 0 {
+		out.PLine = make([]ddex.PLine, len(in.PLine))
+		for i, m := range in.PLine {
+			v, err := ToPLine(m)
+			if err != nil {
+				return nil, wrapIndex("PLine", i, err)
+			}
+			if v != nil {
+				out.PLine[i] = *v
+			}
+		}
+	}
+	if len(in.CLine) > 0 {
+		out.CLine = make([]ddex.CLine, len(in.CLine))
+		for i, m := range in.CLine {
+			v, err := ToCLine(m)
+			if err != nil {
+				return nil, wrapIndex("CLine", i, err)
+			}
+			if v != nil {
+				out.CLine[i] = *v
+			}
+		}
+	}
+	if out.ReleaseDate, err = ToEventDate(in.ReleaseDate); err != nil {
+		return nil, wrapField("ReleaseDate", err)
+	}
+	if out.OriginalReleaseDate, err = ToEventDate(in.OriginalReleaseDate); err != nil {
+		return nil, wrapField("OriginalReleaseDate", err)
+	}
+	if len(in.Keywords) > 0 {
+		out.Keywords = make([]ddex.Keywords, len(in.Keywords))
+		for i, m := range in.Keywords {
+			v, err := ToKeywords(m)
+			if err != nil {
+				return nil, wrapIndex("Keywords", i, err)
+			}
+			if v != nil {
+				out.Keywords[i] = *v
+			}
+		}
+	}
+	if out.Synopsis, err = ToSynopsis(in.Synopsis); err != nil {
+		return nil, wrapField("Synopsis", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromPLine converts a ddex.PLine to its protobuf message
+func FromPLine(in *ddex.PLine) *PLine {
+	if in == nil {
+		return nil
+	}
+	out := &PLine{}
+	out.Year = int64(in.Year)
+	out.PLineText = in.PLineText
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToPLine converts a PLine message to a ddex.PLine
+func ToPLine(in *PLine) (*ddex.PLine, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.PLine{}
+	out.Year = int(in.Year)
+	out.PLineText = in.PLineText
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromCLine converts a ddex.CLine to its protobuf message
+func FromCLine(in *ddex.CLine) *CLine {
+	if in == nil {
+		return nil
+	}
+	out := &CLine{}
+	out.Year = int64(in.Year)
+	out.CLineText = in.CLineText
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToCLine converts a CLine message to a ddex.CLine
+func ToCLine(in *CLine) (*ddex.CLine, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.CLine{}
+	out.Year = int(in.Year)
+	out.CLineText = in.CLineText
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromDeal converts a ddex.Deal to its protobuf message
+func FromDeal(in *ddex.Deal) *Deal {
+	if in == nil {
+		return nil
+	}
+	out := &Deal{}
+	out.DealTerms = FromDealTerms(in.DealTerms)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToDeal converts a Deal message to a ddex.Deal
+func ToDeal(in *Deal) (*ddex.Deal, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Deal{}
+	var err error
+	if out.DealTerms, err = ToDealTerms(in.DealTerms); err != nil {
+		return nil, wrapField("DealTerms", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromCatalogNumber converts a ddex.CatalogNumber to its protobuf message
+func FromCatalogNumber(in *ddex.CatalogNumber) *CatalogNumber {
+	if in == nil {
+		return nil
+	}
+	out := &CatalogNumber{}
+	out.Value = in.Value
+	out.Namespace = in.Namespace
+	return out
+}
+
+// ToCatalogNumber converts a CatalogNumber message to a ddex.CatalogNumber
+func ToCatalogNumber(in *CatalogNumber) (*ddex.CatalogNumber, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.CatalogNumber{}
+	out.Value = in.Value
+	out.Namespace = in.Namespace
+	return out, nil
+}
+
+// FromProprietaryId converts a ddex.ProprietaryId to its protobuf message
+func FromProprietaryId(in *ddex.ProprietaryId) *ProprietaryId {
+	if in == nil {
+		return nil
+	}
+	out := &ProprietaryId{}
+	out.Namespace = in.Namespace
+	out.Value = in.Value
+	return out
+}
+
+// ToProprietaryId converts a ProprietaryId message to a ddex.ProprietaryId
+func ToProprietaryId(in *ProprietaryId) (*ddex.ProprietaryId, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ProprietaryId{}
+	out.Namespace = in.Namespace
+	out.Value = in.Value
+	return out, nil
+}
+
+// FromResourceMusicalWorkReference converts a ddex.ResourceMusicalWorkReference to its protobuf message
+func FromResourceMusicalWorkReference(in *ddex.ResourceMusicalWorkReference) *ResourceMusicalWorkReference {
+	if in == nil {
+		return nil
+	}
+	out := &ResourceMusicalWorkReference{}
+	for i := range in.MusicalWorkId {
+		out.MusicalWorkId = append(out.MusicalWorkId, FromMusicalWorkId(&in.MusicalWorkId[i]))
+	}
+	out.Duration = in.Duration
+	out.StartPoint = in.StartPoint
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToResourceMusicalWorkReference converts a ResourceMusicalWorkReference message to a ddex.ResourceMusicalWorkReference
+func ToResourceMusicalWorkReference(in *ResourceMusicalWorkReference) (*ddex.ResourceMusicalWorkReference, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ResourceMusicalWorkReference{}
+	if len(in.MusicalWorkId) > 0 {
+		out.MusicalWorkId = make([]ddex.MusicalWorkId, len(in.MusicalWorkId))
+		for i, m := range in.MusicalWorkId {
+			v, err := ToMusicalWorkId(m)
+			if err != nil {
+				return nil, wrapIndex("MusicalWorkId", i, err)
+			}
+			if v != nil {
+				out.MusicalWorkId[i] = *v
+			}
+		}
+	}
+	out.Duration = in.Duration
+	out.StartPoint = in.StartPoint
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromResourceContainedResourceReference converts a ddex.ResourceContainedResourceReference to its protobuf message
+func FromResourceContainedResourceReference(in *ddex.ResourceContainedResourceReference) *ResourceContainedResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := &ResourceContainedResourceReference{}
+	out.ResourceContainedResourceReference = in.ResourceContainedResourceReference
+	out.DurationUsed = in.DurationUsed
+	out.StartPoint = in.StartPoint
+	return out
+}
+
+// ToResourceContainedResourceReference converts a ResourceContainedResourceReference message to a ddex.ResourceContainedResourceReference
+func ToResourceContainedResourceReference(in *ResourceContainedResourceReference) (*ddex.ResourceContainedResourceReference, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ResourceContainedResourceReference{}
+	out.ResourceContainedResourceReference = in.ResourceContainedResourceReference
+	out.DurationUsed = in.DurationUsed
+	out.StartPoint = in.StartPoint
+	return out, nil
+}
+
+// FromDisplayConductor converts a ddex.DisplayConductor to its protobuf message
+func FromDisplayConductor(in *ddex.DisplayConductor) *DisplayConductor {
+	if in == nil {
+		return nil
+	}
+	out := &DisplayConductor{}
+	out.SequenceNumber = int64(in.SequenceNumber)
+	for i := range in.PartyName {
+		out.PartyName = append(out.PartyName, FromPartyName(&in.PartyName[i]))
+	}
+	for i := range in.PartyId {
+		out.PartyId = append(out.PartyId, FromPartyId(&in.PartyId[i]))
+	}
+	out.ArtistRole = cloneSlice(in.ArtistRole)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToDisplayConductor converts a DisplayConductor message to a ddex.DisplayConductor
+func ToDisplayConductor(in *DisplayConductor) (*ddex.DisplayConductor, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.DisplayConductor{}
+	out.SequenceNumber = int(in.SequenceNumber)
+	if len(in.PartyName) > 0 {
+		out.PartyName = make([]ddex.PartyName, len(in.PartyName))
+		for i, m := range in.PartyName {
+			v, err := ToPartyName(m)
+			if err != nil {
+				return nil, wrapIndex("PartyName", i, err)
+			}
+			if v != nil {
+				out.PartyName[i] = *v
+			}
+		}
+	}
+	if len(in.PartyId) > 0 {
+		out.PartyId = make([]ddex.PartyId, len(in.PartyId))
+		for i, m := range in.PartyId {
+			v, err := ToPartyId(m)
+			if err != nil {
+				return nil, wrapIndex("PartyId", i, err)
+			}
+			if v != nil {
+				out.PartyId[i] = *v
+			}
+		}
+	}
+	out.ArtistRole = cloneSlice(in.ArtistRole)
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromResourceContributor converts a ddex.ResourceContributor to its protobuf message
+func FromResourceContributor(in *ddex.ResourceContributor) *ResourceContributor {
+	if in == nil {
+		return nil
+	}
+	out := &ResourceContributor{}
+	out.SequenceNumber = int64(in.SequenceNumber)
+	for i := range in.PartyId {
+		out.PartyId = append(out.PartyId, FromPartyId(&in.PartyId[i]))
+	}
+	for i := range in.PartyName {
+		out.PartyName = append(out.PartyName, FromPartyName(&in.PartyName[i]))
+	}
+	out.ResourceContributorRole = cloneSlice(in.ResourceContributorRole)
+	out.InstrumentType = cloneSlice(in.InstrumentType)
+	out.HasMadeFeaturedContribution = clonePtr(in.HasMadeFeaturedContribution)
+	out.HasMadeContractedContribution = clonePtr(in.HasMadeContractedContribution)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToResourceContributor converts a ResourceContributor message to a ddex.ResourceContributor
+func ToResourceContributor(in *ResourceContributor) (*ddex.ResourceContributor, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ResourceContributor{}
+	out.SequenceNumber = int(in.SequenceNumber)
+	if len(in.PartyId) > 0 {
+		out.PartyId = make([]ddex.PartyId, len(in.PartyId))
+		for i, m := range in.PartyId {
+			v, err := ToPartyId(m)
+			if err != nil {
+				return nil, wrapIndex("PartyId", i, err)
+			}
+			if v != nil {
+				out.PartyId[i] = *v
+			}
+		}
+	}
+	if len(in.PartyName) > 0 {
+		out.PartyName = make([]ddex.PartyName, len(in.PartyName))
+		for i, m := range in.PartyName {
+			v, err := ToPartyName(m)
+			if err != nil {
+				return nil, wrapIndex("PartyName", i, err)
+			}
+			if v != nil {
+				out.PartyName[i] = *v
+			}
+		}
+	}
+	out.ResourceContributorRole = cloneSlice(in.ResourceContributorRole)
+	out.InstrumentType = cloneSlice(in.InstrumentType)
+	out.HasMadeFeaturedContribution = clonePtr(in.HasMadeFeaturedContribution)
+	out.HasMadeContractedContribution = clonePtr(in.HasMadeContractedContribution)
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromIndirectResourceContributor converts a ddex.IndirectResourceContributor to its protobuf message
+func FromIndirectResourceContributor(in *ddex.IndirectResourceContributor) *IndirectResourceContributor {
+	if in == nil {
+		return nil
+	}
+	out := &IndirectResourceContributor{}
+	out.SequenceNumber = int64(in.SequenceNumber)
+	for i := range in.PartyName {
+		out.PartyName = append(out.PartyName, FromPartyName(&in.PartyName[i]))
+	}
+	for i := range in.PartyId {
+		out.PartyId = append(out.PartyId, FromPartyId(&in.PartyId[i]))
+	}
+	out.IndirectResourceContributorRole = cloneSlice(in.IndirectResourceContributorRole)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToIndirectResourceContributor converts a IndirectResourceContributor message to a ddex.IndirectResourceContributor
+func ToIndirectResourceContributor(in *IndirectResourceContributor) (*ddex.IndirectResourceContributor, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.IndirectResourceContributor{}
+	out.SequenceNumber = int(in.SequenceNumber)
+	if len(in.PartyName) > 0 {
+		out.PartyName = make([]ddex.PartyName, len(in.PartyName))
+		for i, m := range in.PartyName {
+			v, err := ToPartyName(m)
+			if err != nil {
+				return nil, wrapIndex("PartyName", i, err)
+			}
+			if v != nil {
+				out.PartyName[i] = *v
+			}
+		}
+	}
+	if len(in.PartyId) > 0 {
+		out.PartyId = make([]ddex.PartyId, len(in.PartyId))
+		for i, m := range in.PartyId {
+			v, err := ToPartyId(m)
+			if err != nil {
+				return nil, wrapIndex("PartyId", i, err)
+			}
+			if v != nil {
+				out.PartyId[i] = *v
+			}
+		}
+	}
+	out.IndirectResourceContributorRole = cloneSlice(in.IndirectResourceContributorRole)
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromDisplayArtistName converts a ddex.DisplayArtistName to its protobuf message
+func FromDisplayArtistName(in *ddex.DisplayArtistName) *DisplayArtistName {
+	if in == nil {
+		return nil
+	}
+	out := &DisplayArtistName{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out
+}
+
+// ToDisplayArtistName converts a DisplayArtistName message to a ddex.DisplayArtistName
+func ToDisplayArtistName(in *DisplayArtistName) (*ddex.DisplayArtistName, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.DisplayArtistName{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out, nil
+}
+
+// FromLabelName converts a ddex.LabelName to its protobuf message
+func FromLabelName(in *ddex.LabelName) *LabelName {
+	if in == nil {
+		return nil
+	}
+	out := &LabelName{}
+	out.LabelNameType = in.LabelNameType
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out
+}
+
+// ToLabelName converts a LabelName message to a ddex.LabelName
+func ToLabelName(in *LabelName) (*ddex.LabelName, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.LabelName{}
+	out.LabelNameType = in.LabelNameType
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out, nil
+}
+
+// FromRightsController converts a ddex.RightsController to its protobuf message
+func FromRightsController(in *ddex.RightsController) *RightsController {
+	if in == nil {
+		return nil
+	}
+	out := &RightsController{}
+	out.SequenceNumber = fromIntPtr(in.SequenceNumber)
+	for i := range in.PartyName {
+		out.PartyName = append(out.PartyName, FromName(&in.PartyName[i]))
+	}
+	for i := range in.PartyId {
+		out.PartyId = append(out.PartyId, FromPartyID(&in.PartyId[i]))
+	}
+	out.RightsControllerPartyReference = in.RightsControllerPartyReference
+	out.RightsControllerRole = cloneSlice(in.RightsControllerRole)
+	out.RightSharePercentage = fromDecimalPtr(in.RightSharePercentage)
+	out.RightShareUnknown = in.RightShareUnknown
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToRightsController converts a RightsController message to a ddex.RightsController
+func ToRightsController(in *RightsController) (*ddex.RightsController, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.RightsController{}
+	var err error
+	out.SequenceNumber = toIntPtr(in.SequenceNumber)
+	if len(in.PartyName) > 0 {
+		out.PartyName = make([]ddex.Name, len(in.PartyName))
+		for i, m := range in.PartyName {
+			v, err := ToName(m)
+			if err != nil {
+				return nil, wrapIndex("PartyName", i, err)
+			}
+			if v != nil {
+				out.PartyName[i] = *v
+			}
+		}
+	}
+	if len(in.PartyId) > 0 {
+		out.PartyId = make([]ddex.PartyID, len(in.PartyId))
+		for i, m := range in.PartyId {
+			v, err := ToPartyID(m)
+			if err != nil {
+				return nil, wrapIndex("PartyId", i, err)
+			}
+			if v != nil {
+				out.PartyId[i] = *v
+			}
+		}
+	}
+	out.RightsControllerPartyReference = in.RightsControllerPartyReference
+	out.RightsControllerRole = cloneSlice(in.RightsControllerRole)
+	if out.RightSharePercentage, err = toDecimalPtr(in.RightSharePercentage); err != nil {
+		return nil, wrapField("RightSharePercentage", err)
+	}
+	out.RightShareUnknown = in.RightShareUnknown
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromCourtesyLine converts a ddex.CourtesyLine to its protobuf message
+func FromCourtesyLine(in *ddex.CourtesyLine) *CourtesyLine {
+	if in == nil {
+		return nil
+	}
+	out := &CourtesyLine{}
+	out.Year = int64(in.Year)
+	out.CourtesyLineText = in.CourtesyLineText
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToCourtesyLine converts a CourtesyLine message to a ddex.CourtesyLine
+func ToCourtesyLine(in *CourtesyLine) (*ddex.CourtesyLine, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.CourtesyLine{}
+	out.Year = int(in.Year)
+	out.CourtesyLineText = in.CourtesyLineText
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromHostSoundCarrier converts a ddex.HostSoundCarrier to its protobuf message
+func FromHostSoundCarrier(in *ddex.HostSoundCarrier) *HostSoundCarrier {
+	if in == nil {
+		return nil
+	}
+	out := &HostSoundCarrier{}
+	for i := range in.ReleaseId {
+		out.ReleaseId = append(out.ReleaseId, FromReleaseId(&in.ReleaseId[i]))
+	}
+	out.CatalogNumber = FromCatalogNumber(in.CatalogNumber)
+	for i := range in.Title {
+		out.Title = append(out.Title, FromTitle(&in.Title[i]))
+	}
+	for i := range in.DisplayArtistName {
+		out.DisplayArtistName = append(out.DisplayArtistName, FromDisplayArtistName(&in.DisplayArtistName[i]))
+	}
+	for i := range in.DisplayArtist {
+		out.DisplayArtist = append(out.DisplayArtist, FromDisplayArtist(&in.DisplayArtist[i]))
+	}
+	out.OriginalReleaseDate = FromEventDate(in.OriginalReleaseDate)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToHostSoundCarrier converts a HostSoundCarrier message to a ddex.HostSoundCarrier
+func ToHostSoundCarrier(in *HostSoundCarrier) (*ddex.HostSoundCarrier, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.HostSoundCarrier{}
+	var err error
+	if len(in.ReleaseId) > 0 {
+		out.ReleaseId = make([]ddex.ReleaseId, len(in.ReleaseId))
+		for i, m := range in.ReleaseId {
+			v, err := ToReleaseId(m)
+			if err != nil {
+				return nil, wrapIndex("ReleaseId", i, err)
+			}
+			if v != nil {
+				out.ReleaseId[i] = *v
+			}
+		}
+	}
+	if out.CatalogNumber, err = ToCatalogNumber(in.CatalogNumber); err != nil {
+		return nil, wrapField("CatalogNumber", err)
+	}
+	if len(in.Title) > 0 {
+		out.Title = make([]ddex.Title, len(in.Title))
+		for i, m := range in.Title {
+			v, err := ToTitle(m)
+			if err != nil {
+				return nil, wrapIndex("Title", i, err)
+			}
+			if v != nil {
+				out.Title[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayArtistName) > 0 {
+		out.DisplayArtistName = make([]ddex.DisplayArtistName, len(in.DisplayArtistName))
+		for i, m := range in.DisplayArtistName {
+			v, err := ToDisplayArtistName(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayArtistName", i, err)
+			}
+			if v != nil {
+				out.DisplayArtistName[i] = *v
+			}
+		}
+	}
+	if len(in.DisplayArtist) > 0 {
+		out.DisplayArtist = make([]ddex.DisplayArtist, len(in.DisplayArtist))
+		for i, m := range in.DisplayArtist {
+			v, err := ToDisplayArtist(m)
+			if err != nil {
+				return nil, wrapIndex("DisplayArtist", i, err)
+			}
+			if v != nil {
+				out.DisplayArtist[i] = *v
+			}
+		}
+	}
+	if out.OriginalReleaseDate, err = ToEventDate(in.OriginalReleaseDate); err != nil {
+		return nil, wrapField("OriginalReleaseDate", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromComment converts a ddex.Comment to its protobuf message
+func FromComment(in *ddex.Comment) *Comment {
+	if in == nil {
+		return nil
+	}
+	out := &Comment{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out
+}
+
+// ToComment converts a Comment message to a ddex.Comment
+func ToComment(in *Comment) (*ddex.Comment, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Comment{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out, nil
+}
+
+// FromGenre converts a ddex.Genre to its protobuf message
+func FromGenre(in *ddex.Genre) *Genre {
+	if in == nil {
+		return nil
+	}
+	out := &Genre{}
+	out.GenreText = in.GenreText
+	out.SubGenre = in.SubGenre
+	out.ApplicableTerritoryCode = in.ApplicableTerritoryCode
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToGenre converts a Genre message to a ddex.Genre
+func ToGenre(in *Genre) (*ddex.Genre, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Genre{}
+	out.GenreText = in.GenreText
+	out.SubGenre = in.SubGenre
+	out.ApplicableTerritoryCode = in.ApplicableTerritoryCode
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromAvRating converts a ddex.AvRating to its protobuf message
+func FromAvRating(in *ddex.AvRating) *AvRating {
+	if in == nil {
+		return nil
+	}
+	out := &AvRating{}
+	out.RatingText = in.RatingText
+	out.RatingAgency = FromRatingAgency(in.RatingAgency)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToAvRating converts a AvRating message to a ddex.AvRating
+func ToAvRating(in *AvRating) (*ddex.AvRating, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.AvRating{}
+	var err error
+	out.RatingText = in.RatingText
+	if out.RatingAgency, err = ToRatingAgency(in.RatingAgency); err != nil {
+		return nil, wrapField("RatingAgency", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromTechnicalSoundRecordingDetails converts a ddex.TechnicalSoundRecordingDetails to its protobuf message
+func FromTechnicalSoundRecordingDetails(in *ddex.TechnicalSoundRecordingDetails) *TechnicalSoundRecordingDetails {
+	if in == nil {
+		return nil
+	}
+	out := &TechnicalSoundRecordingDetails{}
+	out.TechnicalResourceDetailsReference = in.TechnicalResourceDetailsReference
+	out.AudioCodecType = in.AudioCodecType
+	out.BitRate = int64(in.BitRate)
+	out.NumberOfChannels = int64(in.NumberOfChannels)
+	out.SamplingRate = in.SamplingRate
+	out.BitsPerSample = int64(in.BitsPerSample)
+	out.Duration = in.Duration
+	out.IsPreview = clonePtr(in.IsPreview)
+	out.File = FromFile(in.File)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToTechnicalSoundRecordingDetails converts a TechnicalSoundRecordingDetails message to a ddex.TechnicalSoundRecordingDetails
+func ToTechnicalSoundRecordingDetails(in *TechnicalSoundRecordingDetails) (*ddex.TechnicalSoundRecordingDetails, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.TechnicalSoundRecordingDetails{}
+	var err error
+	out.TechnicalResourceDetailsReference = in.TechnicalResourceDetailsReference
+	out.AudioCodecType = in.AudioCodecType
+	out.BitRate = int(in.BitRate)
+	out.NumberOfChannels = int(in.NumberOfChannels)
+	out.SamplingRate = in.SamplingRate
+	out.BitsPerSample = int(in.BitsPerSample)
+	out.Duration = in.Duration
+	out.IsPreview = clonePtr(in.IsPreview)
+	if out.File, err = ToFile(in.File); err != nil {
+		return nil, wrapField("File", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromFulfillmentDate converts a ddex.FulfillmentDate to its protobuf message
+func FromFulfillmentDate(in *ddex.FulfillmentDate) *FulfillmentDate {
+	if in == nil {
+		return nil
+	}
+	out := &FulfillmentDate{}
+	out.Date = in.Date
+	out.ResourceReleaseReference = in.ResourceReleaseReference
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToFulfillmentDate converts a FulfillmentDate message to a ddex.FulfillmentDate
+func ToFulfillmentDate(in *FulfillmentDate) (*ddex.FulfillmentDate, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.FulfillmentDate{}
+	out.Date = in.Date
+	out.ResourceReleaseReference = in.ResourceReleaseReference
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromKeywords converts a ddex.Keywords to its protobuf message
+func FromKeywords(in *ddex.Keywords) *Keywords {
+	if in == nil {
+		return nil
+	}
+	out := &Keywords{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out
+}
+
+// ToKeywords converts a Keywords message to a ddex.Keywords
+func ToKeywords(in *Keywords) (*ddex.Keywords, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Keywords{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out, nil
+}
+
+// FromSynopsis converts a ddex.Synopsis to its protobuf message
+func FromSynopsis(in *ddex.Synopsis) *Synopsis {
+	if in == nil {
+		return nil
+	}
+	out := &Synopsis{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out
+}
+
+// ToSynopsis converts a Synopsis message to a ddex.Synopsis
+func ToSynopsis(in *Synopsis) (*ddex.Synopsis, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Synopsis{}
+	out.Value = in.Value
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	return out, nil
+}
+
+// FromSoundRecordingCollectionReference converts a ddex.SoundRecordingCollectionReference to its protobuf message
+func FromSoundRecordingCollectionReference(in *ddex.SoundRecordingCollectionReference) *SoundRecordingCollectionReference {
+	if in == nil {
+		return nil
+	}
+	out := &SoundRecordingCollectionReference{}
+	out.Value = in.Value
+	return out
+}
+
+// ToSoundRecordingCollectionReference converts a SoundRecordingCollectionReference message to a ddex.SoundRecordingCollectionReference
+func ToSoundRecordingCollectionReference(in *SoundRecordingCollectionReference) (*ddex.SoundRecordingCollectionReference, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.SoundRecordingCollectionReference{}
+	out.Value = in.Value
+	return out, nil
+}
+
+// FromTechnicalVideoDetails converts a ddex.TechnicalVideoDetails to its protobuf message
+func FromTechnicalVideoDetails(in *ddex.TechnicalVideoDetails) *TechnicalVideoDetails {
+	if in == nil {
+		return nil
+	}
+	out := &TechnicalVideoDetails{}
+	out.TechnicalResourceDetailsReference = in.TechnicalResourceDetailsReference
+	out.VideoCodecType = in.VideoCodecType
+	out.VideoDefinitionType = in.VideoDefinitionType
+	out.Duration = in.Duration
+	out.File = FromFile(in.File)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToTechnicalVideoDetails converts a TechnicalVideoDetails message to a ddex.TechnicalVideoDetails
+func ToTechnicalVideoDetails(in *TechnicalVideoDetails) (*ddex.TechnicalVideoDetails, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.TechnicalVideoDetails{}
+	var err error
+	out.TechnicalResourceDetailsReference = in.TechnicalResourceDetailsReference
+	out.VideoCodecType = in.VideoCodecType
+	out.VideoDefinitionType = in.VideoDefinitionType
+	out.Duration = in.Duration
+	if out.File, err = ToFile(in.File); err != nil {
+		return nil, wrapField("File", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromCharacter converts a ddex.Character to its protobuf message
+func FromCharacter(in *ddex.Character) *Character {
+	if in == nil {
+		return nil
+	}
+	out := &Character{}
+	out.CharacterPartyReference = in.CharacterPartyReference
+	out.Name = in.Name
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToCharacter converts a Character message to a ddex.Character
+func ToCharacter(in *Character) (*ddex.Character, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Character{}
+	out.CharacterPartyReference = in.CharacterPartyReference
+	out.Name = in.Name
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromTechnicalImageDetails converts a ddex.TechnicalImageDetails to its protobuf message
+func FromTechnicalImageDetails(in *ddex.TechnicalImageDetails) *TechnicalImageDetails {
+	if in == nil {
+		return nil
+	}
+	out := &TechnicalImageDetails{}
+	out.TechnicalResourceDetailsReference = in.TechnicalResourceDetailsReference
+	out.ImageCodecType = in.ImageCodecType
+	out.ImageHeight = int64(in.ImageHeight)
+	out.ImageWidth = int64(in.ImageWidth)
+	out.File = FromFile(in.File)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToTechnicalImageDetails converts a TechnicalImageDetails message to a ddex.TechnicalImageDetails
+func ToTechnicalImageDetails(in *TechnicalImageDetails) (*ddex.TechnicalImageDetails, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.TechnicalImageDetails{}
+	var err error
+	out.TechnicalResourceDetailsReference = in.TechnicalResourceDetailsReference
+	out.ImageCodecType = in.ImageCodecType
+	out.ImageHeight = int(in.ImageHeight)
+	out.ImageWidth = int(in.ImageWidth)
+	if out.File, err = ToFile(in.File); err != nil {
+		return nil, wrapField("File", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromPartyName converts a ddex.PartyName to its protobuf message
+func FromPartyName(in *ddex.PartyName) *PartyName {
+	if in == nil {
+		return nil
+	}
+	out := &PartyName{}
+	out.FullName = in.FullName
+	out.FullNameIndexed = in.FullNameIndexed
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToPartyName converts a PartyName message to a ddex.PartyName
+func ToPartyName(in *PartyName) (*ddex.PartyName, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.PartyName{}
+	out.FullName = in.FullName
+	out.FullNameIndexed = in.FullNameIndexed
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromPartyId converts a ddex.PartyId to its protobuf message
+func FromPartyId(in *ddex.PartyId) *PartyId {
+	if in == nil {
+		return nil
+	}
+	out := &PartyId{}
+	out.Isni = in.ISNI
+	out.Dpid = in.DPID
+	out.IpiNameNumber = in.IpiNameNumber
+	for i := range in.ProprietaryId {
+		out.ProprietaryId = append(out.ProprietaryId, FromProprietaryId(&in.ProprietaryId[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToPartyId converts a PartyId message to a ddex.PartyId
+func ToPartyId(in *PartyId) (*ddex.PartyId, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.PartyId{}
+	out.ISNI = in.Isni
+	out.DPID = in.Dpid
+	out.IpiNameNumber = in.IpiNameNumber
+	if len(in.ProprietaryId) > 0 {
+		out.ProprietaryId = make([]ddex.ProprietaryId, len(in.ProprietaryId))
+		for i, m := range in.ProprietaryId {
+			v, err := ToProprietaryId(m)
+			if err != nil {
+				return nil, wrapIndex("ProprietaryId", i, err)
+			}
+			if v != nil {
+				out.ProprietaryId[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromReleaseResourceReference converts a ddex.ReleaseResourceReference to its protobuf message
+func FromReleaseResourceReference(in *ddex.ReleaseResourceReference) *ReleaseResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := &ReleaseResourceReference{}
+	out.ReleaseResourceType = in.ReleaseResourceType
+	out.Value = in.Value
+	return out
+}
+
+// ToReleaseResourceReference converts a ReleaseResourceReference message to a ddex.ReleaseResourceReference
+func ToReleaseResourceReference(in *ReleaseResourceReference) (*ddex.ReleaseResourceReference, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ReleaseResourceReference{}
+	out.ReleaseResourceType = in.ReleaseResourceType
+	out.Value = in.Value
+	return out, nil
+}
+
+// FromResourceRelatedId converts a ddex.ResourceRelatedId to its protobuf message
+func FromResourceRelatedId(in *ddex.ResourceRelatedId) *ResourceRelatedId {
+	if in == nil {
+		return nil
+	}
+	out := &ResourceRelatedId{}
+	out.Isrc = in.ISRC
+	for i := range in.ProprietaryId {
+		out.ProprietaryId = append(out.ProprietaryId, FromProprietaryId(&in.ProprietaryId[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToResourceRelatedId converts a ResourceRelatedId message to a ddex.ResourceRelatedId
+func ToResourceRelatedId(in *ResourceRelatedId) (*ddex.ResourceRelatedId, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ResourceRelatedId{}
+	out.ISRC = in.Isrc
+	if len(in.ProprietaryId) > 0 {
+		out.ProprietaryId = make([]ddex.ProprietaryId, len(in.ProprietaryId))
+		for i, m := range in.ProprietaryId {
+			v, err := ToProprietaryId(m)
+			if err != nil {
+				return nil, wrapIndex("ProprietaryId", i, err)
+			}
+			if v != nil {
+				out.ProprietaryId[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromAdministratingRecordCompany converts a ddex.AdministratingRecordCompany to its protobuf message
+func FromAdministratingRecordCompany(in *ddex.AdministratingRecordCompany) *AdministratingRecordCompany {
+	if in == nil {
+		return nil
+	}
+	out := &AdministratingRecordCompany{}
+	for i := range in.PartyId {
+		out.PartyId = append(out.PartyId, FromPartyId(&in.PartyId[i]))
+	}
+	for i := range in.PartyName {
+		out.PartyName = append(out.PartyName, FromName(&in.PartyName[i]))
+	}
+	out.TradingName = in.TradingName
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToAdministratingRecordCompany converts a AdministratingRecordCompany message to a ddex.AdministratingRecordCompany
+func ToAdministratingRecordCompany(in *AdministratingRecordCompany) (*ddex.AdministratingRecordCompany, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.AdministratingRecordCompany{}
+	if len(in.PartyId) > 0 {
+		out.PartyId = make([]ddex.PartyId, len(in.PartyId))
+		for i, m := range in.PartyId {
+			v, err := ToPartyId(m)
+			if err != nil {
+				return nil, wrapIndex("PartyId", i, err)
+			}
+			if v != nil {
+				out.PartyId[i] = *v
+			}
+		}
+	}
+	if len(in.PartyName) > 0 {
+		out.PartyName = make([]ddex.Name, len(in.PartyName))
+		for i, m := range in.PartyName {
+			v, err := ToName(m)
+			if err != nil {
+				return nil, wrapIndex("PartyName", i, err)
+			}
+			if v != nil {
+				out.PartyName[i] = *v
+			}
+		}
+	}
+	out.TradingName = in.TradingName
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromRelatedRelease converts a ddex.RelatedRelease to its protobuf message
+func FromRelatedRelease(in *ddex.RelatedRelease) *RelatedRelease {
+	if in == nil {
+		return nil
+	}
+	out := &RelatedRelease{}
+	out.ReleaseId = FromReleaseId(&in.ReleaseId)
+	out.ReleaseRelationshipType = in.ReleaseRelationshipType
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToRelatedRelease converts a RelatedRelease message to a ddex.RelatedRelease
+func ToRelatedRelease(in *RelatedRelease) (*ddex.RelatedRelease, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.RelatedRelease{}
+	if v, err := ToReleaseId(in.ReleaseId); err != nil {
+		return nil, wrapField("ReleaseId", err)
+	} else if v != nil {
+		out.ReleaseId = *v
+	}
+	out.ReleaseRelationshipType = in.ReleaseRelationshipType
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromParentalWarningType converts a ddex.ParentalWarningType to its protobuf message
+func FromParentalWarningType(in *ddex.ParentalWarningType) *ParentalWarningType {
+	if in == nil {
+		return nil
+	}
+	out := &ParentalWarningType{}
+	out.Value = in.Value
+	return out
+}
+
+// ToParentalWarningType converts a ParentalWarningType message to a ddex.ParentalWarningType
+func ToParentalWarningType(in *ParentalWarningType) (*ddex.ParentalWarningType, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ParentalWarningType{}
+	out.Value = in.Value
+	return out, nil
+}
+
+// FromResourceGroup converts a ddex.ResourceGroup to its protobuf message
+func FromResourceGroup(in *ddex.ResourceGroup) *ResourceGroup {
+	if in == nil {
+		return nil
+	}
+	out := &ResourceGroup{}
+	out.Title = FromTitle(&in.Title)
+	out.SequenceNumber = int64(in.SequenceNumber)
+	for i := range in.ResourceGroupContentItem {
+		out.ResourceGroupContentItem = append(out.ResourceGroupContentItem, FromResourceGroupContentItem(&in.ResourceGroupContentItem[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToResourceGroup converts a ResourceGroup message to a ddex.ResourceGroup
+func ToResourceGroup(in *ResourceGroup) (*ddex.ResourceGroup, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ResourceGroup{}
+	if v, err := ToTitle(in.Title); err != nil {
+		return nil, wrapField("Title", err)
+	} else if v != nil {
+		out.Title = *v
+	}
+	out.SequenceNumber = int(in.SequenceNumber)
+	if len(in.ResourceGroupContentItem) > 0 {
+		out.ResourceGroupContentItem = make([]ddex.ResourceGroupContentItem, len(in.ResourceGroupContentItem))
+		for i, m := range in.ResourceGroupContentItem {
+			v, err := ToResourceGroupContentItem(m)
+			if err != nil {
+				return nil, wrapIndex("ResourceGroupContentItem", i, err)
+			}
+			if v != nil {
+				out.ResourceGroupContentItem[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromDealTerms converts a ddex.DealTerms to its protobuf message
+func FromDealTerms(in *ddex.DealTerms) *DealTerms {
+	if in == nil {
+		return nil
+	}
+	out := &DealTerms{}
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.IsPreOrderDeal = clonePtr(in.IsPreOrderDeal)
+	out.CommercialModelType = cloneSlice(in.CommercialModelType)
+	for i := range in.Usage {
+		out.Usage = append(out.Usage, FromUsage(&in.Usage[i]))
+	}
+	out.AllDealsCancelled = clonePtr(in.AllDealsCancelled)
+	out.TakeDown = clonePtr(in.TakeDown)
+	out.TerritoryCode = cloneSlice(in.TerritoryCode)
+	out.ExcludedTerritoryCode = cloneSlice(in.ExcludedTerritoryCode)
+	for i := range in.DistributionChannel {
+		out.DistributionChannel = append(out.DistributionChannel, FromDSP(&in.DistributionChannel[i]))
+	}
+	for i := range in.ExcludedDistributionChannel {
+		out.ExcludedDistributionChannel = append(out.ExcludedDistributionChannel, FromDSP(&in.ExcludedDistributionChannel[i]))
+	}
+	for i := range in.PriceInformation {
+		out.PriceInformation = append(out.PriceInformation, FromPriceInformation(&in.PriceInformation[i]))
+	}
+	out.IsPromotional = clonePtr(in.IsPromotional)
+	out.PromotionalCode = FromPromotionalCode(in.PromotionalCode)
+	for i := range in.ValidityPeriod {
+		out.ValidityPeriod = append(out.ValidityPeriod, FromValidityPeriod(&in.ValidityPeriod[i]))
+	}
+	out.ConsumerRentalPeriod = FromConsumerRentalPeriod(in.ConsumerRentalPeriod)
+	out.PreOrderReleaseDate = FromEventDate(in.PreOrderReleaseDate)
+	out.ReleaseDisplayStartDate = in.ReleaseDisplayStartDate
+	out.TrackListingPreviewStartDate = in.TrackListingPreviewStartDate
+	out.CoverArtPreviewStartDate = in.CoverArtPreviewStartDate
+	out.ClipPreviewStartDate = in.ClipPreviewStartDate
+	out.PreOrderPreviewDate = FromEventDate(in.PreOrderPreviewDate)
+	out.PreOrderIncentiveResourceList = FromDealResourceReferenceList(in.PreOrderIncentiveResourceList)
+	out.InstantGratificationResourceList = FromDealResourceReferenceList(in.InstantGratificationResourceList)
+	out.IsExclusive = clonePtr(in.IsExclusive)
+	for i := range in.RelatedReleaseOfferSet {
+		out.RelatedReleaseOfferSet = append(out.RelatedReleaseOfferSet, FromRelatedReleaseOfferSet(&in.RelatedReleaseOfferSet[i]))
+	}
+	out.PhysicalReturns = FromPhysicalReturns(in.PhysicalReturns)
+	out.NumberOfProductsPerCarton = fromIntPtr(in.NumberOfProductsPerCarton)
+	for i := range in.RightsClaimPolicy {
+		out.RightsClaimPolicy = append(out.RightsClaimPolicy, FromRightsClaimPolicy(&in.RightsClaimPolicy[i]))
+	}
+	for i := range in.WebPolicy {
+		out.WebPolicy = append(out.WebPolicy, FromWebPolicy(&in.WebPolicy[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToDealTerms converts a DealTerms message to a ddex.DealTerms
+func ToDealTerms(in *DealTerms) (*ddex.DealTerms, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.DealTerms{}
+	var err error
+	out.LanguageAndScriptCode = in.LanguageAndScriptCode
+	out.IsPreOrderDeal = clonePtr(in.IsPreOrderDeal)
+	out.CommercialModelType = cloneSlice(in.CommercialModelType)
+	if len(in.Usage) > 0 {
+		out.Usage = make([]ddex.Usage, len(in.Usage))
+		for i, m := range in.Usage {
+			v, err := ToUsage(m)
+			if err != nil {
+				return nil, wrapIndex("Usage", i, err)
+			}
+			if v != nil {
+				out.Usage[i] = *v
+			}
+		}
+	}
+	out.AllDealsCancelled = clonePtr(in.AllDealsCancelled)
+	out.TakeDown = clonePtr(in.TakeDown)
+	out.TerritoryCode = cloneSlice(in.TerritoryCode)
+	out.ExcludedTerritoryCode = cloneSlice(in.ExcludedTerritoryCode)
+	if len(in.DistributionChannel) > 0 {
+		out.DistributionChannel = make([]ddex.DSP, len(in.DistributionChannel))
+		for i, m := range in.DistributionChannel {
+			v, err := ToDSP(m)
+			if err != nil {
+				return nil, wrapIndex("DistributionChannel", i, err)
+			}
+			if v != nil {
+				out.DistributionChannel[i] = *v
+			}
+		}
+	}
+	if len(in.ExcludedDistributionChannel) > 0 {
+		out.ExcludedDistributionChannel = make([]ddex.DSP, len(in.ExcludedDistributionChannel))
+		for i, m := range in.ExcludedDistributionChannel {
+			v, err := ToDSP(m)
+			if err != nil {
+				return nil, wrapIndex("ExcludedDistributionChannel", i, err)
+			}
+			if v != nil {
+				out.ExcludedDistributionChannel[i] = *v
+			}
+		}
+	}
+	if len(in.PriceInformation) > 0 {
+		out.PriceInformation = make([]ddex.PriceInformation, len(in.PriceInformation))
+		for i, m := range in.PriceInformation {
+			v, err := ToPriceInformation(m)
+			if err != nil {
+				return nil, wrapIndex("PriceInformation", i, err)
+			}
+			if v != nil {
+				out.PriceInformation[i] = *v
+			}
+		}
+	}
+	out.IsPromotional = clonePtr(in.IsPromotional)
+	if out.PromotionalCode, err = ToPromotionalCode(in.PromotionalCode); err != nil {
+		return nil, wrapField("PromotionalCode", err)
+	}
+	if len(in.ValidityPeriod) > 0 {
+		out.ValidityPeriod = make([]ddex.ValidityPeriod, len(in.ValidityPeriod))
+		for i, m := range in.ValidityPeriod {
+			v, err := ToValidityPeriod(m)
+			if err != nil {
+				return nil, wrapIndex("ValidityPeriod", i, err)
+			}
+			if v != nil {
+				out.ValidityPeriod[i] = *v
+			}
+		}
+	}
+	if out.ConsumerRentalPeriod, err = ToConsumerRentalPeriod(in.ConsumerRentalPeriod); err != nil {
+		return nil, wrapField("ConsumerRentalPeriod", err)
+	}
+	if out.PreOrderReleaseDate, err = ToEventDate(in.PreOrderReleaseDate); err != nil {
+		return nil, wrapField("PreOrderReleaseDate", err)
+	}
+	out.ReleaseDisplayStartDate = in.ReleaseDisplayStartDate
+	out.TrackListingPreviewStartDate = in.TrackListingPreviewStartDate
+	out.CoverArtPreviewStartDate = in.CoverArtPreviewStartDate
+	out.ClipPreviewStartDate = in.ClipPreviewStartDate
+	if out.PreOrderPreviewDate, err = ToEventDate(in.PreOrderPreviewDate); err != nil {
+		return nil, wrapField("PreOrderPreviewDate", err)
+	}
+	if out.PreOrderIncentiveResourceList, err = ToDealResourceReferenceList(in.PreOrderIncentiveResourceList); err != nil {
+		return nil, wrapField("PreOrderIncentiveResourceList", err)
+	}
+	if out.InstantGratificationResourceList, err = ToDealResourceReferenceList(in.InstantGratificationResourceList); err != nil {
+		return nil, wrapField("InstantGratificationResourceList", err)
+	}
+	out.IsExclusive = clonePtr(in.IsExclusive)
+	if len(in.RelatedReleaseOfferSet) > 0 {
+		out.RelatedReleaseOfferSet = make([]ddex.RelatedReleaseOfferSet, len(in.RelatedReleaseOfferSet))
+		for i, m := range in.RelatedReleaseOfferSet {
+			v, err := ToRelatedReleaseOfferSet(m)
+			if err != nil {
+				return nil, wrapIndex("RelatedReleaseOfferSet", i, err)
+			}
+			if v != nil {
+				out.RelatedReleaseOfferSet[i] = *v
+			}
+		}
+	}
+	if out.PhysicalReturns, err = ToPhysicalReturns(in.PhysicalReturns); err != nil {
+		return nil, wrapField("PhysicalReturns", err)
+	}
+	out.NumberOfProductsPerCarton = toIntPtr(in.NumberOfProductsPerCarton)
+	if len(in.RightsClaimPolicy) > 0 {
+		out.RightsClaimPolicy = make([]ddex.RightsClaimPolicy, len(in.RightsClaimPolicy))
+		for i, m := range in.RightsClaimPolicy {
+			v, err := ToRightsClaimPolicy(m)
+			if err != nil {
+				return nil, wrapIndex("RightsClaimPolicy", i, err)
+			}
+			if v != nil {
+				out.RightsClaimPolicy[i] = *v
+			}
+		}
+	}
+	if len(in.WebPolicy) > 0 {
+		out.WebPolicy = make([]ddex.WebPolicy, len(in.WebPolicy))
+		for i, m := range in.WebPolicy {
+			v, err := ToWebPolicy(m)
+			if err != nil {
+				return nil, wrapIndex("WebPolicy", i, err)
+			}
+			if v != nil {
+				out.WebPolicy[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromRatingAgency converts a ddex.RatingAgency to its protobuf message
+func FromRatingAgency(in *ddex.RatingAgency) *RatingAgency {
+	if in == nil {
+		return nil
+	}
+	out := &RatingAgency{}
+	out.Value = in.Value
+	out.Namespace = in.Namespace
+	return out
+}
+
+// ToRatingAgency converts a RatingAgency message to a ddex.RatingAgency
+func ToRatingAgency(in *RatingAgency) (*ddex.RatingAgency, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.RatingAgency{}
+	out.Value = in.Value
+	out.Namespace = in.Namespace
+	return out, nil
+}
+
+// FromFile converts a ddex.File to its protobuf message
+func FromFile(in *ddex.File) *File {
+	if in == nil {
+		return nil
+	}
+	out := &File{}
+	out.FileName = in.FileName
+	out.HashSum = FromHashSum(in.HashSum)
+	out.FileSize = int64(in.FileSize)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToFile converts a File message to a ddex.File
+func ToFile(in *File) (*ddex.File, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.File{}
+	var err error
+	out.FileName = in.FileName
+	if out.HashSum, err = ToHashSum(in.HashSum); err != nil {
+		return nil, wrapField("HashSum", err)
+	}
+	out.FileSize = int(in.FileSize)
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromResourceGroupContentItem converts a ddex.ResourceGroupContentItem to its protobuf message
+func FromResourceGroupContentItem(in *ddex.ResourceGroupContentItem) *ResourceGroupContentItem {
+	if in == nil {
+		return nil
+	}
+	out := &ResourceGroupContentItem{}
+	out.SequenceNumber = int64(in.SequenceNumber)
+	out.ResourceType = in.ResourceType
+	out.ReleaseResourceReference = FromReleaseResourceReference(&in.ReleaseResourceReference)
+	for i := range in.LinkedReleaseResourceReference {
+		out.LinkedReleaseResourceReference = append(out.LinkedReleaseResourceReference, FromLinkedReleaseResourceReference(&in.LinkedReleaseResourceReference[i]))
+	}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToResourceGroupContentItem converts a ResourceGroupContentItem message to a ddex.ResourceGroupContentItem
+func ToResourceGroupContentItem(in *ResourceGroupContentItem) (*ddex.ResourceGroupContentItem, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ResourceGroupContentItem{}
+	out.SequenceNumber = int(in.SequenceNumber)
+	out.ResourceType = in.ResourceType
+	if v, err := ToReleaseResourceReference(in.ReleaseResourceReference); err != nil {
+		return nil, wrapField("ReleaseResourceReference", err)
+	} else if v != nil {
+		out.ReleaseResourceReference = *v
+	}
+	if len(in.LinkedReleaseResourceReference) > 0 {
+		out.LinkedReleaseResourceReference = make([]ddex.LinkedReleaseResourceReference, len(in.LinkedReleaseResourceReference))
+		for i, m := range in.LinkedReleaseResourceReference {
+			v, err := ToLinkedReleaseResourceReference(m)
+			if err != nil {
+				return nil, wrapIndex("LinkedReleaseResourceReference", i, err)
+			}
+			if v != nil {
+				out.LinkedReleaseResourceReference[i] = *v
+			}
+		}
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromUsage converts a ddex.Usage to its protobuf message
+func FromUsage(in *ddex.Usage) *Usage {
+	if in == nil {
+		return nil
+	}
+	out := &Usage{}
+	out.UseType = cloneSlice(in.UseType)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToUsage converts a Usage message to a ddex.Usage
+func ToUsage(in *Usage) (*ddex.Usage, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.Usage{}
+	out.UseType = cloneSlice(in.UseType)
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromDSP converts a ddex.DSP to its protobuf message
+func FromDSP(in *ddex.DSP) *DSP {
+	if in == nil {
+		return nil
+	}
+	out := &DSP{}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToDSP converts a DSP message to a ddex.DSP
+func ToDSP(in *DSP) (*ddex.DSP, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.DSP{}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromPriceInformation converts a ddex.PriceInformation to its protobuf message
+func FromPriceInformation(in *ddex.PriceInformation) *PriceInformation {
+	if in == nil {
+		return nil
+	}
+	out := &PriceInformation{}
+	out.BulkOrderWholesalePricePerUnit = fromDecimalPtr(in.BulkOrderWholesalePricePerUnit)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToPriceInformation converts a PriceInformation message to a ddex.PriceInformation
+func ToPriceInformation(in *PriceInformation) (*ddex.PriceInformation, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.PriceInformation{}
+	var err error
+	if out.BulkOrderWholesalePricePerUnit, err = toDecimalPtr(in.BulkOrderWholesalePricePerUnit); err != nil {
+		return nil, wrapField("BulkOrderWholesalePricePerUnit", err)
+	}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromPromotionalCode converts a ddex.PromotionalCode to its protobuf message
+func FromPromotionalCode(in *ddex.PromotionalCode) *PromotionalCode {
+	if in == nil {
+		return nil
+	}
+	out := &PromotionalCode{}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToPromotionalCode converts a PromotionalCode message to a ddex.PromotionalCode
+func ToPromotionalCode(in *PromotionalCode) (*ddex.PromotionalCode, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.PromotionalCode{}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromValidityPeriod converts a ddex.ValidityPeriod to its protobuf message
+func FromValidityPeriod(in *ddex.ValidityPeriod) *ValidityPeriod {
+	if in == nil {
+		return nil
+	}
+	out := &ValidityPeriod{}
+	out.StartDate = in.StartDate
+	out.StartDateTime = in.StartDateTime
+	out.EndDate = in.EndDate
+	out.EndDateTime = in.EndDateTime
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToValidityPeriod converts a ValidityPeriod message to a ddex.ValidityPeriod
+func ToValidityPeriod(in *ValidityPeriod) (*ddex.ValidityPeriod, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ValidityPeriod{}
+	out.StartDate = in.StartDate
+	out.StartDateTime = in.StartDateTime
+	out.EndDate = in.EndDate
+	out.EndDateTime = in.EndDateTime
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromConsumerRentalPeriod converts a ddex.ConsumerRentalPeriod to its protobuf message
+func FromConsumerRentalPeriod(in *ddex.ConsumerRentalPeriod) *ConsumerRentalPeriod {
+	if in == nil {
+		return nil
+	}
+	out := &ConsumerRentalPeriod{}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToConsumerRentalPeriod converts a ConsumerRentalPeriod message to a ddex.ConsumerRentalPeriod
+func ToConsumerRentalPeriod(in *ConsumerRentalPeriod) (*ddex.ConsumerRentalPeriod, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.ConsumerRentalPeriod{}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromDealResourceReferenceList converts a ddex.DealResourceReferenceList to its protobuf message
+func FromDealResourceReferenceList(in *ddex.DealResourceReferenceList) *DealResourceReferenceList {
+	if in == nil {
+		return nil
+	}
+	out := &DealResourceReferenceList{}
+	out.DealResourceReference = cloneSlice(in.DealResourceReference)
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToDealResourceReferenceList converts a DealResourceReferenceList message to a ddex.DealResourceReferenceList
+func ToDealResourceReferenceList(in *DealResourceReferenceList) (*ddex.DealResourceReferenceList, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.DealResourceReferenceList{}
+	out.DealResourceReference = cloneSlice(in.DealResourceReference)
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromRelatedReleaseOfferSet converts a ddex.RelatedReleaseOfferSet to its protobuf message
+func FromRelatedReleaseOfferSet(in *ddex.RelatedReleaseOfferSet) *RelatedReleaseOfferSet {
+	if in == nil {
+		return nil
+	}
+	out := &RelatedReleaseOfferSet{}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToRelatedReleaseOfferSet converts a RelatedReleaseOfferSet message to a ddex.RelatedReleaseOfferSet
+func ToRelatedReleaseOfferSet(in *RelatedReleaseOfferSet) (*ddex.RelatedReleaseOfferSet, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.RelatedReleaseOfferSet{}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromPhysicalReturns converts a ddex.PhysicalReturns to its protobuf message
+func FromPhysicalReturns(in *ddex.PhysicalReturns) *PhysicalReturns {
+	if in == nil {
+		return nil
+	}
+	out := &PhysicalReturns{}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToPhysicalReturns converts a PhysicalReturns message to a ddex.PhysicalReturns
+func ToPhysicalReturns(in *PhysicalReturns) (*ddex.PhysicalReturns, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.PhysicalReturns{}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromRightsClaimPolicy converts a ddex.RightsClaimPolicy to its protobuf message
+func FromRightsClaimPolicy(in *ddex.RightsClaimPolicy) *RightsClaimPolicy {
+	if in == nil {
+		return nil
+	}
+	out := &RightsClaimPolicy{}
+	out.RightsClaimPolicyType = in.RightsClaimPolicyType
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToRightsClaimPolicy converts a RightsClaimPolicy message to a ddex.RightsClaimPolicy
+func ToRightsClaimPolicy(in *RightsClaimPolicy) (*ddex.RightsClaimPolicy, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.RightsClaimPolicy{}
+	out.RightsClaimPolicyType = in.RightsClaimPolicyType
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromWebPolicy converts a ddex.WebPolicy to its protobuf message
+func FromWebPolicy(in *ddex.WebPolicy) *WebPolicy {
+	if in == nil {
+		return nil
+	}
+	out := &WebPolicy{}
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToWebPolicy converts a WebPolicy message to a ddex.WebPolicy
+func ToWebPolicy(in *WebPolicy) (*ddex.WebPolicy, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.WebPolicy{}
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromHashSum converts a ddex.HashSum to its protobuf message
+func FromHashSum(in *ddex.HashSum) *HashSum {
+	if in == nil {
+		return nil
+	}
+	out := &HashSum{}
+	out.HashSum = in.HashSum
+	out.HashSumAlgorithmType = in.HashSumAlgorithmType
+	for i := range in.Extensions {
+		out.Extensions = append(out.Extensions, FromRawElement(&in.Extensions[i]))
+	}
+	return out
+}
+
+// ToHashSum converts a HashSum message to a ddex.HashSum
+func ToHashSum(in *HashSum) (*ddex.HashSum, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.HashSum{}
+	out.HashSum = in.HashSum
+	out.HashSumAlgorithmType = in.HashSumAlgorithmType
+	if len(in.Extensions) > 0 {
+		out.Extensions = make([]ddex.RawElement, len(in.Extensions))
+		for i, m := range in.Extensions {
+			v, err := ToRawElement(m)
+			if err != nil {
+				return nil, wrapIndex("Extensions", i, err)
+			}
+			if v != nil {
+				out.Extensions[i] = *v
+			}
+		}
+	}
+	return out, nil
+}
+
+// FromLinkedReleaseResourceReference converts a ddex.LinkedReleaseResourceReference to its protobuf message
+func FromLinkedReleaseResourceReference(in *ddex.LinkedReleaseResourceReference) *LinkedReleaseResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := &LinkedReleaseResourceReference{}
+	out.LinkDescription = in.LinkDescription
+	out.Value = in.Value
+	return out
+}
+
+// ToLinkedReleaseResourceReference converts a LinkedReleaseResourceReference message to a ddex.LinkedReleaseResourceReference
+func ToLinkedReleaseResourceReference(in *LinkedReleaseResourceReference) (*ddex.LinkedReleaseResourceReference, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &ddex.LinkedReleaseResourceReference{}
+	out.LinkDescription = in.LinkDescription
+	out.Value = in.Value
+	return out, nil
+}
@@ -0,0 +1,193 @@
+// Package ddexpb is the protobuf representation of the ERN 3.8 model of package ddex, for
+// passing releases, resources and deals between gRPC services. ern38.proto has a message
+// per struct of the model with the same name, and From<Type> and To<Type> convert between
+// the two:
+//
+//	pb := ddexpb.FromNewReleaseMessage(message)
+//	data, err := proto.Marshal(pb)
+//	...
+//	message, err := ddexpb.ToNewReleaseMessage(pb)
+//
+// Fields are named after the struct fields in snake_case (ISRC is isrc, ReleaseId is
+// release_id). DateTime values become DateTime messages, which are
+// google.protobuf.Timestamp fields with the UTC offset of the time, and Decimal values
+// their decimal strings such as "33.33", which To<Type> parses back. Extensions and other
+// attributes are kept as XML, so a message converted to protobuf and back marshals to the
+// same XML.
+package ddexpb
+
+//go:generate go run ../../../cmd/protogen
+//go:generate protoc --go_out=. --go_opt=paths=source_relative ern38.proto
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fieldError is a conversion error at a field path such as ReleaseList.Release[0]
+type fieldError struct {
+	path string
+	err  error
+}
+
+func (e *fieldError) Error() string {
+	return e.path + ": " + e.err.Error()
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+// wrapField prefixes the path of err with a field name
+func wrapField(name string, err error) error {
+	if fe, ok := err.(*fieldError); ok {
+		if strings.HasPrefix(fe.path, "[") {
+			return &fieldError{path: name + fe.path, err: fe.err}
+		}
+		return &fieldError{path: name + "." + fe.path, err: fe.err}
+	}
+	return &fieldError{path: name, err: err}
+}
+
+// wrapIndex prefixes the path of err with an element of a list field
+func wrapIndex(name string, i int, err error) error {
+	return wrapField(fmt.Sprintf("%s[%d]", name, i), err)
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func cloneSlice[T any](s []T) []T {
+	if len(s) == 0 {
+		return nil
+	}
+	return append([]T(nil), s...)
+}
+
+func fromIntPtr(p *int) *int64 {
+	if p == nil {
+		return nil
+	}
+	v := int64(*p)
+	return &v
+}
+
+func toIntPtr(p *int64) *int {
+	if p == nil {
+		return nil
+	}
+	v := int(*p)
+	return &v
+}
+
+func fromInts(s []int) []int64 {
+	var out []int64
+	for _, v := range s {
+		out = append(out, int64(v))
+	}
+	return out
+}
+
+func toInts(s []int64) []int {
+	var out []int
+	for _, v := range s {
+		out = append(out, int(v))
+	}
+	return out
+}
+
+func fromDateTime(dt *ddex.DateTime) *DateTime {
+	if dt == nil {
+		return nil
+	}
+	_, offset := dt.Time.Zone()
+	return &DateTime{
+		Seconds:          dt.Time.Unix(),
+		Nanos:            int32(dt.Time.Nanosecond()),
+		UtcOffsetSeconds: int32(offset),
+	}
+}
+
+// maxUTCOffset is the largest UTC offset toDateTime accepts, as for time.FixedZone
+const maxUTCOffset = 24 * 60 * 60
+
+func toDateTime(dt *DateTime) (*ddex.DateTime, error) {
+	if dt == nil {
+		return nil, nil
+	}
+	if err := (&timestamppb.Timestamp{Seconds: dt.GetSeconds(), Nanos: dt.GetNanos()}).CheckValid(); err != nil {
+		return nil, err
+	}
+	offset := dt.GetUtcOffsetSeconds()
+	if offset <= -maxUTCOffset || offset >= maxUTCOffset {
+		return nil, fmt.Errorf("invalid UTC offset of %d seconds", offset)
+	}
+	location := time.UTC
+	if offset != 0 {
+		location = time.FixedZone("", int(offset))
+	}
+	return &ddex.DateTime{Time: time.Unix(dt.GetSeconds(), int64(dt.GetNanos())).In(location)}, nil
+}
+
+func fromDecimalPtr(d *ddex.Decimal) *string {
+	if d == nil {
+		return nil
+	}
+	s := d.String()
+	return &s
+}
+
+func toDecimal(s string) (ddex.Decimal, error) {
+	if s == "" {
+		return ddex.Decimal{}, nil
+	}
+	return ddex.ParseDecimal(s)
+}
+
+func toDecimalPtr(s *string) (*ddex.Decimal, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d, err := ddex.ParseDecimal(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func fromXMLName(name xml.Name) *XmlName {
+	if name.Space == "" && name.Local == "" {
+		return nil
+	}
+	return &XmlName{Space: name.Space, Local: name.Local}
+}
+
+func toXMLName(name *XmlName) xml.Name {
+	return xml.Name{Space: name.GetSpace(), Local: name.GetLocal()}
+}
+
+func fromXMLAttrs(attrs []xml.Attr) []*XmlAttr {
+	var out []*XmlAttr
+	for _, attr := range attrs {
+		out = append(out, &XmlAttr{Name: fromXMLName(attr.Name), Value: attr.Value})
+	}
+	return out
+}
+
+func toXMLAttrs(attrs []*XmlAttr) []xml.Attr {
+	var out []xml.Attr
+	for _, attr := range attrs {
+		out = append(out, xml.Attr{Name: toXMLName(attr.GetName()), Value: attr.GetValue()})
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+package ddexpb
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewReleaseMessageRoundTrip(t *testing.T) {
+	for _, created := range []string{"2022-07-01T18:59:21+02:00", "2022-07-01T18:59:21-05:30", "2022-07-01T16:59:21Z"} {
+		message, err := ddex.GenerateSample(ddex.SampleAudioAlbum, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		at, err := time.Parse(time.RFC3339, created)
+		if err != nil {
+			t.Fatal(err)
+		}
+		message.MessageHeader.MessageCreatedDateTime = &ddex.DateTime{Time: at}
+		want, err := message.ToXML()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := proto.Marshal(FromNewReleaseMessage(message))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var pb NewReleaseMessage
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			t.Fatal(err)
+		}
+		back, err := ToNewReleaseMessage(&pb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := back.ToXML()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: XML changed in the round trip", created)
+		}
+		if !bytes.Contains(got, []byte("<MessageCreatedDateTime>"+created+"</MessageCreatedDateTime>")) {
+			t.Errorf("%s: MessageCreatedDateTime not kept", created)
+		}
+	}
+}
+
+func TestDateTimeDecodesAsTimestamp(t *testing.T) {
+	at := time.Date(2022, 7, 1, 18, 59, 21, 500, time.FixedZone("", 2*60*60))
+	data, err := proto.Marshal(fromDateTime(&ddex.DateTime{Time: at}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ts timestamppb.Timestamp
+	if err := proto.Unmarshal(data, &ts); err != nil {
+		t.Fatal(err)
+	}
+	if !ts.AsTime().Equal(at) {
+		t.Errorf("decoded as Timestamp %v, want %v", ts.AsTime(), at)
+	}
+}
+
+func TestToDateTimeInvalid(t *testing.T) {
+	for _, dt := range []*DateTime{
+		{Seconds: 0, Nanos: 1e9},
+		{Seconds: 0, UtcOffsetSeconds: 24 * 60 * 60},
+		{Seconds: 0, UtcOffsetSeconds: -24 * 60 * 60},
+	} {
+		if _, err := toDateTime(dt); err == nil {
+			t.Errorf("toDateTime(%v) returned no error", dt)
+		}
+	}
+}
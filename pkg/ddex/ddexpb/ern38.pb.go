@@ -0,0 +1,11236 @@
+// Code generated by protogen from package ddex. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: ern38.proto
+
+package ddexpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NewReleaseMessage represents the complete DDEX ERN 3.8 NewReleaseMessage structure
+// specifically configured for YouTube delivery
+type NewReleaseMessage struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	XmlnsErn               string                 `protobuf:"bytes,1,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty"`
+	XmlnsXsi               string                 `protobuf:"bytes,2,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty"`
+	XsiSchemaLocation      string                 `protobuf:"bytes,3,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty"`
+	MessageSchemaVersionId string                 `protobuf:"bytes,4,opt,name=message_schema_version_id,json=messageSchemaVersionId,proto3" json:"message_schema_version_id,omitempty"`
+	LanguageAndScriptCode  string                 `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	OtherAttr              []*XmlAttr             `protobuf:"bytes,6,rep,name=other_attr,json=otherAttr,proto3" json:"other_attr,omitempty"` // Other namespace declarations and attributes of the root, kept by FromXML
+	MessageHeader          *MessageHeader         `protobuf:"bytes,7,opt,name=message_header,json=messageHeader,proto3" json:"message_header,omitempty"`
+	UpdateIndicator        string                 `protobuf:"bytes,8,opt,name=update_indicator,json=updateIndicator,proto3" json:"update_indicator,omitempty"` // Deprecated: OriginalMessage or UpdateMessage
+	ResourceList           *ResourceList          `protobuf:"bytes,9,opt,name=resource_list,json=resourceList,proto3" json:"resource_list,omitempty"`
+	CollectionList         *CollectionList        `protobuf:"bytes,10,opt,name=collection_list,json=collectionList,proto3" json:"collection_list,omitempty"`
+	ReleaseList            *ReleaseList           `protobuf:"bytes,11,opt,name=release_list,json=releaseList,proto3" json:"release_list,omitempty"`
+	DealList               *DealList              `protobuf:"bytes,12,opt,name=deal_list,json=dealList,proto3" json:"deal_list,omitempty"`
+	Extensions             []*RawElement          `protobuf:"bytes,13,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *NewReleaseMessage) Reset() {
+	*x = NewReleaseMessage{}
+	mi := &file_ern38_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewReleaseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewReleaseMessage) ProtoMessage() {}
+
+func (x *NewReleaseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewReleaseMessage.ProtoReflect.Descriptor instead.
+func (*NewReleaseMessage) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NewReleaseMessage) GetXmlnsErn() string {
+	if x != nil {
+		return x.XmlnsErn
+	}
+	return ""
+}
+
+func (x *NewReleaseMessage) GetXmlnsXsi() string {
+	if x != nil {
+		return x.XmlnsXsi
+	}
+	return ""
+}
+
+func (x *NewReleaseMessage) GetXsiSchemaLocation() string {
+	if x != nil {
+		return x.XsiSchemaLocation
+	}
+	return ""
+}
+
+func (x *NewReleaseMessage) GetMessageSchemaVersionId() string {
+	if x != nil {
+		return x.MessageSchemaVersionId
+	}
+	return ""
+}
+
+func (x *NewReleaseMessage) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *NewReleaseMessage) GetOtherAttr() []*XmlAttr {
+	if x != nil {
+		return x.OtherAttr
+	}
+	return nil
+}
+
+func (x *NewReleaseMessage) GetMessageHeader() *MessageHeader {
+	if x != nil {
+		return x.MessageHeader
+	}
+	return nil
+}
+
+func (x *NewReleaseMessage) GetUpdateIndicator() string {
+	if x != nil {
+		return x.UpdateIndicator
+	}
+	return ""
+}
+
+func (x *NewReleaseMessage) GetResourceList() *ResourceList {
+	if x != nil {
+		return x.ResourceList
+	}
+	return nil
+}
+
+func (x *NewReleaseMessage) GetCollectionList() *CollectionList {
+	if x != nil {
+		return x.CollectionList
+	}
+	return nil
+}
+
+func (x *NewReleaseMessage) GetReleaseList() *ReleaseList {
+	if x != nil {
+		return x.ReleaseList
+	}
+	return nil
+}
+
+func (x *NewReleaseMessage) GetDealList() *DealList {
+	if x != nil {
+		return x.DealList
+	}
+	return nil
+}
+
+func (x *NewReleaseMessage) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// MessageHeader contains information about the sender and recipient, including their unique
+// DDEX Party IDs (DPIDs), and a timestamp indicating when the message was created.
+type MessageHeader struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	MessageThreadId        string                 `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3" json:"message_thread_id,omitempty"`
+	MessageId              string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	MessageFileName        string                 `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3" json:"message_file_name,omitempty"`
+	MessageSender          *MessageSender         `protobuf:"bytes,4,opt,name=message_sender,json=messageSender,proto3" json:"message_sender,omitempty"`
+	SentOnBehalfOf         *SentOnBehalfOf        `protobuf:"bytes,5,opt,name=sent_on_behalf_of,json=sentOnBehalfOf,proto3" json:"sent_on_behalf_of,omitempty"`
+	MessageRecipient       []*MessageRecipient    `protobuf:"bytes,6,rep,name=message_recipient,json=messageRecipient,proto3" json:"message_recipient,omitempty"`
+	MessageCreatedDateTime *DateTime              `protobuf:"bytes,7,opt,name=message_created_date_time,json=messageCreatedDateTime,proto3" json:"message_created_date_time,omitempty"`
+	MessageAuditTrail      *MessageAuditTrail     `protobuf:"bytes,8,opt,name=message_audit_trail,json=messageAuditTrail,proto3" json:"message_audit_trail,omitempty"`
+	Comment                string                 `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	MessageControlType     string                 `protobuf:"bytes,10,opt,name=message_control_type,json=messageControlType,proto3" json:"message_control_type,omitempty"`
+	Extensions             []*RawElement          `protobuf:"bytes,11,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MessageHeader) Reset() {
+	*x = MessageHeader{}
+	mi := &file_ern38_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageHeader) ProtoMessage() {}
+
+func (x *MessageHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageHeader.ProtoReflect.Descriptor instead.
+func (*MessageHeader) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MessageHeader) GetMessageThreadId() string {
+	if x != nil {
+		return x.MessageThreadId
+	}
+	return ""
+}
+
+func (x *MessageHeader) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageHeader) GetMessageFileName() string {
+	if x != nil {
+		return x.MessageFileName
+	}
+	return ""
+}
+
+func (x *MessageHeader) GetMessageSender() *MessageSender {
+	if x != nil {
+		return x.MessageSender
+	}
+	return nil
+}
+
+func (x *MessageHeader) GetSentOnBehalfOf() *SentOnBehalfOf {
+	if x != nil {
+		return x.SentOnBehalfOf
+	}
+	return nil
+}
+
+func (x *MessageHeader) GetMessageRecipient() []*MessageRecipient {
+	if x != nil {
+		return x.MessageRecipient
+	}
+	return nil
+}
+
+func (x *MessageHeader) GetMessageCreatedDateTime() *DateTime {
+	if x != nil {
+		return x.MessageCreatedDateTime
+	}
+	return nil
+}
+
+func (x *MessageHeader) GetMessageAuditTrail() *MessageAuditTrail {
+	if x != nil {
+		return x.MessageAuditTrail
+	}
+	return nil
+}
+
+func (x *MessageHeader) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *MessageHeader) GetMessageControlType() string {
+	if x != nil {
+		return x.MessageControlType
+	}
+	return ""
+}
+
+func (x *MessageHeader) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ResourceList lists all Resources composites in a release
+type ResourceList struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SoundRecording []*SoundRecording      `protobuf:"bytes,1,rep,name=sound_recording,json=soundRecording,proto3" json:"sound_recording,omitempty"`
+	Video          []*Video               `protobuf:"bytes,2,rep,name=video,proto3" json:"video,omitempty"`
+	Image          []*Image               `protobuf:"bytes,3,rep,name=image,proto3" json:"image,omitempty"`
+	Text           []*Text                `protobuf:"bytes,4,rep,name=text,proto3" json:"text,omitempty"`
+	Extensions     []*RawElement          `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResourceList) Reset() {
+	*x = ResourceList{}
+	mi := &file_ern38_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceList) ProtoMessage() {}
+
+func (x *ResourceList) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceList.ProtoReflect.Descriptor instead.
+func (*ResourceList) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResourceList) GetSoundRecording() []*SoundRecording {
+	if x != nil {
+		return x.SoundRecording
+	}
+	return nil
+}
+
+func (x *ResourceList) GetVideo() []*Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *ResourceList) GetImage() []*Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *ResourceList) GetText() []*Text {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *ResourceList) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// CollectionList represents collections (playlists, compilations)
+type CollectionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    []*Collection          `protobuf:"bytes,1,rep,name=collection,proto3" json:"collection,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionList) Reset() {
+	*x = CollectionList{}
+	mi := &file_ern38_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionList) ProtoMessage() {}
+
+func (x *CollectionList) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionList.ProtoReflect.Descriptor instead.
+func (*CollectionList) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CollectionList) GetCollection() []*Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *CollectionList) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ReleaseList lists all the Release composites
+type ReleaseList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Release       []*Release             `protobuf:"bytes,1,rep,name=release,proto3" json:"release,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseList) Reset() {
+	*x = ReleaseList{}
+	mi := &file_ern38_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseList) ProtoMessage() {}
+
+func (x *ReleaseList) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseList.ProtoReflect.Descriptor instead.
+func (*ReleaseList) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReleaseList) GetRelease() []*Release {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *ReleaseList) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// DealList lists all Deal composites
+type DealList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseDeal   []*ReleaseDeal         `protobuf:"bytes,1,rep,name=release_deal,json=releaseDeal,proto3" json:"release_deal,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DealList) Reset() {
+	*x = DealList{}
+	mi := &file_ern38_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DealList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealList) ProtoMessage() {}
+
+func (x *DealList) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealList.ProtoReflect.Descriptor instead.
+func (*DealList) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DealList) GetReleaseDeal() []*ReleaseDeal {
+	if x != nil {
+		return x.ReleaseDeal
+	}
+	return nil
+}
+
+func (x *DealList) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// RawElement is a child element the model has no field for, such as a proprietary or
+// not-yet-modeled element of a third-party message. FromXML keeps these in the Extensions
+// field of the enclosing composite, and they are written back verbatim after the modeled
+// children when the message is marshaled.
+//
+// Name and Attr use the literal prefixed form ("yt:Policy", "xmlns:yt") where the prefix is
+// declared on the element itself or is a well-known one; otherwise Name.Space holds the
+// namespace URI and it is redeclared on output. InnerXML is the raw content, so prefixes
+// used inside it must be declared on the element or on the message root.
+type RawElement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *XmlName               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Attr          []*XmlAttr             `protobuf:"bytes,2,rep,name=attr,proto3" json:"attr,omitempty"`
+	InnerXml      string                 `protobuf:"bytes,3,opt,name=inner_xml,json=innerXml,proto3" json:"inner_xml,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RawElement) Reset() {
+	*x = RawElement{}
+	mi := &file_ern38_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RawElement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawElement) ProtoMessage() {}
+
+func (x *RawElement) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawElement.ProtoReflect.Descriptor instead.
+func (*RawElement) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RawElement) GetName() *XmlName {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *RawElement) GetAttr() []*XmlAttr {
+	if x != nil {
+		return x.Attr
+	}
+	return nil
+}
+
+func (x *RawElement) GetInnerXml() string {
+	if x != nil {
+		return x.InnerXml
+	}
+	return ""
+}
+
+// MessageSender represents the sender of the DDEX message
+type MessageSender struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartyId       []*PartyID             `protobuf:"bytes,1,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	PartyName     []*Name                `protobuf:"bytes,2,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty"`
+	TradingName   string                 `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageSender) Reset() {
+	*x = MessageSender{}
+	mi := &file_ern38_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageSender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageSender) ProtoMessage() {}
+
+func (x *MessageSender) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageSender.ProtoReflect.Descriptor instead.
+func (*MessageSender) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MessageSender) GetPartyId() []*PartyID {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *MessageSender) GetPartyName() []*Name {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
+func (x *MessageSender) GetTradingName() string {
+	if x != nil {
+		return x.TradingName
+	}
+	return ""
+}
+
+func (x *MessageSender) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// SentOnBehalfOf represents the party the sender sends the message for, such as a label an
+// aggregator delivers on behalf of
+type SentOnBehalfOf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartyId       []*PartyID             `protobuf:"bytes,1,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	PartyName     []*Name                `protobuf:"bytes,2,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty"`
+	TradingName   string                 `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentOnBehalfOf) Reset() {
+	*x = SentOnBehalfOf{}
+	mi := &file_ern38_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentOnBehalfOf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentOnBehalfOf) ProtoMessage() {}
+
+func (x *SentOnBehalfOf) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentOnBehalfOf.ProtoReflect.Descriptor instead.
+func (*SentOnBehalfOf) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SentOnBehalfOf) GetPartyId() []*PartyID {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *SentOnBehalfOf) GetPartyName() []*Name {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
+func (x *SentOnBehalfOf) GetTradingName() string {
+	if x != nil {
+		return x.TradingName
+	}
+	return ""
+}
+
+func (x *SentOnBehalfOf) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// MessageRecipient represents the recipient of the DDEX message
+type MessageRecipient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartyId       []*PartyID             `protobuf:"bytes,1,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	PartyName     []*Name                `protobuf:"bytes,2,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty"`
+	TradingName   string                 `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageRecipient) Reset() {
+	*x = MessageRecipient{}
+	mi := &file_ern38_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRecipient) ProtoMessage() {}
+
+func (x *MessageRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRecipient.ProtoReflect.Descriptor instead.
+func (*MessageRecipient) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MessageRecipient) GetPartyId() []*PartyID {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *MessageRecipient) GetPartyName() []*Name {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
+func (x *MessageRecipient) GetTradingName() string {
+	if x != nil {
+		return x.TradingName
+	}
+	return ""
+}
+
+func (x *MessageRecipient) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// MessageAuditTrail represents audit trail information for the message
+type MessageAuditTrail struct {
+	state                  protoimpl.MessageState    `protogen:"open.v1"`
+	MessageAuditTrailEvent []*MessageAuditTrailEvent `protobuf:"bytes,1,rep,name=message_audit_trail_event,json=messageAuditTrailEvent,proto3" json:"message_audit_trail_event,omitempty"`
+	Extensions             []*RawElement             `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MessageAuditTrail) Reset() {
+	*x = MessageAuditTrail{}
+	mi := &file_ern38_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageAuditTrail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageAuditTrail) ProtoMessage() {}
+
+func (x *MessageAuditTrail) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageAuditTrail.ProtoReflect.Descriptor instead.
+func (*MessageAuditTrail) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MessageAuditTrail) GetMessageAuditTrailEvent() []*MessageAuditTrailEvent {
+	if x != nil {
+		return x.MessageAuditTrailEvent
+	}
+	return nil
+}
+
+func (x *MessageAuditTrail) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// SoundRecording represents an audio resource for ERN 3.8
+type SoundRecording struct {
+	state                                  protoimpl.MessageState                  `protogen:"open.v1"`
+	IsUpdated                              *bool                                   `protobuf:"varint,1,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty"` // Deprecated
+	LanguageAndScriptCode                  string                                  `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	SoundRecordingType                     string                                  `protobuf:"bytes,3,opt,name=sound_recording_type,json=soundRecordingType,proto3" json:"sound_recording_type,omitempty"`
+	IsArtistRelated                        *bool                                   `protobuf:"varint,4,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty"`
+	SoundRecordingId                       []*SoundRecordingId                     `protobuf:"bytes,5,rep,name=sound_recording_id,json=soundRecordingId,proto3" json:"sound_recording_id,omitempty"`                           // Mandatory 1-n
+	IndirectSoundRecordingId               []*MusicalWorkId                        `protobuf:"bytes,6,rep,name=indirect_sound_recording_id,json=indirectSoundRecordingId,proto3" json:"indirect_sound_recording_id,omitempty"` // 0-n
+	ResourceReference                      string                                  `protobuf:"bytes,7,opt,name=resource_reference,json=resourceReference,proto3" json:"resource_reference,omitempty"`                          // Mandatory (ID)
+	ReferenceTitle                         *ReferenceTitle                         `protobuf:"bytes,8,opt,name=reference_title,json=referenceTitle,proto3" json:"reference_title,omitempty"`                                   // Mandatory
+	InstrumentationDescription             *Description                            `protobuf:"bytes,9,opt,name=instrumentation_description,json=instrumentationDescription,proto3" json:"instrumentation_description,omitempty"`
+	IsMedley                               *bool                                   `protobuf:"varint,10,opt,name=is_medley,json=isMedley,proto3,oneof" json:"is_medley,omitempty"`
+	IsPotpourri                            *bool                                   `protobuf:"varint,11,opt,name=is_potpourri,json=isPotpourri,proto3,oneof" json:"is_potpourri,omitempty"`
+	IsInstrumental                         *bool                                   `protobuf:"varint,12,opt,name=is_instrumental,json=isInstrumental,proto3,oneof" json:"is_instrumental,omitempty"`
+	IsBackground                           *bool                                   `protobuf:"varint,13,opt,name=is_background,json=isBackground,proto3,oneof" json:"is_background,omitempty"`
+	IsHiddenResource                       *bool                                   `protobuf:"varint,14,opt,name=is_hidden_resource,json=isHiddenResource,proto3,oneof" json:"is_hidden_resource,omitempty"`
+	IsBonusResource                        *bool                                   `protobuf:"varint,15,opt,name=is_bonus_resource,json=isBonusResource,proto3,oneof" json:"is_bonus_resource,omitempty"` // Deprecated
+	HasPreOrderFulfillment                 *bool                                   `protobuf:"varint,16,opt,name=has_pre_order_fulfillment,json=hasPreOrderFulfillment,proto3,oneof" json:"has_pre_order_fulfillment,omitempty"`
+	IsRemastered                           *bool                                   `protobuf:"varint,17,opt,name=is_remastered,json=isRemastered,proto3,oneof" json:"is_remastered,omitempty"`
+	NoSilenceBefore                        *bool                                   `protobuf:"varint,18,opt,name=no_silence_before,json=noSilenceBefore,proto3,oneof" json:"no_silence_before,omitempty"`
+	NoSilenceAfter                         *bool                                   `protobuf:"varint,19,opt,name=no_silence_after,json=noSilenceAfter,proto3,oneof" json:"no_silence_after,omitempty"`
+	PerformerInformationRequired           *bool                                   `protobuf:"varint,20,opt,name=performer_information_required,json=performerInformationRequired,proto3,oneof" json:"performer_information_required,omitempty"`
+	LanguageOfPerformance                  []string                                `protobuf:"bytes,21,rep,name=language_of_performance,json=languageOfPerformance,proto3" json:"language_of_performance,omitempty"` // ISO 639-2
+	Duration                               string                                  `protobuf:"bytes,22,opt,name=duration,proto3" json:"duration,omitempty"`                                                          // Mandatory
+	RightsAgreementId                      *RightsAgreementId                      `protobuf:"bytes,23,opt,name=rights_agreement_id,json=rightsAgreementId,proto3" json:"rights_agreement_id,omitempty"`
+	ResourceMusicalWorkReferenceList       *ResourceMusicalWorkReferenceList       `protobuf:"bytes,24,opt,name=resource_musical_work_reference_list,json=resourceMusicalWorkReferenceList,proto3" json:"resource_musical_work_reference_list,omitempty"`
+	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `protobuf:"bytes,25,opt,name=resource_contained_resource_reference_list,json=resourceContainedResourceReferenceList,proto3" json:"resource_contained_resource_reference_list,omitempty"`
+	CreationDate                           *EventDate                              `protobuf:"bytes,26,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	MasteredDate                           *EventDate                              `protobuf:"bytes,27,opt,name=mastered_date,json=masteredDate,proto3" json:"mastered_date,omitempty"`
+	RemasteredDate                         *EventDate                              `protobuf:"bytes,28,opt,name=remastered_date,json=remasteredDate,proto3" json:"remastered_date,omitempty"`
+	SoundRecordingDetailsByTerritory       []*SoundRecordingDetailsByTerritory     `protobuf:"bytes,29,rep,name=sound_recording_details_by_territory,json=soundRecordingDetailsByTerritory,proto3" json:"sound_recording_details_by_territory,omitempty"` // Mandatory 1-n
+	TerritoryOfCommissioning               string                                  `protobuf:"bytes,30,opt,name=territory_of_commissioning,json=territoryOfCommissioning,proto3" json:"territory_of_commissioning,omitempty"`
+	NumberOfFeaturedArtists                *int64                                  `protobuf:"varint,31,opt,name=number_of_featured_artists,json=numberOfFeaturedArtists,proto3,oneof" json:"number_of_featured_artists,omitempty"`
+	NumberOfNonFeaturedArtists             *int64                                  `protobuf:"varint,32,opt,name=number_of_non_featured_artists,json=numberOfNonFeaturedArtists,proto3,oneof" json:"number_of_non_featured_artists,omitempty"`
+	NumberOfContractedArtists              *int64                                  `protobuf:"varint,33,opt,name=number_of_contracted_artists,json=numberOfContractedArtists,proto3,oneof" json:"number_of_contracted_artists,omitempty"`
+	NumberOfNonContractedArtists           *int64                                  `protobuf:"varint,34,opt,name=number_of_non_contracted_artists,json=numberOfNonContractedArtists,proto3,oneof" json:"number_of_non_contracted_artists,omitempty"`
+	Extensions                             []*RawElement                           `protobuf:"bytes,35,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *SoundRecording) Reset() {
+	*x = SoundRecording{}
+	mi := &file_ern38_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoundRecording) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoundRecording) ProtoMessage() {}
+
+func (x *SoundRecording) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoundRecording.ProtoReflect.Descriptor instead.
+func (*SoundRecording) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SoundRecording) GetIsUpdated() bool {
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
+	}
+	return false
+}
+
+func (x *SoundRecording) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *SoundRecording) GetSoundRecordingType() string {
+	if x != nil {
+		return x.SoundRecordingType
+	}
+	return ""
+}
+
+func (x *SoundRecording) GetIsArtistRelated() bool {
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
+	}
+	return false
+}
+
+func (x *SoundRecording) GetSoundRecordingId() []*SoundRecordingId {
+	if x != nil {
+		return x.SoundRecordingId
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetIndirectSoundRecordingId() []*MusicalWorkId {
+	if x != nil {
+		return x.IndirectSoundRecordingId
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetResourceReference() string {
+	if x != nil {
+		return x.ResourceReference
+	}
+	return ""
+}
+
+func (x *SoundRecording) GetReferenceTitle() *ReferenceTitle {
+	if x != nil {
+		return x.ReferenceTitle
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetInstrumentationDescription() *Description {
+	if x != nil {
+		return x.InstrumentationDescription
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetIsMedley() bool {
+	if x != nil && x.IsMedley != nil {
+		return *x.IsMedley
+	}
+	return false
+}
+
+func (x *SoundRecording) GetIsPotpourri() bool {
+	if x != nil && x.IsPotpourri != nil {
+		return *x.IsPotpourri
+	}
+	return false
+}
+
+func (x *SoundRecording) GetIsInstrumental() bool {
+	if x != nil && x.IsInstrumental != nil {
+		return *x.IsInstrumental
+	}
+	return false
+}
+
+func (x *SoundRecording) GetIsBackground() bool {
+	if x != nil && x.IsBackground != nil {
+		return *x.IsBackground
+	}
+	return false
+}
+
+func (x *SoundRecording) GetIsHiddenResource() bool {
+	if x != nil && x.IsHiddenResource != nil {
+		return *x.IsHiddenResource
+	}
+	return false
+}
+
+func (x *SoundRecording) GetIsBonusResource() bool {
+	if x != nil && x.IsBonusResource != nil {
+		return *x.IsBonusResource
+	}
+	return false
+}
+
+func (x *SoundRecording) GetHasPreOrderFulfillment() bool {
+	if x != nil && x.HasPreOrderFulfillment != nil {
+		return *x.HasPreOrderFulfillment
+	}
+	return false
+}
+
+func (x *SoundRecording) GetIsRemastered() bool {
+	if x != nil && x.IsRemastered != nil {
+		return *x.IsRemastered
+	}
+	return false
+}
+
+func (x *SoundRecording) GetNoSilenceBefore() bool {
+	if x != nil && x.NoSilenceBefore != nil {
+		return *x.NoSilenceBefore
+	}
+	return false
+}
+
+func (x *SoundRecording) GetNoSilenceAfter() bool {
+	if x != nil && x.NoSilenceAfter != nil {
+		return *x.NoSilenceAfter
+	}
+	return false
+}
+
+func (x *SoundRecording) GetPerformerInformationRequired() bool {
+	if x != nil && x.PerformerInformationRequired != nil {
+		return *x.PerformerInformationRequired
+	}
+	return false
+}
+
+func (x *SoundRecording) GetLanguageOfPerformance() []string {
+	if x != nil {
+		return x.LanguageOfPerformance
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *SoundRecording) GetRightsAgreementId() *RightsAgreementId {
+	if x != nil {
+		return x.RightsAgreementId
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetResourceMusicalWorkReferenceList() *ResourceMusicalWorkReferenceList {
+	if x != nil {
+		return x.ResourceMusicalWorkReferenceList
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetResourceContainedResourceReferenceList() *ResourceContainedResourceReferenceList {
+	if x != nil {
+		return x.ResourceContainedResourceReferenceList
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetCreationDate() *EventDate {
+	if x != nil {
+		return x.CreationDate
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetMasteredDate() *EventDate {
+	if x != nil {
+		return x.MasteredDate
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetRemasteredDate() *EventDate {
+	if x != nil {
+		return x.RemasteredDate
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetSoundRecordingDetailsByTerritory() []*SoundRecordingDetailsByTerritory {
+	if x != nil {
+		return x.SoundRecordingDetailsByTerritory
+	}
+	return nil
+}
+
+func (x *SoundRecording) GetTerritoryOfCommissioning() string {
+	if x != nil {
+		return x.TerritoryOfCommissioning
+	}
+	return ""
+}
+
+func (x *SoundRecording) GetNumberOfFeaturedArtists() int64 {
+	if x != nil && x.NumberOfFeaturedArtists != nil {
+		return *x.NumberOfFeaturedArtists
+	}
+	return 0
+}
+
+func (x *SoundRecording) GetNumberOfNonFeaturedArtists() int64 {
+	if x != nil && x.NumberOfNonFeaturedArtists != nil {
+		return *x.NumberOfNonFeaturedArtists
+	}
+	return 0
+}
+
+func (x *SoundRecording) GetNumberOfContractedArtists() int64 {
+	if x != nil && x.NumberOfContractedArtists != nil {
+		return *x.NumberOfContractedArtists
+	}
+	return 0
+}
+
+func (x *SoundRecording) GetNumberOfNonContractedArtists() int64 {
+	if x != nil && x.NumberOfNonContractedArtists != nil {
+		return *x.NumberOfNonContractedArtists
+	}
+	return 0
+}
+
+func (x *SoundRecording) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Video represents a video resource for ERN 3.8
+type Video struct {
+	state                                  protoimpl.MessageState                  `protogen:"open.v1"`
+	IsUpdated                              *bool                                   `protobuf:"varint,1,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty"` // Deprecated
+	LanguageAndScriptCode                  string                                  `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	VideoType                              *VideoType                              `protobuf:"bytes,3,opt,name=video_type,json=videoType,proto3" json:"video_type,omitempty"`
+	IsArtistRelated                        *bool                                   `protobuf:"varint,4,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty"`
+	VideoId                                *VideoId                                `protobuf:"bytes,5,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`                                                          // 0-1
+	IndirectVideoId                        []*MusicalWorkId                        `protobuf:"bytes,6,rep,name=indirect_video_id,json=indirectVideoId,proto3" json:"indirect_video_id,omitempty"`                                // 0-n
+	ResourceReference                      string                                  `protobuf:"bytes,7,opt,name=resource_reference,json=resourceReference,proto3" json:"resource_reference,omitempty"`                            // Mandatory (ID)
+	VideoCueSheetReference                 []*VideoCueSheetReference               `protobuf:"bytes,8,rep,name=video_cue_sheet_reference,json=videoCueSheetReference,proto3" json:"video_cue_sheet_reference,omitempty"`         // 1-n if present
+	ReasonForCueSheetAbsence               *Reason                                 `protobuf:"bytes,9,opt,name=reason_for_cue_sheet_absence,json=reasonForCueSheetAbsence,proto3" json:"reason_for_cue_sheet_absence,omitempty"` // 1 if present
+	ReferenceTitle                         *ReferenceTitle                         `protobuf:"bytes,10,opt,name=reference_title,json=referenceTitle,proto3" json:"reference_title,omitempty"`
+	Title                                  []*Title                                `protobuf:"bytes,11,rep,name=title,proto3" json:"title,omitempty"` // 0-n
+	InstrumentationDescription             *Description                            `protobuf:"bytes,12,opt,name=instrumentation_description,json=instrumentationDescription,proto3" json:"instrumentation_description,omitempty"`
+	IsMedley                               *bool                                   `protobuf:"varint,13,opt,name=is_medley,json=isMedley,proto3,oneof" json:"is_medley,omitempty"`
+	IsPotpourri                            *bool                                   `protobuf:"varint,14,opt,name=is_potpourri,json=isPotpourri,proto3,oneof" json:"is_potpourri,omitempty"`
+	IsInstrumental                         *bool                                   `protobuf:"varint,15,opt,name=is_instrumental,json=isInstrumental,proto3,oneof" json:"is_instrumental,omitempty"`
+	IsBackground                           *bool                                   `protobuf:"varint,16,opt,name=is_background,json=isBackground,proto3,oneof" json:"is_background,omitempty"`
+	IsHiddenResource                       *bool                                   `protobuf:"varint,17,opt,name=is_hidden_resource,json=isHiddenResource,proto3,oneof" json:"is_hidden_resource,omitempty"`
+	IsBonusResource                        *bool                                   `protobuf:"varint,18,opt,name=is_bonus_resource,json=isBonusResource,proto3,oneof" json:"is_bonus_resource,omitempty"` // Deprecated
+	HasPreOrderFulfillment                 *bool                                   `protobuf:"varint,19,opt,name=has_pre_order_fulfillment,json=hasPreOrderFulfillment,proto3,oneof" json:"has_pre_order_fulfillment,omitempty"`
+	IsRemastered                           *bool                                   `protobuf:"varint,20,opt,name=is_remastered,json=isRemastered,proto3,oneof" json:"is_remastered,omitempty"`
+	NoSilenceBefore                        *bool                                   `protobuf:"varint,21,opt,name=no_silence_before,json=noSilenceBefore,proto3,oneof" json:"no_silence_before,omitempty"`
+	NoSilenceAfter                         *bool                                   `protobuf:"varint,22,opt,name=no_silence_after,json=noSilenceAfter,proto3,oneof" json:"no_silence_after,omitempty"`
+	PerformerInformationRequired           *bool                                   `protobuf:"varint,23,opt,name=performer_information_required,json=performerInformationRequired,proto3,oneof" json:"performer_information_required,omitempty"`
+	LanguageOfPerformance                  []string                                `protobuf:"bytes,24,rep,name=language_of_performance,json=languageOfPerformance,proto3" json:"language_of_performance,omitempty"` // ISO 639-2
+	LanguageOfDubbing                      []string                                `protobuf:"bytes,25,rep,name=language_of_dubbing,json=languageOfDubbing,proto3" json:"language_of_dubbing,omitempty"`             // ISO 639-2
+	SubTitleLanguage                       []string                                `protobuf:"bytes,26,rep,name=sub_title_language,json=subTitleLanguage,proto3" json:"sub_title_language,omitempty"`                // ISO 639-2
+	Duration                               string                                  `protobuf:"bytes,27,opt,name=duration,proto3" json:"duration,omitempty"`                                                          // Mandatory
+	RightsAgreementId                      *RightsAgreementId                      `protobuf:"bytes,28,opt,name=rights_agreement_id,json=rightsAgreementId,proto3" json:"rights_agreement_id,omitempty"`
+	VideoCollectionReferenceList           *SoundRecordingCollectionReferenceList  `protobuf:"bytes,29,opt,name=video_collection_reference_list,json=videoCollectionReferenceList,proto3" json:"video_collection_reference_list,omitempty"`
+	ResourceMusicalWorkReferenceList       *ResourceMusicalWorkReferenceList       `protobuf:"bytes,30,opt,name=resource_musical_work_reference_list,json=resourceMusicalWorkReferenceList,proto3" json:"resource_musical_work_reference_list,omitempty"`
+	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `protobuf:"bytes,31,opt,name=resource_contained_resource_reference_list,json=resourceContainedResourceReferenceList,proto3" json:"resource_contained_resource_reference_list,omitempty"`
+	CreationDate                           *EventDate                              `protobuf:"bytes,32,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	MasteredDate                           *EventDate                              `protobuf:"bytes,33,opt,name=mastered_date,json=masteredDate,proto3" json:"mastered_date,omitempty"`
+	RemasteredDate                         *EventDate                              `protobuf:"bytes,34,opt,name=remastered_date,json=remasteredDate,proto3" json:"remastered_date,omitempty"`
+	VideoDetailsByTerritory                []*VideoDetailsByTerritory              `protobuf:"bytes,35,rep,name=video_details_by_territory,json=videoDetailsByTerritory,proto3" json:"video_details_by_territory,omitempty"` // Mandatory 1-n
+	TerritoryOfCommissioning               string                                  `protobuf:"bytes,36,opt,name=territory_of_commissioning,json=territoryOfCommissioning,proto3" json:"territory_of_commissioning,omitempty"`
+	NumberOfFeaturedArtists                *int64                                  `protobuf:"varint,37,opt,name=number_of_featured_artists,json=numberOfFeaturedArtists,proto3,oneof" json:"number_of_featured_artists,omitempty"`
+	NumberOfNonFeaturedArtists             *int64                                  `protobuf:"varint,38,opt,name=number_of_non_featured_artists,json=numberOfNonFeaturedArtists,proto3,oneof" json:"number_of_non_featured_artists,omitempty"`
+	NumberOfContractedArtists              *int64                                  `protobuf:"varint,39,opt,name=number_of_contracted_artists,json=numberOfContractedArtists,proto3,oneof" json:"number_of_contracted_artists,omitempty"`
+	NumberOfNonContractedArtists           *int64                                  `protobuf:"varint,40,opt,name=number_of_non_contracted_artists,json=numberOfNonContractedArtists,proto3,oneof" json:"number_of_non_contracted_artists,omitempty"`
+	Extensions                             []*RawElement                           `protobuf:"bytes,41,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *Video) Reset() {
+	*x = Video{}
+	mi := &file_ern38_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Video) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Video) ProtoMessage() {}
+
+func (x *Video) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Video.ProtoReflect.Descriptor instead.
+func (*Video) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Video) GetIsUpdated() bool {
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
+	}
+	return false
+}
+
+func (x *Video) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *Video) GetVideoType() *VideoType {
+	if x != nil {
+		return x.VideoType
+	}
+	return nil
+}
+
+func (x *Video) GetIsArtistRelated() bool {
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
+	}
+	return false
+}
+
+func (x *Video) GetVideoId() *VideoId {
+	if x != nil {
+		return x.VideoId
+	}
+	return nil
+}
+
+func (x *Video) GetIndirectVideoId() []*MusicalWorkId {
+	if x != nil {
+		return x.IndirectVideoId
+	}
+	return nil
+}
+
+func (x *Video) GetResourceReference() string {
+	if x != nil {
+		return x.ResourceReference
+	}
+	return ""
+}
+
+func (x *Video) GetVideoCueSheetReference() []*VideoCueSheetReference {
+	if x != nil {
+		return x.VideoCueSheetReference
+	}
+	return nil
+}
+
+func (x *Video) GetReasonForCueSheetAbsence() *Reason {
+	if x != nil {
+		return x.ReasonForCueSheetAbsence
+	}
+	return nil
+}
+
+func (x *Video) GetReferenceTitle() *ReferenceTitle {
+	if x != nil {
+		return x.ReferenceTitle
+	}
+	return nil
+}
+
+func (x *Video) GetTitle() []*Title {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *Video) GetInstrumentationDescription() *Description {
+	if x != nil {
+		return x.InstrumentationDescription
+	}
+	return nil
+}
+
+func (x *Video) GetIsMedley() bool {
+	if x != nil && x.IsMedley != nil {
+		return *x.IsMedley
+	}
+	return false
+}
+
+func (x *Video) GetIsPotpourri() bool {
+	if x != nil && x.IsPotpourri != nil {
+		return *x.IsPotpourri
+	}
+	return false
+}
+
+func (x *Video) GetIsInstrumental() bool {
+	if x != nil && x.IsInstrumental != nil {
+		return *x.IsInstrumental
+	}
+	return false
+}
+
+func (x *Video) GetIsBackground() bool {
+	if x != nil && x.IsBackground != nil {
+		return *x.IsBackground
+	}
+	return false
+}
+
+func (x *Video) GetIsHiddenResource() bool {
+	if x != nil && x.IsHiddenResource != nil {
+		return *x.IsHiddenResource
+	}
+	return false
+}
+
+func (x *Video) GetIsBonusResource() bool {
+	if x != nil && x.IsBonusResource != nil {
+		return *x.IsBonusResource
+	}
+	return false
+}
+
+func (x *Video) GetHasPreOrderFulfillment() bool {
+	if x != nil && x.HasPreOrderFulfillment != nil {
+		return *x.HasPreOrderFulfillment
+	}
+	return false
+}
+
+func (x *Video) GetIsRemastered() bool {
+	if x != nil && x.IsRemastered != nil {
+		return *x.IsRemastered
+	}
+	return false
+}
+
+func (x *Video) GetNoSilenceBefore() bool {
+	if x != nil && x.NoSilenceBefore != nil {
+		return *x.NoSilenceBefore
+	}
+	return false
+}
+
+func (x *Video) GetNoSilenceAfter() bool {
+	if x != nil && x.NoSilenceAfter != nil {
+		return *x.NoSilenceAfter
+	}
+	return false
+}
+
+func (x *Video) GetPerformerInformationRequired() bool {
+	if x != nil && x.PerformerInformationRequired != nil {
+		return *x.PerformerInformationRequired
+	}
+	return false
+}
+
+func (x *Video) GetLanguageOfPerformance() []string {
+	if x != nil {
+		return x.LanguageOfPerformance
+	}
+	return nil
+}
+
+func (x *Video) GetLanguageOfDubbing() []string {
+	if x != nil {
+		return x.LanguageOfDubbing
+	}
+	return nil
+}
+
+func (x *Video) GetSubTitleLanguage() []string {
+	if x != nil {
+		return x.SubTitleLanguage
+	}
+	return nil
+}
+
+func (x *Video) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *Video) GetRightsAgreementId() *RightsAgreementId {
+	if x != nil {
+		return x.RightsAgreementId
+	}
+	return nil
+}
+
+func (x *Video) GetVideoCollectionReferenceList() *SoundRecordingCollectionReferenceList {
+	if x != nil {
+		return x.VideoCollectionReferenceList
+	}
+	return nil
+}
+
+func (x *Video) GetResourceMusicalWorkReferenceList() *ResourceMusicalWorkReferenceList {
+	if x != nil {
+		return x.ResourceMusicalWorkReferenceList
+	}
+	return nil
+}
+
+func (x *Video) GetResourceContainedResourceReferenceList() *ResourceContainedResourceReferenceList {
+	if x != nil {
+		return x.ResourceContainedResourceReferenceList
+	}
+	return nil
+}
+
+func (x *Video) GetCreationDate() *EventDate {
+	if x != nil {
+		return x.CreationDate
+	}
+	return nil
+}
+
+func (x *Video) GetMasteredDate() *EventDate {
+	if x != nil {
+		return x.MasteredDate
+	}
+	return nil
+}
+
+func (x *Video) GetRemasteredDate() *EventDate {
+	if x != nil {
+		return x.RemasteredDate
+	}
+	return nil
+}
+
+func (x *Video) GetVideoDetailsByTerritory() []*VideoDetailsByTerritory {
+	if x != nil {
+		return x.VideoDetailsByTerritory
+	}
+	return nil
+}
+
+func (x *Video) GetTerritoryOfCommissioning() string {
+	if x != nil {
+		return x.TerritoryOfCommissioning
+	}
+	return ""
+}
+
+func (x *Video) GetNumberOfFeaturedArtists() int64 {
+	if x != nil && x.NumberOfFeaturedArtists != nil {
+		return *x.NumberOfFeaturedArtists
+	}
+	return 0
+}
+
+func (x *Video) GetNumberOfNonFeaturedArtists() int64 {
+	if x != nil && x.NumberOfNonFeaturedArtists != nil {
+		return *x.NumberOfNonFeaturedArtists
+	}
+	return 0
+}
+
+func (x *Video) GetNumberOfContractedArtists() int64 {
+	if x != nil && x.NumberOfContractedArtists != nil {
+		return *x.NumberOfContractedArtists
+	}
+	return 0
+}
+
+func (x *Video) GetNumberOfNonContractedArtists() int64 {
+	if x != nil && x.NumberOfNonContractedArtists != nil {
+		return *x.NumberOfNonContractedArtists
+	}
+	return 0
+}
+
+func (x *Video) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Image represents an image resource for ERN 3.8
+type Image struct {
+	state                   protoimpl.MessageState     `protogen:"open.v1"`
+	IsUpdated               *bool                      `protobuf:"varint,1,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty"` // Deprecated (0-1)
+	LanguageAndScriptCode   string                     `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	ImageType               *ImageType                 `protobuf:"bytes,3,opt,name=image_type,json=imageType,proto3" json:"image_type,omitempty"`                                               // 0-1
+	IsArtistRelated         *bool                      `protobuf:"varint,4,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty"`                    // 0-1
+	ImageId                 []*ImageId                 `protobuf:"bytes,5,rep,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`                                                     // Mandatory 1-n
+	ResourceReference       string                     `protobuf:"bytes,6,opt,name=resource_reference,json=resourceReference,proto3" json:"resource_reference,omitempty"`                       // Mandatory (ID)
+	Title                   []*Title                   `protobuf:"bytes,7,rep,name=title,proto3" json:"title,omitempty"`                                                                        // 0-n
+	CreationDate            *EventDate                 `protobuf:"bytes,8,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`                                      // 0-1
+	ImageDetailsByTerritory []*ImageDetailsByTerritory `protobuf:"bytes,9,rep,name=image_details_by_territory,json=imageDetailsByTerritory,proto3" json:"image_details_by_territory,omitempty"` // Mandatory 1-n
+	Extensions              []*RawElement              `protobuf:"bytes,10,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_ern38_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Image) GetIsUpdated() bool {
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
+	}
+	return false
+}
+
+func (x *Image) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *Image) GetImageType() *ImageType {
+	if x != nil {
+		return x.ImageType
+	}
+	return nil
+}
+
+func (x *Image) GetIsArtistRelated() bool {
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
+	}
+	return false
+}
+
+func (x *Image) GetImageId() []*ImageId {
+	if x != nil {
+		return x.ImageId
+	}
+	return nil
+}
+
+func (x *Image) GetResourceReference() string {
+	if x != nil {
+		return x.ResourceReference
+	}
+	return ""
+}
+
+func (x *Image) GetTitle() []*Title {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *Image) GetCreationDate() *EventDate {
+	if x != nil {
+		return x.CreationDate
+	}
+	return nil
+}
+
+func (x *Image) GetImageDetailsByTerritory() []*ImageDetailsByTerritory {
+	if x != nil {
+		return x.ImageDetailsByTerritory
+	}
+	return nil
+}
+
+func (x *Image) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Text represents a text resource
+type Text struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ResourceReference string                 `protobuf:"bytes,1,opt,name=resource_reference,json=resourceReference,proto3" json:"resource_reference,omitempty"`
+	Type              string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ResourceId        []*ResourceID          `protobuf:"bytes,3,rep,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	DisplayTitleText  *DisplayTitleText      `protobuf:"bytes,4,opt,name=display_title_text,json=displayTitleText,proto3" json:"display_title_text,omitempty"`
+	Extensions        []*RawElement          `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Text) Reset() {
+	*x = Text{}
+	mi := &file_ern38_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Text) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Text) ProtoMessage() {}
+
+func (x *Text) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Text.ProtoReflect.Descriptor instead.
+func (*Text) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Text) GetResourceReference() string {
+	if x != nil {
+		return x.ResourceReference
+	}
+	return ""
+}
+
+func (x *Text) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Text) GetResourceId() []*ResourceID {
+	if x != nil {
+		return x.ResourceId
+	}
+	return nil
+}
+
+func (x *Text) GetDisplayTitleText() *DisplayTitleText {
+	if x != nil {
+		return x.DisplayTitleText
+	}
+	return nil
+}
+
+func (x *Text) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Collection represents a collection of releases
+type Collection struct {
+	state                        protoimpl.MessageState          `protogen:"open.v1"`
+	CollectionReference          string                          `protobuf:"bytes,1,opt,name=collection_reference,json=collectionReference,proto3" json:"collection_reference,omitempty"`
+	CollectionType               string                          `protobuf:"bytes,2,opt,name=collection_type,json=collectionType,proto3" json:"collection_type,omitempty"`
+	CollectionId                 []*ReleaseId                    `protobuf:"bytes,3,rep,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	DisplayTitleText             []*TitleText                    `protobuf:"bytes,4,rep,name=display_title_text,json=displayTitleText,proto3" json:"display_title_text,omitempty"`
+	DisplayArtistName            []string                        `protobuf:"bytes,5,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty"`
+	DisplayArtist                []*DisplayArtist                `protobuf:"bytes,6,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty"`
+	CollectionDetailsByTerritory []*CollectionDetailsByTerritory `protobuf:"bytes,7,rep,name=collection_details_by_territory,json=collectionDetailsByTerritory,proto3" json:"collection_details_by_territory,omitempty"`
+	Extensions                   []*RawElement                   `protobuf:"bytes,8,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_ern38_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Collection) GetCollectionReference() string {
+	if x != nil {
+		return x.CollectionReference
+	}
+	return ""
+}
+
+func (x *Collection) GetCollectionType() string {
+	if x != nil {
+		return x.CollectionType
+	}
+	return ""
+}
+
+func (x *Collection) GetCollectionId() []*ReleaseId {
+	if x != nil {
+		return x.CollectionId
+	}
+	return nil
+}
+
+func (x *Collection) GetDisplayTitleText() []*TitleText {
+	if x != nil {
+		return x.DisplayTitleText
+	}
+	return nil
+}
+
+func (x *Collection) GetDisplayArtistName() []string {
+	if x != nil {
+		return x.DisplayArtistName
+	}
+	return nil
+}
+
+func (x *Collection) GetDisplayArtist() []*DisplayArtist {
+	if x != nil {
+		return x.DisplayArtist
+	}
+	return nil
+}
+
+func (x *Collection) GetCollectionDetailsByTerritory() []*CollectionDetailsByTerritory {
+	if x != nil {
+		return x.CollectionDetailsByTerritory
+	}
+	return nil
+}
+
+func (x *Collection) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Release represents a single release for ERN 3.8
+// Following ERN 3.8 specification with mandatory ReferenceTitle and ReleaseDetailsByTerritory
+type Release struct {
+	state                          protoimpl.MessageState          `protogen:"open.v1"`
+	LanguageAndScriptCode          string                          `protobuf:"bytes,1,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	IsMainRelease                  bool                            `protobuf:"varint,2,opt,name=is_main_release,json=isMainRelease,proto3" json:"is_main_release,omitempty"`
+	ReleaseId                      []*ReleaseId                    `protobuf:"bytes,3,rep,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`                                                                     // 1-n
+	ReleaseReference               string                          `protobuf:"bytes,4,opt,name=release_reference,json=releaseReference,proto3" json:"release_reference,omitempty"`                                                // Mandatory (ID)
+	ExternalResourceLink           []*ExternalResourceLink         `protobuf:"bytes,5,rep,name=external_resource_link,json=externalResourceLink,proto3" json:"external_resource_link,omitempty"`                                  // 0-n
+	DisplayTitleText               []*DisplayTitleText             `protobuf:"bytes,6,rep,name=display_title_text,json=displayTitleText,proto3" json:"display_title_text,omitempty"`                                              // 0-n
+	DisplayTitle                   []*DisplayTitle                 `protobuf:"bytes,7,rep,name=display_title,json=displayTitle,proto3" json:"display_title,omitempty"`                                                            // 0-n
+	AdditionalTitle                []*AdditionalTitle              `protobuf:"bytes,8,rep,name=additional_title,json=additionalTitle,proto3" json:"additional_title,omitempty"`                                                   // 0-n
+	ReferenceTitle                 *ReferenceTitle                 `protobuf:"bytes,9,opt,name=reference_title,json=referenceTitle,proto3" json:"reference_title,omitempty"`                                                      // Mandatory (1)
+	ReleaseResourceReferenceList   *ReleaseResourceReferenceList   `protobuf:"bytes,10,opt,name=release_resource_reference_list,json=releaseResourceReferenceList,proto3" json:"release_resource_reference_list,omitempty"`       // 0-1
+	ReleaseCollectionReferenceList *ReleaseCollectionReferenceList `protobuf:"bytes,11,opt,name=release_collection_reference_list,json=releaseCollectionReferenceList,proto3" json:"release_collection_reference_list,omitempty"` // 0-1
+	RelatedResource                []*RelatedResource              `protobuf:"bytes,12,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty"`                                                  // 0-n
+	IsCompilation                  *bool                           `protobuf:"varint,13,opt,name=is_compilation,json=isCompilation,proto3,oneof" json:"is_compilation,omitempty"`                                                 // 0-1
+	ReleaseType                    []*ReleaseType                  `protobuf:"bytes,14,rep,name=release_type,json=releaseType,proto3" json:"release_type,omitempty"`                                                              // 0-n
+	ReleaseDetailsByTerritory      []*ReleaseDetailsByTerritory    `protobuf:"bytes,15,rep,name=release_details_by_territory,json=releaseDetailsByTerritory,proto3" json:"release_details_by_territory,omitempty"`                // 1-n (Mandatory)
+	LanguageOfPerformance          []string                        `protobuf:"bytes,16,rep,name=language_of_performance,json=languageOfPerformance,proto3" json:"language_of_performance,omitempty"`                              // 0-n
+	LanguageOfDubbing              []string                        `protobuf:"bytes,17,rep,name=language_of_dubbing,json=languageOfDubbing,proto3" json:"language_of_dubbing,omitempty"`                                          // 0-n
+	SubTitleLanguage               []string                        `protobuf:"bytes,18,rep,name=sub_title_language,json=subTitleLanguage,proto3" json:"sub_title_language,omitempty"`                                             // 0-n
+	Duration                       string                          `protobuf:"bytes,19,opt,name=duration,proto3" json:"duration,omitempty"`                                                                                       // 0-1
+	PLine                          []*PLine                        `protobuf:"bytes,20,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty"`                                                                                // 0-n
+	CLine                          []*CLine                        `protobuf:"bytes,21,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty"`                                                                                // 0-n
+	GlobalReleaseDate              *EventDate                      `protobuf:"bytes,22,opt,name=global_release_date,json=globalReleaseDate,proto3" json:"global_release_date,omitempty"`                                          // 0-1
+	GlobalOriginalReleaseDate      *EventDate                      `protobuf:"bytes,23,opt,name=global_original_release_date,json=globalOriginalReleaseDate,proto3" json:"global_original_release_date,omitempty"`                // 0-1
+	Extensions                     []*RawElement                   `protobuf:"bytes,24,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *Release) Reset() {
+	*x = Release{}
+	mi := &file_ern38_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Release) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Release) ProtoMessage() {}
+
+func (x *Release) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Release.ProtoReflect.Descriptor instead.
+func (*Release) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Release) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *Release) GetIsMainRelease() bool {
+	if x != nil {
+		return x.IsMainRelease
+	}
+	return false
+}
+
+func (x *Release) GetReleaseId() []*ReleaseId {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return nil
+}
+
+func (x *Release) GetReleaseReference() string {
+	if x != nil {
+		return x.ReleaseReference
+	}
+	return ""
+}
+
+func (x *Release) GetExternalResourceLink() []*ExternalResourceLink {
+	if x != nil {
+		return x.ExternalResourceLink
+	}
+	return nil
+}
+
+func (x *Release) GetDisplayTitleText() []*DisplayTitleText {
+	if x != nil {
+		return x.DisplayTitleText
+	}
+	return nil
+}
+
+func (x *Release) GetDisplayTitle() []*DisplayTitle {
+	if x != nil {
+		return x.DisplayTitle
+	}
+	return nil
+}
+
+func (x *Release) GetAdditionalTitle() []*AdditionalTitle {
+	if x != nil {
+		return x.AdditionalTitle
+	}
+	return nil
+}
+
+func (x *Release) GetReferenceTitle() *ReferenceTitle {
+	if x != nil {
+		return x.ReferenceTitle
+	}
+	return nil
+}
+
+func (x *Release) GetReleaseResourceReferenceList() *ReleaseResourceReferenceList {
+	if x != nil {
+		return x.ReleaseResourceReferenceList
+	}
+	return nil
+}
+
+func (x *Release) GetReleaseCollectionReferenceList() *ReleaseCollectionReferenceList {
+	if x != nil {
+		return x.ReleaseCollectionReferenceList
+	}
+	return nil
+}
+
+func (x *Release) GetRelatedResource() []*RelatedResource {
+	if x != nil {
+		return x.RelatedResource
+	}
+	return nil
+}
+
+func (x *Release) GetIsCompilation() bool {
+	if x != nil && x.IsCompilation != nil {
+		return *x.IsCompilation
+	}
+	return false
+}
+
+func (x *Release) GetReleaseType() []*ReleaseType {
+	if x != nil {
+		return x.ReleaseType
+	}
+	return nil
+}
+
+func (x *Release) GetReleaseDetailsByTerritory() []*ReleaseDetailsByTerritory {
+	if x != nil {
+		return x.ReleaseDetailsByTerritory
+	}
+	return nil
+}
+
+func (x *Release) GetLanguageOfPerformance() []string {
+	if x != nil {
+		return x.LanguageOfPerformance
+	}
+	return nil
+}
+
+func (x *Release) GetLanguageOfDubbing() []string {
+	if x != nil {
+		return x.LanguageOfDubbing
+	}
+	return nil
+}
+
+func (x *Release) GetSubTitleLanguage() []string {
+	if x != nil {
+		return x.SubTitleLanguage
+	}
+	return nil
+}
+
+func (x *Release) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *Release) GetPLine() []*PLine {
+	if x != nil {
+		return x.PLine
+	}
+	return nil
+}
+
+func (x *Release) GetCLine() []*CLine {
+	if x != nil {
+		return x.CLine
+	}
+	return nil
+}
+
+func (x *Release) GetGlobalReleaseDate() *EventDate {
+	if x != nil {
+		return x.GlobalReleaseDate
+	}
+	return nil
+}
+
+func (x *Release) GetGlobalOriginalReleaseDate() *EventDate {
+	if x != nil {
+		return x.GlobalOriginalReleaseDate
+	}
+	return nil
+}
+
+func (x *Release) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ReleaseDeal represents a deal for a specific release
+type ReleaseDeal struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DealReleaseReference string                 `protobuf:"bytes,1,opt,name=deal_release_reference,json=dealReleaseReference,proto3" json:"deal_release_reference,omitempty"`
+	Deal                 []*Deal                `protobuf:"bytes,2,rep,name=deal,proto3" json:"deal,omitempty"`
+	Extensions           []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReleaseDeal) Reset() {
+	*x = ReleaseDeal{}
+	mi := &file_ern38_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseDeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDeal) ProtoMessage() {}
+
+func (x *ReleaseDeal) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseDeal.ProtoReflect.Descriptor instead.
+func (*ReleaseDeal) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReleaseDeal) GetDealReleaseReference() string {
+	if x != nil {
+		return x.DealReleaseReference
+	}
+	return ""
+}
+
+func (x *ReleaseDeal) GetDeal() []*Deal {
+	if x != nil {
+		return x.Deal
+	}
+	return nil
+}
+
+func (x *ReleaseDeal) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// PartyID represents various party identification types
+type PartyID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyID) Reset() {
+	*x = PartyID{}
+	mi := &file_ern38_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyID) ProtoMessage() {}
+
+func (x *PartyID) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyID.ProtoReflect.Descriptor instead.
+func (*PartyID) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PartyID) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PartyID) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// Name represents party names with localization
+type Name struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullName      string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	FullNameAscii string                 `protobuf:"bytes,2,opt,name=full_name_ascii,json=fullNameAscii,proto3" json:"full_name_ascii,omitempty"`
+	LanguageCode  string                 `protobuf:"bytes,3,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	NameType      string                 `protobuf:"bytes,4,opt,name=name_type,json=nameType,proto3" json:"name_type,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Name) Reset() {
+	*x = Name{}
+	mi := &file_ern38_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Name) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Name) ProtoMessage() {}
+
+func (x *Name) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Name.ProtoReflect.Descriptor instead.
+func (*Name) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Name) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Name) GetFullNameAscii() string {
+	if x != nil {
+		return x.FullNameAscii
+	}
+	return ""
+}
+
+func (x *Name) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+func (x *Name) GetNameType() string {
+	if x != nil {
+		return x.NameType
+	}
+	return ""
+}
+
+func (x *Name) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// MessageAuditTrailEvent represents a single audit trail event
+type MessageAuditTrailEvent struct {
+	state                          protoimpl.MessageState `protogen:"open.v1"`
+	MessagingPartyReference        string                 `protobuf:"bytes,1,opt,name=messaging_party_reference,json=messagingPartyReference,proto3" json:"messaging_party_reference,omitempty"`
+	MessageAuditTrailEventDateTime *DateTime              `protobuf:"bytes,2,opt,name=message_audit_trail_event_date_time,json=messageAuditTrailEventDateTime,proto3" json:"message_audit_trail_event_date_time,omitempty"`
+	MessageAuditTrailEventTypeCode string                 `protobuf:"bytes,3,opt,name=message_audit_trail_event_type_code,json=messageAuditTrailEventTypeCode,proto3" json:"message_audit_trail_event_type_code,omitempty"`
+	Extensions                     []*RawElement          `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *MessageAuditTrailEvent) Reset() {
+	*x = MessageAuditTrailEvent{}
+	mi := &file_ern38_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageAuditTrailEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageAuditTrailEvent) ProtoMessage() {}
+
+func (x *MessageAuditTrailEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageAuditTrailEvent.ProtoReflect.Descriptor instead.
+func (*MessageAuditTrailEvent) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MessageAuditTrailEvent) GetMessagingPartyReference() string {
+	if x != nil {
+		return x.MessagingPartyReference
+	}
+	return ""
+}
+
+func (x *MessageAuditTrailEvent) GetMessageAuditTrailEventDateTime() *DateTime {
+	if x != nil {
+		return x.MessageAuditTrailEventDateTime
+	}
+	return nil
+}
+
+func (x *MessageAuditTrailEvent) GetMessageAuditTrailEventTypeCode() string {
+	if x != nil {
+		return x.MessageAuditTrailEventTypeCode
+	}
+	return ""
+}
+
+func (x *MessageAuditTrailEvent) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// SoundRecordingId represents sound recording identification for ERN 3.8
+type SoundRecordingId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isrc          string                 `protobuf:"bytes,1,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	CatalogNumber *CatalogNumber         `protobuf:"bytes,2,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty"`
+	ProprietaryId []*ProprietaryId       `protobuf:"bytes,3,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoundRecordingId) Reset() {
+	*x = SoundRecordingId{}
+	mi := &file_ern38_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoundRecordingId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoundRecordingId) ProtoMessage() {}
+
+func (x *SoundRecordingId) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoundRecordingId.ProtoReflect.Descriptor instead.
+func (*SoundRecordingId) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SoundRecordingId) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *SoundRecordingId) GetCatalogNumber() *CatalogNumber {
+	if x != nil {
+		return x.CatalogNumber
+	}
+	return nil
+}
+
+func (x *SoundRecordingId) GetProprietaryId() []*ProprietaryId {
+	if x != nil {
+		return x.ProprietaryId
+	}
+	return nil
+}
+
+func (x *SoundRecordingId) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// MusicalWorkId represents a musical work identifier
+type MusicalWorkId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iswc          string                 `protobuf:"bytes,1,opt,name=iswc,proto3" json:"iswc,omitempty"`
+	ProprietaryId []*ProprietaryId       `protobuf:"bytes,2,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MusicalWorkId) Reset() {
+	*x = MusicalWorkId{}
+	mi := &file_ern38_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MusicalWorkId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MusicalWorkId) ProtoMessage() {}
+
+func (x *MusicalWorkId) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MusicalWorkId.ProtoReflect.Descriptor instead.
+func (*MusicalWorkId) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MusicalWorkId) GetIswc() string {
+	if x != nil {
+		return x.Iswc
+	}
+	return ""
+}
+
+func (x *MusicalWorkId) GetProprietaryId() []*ProprietaryId {
+	if x != nil {
+		return x.ProprietaryId
+	}
+	return nil
+}
+
+func (x *MusicalWorkId) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ReferenceTitle represents the reference title of a release (mandatory in ERN 3.8)
+type ReferenceTitle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TitleText     string                 `protobuf:"bytes,1,opt,name=title_text,json=titleText,proto3" json:"title_text,omitempty"`
+	SubTitle      string                 `protobuf:"bytes,2,opt,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferenceTitle) Reset() {
+	*x = ReferenceTitle{}
+	mi := &file_ern38_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferenceTitle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceTitle) ProtoMessage() {}
+
+func (x *ReferenceTitle) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferenceTitle.ProtoReflect.Descriptor instead.
+func (*ReferenceTitle) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReferenceTitle) GetTitleText() string {
+	if x != nil {
+		return x.TitleText
+	}
+	return ""
+}
+
+func (x *ReferenceTitle) GetSubTitle() string {
+	if x != nil {
+		return x.SubTitle
+	}
+	return ""
+}
+
+func (x *ReferenceTitle) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Description provides textual description
+type Description struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,1,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	Value                 string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Description) Reset() {
+	*x = Description{}
+	mi := &file_ern38_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Description) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Description) ProtoMessage() {}
+
+func (x *Description) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Description.ProtoReflect.Descriptor instead.
+func (*Description) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Description) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *Description) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// RightsAgreementId identifies rights agreements
+type RightsAgreementId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mwli          string                 `protobuf:"bytes,1,opt,name=mwli,proto3" json:"mwli,omitempty"`
+	ProprietaryId []*ProprietaryId       `protobuf:"bytes,2,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RightsAgreementId) Reset() {
+	*x = RightsAgreementId{}
+	mi := &file_ern38_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RightsAgreementId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RightsAgreementId) ProtoMessage() {}
+
+func (x *RightsAgreementId) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RightsAgreementId.ProtoReflect.Descriptor instead.
+func (*RightsAgreementId) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RightsAgreementId) GetMwli() string {
+	if x != nil {
+		return x.Mwli
+	}
+	return ""
+}
+
+func (x *RightsAgreementId) GetProprietaryId() []*ProprietaryId {
+	if x != nil {
+		return x.ProprietaryId
+	}
+	return nil
+}
+
+func (x *RightsAgreementId) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ResourceMusicalWorkReferenceList contains references to musical works
+type ResourceMusicalWorkReferenceList struct {
+	state                        protoimpl.MessageState          `protogen:"open.v1"`
+	ResourceMusicalWorkReference []*ResourceMusicalWorkReference `protobuf:"bytes,1,rep,name=resource_musical_work_reference,json=resourceMusicalWorkReference,proto3" json:"resource_musical_work_reference,omitempty"`
+	Extensions                   []*RawElement                   `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ResourceMusicalWorkReferenceList) Reset() {
+	*x = ResourceMusicalWorkReferenceList{}
+	mi := &file_ern38_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceMusicalWorkReferenceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceMusicalWorkReferenceList) ProtoMessage() {}
+
+func (x *ResourceMusicalWorkReferenceList) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceMusicalWorkReferenceList.ProtoReflect.Descriptor instead.
+func (*ResourceMusicalWorkReferenceList) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ResourceMusicalWorkReferenceList) GetResourceMusicalWorkReference() []*ResourceMusicalWorkReference {
+	if x != nil {
+		return x.ResourceMusicalWorkReference
+	}
+	return nil
+}
+
+func (x *ResourceMusicalWorkReferenceList) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ResourceContainedResourceReferenceList contains references to contained resources
+type ResourceContainedResourceReferenceList struct {
+	state                              protoimpl.MessageState                `protogen:"open.v1"`
+	ResourceContainedResourceReference []*ResourceContainedResourceReference `protobuf:"bytes,1,rep,name=resource_contained_resource_reference,json=resourceContainedResourceReference,proto3" json:"resource_contained_resource_reference,omitempty"`
+	Extensions                         []*RawElement                         `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                      protoimpl.UnknownFields
+	sizeCache                          protoimpl.SizeCache
+}
+
+func (x *ResourceContainedResourceReferenceList) Reset() {
+	*x = ResourceContainedResourceReferenceList{}
+	mi := &file_ern38_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceContainedResourceReferenceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceContainedResourceReferenceList) ProtoMessage() {}
+
+func (x *ResourceContainedResourceReferenceList) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceContainedResourceReferenceList.ProtoReflect.Descriptor instead.
+func (*ResourceContainedResourceReferenceList) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ResourceContainedResourceReferenceList) GetResourceContainedResourceReference() []*ResourceContainedResourceReference {
+	if x != nil {
+		return x.ResourceContainedResourceReference
+	}
+	return nil
+}
+
+func (x *ResourceContainedResourceReferenceList) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// EventDate represents a date for ERN 3.8 events (ISO 8601 format)
+// Following ERN 3.8 standard specification for ReleaseDate and OriginalReleaseDate
+// In ERN 3.8, dates don't have territory attributes at this level
+type EventDate struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Value                 string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	IsApproximate         bool                   `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty"`
+	IsBefore              bool                   `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3" json:"is_before,omitempty"`
+	IsAfter               bool                   `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3" json:"is_after,omitempty"`
+	TerritoryCode         string                 `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty"`
+	LocationDescription   string                 `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *EventDate) Reset() {
+	*x = EventDate{}
+	mi := &file_ern38_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDate) ProtoMessage() {}
+
+func (x *EventDate) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventDate.ProtoReflect.Descriptor instead.
+func (*EventDate) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EventDate) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *EventDate) GetIsApproximate() bool {
+	if x != nil {
+		return x.IsApproximate
+	}
+	return false
+}
+
+func (x *EventDate) GetIsBefore() bool {
+	if x != nil {
+		return x.IsBefore
+	}
+	return false
+}
+
+func (x *EventDate) GetIsAfter() bool {
+	if x != nil {
+		return x.IsAfter
+	}
+	return false
+}
+
+func (x *EventDate) GetTerritoryCode() string {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return ""
+}
+
+func (x *EventDate) GetLocationDescription() string {
+	if x != nil {
+		return x.LocationDescription
+	}
+	return ""
+}
+
+func (x *EventDate) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+// SoundRecordingDetailsByTerritory contains territory-specific sound recording details for ERN 3.8
+type SoundRecordingDetailsByTerritory struct {
+	state                          protoimpl.MessageState            `protogen:"open.v1"`
+	LanguageAndScriptCode          string                            `protobuf:"bytes,1,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	TerritoryCode                  []string                          `protobuf:"bytes,2,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty"`                                                         // 1-n (if used)
+	ExcludedTerritoryCode          []string                          `protobuf:"bytes,3,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty"`                               // 1-n (if used)
+	Title                          []*Title                          `protobuf:"bytes,4,rep,name=title,proto3" json:"title,omitempty"`                                                                                              // 0-n
+	DisplayArtist                  []*DisplayArtist                  `protobuf:"bytes,5,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty"`                                                         // 0-n
+	DisplayConductor               []*DisplayConductor               `protobuf:"bytes,6,rep,name=display_conductor,json=displayConductor,proto3" json:"display_conductor,omitempty"`                                                // 0-n
+	ResourceContributor            []*ResourceContributor            `protobuf:"bytes,7,rep,name=resource_contributor,json=resourceContributor,proto3" json:"resource_contributor,omitempty"`                                       // 0-n
+	IndirectResourceContributor    []*IndirectResourceContributor    `protobuf:"bytes,8,rep,name=indirect_resource_contributor,json=indirectResourceContributor,proto3" json:"indirect_resource_contributor,omitempty"`             // 0-n
+	RightsAgreementId              *RightsAgreementId                `protobuf:"bytes,9,opt,name=rights_agreement_id,json=rightsAgreementId,proto3" json:"rights_agreement_id,omitempty"`                                           // 0-1
+	DisplayArtistName              []*DisplayArtistName              `protobuf:"bytes,10,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty"`                                          // 0-n
+	LabelName                      []*LabelName                      `protobuf:"bytes,11,rep,name=label_name,json=labelName,proto3" json:"label_name,omitempty"`                                                                    // 0-n
+	RightsController               []*RightsController               `protobuf:"bytes,12,rep,name=rights_controller,json=rightsController,proto3" json:"rights_controller,omitempty"`                                               // 0-n (TypedRightsController)
+	RemasteredDate                 *EventDate                        `protobuf:"bytes,13,opt,name=remastered_date,json=remasteredDate,proto3" json:"remastered_date,omitempty"`                                                     // 0-1
+	ResourceReleaseDate            *EventDate                        `protobuf:"bytes,14,opt,name=resource_release_date,json=resourceReleaseDate,proto3" json:"resource_release_date,omitempty"`                                    // 0-1
+	OriginalResourceReleaseDate    *EventDate                        `protobuf:"bytes,15,opt,name=original_resource_release_date,json=originalResourceReleaseDate,proto3" json:"original_resource_release_date,omitempty"`          // 0-1
+	PLine                          []*PLine                          `protobuf:"bytes,16,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty"`                                                                                // 0-n
+	CourtesyLine                   *CourtesyLine                     `protobuf:"bytes,17,opt,name=courtesy_line,json=courtesyLine,proto3" json:"courtesy_line,omitempty"`                                                           // 0-1
+	SequenceNumber                 *int64                            `protobuf:"varint,18,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty"`                                              // 0-1
+	HostSoundCarrier               []*HostSoundCarrier               `protobuf:"bytes,19,rep,name=host_sound_carrier,json=hostSoundCarrier,proto3" json:"host_sound_carrier,omitempty"`                                             // 0-n
+	MarketingComment               *Comment                          `protobuf:"bytes,20,opt,name=marketing_comment,json=marketingComment,proto3" json:"marketing_comment,omitempty"`                                               // 0-1
+	Genre                          []*Genre                          `protobuf:"bytes,21,rep,name=genre,proto3" json:"genre,omitempty"`                                                                                             // 0-n
+	ParentalWarningType            []string                          `protobuf:"bytes,22,rep,name=parental_warning_type,json=parentalWarningType,proto3" json:"parental_warning_type,omitempty"`                                    // 0-n (ParentalWarningType)
+	AvRating                       []*AvRating                       `protobuf:"bytes,23,rep,name=av_rating,json=avRating,proto3" json:"av_rating,omitempty"`                                                                       // 0-n
+	TechnicalSoundRecordingDetails []*TechnicalSoundRecordingDetails `protobuf:"bytes,24,rep,name=technical_sound_recording_details,json=technicalSoundRecordingDetails,proto3" json:"technical_sound_recording_details,omitempty"` // 0-n
+	FulfillmentDate                *FulfillmentDate                  `protobuf:"bytes,25,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty"`                                                  // 0-1
+	Keywords                       []*Keywords                       `protobuf:"bytes,26,rep,name=keywords,proto3" json:"keywords,omitempty"`                                                                                       // 0-n
+	Synopsis                       *Synopsis                         `protobuf:"bytes,27,opt,name=synopsis,proto3" json:"synopsis,omitempty"`                                                                                       // 0-1
+	Extensions                     []*RawElement                     `protobuf:"bytes,28,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *SoundRecordingDetailsByTerritory) Reset() {
+	*x = SoundRecordingDetailsByTerritory{}
+	mi := &file_ern38_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoundRecordingDetailsByTerritory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoundRecordingDetailsByTerritory) ProtoMessage() {}
+
+func (x *SoundRecordingDetailsByTerritory) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoundRecordingDetailsByTerritory.ProtoReflect.Descriptor instead.
+func (*SoundRecordingDetailsByTerritory) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetTerritoryCode() []string {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetExcludedTerritoryCode() []string {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetTitle() []*Title {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetDisplayArtist() []*DisplayArtist {
+	if x != nil {
+		return x.DisplayArtist
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetDisplayConductor() []*DisplayConductor {
+	if x != nil {
+		return x.DisplayConductor
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetResourceContributor() []*ResourceContributor {
+	if x != nil {
+		return x.ResourceContributor
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetIndirectResourceContributor() []*IndirectResourceContributor {
+	if x != nil {
+		return x.IndirectResourceContributor
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetRightsAgreementId() *RightsAgreementId {
+	if x != nil {
+		return x.RightsAgreementId
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetDisplayArtistName() []*DisplayArtistName {
+	if x != nil {
+		return x.DisplayArtistName
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetLabelName() []*LabelName {
+	if x != nil {
+		return x.LabelName
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetRightsController() []*RightsController {
+	if x != nil {
+		return x.RightsController
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetRemasteredDate() *EventDate {
+	if x != nil {
+		return x.RemasteredDate
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetResourceReleaseDate() *EventDate {
+	if x != nil {
+		return x.ResourceReleaseDate
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetOriginalResourceReleaseDate() *EventDate {
+	if x != nil {
+		return x.OriginalResourceReleaseDate
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetPLine() []*PLine {
+	if x != nil {
+		return x.PLine
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetCourtesyLine() *CourtesyLine {
+	if x != nil {
+		return x.CourtesyLine
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetSequenceNumber() int64 {
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetHostSoundCarrier() []*HostSoundCarrier {
+	if x != nil {
+		return x.HostSoundCarrier
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetMarketingComment() *Comment {
+	if x != nil {
+		return x.MarketingComment
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetGenre() []*Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetParentalWarningType() []string {
+	if x != nil {
+		return x.ParentalWarningType
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetAvRating() []*AvRating {
+	if x != nil {
+		return x.AvRating
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetTechnicalSoundRecordingDetails() []*TechnicalSoundRecordingDetails {
+	if x != nil {
+		return x.TechnicalSoundRecordingDetails
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetFulfillmentDate() *FulfillmentDate {
+	if x != nil {
+		return x.FulfillmentDate
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetKeywords() []*Keywords {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetSynopsis() *Synopsis {
+	if x != nil {
+		return x.Synopsis
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// VideoType represents the type of a video.
+type VideoType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoType) Reset() {
+	*x = VideoType{}
+	mi := &file_ern38_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoType) ProtoMessage() {}
+
+func (x *VideoType) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoType.ProtoReflect.Descriptor instead.
+func (*VideoType) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VideoType) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// VideoId represents video identification for ERN 3.8
+type VideoId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isrc          string                 `protobuf:"bytes,1,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	ProprietaryId []*ProprietaryId       `protobuf:"bytes,2,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoId) Reset() {
+	*x = VideoId{}
+	mi := &file_ern38_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoId) ProtoMessage() {}
+
+func (x *VideoId) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoId.ProtoReflect.Descriptor instead.
+func (*VideoId) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VideoId) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *VideoId) GetProprietaryId() []*ProprietaryId {
+	if x != nil {
+		return x.ProprietaryId
+	}
+	return nil
+}
+
+func (x *VideoId) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// VideoCueSheetReference references a cue sheet
+type VideoCueSheetReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoCueSheetReference) Reset() {
+	*x = VideoCueSheetReference{}
+	mi := &file_ern38_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoCueSheetReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoCueSheetReference) ProtoMessage() {}
+
+func (x *VideoCueSheetReference) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoCueSheetReference.ProtoReflect.Descriptor instead.
+func (*VideoCueSheetReference) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VideoCueSheetReference) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Reason provides a textual reason
+type Reason struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,1,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	Value                 string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Reason) Reset() {
+	*x = Reason{}
+	mi := &file_ern38_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reason) ProtoMessage() {}
+
+func (x *Reason) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reason.ProtoReflect.Descriptor instead.
+func (*Reason) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Reason) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *Reason) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Title represents a title (different from DisplayTitle)
+type Title struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,1,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	TitleType             string                 `protobuf:"bytes,2,opt,name=title_type,json=titleType,proto3" json:"title_type,omitempty"`
+	TitleText             string                 `protobuf:"bytes,3,opt,name=title_text,json=titleText,proto3" json:"title_text,omitempty"`
+	SubTitle              string                 `protobuf:"bytes,4,opt,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty"`
+	Extensions            []*RawElement          `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Title) Reset() {
+	*x = Title{}
+	mi := &file_ern38_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Title) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Title) ProtoMessage() {}
+
+func (x *Title) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Title.ProtoReflect.Descriptor instead.
+func (*Title) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Title) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *Title) GetTitleType() string {
+	if x != nil {
+		return x.TitleType
+	}
+	return ""
+}
+
+func (x *Title) GetTitleText() string {
+	if x != nil {
+		return x.TitleText
+	}
+	return ""
+}
+
+func (x *Title) GetSubTitle() string {
+	if x != nil {
+		return x.SubTitle
+	}
+	return ""
+}
+
+func (x *Title) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// SoundRecordingCollectionReferenceList contains collection references (used for VideoCollectionReferenceList)
+type SoundRecordingCollectionReferenceList struct {
+	state                             protoimpl.MessageState               `protogen:"open.v1"`
+	SoundRecordingCollectionReference []*SoundRecordingCollectionReference `protobuf:"bytes,1,rep,name=sound_recording_collection_reference,json=soundRecordingCollectionReference,proto3" json:"sound_recording_collection_reference,omitempty"`
+	Extensions                        []*RawElement                        `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *SoundRecordingCollectionReferenceList) Reset() {
+	*x = SoundRecordingCollectionReferenceList{}
+	mi := &file_ern38_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoundRecordingCollectionReferenceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoundRecordingCollectionReferenceList) ProtoMessage() {}
+
+func (x *SoundRecordingCollectionReferenceList) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoundRecordingCollectionReferenceList.ProtoReflect.Descriptor instead.
+func (*SoundRecordingCollectionReferenceList) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SoundRecordingCollectionReferenceList) GetSoundRecordingCollectionReference() []*SoundRecordingCollectionReference {
+	if x != nil {
+		return x.SoundRecordingCollectionReference
+	}
+	return nil
+}
+
+func (x *SoundRecordingCollectionReferenceList) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// VideoDetailsByTerritory contains territory-specific video details for ERN 3.8
+type VideoDetailsByTerritory struct {
+	state                       protoimpl.MessageState         `protogen:"open.v1"`
+	LanguageAndScriptCode       string                         `protobuf:"bytes,1,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	TerritoryCode               []string                       `protobuf:"bytes,2,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty"`                                                // 1-n (if used)
+	ExcludedTerritoryCode       []string                       `protobuf:"bytes,3,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty"`                      // 1-n (if used)
+	Title                       []*Title                       `protobuf:"bytes,4,rep,name=title,proto3" json:"title,omitempty"`                                                                                     // 0-n
+	DisplayArtist               []*DisplayArtist               `protobuf:"bytes,5,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty"`                                                // 0-n
+	DisplayConductor            []*DisplayConductor            `protobuf:"bytes,6,rep,name=display_conductor,json=displayConductor,proto3" json:"display_conductor,omitempty"`                                       // 0-n
+	ResourceContributor         []*ResourceContributor         `protobuf:"bytes,7,rep,name=resource_contributor,json=resourceContributor,proto3" json:"resource_contributor,omitempty"`                              // 0-n
+	IndirectResourceContributor []*IndirectResourceContributor `protobuf:"bytes,8,rep,name=indirect_resource_contributor,json=indirectResourceContributor,proto3" json:"indirect_resource_contributor,omitempty"`    // 0-n
+	RightsAgreementId           *RightsAgreementId             `protobuf:"bytes,9,opt,name=rights_agreement_id,json=rightsAgreementId,proto3" json:"rights_agreement_id,omitempty"`                                  // 0-1
+	DisplayArtistName           []*DisplayArtistName           `protobuf:"bytes,10,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty"`                                 // 0-n
+	LabelName                   []*LabelName                   `protobuf:"bytes,11,rep,name=label_name,json=labelName,proto3" json:"label_name,omitempty"`                                                           // 0-n
+	RightsController            []*RightsController            `protobuf:"bytes,12,rep,name=rights_controller,json=rightsController,proto3" json:"rights_controller,omitempty"`                                      // 0-n (TypedRightsController)
+	RemasteredDate              *EventDate                     `protobuf:"bytes,13,opt,name=remastered_date,json=remasteredDate,proto3" json:"remastered_date,omitempty"`                                            // 0-1
+	ResourceReleaseDate         *EventDate                     `protobuf:"bytes,14,opt,name=resource_release_date,json=resourceReleaseDate,proto3" json:"resource_release_date,omitempty"`                           // 0-1
+	OriginalResourceReleaseDate *EventDate                     `protobuf:"bytes,15,opt,name=original_resource_release_date,json=originalResourceReleaseDate,proto3" json:"original_resource_release_date,omitempty"` // 0-1
+	PLine                       []*PLine                       `protobuf:"bytes,16,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty"`                                                                       // 0-n
+	CLine                       []*CLine                       `protobuf:"bytes,17,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty"`                                                                       // 0-n
+	CourtesyLine                *CourtesyLine                  `protobuf:"bytes,18,opt,name=courtesy_line,json=courtesyLine,proto3" json:"courtesy_line,omitempty"`                                                  // 0-1
+	SequenceNumber              *int64                         `protobuf:"varint,19,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty"`                                     // 0-1
+	HostSoundCarrier            []*HostSoundCarrier            `protobuf:"bytes,20,rep,name=host_sound_carrier,json=hostSoundCarrier,proto3" json:"host_sound_carrier,omitempty"`                                    // 0-n
+	MarketingComment            *Comment                       `protobuf:"bytes,21,opt,name=marketing_comment,json=marketingComment,proto3" json:"marketing_comment,omitempty"`                                      // 0-1
+	Genre                       []*Genre                       `protobuf:"bytes,22,rep,name=genre,proto3" json:"genre,omitempty"`                                                                                    // 0-n
+	ParentalWarningType         []string                       `protobuf:"bytes,23,rep,name=parental_warning_type,json=parentalWarningType,proto3" json:"parental_warning_type,omitempty"`                           // 0-n (ParentalWarningType)
+	AvRating                    []*AvRating                    `protobuf:"bytes,24,rep,name=av_rating,json=avRating,proto3" json:"av_rating,omitempty"`                                                              // 0-n
+	TechnicalVideoDetails       []*TechnicalVideoDetails       `protobuf:"bytes,25,rep,name=technical_video_details,json=technicalVideoDetails,proto3" json:"technical_video_details,omitempty"`                     // 0-n
+	Character                   []*Character                   `protobuf:"bytes,26,rep,name=character,proto3" json:"character,omitempty"`                                                                            // 0-n
+	FulfillmentDate             *FulfillmentDate               `protobuf:"bytes,27,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty"`                                         // 0-1
+	Keywords                    []*Keywords                    `protobuf:"bytes,28,rep,name=keywords,proto3" json:"keywords,omitempty"`                                                                              // 0-n
+	Synopsis                    *Synopsis                      `protobuf:"bytes,29,opt,name=synopsis,proto3" json:"synopsis,omitempty"`                                                                              // 0-1
+	Extensions                  []*RawElement                  `protobuf:"bytes,30,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *VideoDetailsByTerritory) Reset() {
+	*x = VideoDetailsByTerritory{}
+	mi := &file_ern38_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoDetailsByTerritory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoDetailsByTerritory) ProtoMessage() {}
+
+func (x *VideoDetailsByTerritory) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoDetailsByTerritory.ProtoReflect.Descriptor instead.
+func (*VideoDetailsByTerritory) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VideoDetailsByTerritory) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *VideoDetailsByTerritory) GetTerritoryCode() []string {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetExcludedTerritoryCode() []string {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetTitle() []*Title {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetDisplayArtist() []*DisplayArtist {
+	if x != nil {
+		return x.DisplayArtist
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetDisplayConductor() []*DisplayConductor {
+	if x != nil {
+		return x.DisplayConductor
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetResourceContributor() []*ResourceContributor {
+	if x != nil {
+		return x.ResourceContributor
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetIndirectResourceContributor() []*IndirectResourceContributor {
+	if x != nil {
+		return x.IndirectResourceContributor
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetRightsAgreementId() *RightsAgreementId {
+	if x != nil {
+		return x.RightsAgreementId
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetDisplayArtistName() []*DisplayArtistName {
+	if x != nil {
+		return x.DisplayArtistName
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetLabelName() []*LabelName {
+	if x != nil {
+		return x.LabelName
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetRightsController() []*RightsController {
+	if x != nil {
+		return x.RightsController
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetRemasteredDate() *EventDate {
+	if x != nil {
+		return x.RemasteredDate
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetResourceReleaseDate() *EventDate {
+	if x != nil {
+		return x.ResourceReleaseDate
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetOriginalResourceReleaseDate() *EventDate {
+	if x != nil {
+		return x.OriginalResourceReleaseDate
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetPLine() []*PLine {
+	if x != nil {
+		return x.PLine
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetCLine() []*CLine {
+	if x != nil {
+		return x.CLine
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetCourtesyLine() *CourtesyLine {
+	if x != nil {
+		return x.CourtesyLine
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetSequenceNumber() int64 {
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *VideoDetailsByTerritory) GetHostSoundCarrier() []*HostSoundCarrier {
+	if x != nil {
+		return x.HostSoundCarrier
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetMarketingComment() *Comment {
+	if x != nil {
+		return x.MarketingComment
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetGenre() []*Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetParentalWarningType() []string {
+	if x != nil {
+		return x.ParentalWarningType
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetAvRating() []*AvRating {
+	if x != nil {
+		return x.AvRating
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetTechnicalVideoDetails() []*TechnicalVideoDetails {
+	if x != nil {
+		return x.TechnicalVideoDetails
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetCharacter() []*Character {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetFulfillmentDate() *FulfillmentDate {
+	if x != nil {
+		return x.FulfillmentDate
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetKeywords() []*Keywords {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetSynopsis() *Synopsis {
+	if x != nil {
+		return x.Synopsis
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ImageType represents the type of an image
+type ImageType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageType) Reset() {
+	*x = ImageType{}
+	mi := &file_ern38_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageType) ProtoMessage() {}
+
+func (x *ImageType) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageType.ProtoReflect.Descriptor instead.
+func (*ImageType) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ImageType) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// ImageId represents image identification
+type ImageId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProprietaryId []*ProprietaryId       `protobuf:"bytes,1,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageId) Reset() {
+	*x = ImageId{}
+	mi := &file_ern38_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageId) ProtoMessage() {}
+
+func (x *ImageId) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageId.ProtoReflect.Descriptor instead.
+func (*ImageId) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImageId) GetProprietaryId() []*ProprietaryId {
+	if x != nil {
+		return x.ProprietaryId
+	}
+	return nil
+}
+
+func (x *ImageId) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ImageDetailsByTerritory contains territory-specific image details for ERN 3.8
+type ImageDetailsByTerritory struct {
+	state                       protoimpl.MessageState         `protogen:"open.v1"`
+	LanguageAndScriptCode       string                         `protobuf:"bytes,1,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	TerritoryCode               []string                       `protobuf:"bytes,2,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty"`                                                // 1-n (if used)
+	ExcludedTerritoryCode       []string                       `protobuf:"bytes,3,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty"`                      // 1-n (if used)
+	Title                       []*Title                       `protobuf:"bytes,4,rep,name=title,proto3" json:"title,omitempty"`                                                                                     // 0-n
+	ResourceContributor         []*ResourceContributor         `protobuf:"bytes,5,rep,name=resource_contributor,json=resourceContributor,proto3" json:"resource_contributor,omitempty"`                              // 0-n
+	IndirectResourceContributor []*IndirectResourceContributor `protobuf:"bytes,6,rep,name=indirect_resource_contributor,json=indirectResourceContributor,proto3" json:"indirect_resource_contributor,omitempty"`    // 0-n
+	DisplayArtistName           []*DisplayArtistName           `protobuf:"bytes,7,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty"`                                  // 0-n
+	CLine                       []*CLine                       `protobuf:"bytes,8,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty"`                                                                        // 0-n
+	Description                 *Description                   `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`                                                                         // 0-1
+	CourtesyLine                *CourtesyLine                  `protobuf:"bytes,10,opt,name=courtesy_line,json=courtesyLine,proto3" json:"courtesy_line,omitempty"`                                                  // 0-1
+	ResourceReleaseDate         *EventDate                     `protobuf:"bytes,11,opt,name=resource_release_date,json=resourceReleaseDate,proto3" json:"resource_release_date,omitempty"`                           // 0-1
+	OriginalResourceReleaseDate *EventDate                     `protobuf:"bytes,12,opt,name=original_resource_release_date,json=originalResourceReleaseDate,proto3" json:"original_resource_release_date,omitempty"` // 0-1
+	FulfillmentDate             *FulfillmentDate               `protobuf:"bytes,13,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty"`                                         // 0-1
+	Keywords                    []*Keywords                    `protobuf:"bytes,14,rep,name=keywords,proto3" json:"keywords,omitempty"`                                                                              // 0-n
+	Synopsis                    *Synopsis                      `protobuf:"bytes,15,opt,name=synopsis,proto3" json:"synopsis,omitempty"`                                                                              // 0-1
+	Genre                       []*Genre                       `protobuf:"bytes,16,rep,name=genre,proto3" json:"genre,omitempty"`                                                                                    // 0-n
+	ParentalWarningType         []string                       `protobuf:"bytes,17,rep,name=parental_warning_type,json=parentalWarningType,proto3" json:"parental_warning_type,omitempty"`                           // 0-n
+	TechnicalImageDetails       []*TechnicalImageDetails       `protobuf:"bytes,18,rep,name=technical_image_details,json=technicalImageDetails,proto3" json:"technical_image_details,omitempty"`                     // 0-n
+	Extensions                  []*RawElement                  `protobuf:"bytes,19,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ImageDetailsByTerritory) Reset() {
+	*x = ImageDetailsByTerritory{}
+	mi := &file_ern38_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageDetailsByTerritory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageDetailsByTerritory) ProtoMessage() {}
+
+func (x *ImageDetailsByTerritory) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageDetailsByTerritory.ProtoReflect.Descriptor instead.
+func (*ImageDetailsByTerritory) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ImageDetailsByTerritory) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *ImageDetailsByTerritory) GetTerritoryCode() []string {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetExcludedTerritoryCode() []string {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetTitle() []*Title {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetResourceContributor() []*ResourceContributor {
+	if x != nil {
+		return x.ResourceContributor
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetIndirectResourceContributor() []*IndirectResourceContributor {
+	if x != nil {
+		return x.IndirectResourceContributor
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetDisplayArtistName() []*DisplayArtistName {
+	if x != nil {
+		return x.DisplayArtistName
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetCLine() []*CLine {
+	if x != nil {
+		return x.CLine
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetDescription() *Description {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetCourtesyLine() *CourtesyLine {
+	if x != nil {
+		return x.CourtesyLine
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetResourceReleaseDate() *EventDate {
+	if x != nil {
+		return x.ResourceReleaseDate
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetOriginalResourceReleaseDate() *EventDate {
+	if x != nil {
+		return x.OriginalResourceReleaseDate
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetFulfillmentDate() *FulfillmentDate {
+	if x != nil {
+		return x.FulfillmentDate
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetKeywords() []*Keywords {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetSynopsis() *Synopsis {
+	if x != nil {
+		return x.Synopsis
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetGenre() []*Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetParentalWarningType() []string {
+	if x != nil {
+		return x.ParentalWarningType
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetTechnicalImageDetails() []*TechnicalImageDetails {
+	if x != nil {
+		return x.TechnicalImageDetails
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ResourceID represents unique resource identification
+type ResourceID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceID) Reset() {
+	*x = ResourceID{}
+	mi := &file_ern38_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceID) ProtoMessage() {}
+
+func (x *ResourceID) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceID.ProtoReflect.Descriptor instead.
+func (*ResourceID) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResourceID) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ResourceID) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// DisplayTitleText represents title suggested to show consumer
+// ERN 3.8 version - simpler structure without territory attributes
+type DisplayTitleText struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Value                 string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DisplayTitleText) Reset() {
+	*x = DisplayTitleText{}
+	mi := &file_ern38_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisplayTitleText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayTitleText) ProtoMessage() {}
+
+func (x *DisplayTitleText) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayTitleText.ProtoReflect.Descriptor instead.
+func (*DisplayTitleText) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DisplayTitleText) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DisplayTitleText) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+// ReleaseId represents release identification (ICPN, GRid, ISRC, etc.) for ERN 3.8
+type ReleaseId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GRid          string                 `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3" json:"g_rid,omitempty"`                            // 0-1
+	Isrc          string                 `protobuf:"bytes,2,opt,name=isrc,proto3" json:"isrc,omitempty"`                                        // 0-1
+	Icpn          string                 `protobuf:"bytes,3,opt,name=icpn,proto3" json:"icpn,omitempty"`                                        // 0-1
+	Isan          string                 `protobuf:"bytes,4,opt,name=isan,proto3" json:"isan,omitempty"`                                        // 0-1
+	CatalogNumber *CatalogNumber         `protobuf:"bytes,5,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty"` // 0-1
+	ProprietaryId []*ProprietaryId       `protobuf:"bytes,6,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty"` // 0-n
+	Extensions    []*RawElement          `protobuf:"bytes,7,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseId) Reset() {
+	*x = ReleaseId{}
+	mi := &file_ern38_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseId) ProtoMessage() {}
+
+func (x *ReleaseId) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseId.ProtoReflect.Descriptor instead.
+func (*ReleaseId) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReleaseId) GetGRid() string {
+	if x != nil {
+		return x.GRid
+	}
+	return ""
+}
+
+func (x *ReleaseId) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *ReleaseId) GetIcpn() string {
+	if x != nil {
+		return x.Icpn
+	}
+	return ""
+}
+
+func (x *ReleaseId) GetIsan() string {
+	if x != nil {
+		return x.Isan
+	}
+	return ""
+}
+
+func (x *ReleaseId) GetCatalogNumber() *CatalogNumber {
+	if x != nil {
+		return x.CatalogNumber
+	}
+	return nil
+}
+
+func (x *ReleaseId) GetProprietaryId() []*ProprietaryId {
+	if x != nil {
+		return x.ProprietaryId
+	}
+	return nil
+}
+
+func (x *ReleaseId) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// TitleText represents localized title information
+type TitleText struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Value                 string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	TitleType             string                 `protobuf:"bytes,3,opt,name=title_type,json=titleType,proto3" json:"title_type,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TitleText) Reset() {
+	*x = TitleText{}
+	mi := &file_ern38_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TitleText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TitleText) ProtoMessage() {}
+
+func (x *TitleText) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TitleText.ProtoReflect.Descriptor instead.
+func (*TitleText) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TitleText) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TitleText) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *TitleText) GetTitleType() string {
+	if x != nil {
+		return x.TitleType
+	}
+	return ""
+}
+
+// DisplayArtist represents how an artist should be displayed
+type DisplayArtist struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SequenceNumber int64                  `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	PartyName      []*PartyName           `protobuf:"bytes,2,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty"`
+	PartyId        []*PartyId             `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	ArtistRole     []string               `protobuf:"bytes,4,rep,name=artist_role,json=artistRole,proto3" json:"artist_role,omitempty"`
+	Extensions     []*RawElement          `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DisplayArtist) Reset() {
+	*x = DisplayArtist{}
+	mi := &file_ern38_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisplayArtist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayArtist) ProtoMessage() {}
+
+func (x *DisplayArtist) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayArtist.ProtoReflect.Descriptor instead.
+func (*DisplayArtist) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DisplayArtist) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *DisplayArtist) GetPartyName() []*PartyName {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
+func (x *DisplayArtist) GetPartyId() []*PartyId {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *DisplayArtist) GetArtistRole() []string {
+	if x != nil {
+		return x.ArtistRole
+	}
+	return nil
+}
+
+func (x *DisplayArtist) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// CollectionDetailsByTerritory represents territory-specific collection details
+type CollectionDetailsByTerritory struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TerritoryCode     string                 `protobuf:"bytes,1,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty"`
+	DisplayTitleText  []*TitleText           `protobuf:"bytes,2,rep,name=display_title_text,json=displayTitleText,proto3" json:"display_title_text,omitempty"`
+	DisplayArtistName []string               `protobuf:"bytes,3,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty"`
+	Genre             []*Genre               `protobuf:"bytes,4,rep,name=genre,proto3" json:"genre,omitempty"`
+	Extensions        []*RawElement          `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CollectionDetailsByTerritory) Reset() {
+	*x = CollectionDetailsByTerritory{}
+	mi := &file_ern38_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionDetailsByTerritory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionDetailsByTerritory) ProtoMessage() {}
+
+func (x *CollectionDetailsByTerritory) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionDetailsByTerritory.ProtoReflect.Descriptor instead.
+func (*CollectionDetailsByTerritory) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CollectionDetailsByTerritory) GetTerritoryCode() string {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return ""
+}
+
+func (x *CollectionDetailsByTerritory) GetDisplayTitleText() []*TitleText {
+	if x != nil {
+		return x.DisplayTitleText
+	}
+	return nil
+}
+
+func (x *CollectionDetailsByTerritory) GetDisplayArtistName() []string {
+	if x != nil {
+		return x.DisplayArtistName
+	}
+	return nil
+}
+
+func (x *CollectionDetailsByTerritory) GetGenre() []*Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+func (x *CollectionDetailsByTerritory) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ExternalResourceLink represents promotional or other material related to the release
+type ExternalResourceLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalResourceLink) Reset() {
+	*x = ExternalResourceLink{}
+	mi := &file_ern38_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalResourceLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalResourceLink) ProtoMessage() {}
+
+func (x *ExternalResourceLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalResourceLink.ProtoReflect.Descriptor instead.
+func (*ExternalResourceLink) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExternalResourceLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ExternalResourceLink) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// DisplayTitle
+type DisplayTitle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TitleText     []*TitleText           `protobuf:"bytes,1,rep,name=title_text,json=titleText,proto3" json:"title_text,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisplayTitle) Reset() {
+	*x = DisplayTitle{}
+	mi := &file_ern38_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisplayTitle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayTitle) ProtoMessage() {}
+
+func (x *DisplayTitle) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayTitle.ProtoReflect.Descriptor instead.
+func (*DisplayTitle) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DisplayTitle) GetTitleText() []*TitleText {
+	if x != nil {
+		return x.TitleText
+	}
+	return nil
+}
+
+func (x *DisplayTitle) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// AdditionalTitle represents additional title information
+type AdditionalTitle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TitleText     string                 `protobuf:"bytes,1,opt,name=title_text,json=titleText,proto3" json:"title_text,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdditionalTitle) Reset() {
+	*x = AdditionalTitle{}
+	mi := &file_ern38_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdditionalTitle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdditionalTitle) ProtoMessage() {}
+
+func (x *AdditionalTitle) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdditionalTitle.ProtoReflect.Descriptor instead.
+func (*AdditionalTitle) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AdditionalTitle) GetTitleText() string {
+	if x != nil {
+		return x.TitleText
+	}
+	return ""
+}
+
+func (x *AdditionalTitle) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ReleaseResourceReferenceList represents a list of resource references
+type ReleaseResourceReferenceList struct {
+	state                    protoimpl.MessageState      `protogen:"open.v1"`
+	ReleaseResourceReference []*ReleaseResourceReference `protobuf:"bytes,1,rep,name=release_resource_reference,json=releaseResourceReference,proto3" json:"release_resource_reference,omitempty"`
+	Extensions               []*RawElement               `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ReleaseResourceReferenceList) Reset() {
+	*x = ReleaseResourceReferenceList{}
+	mi := &file_ern38_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseResourceReferenceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseResourceReferenceList) ProtoMessage() {}
+
+func (x *ReleaseResourceReferenceList) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseResourceReferenceList.ProtoReflect.Descriptor instead.
+func (*ReleaseResourceReferenceList) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReleaseResourceReferenceList) GetReleaseResourceReference() []*ReleaseResourceReference {
+	if x != nil {
+		return x.ReleaseResourceReference
+	}
+	return nil
+}
+
+func (x *ReleaseResourceReferenceList) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ReleaseCollectionReferenceList represents a list of collection references
+type ReleaseCollectionReferenceList struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseCollectionReference []string               `protobuf:"bytes,1,rep,name=release_collection_reference,json=releaseCollectionReference,proto3" json:"release_collection_reference,omitempty"`
+	Extensions                 []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ReleaseCollectionReferenceList) Reset() {
+	*x = ReleaseCollectionReferenceList{}
+	mi := &file_ern38_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCollectionReferenceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCollectionReferenceList) ProtoMessage() {}
+
+func (x *ReleaseCollectionReferenceList) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCollectionReferenceList.ProtoReflect.Descriptor instead.
+func (*ReleaseCollectionReferenceList) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ReleaseCollectionReferenceList) GetReleaseCollectionReference() []string {
+	if x != nil {
+		return x.ReleaseCollectionReference
+	}
+	return nil
+}
+
+func (x *ReleaseCollectionReferenceList) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// RelatedResource identifies a resource the release relates to, e.g. the sound recording
+// a music video has content from. The resource is identified by its ResourceId when it
+// is not delivered in the message, or by ResourceRelatedResourceReference when it is.
+type RelatedResource struct {
+	state                            protoimpl.MessageState `protogen:"open.v1"`
+	ResourceRelationshipType         string                 `protobuf:"bytes,1,opt,name=resource_relationship_type,json=resourceRelationshipType,proto3" json:"resource_relationship_type,omitempty"`
+	ResourceRelatedResourceReference string                 `protobuf:"bytes,2,opt,name=resource_related_resource_reference,json=resourceRelatedResourceReference,proto3" json:"resource_related_resource_reference,omitempty"` // 0-1
+	ResourceId                       *ResourceRelatedId     `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`                                                                       // 0-1
+	Extensions                       []*RawElement          `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *RelatedResource) Reset() {
+	*x = RelatedResource{}
+	mi := &file_ern38_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedResource) ProtoMessage() {}
+
+func (x *RelatedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedResource.ProtoReflect.Descriptor instead.
+func (*RelatedResource) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RelatedResource) GetResourceRelationshipType() string {
+	if x != nil {
+		return x.ResourceRelationshipType
+	}
+	return ""
+}
+
+func (x *RelatedResource) GetResourceRelatedResourceReference() string {
+	if x != nil {
+		return x.ResourceRelatedResourceReference
+	}
+	return ""
+}
+
+func (x *RelatedResource) GetResourceId() *ResourceRelatedId {
+	if x != nil {
+		return x.ResourceId
+	}
+	return nil
+}
+
+func (x *RelatedResource) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ReleaseType represents the form in which a release is offered
+type ReleaseType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseType) Reset() {
+	*x = ReleaseType{}
+	mi := &file_ern38_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseType) ProtoMessage() {}
+
+func (x *ReleaseType) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseType.ProtoReflect.Descriptor instead.
+func (*ReleaseType) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReleaseType) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// ReleaseDetailsByTerritory contains territory-specific release details (mandatory in ERN 3.8)
+type ReleaseDetailsByTerritory struct {
+	state                       protoimpl.MessageState         `protogen:"open.v1"`
+	LanguageAndScriptCode       string                         `protobuf:"bytes,1,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	TerritoryCode               []string                       `protobuf:"bytes,2,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty"`
+	ExcludedTerritoryCode       []string                       `protobuf:"bytes,3,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty"`
+	DisplayArtistName           []*DisplayArtistName           `protobuf:"bytes,4,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty"`
+	LabelName                   []*LabelName                   `protobuf:"bytes,5,rep,name=label_name,json=labelName,proto3" json:"label_name,omitempty"`
+	Title                       []*Title                       `protobuf:"bytes,6,rep,name=title,proto3" json:"title,omitempty"`
+	DisplayArtist               []*DisplayArtist               `protobuf:"bytes,7,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty"`
+	IsMultiArtistCompilation    bool                           `protobuf:"varint,8,opt,name=is_multi_artist_compilation,json=isMultiArtistCompilation,proto3" json:"is_multi_artist_compilation,omitempty"`
+	AdministratingRecordCompany []*AdministratingRecordCompany `protobuf:"bytes,9,rep,name=administrating_record_company,json=administratingRecordCompany,proto3" json:"administrating_record_company,omitempty"`
+	ReleaseType                 []*ReleaseType                 `protobuf:"bytes,10,rep,name=release_type,json=releaseType,proto3" json:"release_type,omitempty"`
+	RelatedRelease              []*RelatedRelease              `protobuf:"bytes,11,rep,name=related_release,json=relatedRelease,proto3" json:"related_release,omitempty"`
+	ParentalWarningType         []*ParentalWarningType         `protobuf:"bytes,12,rep,name=parental_warning_type,json=parentalWarningType,proto3" json:"parental_warning_type,omitempty"`
+	AvRating                    []*AvRating                    `protobuf:"bytes,13,rep,name=av_rating,json=avRating,proto3" json:"av_rating,omitempty"`
+	MarketingComment            *Comment                       `protobuf:"bytes,14,opt,name=marketing_comment,json=marketingComment,proto3" json:"marketing_comment,omitempty"`
+	ResourceGroup               []*ResourceGroup               `protobuf:"bytes,15,rep,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	Genre                       []*Genre                       `protobuf:"bytes,16,rep,name=genre,proto3" json:"genre,omitempty"`
+	PLine                       []*PLine                       `protobuf:"bytes,17,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty"`
+	CLine                       []*CLine                       `protobuf:"bytes,18,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty"`
+	ReleaseDate                 *EventDate                     `protobuf:"bytes,19,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	OriginalReleaseDate         *EventDate                     `protobuf:"bytes,20,opt,name=original_release_date,json=originalReleaseDate,proto3" json:"original_release_date,omitempty"`
+	Keywords                    []*Keywords                    `protobuf:"bytes,21,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Synopsis                    *Synopsis                      `protobuf:"bytes,22,opt,name=synopsis,proto3" json:"synopsis,omitempty"`
+	Extensions                  []*RawElement                  `protobuf:"bytes,23,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ReleaseDetailsByTerritory) Reset() {
+	*x = ReleaseDetailsByTerritory{}
+	mi := &file_ern38_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseDetailsByTerritory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDetailsByTerritory) ProtoMessage() {}
+
+func (x *ReleaseDetailsByTerritory) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseDetailsByTerritory.ProtoReflect.Descriptor instead.
+func (*ReleaseDetailsByTerritory) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReleaseDetailsByTerritory) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *ReleaseDetailsByTerritory) GetTerritoryCode() []string {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetExcludedTerritoryCode() []string {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetDisplayArtistName() []*DisplayArtistName {
+	if x != nil {
+		return x.DisplayArtistName
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetLabelName() []*LabelName {
+	if x != nil {
+		return x.LabelName
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetTitle() []*Title {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetDisplayArtist() []*DisplayArtist {
+	if x != nil {
+		return x.DisplayArtist
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetIsMultiArtistCompilation() bool {
+	if x != nil {
+		return x.IsMultiArtistCompilation
+	}
+	return false
+}
+
+func (x *ReleaseDetailsByTerritory) GetAdministratingRecordCompany() []*AdministratingRecordCompany {
+	if x != nil {
+		return x.AdministratingRecordCompany
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetReleaseType() []*ReleaseType {
+	if x != nil {
+		return x.ReleaseType
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetRelatedRelease() []*RelatedRelease {
+	if x != nil {
+		return x.RelatedRelease
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetParentalWarningType() []*ParentalWarningType {
+	if x != nil {
+		return x.ParentalWarningType
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetAvRating() []*AvRating {
+	if x != nil {
+		return x.AvRating
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetMarketingComment() *Comment {
+	if x != nil {
+		return x.MarketingComment
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetResourceGroup() []*ResourceGroup {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetGenre() []*Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetPLine() []*PLine {
+	if x != nil {
+		return x.PLine
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetCLine() []*CLine {
+	if x != nil {
+		return x.CLine
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetReleaseDate() *EventDate {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetOriginalReleaseDate() *EventDate {
+	if x != nil {
+		return x.OriginalReleaseDate
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetKeywords() []*Keywords {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetSynopsis() *Synopsis {
+	if x != nil {
+		return x.Synopsis
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type PLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int64                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	PLineText     string                 `protobuf:"bytes,2,opt,name=p_line_text,json=pLineText,proto3" json:"p_line_text,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PLine) Reset() {
+	*x = PLine{}
+	mi := &file_ern38_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PLine) ProtoMessage() {}
+
+func (x *PLine) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PLine.ProtoReflect.Descriptor instead.
+func (*PLine) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PLine) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *PLine) GetPLineText() string {
+	if x != nil {
+		return x.PLineText
+	}
+	return ""
+}
+
+func (x *PLine) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type CLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int64                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	CLineText     string                 `protobuf:"bytes,2,opt,name=c_line_text,json=cLineText,proto3" json:"c_line_text,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CLine) Reset() {
+	*x = CLine{}
+	mi := &file_ern38_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CLine) ProtoMessage() {}
+
+func (x *CLine) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CLine.ProtoReflect.Descriptor instead.
+func (*CLine) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CLine) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CLine) GetCLineText() string {
+	if x != nil {
+		return x.CLineText
+	}
+	return ""
+}
+
+func (x *CLine) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Deal represents commercial terms for a release
+type Deal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DealTerms     *DealTerms             `protobuf:"bytes,1,opt,name=deal_terms,json=dealTerms,proto3" json:"deal_terms,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deal) Reset() {
+	*x = Deal{}
+	mi := &file_ern38_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deal) ProtoMessage() {}
+
+func (x *Deal) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deal.ProtoReflect.Descriptor instead.
+func (*Deal) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Deal) GetDealTerms() *DealTerms {
+	if x != nil {
+		return x.DealTerms
+	}
+	return nil
+}
+
+func (x *Deal) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// CatalogNumber represents a catalog number
+type CatalogNumber struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogNumber) Reset() {
+	*x = CatalogNumber{}
+	mi := &file_ern38_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogNumber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogNumber) ProtoMessage() {}
+
+func (x *CatalogNumber) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogNumber.ProtoReflect.Descriptor instead.
+func (*CatalogNumber) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CatalogNumber) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CatalogNumber) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ProprietaryId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProprietaryId) Reset() {
+	*x = ProprietaryId{}
+	mi := &file_ern38_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProprietaryId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProprietaryId) ProtoMessage() {}
+
+func (x *ProprietaryId) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProprietaryId.ProtoReflect.Descriptor instead.
+func (*ProprietaryId) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ProprietaryId) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ProprietaryId) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// ResourceMusicalWorkReference references a musical work
+type ResourceMusicalWorkReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MusicalWorkId []*MusicalWorkId       `protobuf:"bytes,1,rep,name=musical_work_id,json=musicalWorkId,proto3" json:"musical_work_id,omitempty"`
+	Duration      string                 `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	StartPoint    string                 `protobuf:"bytes,3,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceMusicalWorkReference) Reset() {
+	*x = ResourceMusicalWorkReference{}
+	mi := &file_ern38_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceMusicalWorkReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceMusicalWorkReference) ProtoMessage() {}
+
+func (x *ResourceMusicalWorkReference) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceMusicalWorkReference.ProtoReflect.Descriptor instead.
+func (*ResourceMusicalWorkReference) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ResourceMusicalWorkReference) GetMusicalWorkId() []*MusicalWorkId {
+	if x != nil {
+		return x.MusicalWorkId
+	}
+	return nil
+}
+
+func (x *ResourceMusicalWorkReference) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *ResourceMusicalWorkReference) GetStartPoint() string {
+	if x != nil {
+		return x.StartPoint
+	}
+	return ""
+}
+
+func (x *ResourceMusicalWorkReference) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ResourceContainedResourceReference references a contained resource
+type ResourceContainedResourceReference struct {
+	state                              protoimpl.MessageState `protogen:"open.v1"`
+	ResourceContainedResourceReference string                 `protobuf:"bytes,1,opt,name=resource_contained_resource_reference,json=resourceContainedResourceReference,proto3" json:"resource_contained_resource_reference,omitempty"`
+	DurationUsed                       string                 `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3" json:"duration_used,omitempty"`
+	StartPoint                         string                 `protobuf:"bytes,3,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty"`
+	unknownFields                      protoimpl.UnknownFields
+	sizeCache                          protoimpl.SizeCache
+}
+
+func (x *ResourceContainedResourceReference) Reset() {
+	*x = ResourceContainedResourceReference{}
+	mi := &file_ern38_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceContainedResourceReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceContainedResourceReference) ProtoMessage() {}
+
+func (x *ResourceContainedResourceReference) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceContainedResourceReference.ProtoReflect.Descriptor instead.
+func (*ResourceContainedResourceReference) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ResourceContainedResourceReference) GetResourceContainedResourceReference() string {
+	if x != nil {
+		return x.ResourceContainedResourceReference
+	}
+	return ""
+}
+
+func (x *ResourceContainedResourceReference) GetDurationUsed() string {
+	if x != nil {
+		return x.DurationUsed
+	}
+	return ""
+}
+
+func (x *ResourceContainedResourceReference) GetStartPoint() string {
+	if x != nil {
+		return x.StartPoint
+	}
+	return ""
+}
+
+// DisplayConductor represents the conductor of a resource, shown like a display artist
+type DisplayConductor struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SequenceNumber int64                  `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	PartyName      []*PartyName           `protobuf:"bytes,2,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty"`
+	PartyId        []*PartyId             `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	ArtistRole     []string               `protobuf:"bytes,4,rep,name=artist_role,json=artistRole,proto3" json:"artist_role,omitempty"`
+	Extensions     []*RawElement          `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DisplayConductor) Reset() {
+	*x = DisplayConductor{}
+	mi := &file_ern38_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisplayConductor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayConductor) ProtoMessage() {}
+
+func (x *DisplayConductor) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayConductor.ProtoReflect.Descriptor instead.
+func (*DisplayConductor) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DisplayConductor) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *DisplayConductor) GetPartyName() []*PartyName {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
+func (x *DisplayConductor) GetPartyId() []*PartyId {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *DisplayConductor) GetArtistRole() []string {
+	if x != nil {
+		return x.ArtistRole
+	}
+	return nil
+}
+
+func (x *DisplayConductor) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ResourceContributor represents a contributor to a resource (ERN 3.8)
+type ResourceContributor struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	SequenceNumber                int64                  `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	PartyId                       []*PartyId             `protobuf:"bytes,2,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	PartyName                     []*PartyName           `protobuf:"bytes,3,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty"`
+	ResourceContributorRole       []string               `protobuf:"bytes,4,rep,name=resource_contributor_role,json=resourceContributorRole,proto3" json:"resource_contributor_role,omitempty"`
+	InstrumentType                []string               `protobuf:"bytes,5,rep,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty"`
+	HasMadeFeaturedContribution   *bool                  `protobuf:"varint,6,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3,oneof" json:"has_made_featured_contribution,omitempty"`
+	HasMadeContractedContribution *bool                  `protobuf:"varint,7,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3,oneof" json:"has_made_contracted_contribution,omitempty"`
+	Extensions                    []*RawElement          `protobuf:"bytes,8,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *ResourceContributor) Reset() {
+	*x = ResourceContributor{}
+	mi := &file_ern38_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceContributor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceContributor) ProtoMessage() {}
+
+func (x *ResourceContributor) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceContributor.ProtoReflect.Descriptor instead.
+func (*ResourceContributor) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ResourceContributor) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *ResourceContributor) GetPartyId() []*PartyId {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *ResourceContributor) GetPartyName() []*PartyName {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
+func (x *ResourceContributor) GetResourceContributorRole() []string {
+	if x != nil {
+		return x.ResourceContributorRole
+	}
+	return nil
+}
+
+func (x *ResourceContributor) GetInstrumentType() []string {
+	if x != nil {
+		return x.InstrumentType
+	}
+	return nil
+}
+
+func (x *ResourceContributor) GetHasMadeFeaturedContribution() bool {
+	if x != nil && x.HasMadeFeaturedContribution != nil {
+		return *x.HasMadeFeaturedContribution
+	}
+	return false
+}
+
+func (x *ResourceContributor) GetHasMadeContractedContribution() bool {
+	if x != nil && x.HasMadeContractedContribution != nil {
+		return *x.HasMadeContractedContribution
+	}
+	return false
+}
+
+func (x *ResourceContributor) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// IndirectResourceContributor represents an indirect contributor (ERN 3.8)
+type IndirectResourceContributor struct {
+	state                           protoimpl.MessageState `protogen:"open.v1"`
+	SequenceNumber                  int64                  `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	PartyName                       []*PartyName           `protobuf:"bytes,2,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty"`
+	PartyId                         []*PartyId             `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	IndirectResourceContributorRole []string               `protobuf:"bytes,4,rep,name=indirect_resource_contributor_role,json=indirectResourceContributorRole,proto3" json:"indirect_resource_contributor_role,omitempty"`
+	Extensions                      []*RawElement          `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *IndirectResourceContributor) Reset() {
+	*x = IndirectResourceContributor{}
+	mi := &file_ern38_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndirectResourceContributor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndirectResourceContributor) ProtoMessage() {}
+
+func (x *IndirectResourceContributor) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndirectResourceContributor.ProtoReflect.Descriptor instead.
+func (*IndirectResourceContributor) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *IndirectResourceContributor) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *IndirectResourceContributor) GetPartyName() []*PartyName {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
+func (x *IndirectResourceContributor) GetPartyId() []*PartyId {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *IndirectResourceContributor) GetIndirectResourceContributorRole() []string {
+	if x != nil {
+		return x.IndirectResourceContributorRole
+	}
+	return nil
+}
+
+func (x *IndirectResourceContributor) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// DisplayArtistName represents a display artist name with language attributes
+// Following ERN 3.8 standard specification - simpler than ERN 4.3
+type DisplayArtistName struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Value                 string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DisplayArtistName) Reset() {
+	*x = DisplayArtistName{}
+	mi := &file_ern38_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisplayArtistName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayArtistName) ProtoMessage() {}
+
+func (x *DisplayArtistName) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayArtistName.ProtoReflect.Descriptor instead.
+func (*DisplayArtistName) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DisplayArtistName) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DisplayArtistName) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+// LabelName represents the label name
+type LabelName struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	LabelNameType         string                 `protobuf:"bytes,1,opt,name=label_name_type,json=labelNameType,proto3" json:"label_name_type,omitempty"`
+	Value                 string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LabelName) Reset() {
+	*x = LabelName{}
+	mi := &file_ern38_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelName) ProtoMessage() {}
+
+func (x *LabelName) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelName.ProtoReflect.Descriptor instead.
+func (*LabelName) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LabelName) GetLabelNameType() string {
+	if x != nil {
+		return x.LabelNameType
+	}
+	return ""
+}
+
+func (x *LabelName) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *LabelName) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+// RightsController represents a rights controller (TypedRightsController in ERN 3.8)
+type RightsController struct {
+	state                          protoimpl.MessageState `protogen:"open.v1"`
+	SequenceNumber                 *int64                 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty"`
+	PartyName                      []*Name                `protobuf:"bytes,2,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty"`
+	PartyId                        []*PartyID             `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	RightsControllerPartyReference string                 `protobuf:"bytes,4,opt,name=rights_controller_party_reference,json=rightsControllerPartyReference,proto3" json:"rights_controller_party_reference,omitempty"`
+	RightsControllerRole           []string               `protobuf:"bytes,5,rep,name=rights_controller_role,json=rightsControllerRole,proto3" json:"rights_controller_role,omitempty"`
+	RightSharePercentage           *string                `protobuf:"bytes,6,opt,name=right_share_percentage,json=rightSharePercentage,proto3,oneof" json:"right_share_percentage,omitempty"`
+	RightShareUnknown              string                 `protobuf:"bytes,7,opt,name=right_share_unknown,json=rightShareUnknown,proto3" json:"right_share_unknown,omitempty"`
+	Extensions                     []*RawElement          `protobuf:"bytes,8,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *RightsController) Reset() {
+	*x = RightsController{}
+	mi := &file_ern38_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RightsController) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RightsController) ProtoMessage() {}
+
+func (x *RightsController) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RightsController.ProtoReflect.Descriptor instead.
+func (*RightsController) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RightsController) GetSequenceNumber() int64 {
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *RightsController) GetPartyName() []*Name {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
+func (x *RightsController) GetPartyId() []*PartyID {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *RightsController) GetRightsControllerPartyReference() string {
+	if x != nil {
+		return x.RightsControllerPartyReference
+	}
+	return ""
+}
+
+func (x *RightsController) GetRightsControllerRole() []string {
+	if x != nil {
+		return x.RightsControllerRole
+	}
+	return nil
+}
+
+func (x *RightsController) GetRightSharePercentage() string {
+	if x != nil && x.RightSharePercentage != nil {
+		return *x.RightSharePercentage
+	}
+	return ""
+}
+
+func (x *RightsController) GetRightShareUnknown() string {
+	if x != nil {
+		return x.RightShareUnknown
+	}
+	return ""
+}
+
+func (x *RightsController) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// CourtesyLine represents a courtesy line
+type CourtesyLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Year             int64                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	CourtesyLineText string                 `protobuf:"bytes,2,opt,name=courtesy_line_text,json=courtesyLineText,proto3" json:"courtesy_line_text,omitempty"`
+	Extensions       []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CourtesyLine) Reset() {
+	*x = CourtesyLine{}
+	mi := &file_ern38_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourtesyLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourtesyLine) ProtoMessage() {}
+
+func (x *CourtesyLine) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourtesyLine.ProtoReflect.Descriptor instead.
+func (*CourtesyLine) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CourtesyLine) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CourtesyLine) GetCourtesyLineText() string {
+	if x != nil {
+		return x.CourtesyLineText
+	}
+	return ""
+}
+
+func (x *CourtesyLine) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// HostSoundCarrier represents the sound carrier on which a resource was originally released (ERN 3.8)
+type HostSoundCarrier struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseId           []*ReleaseId           `protobuf:"bytes,1,rep,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	CatalogNumber       *CatalogNumber         `protobuf:"bytes,2,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty"`
+	Title               []*Title               `protobuf:"bytes,3,rep,name=title,proto3" json:"title,omitempty"`
+	DisplayArtistName   []*DisplayArtistName   `protobuf:"bytes,4,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty"`
+	DisplayArtist       []*DisplayArtist       `protobuf:"bytes,5,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty"`
+	OriginalReleaseDate *EventDate             `protobuf:"bytes,6,opt,name=original_release_date,json=originalReleaseDate,proto3" json:"original_release_date,omitempty"`
+	Extensions          []*RawElement          `protobuf:"bytes,7,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *HostSoundCarrier) Reset() {
+	*x = HostSoundCarrier{}
+	mi := &file_ern38_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostSoundCarrier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostSoundCarrier) ProtoMessage() {}
+
+func (x *HostSoundCarrier) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostSoundCarrier.ProtoReflect.Descriptor instead.
+func (*HostSoundCarrier) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *HostSoundCarrier) GetReleaseId() []*ReleaseId {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return nil
+}
+
+func (x *HostSoundCarrier) GetCatalogNumber() *CatalogNumber {
+	if x != nil {
+		return x.CatalogNumber
+	}
+	return nil
+}
+
+func (x *HostSoundCarrier) GetTitle() []*Title {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *HostSoundCarrier) GetDisplayArtistName() []*DisplayArtistName {
+	if x != nil {
+		return x.DisplayArtistName
+	}
+	return nil
+}
+
+func (x *HostSoundCarrier) GetDisplayArtist() []*DisplayArtist {
+	if x != nil {
+		return x.DisplayArtist
+	}
+	return nil
+}
+
+func (x *HostSoundCarrier) GetOriginalReleaseDate() *EventDate {
+	if x != nil {
+		return x.OriginalReleaseDate
+	}
+	return nil
+}
+
+func (x *HostSoundCarrier) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Comment represents a comment (used for MarketingComment, etc.)
+type Comment struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Value                 string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_ern38_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Comment) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Comment) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+type Genre struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	GenreText               string                 `protobuf:"bytes,1,opt,name=genre_text,json=genreText,proto3" json:"genre_text,omitempty"`
+	SubGenre                string                 `protobuf:"bytes,2,opt,name=sub_genre,json=subGenre,proto3" json:"sub_genre,omitempty"`
+	ApplicableTerritoryCode string                 `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty"`
+	Extensions              []*RawElement          `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Genre) Reset() {
+	*x = Genre{}
+	mi := &file_ern38_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genre) ProtoMessage() {}
+
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Genre) GetGenreText() string {
+	if x != nil {
+		return x.GenreText
+	}
+	return ""
+}
+
+func (x *Genre) GetSubGenre() string {
+	if x != nil {
+		return x.SubGenre
+	}
+	return ""
+}
+
+func (x *Genre) GetApplicableTerritoryCode() string {
+	if x != nil {
+		return x.ApplicableTerritoryCode
+	}
+	return ""
+}
+
+func (x *Genre) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// AvRating represents an audio-visual rating for a Release
+// Following ERN 3.8 standard specification
+type AvRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatingText    string                 `protobuf:"bytes,1,opt,name=rating_text,json=ratingText,proto3" json:"rating_text,omitempty"`
+	RatingAgency  *RatingAgency          `protobuf:"bytes,2,opt,name=rating_agency,json=ratingAgency,proto3" json:"rating_agency,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvRating) Reset() {
+	*x = AvRating{}
+	mi := &file_ern38_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvRating) ProtoMessage() {}
+
+func (x *AvRating) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvRating.ProtoReflect.Descriptor instead.
+func (*AvRating) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AvRating) GetRatingText() string {
+	if x != nil {
+		return x.RatingText
+	}
+	return ""
+}
+
+func (x *AvRating) GetRatingAgency() *RatingAgency {
+	if x != nil {
+		return x.RatingAgency
+	}
+	return nil
+}
+
+func (x *AvRating) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type TechnicalSoundRecordingDetails struct {
+	state                             protoimpl.MessageState `protogen:"open.v1"`
+	TechnicalResourceDetailsReference string                 `protobuf:"bytes,1,opt,name=technical_resource_details_reference,json=technicalResourceDetailsReference,proto3" json:"technical_resource_details_reference,omitempty"`
+	AudioCodecType                    string                 `protobuf:"bytes,2,opt,name=audio_codec_type,json=audioCodecType,proto3" json:"audio_codec_type,omitempty"`
+	BitRate                           int64                  `protobuf:"varint,3,opt,name=bit_rate,json=bitRate,proto3" json:"bit_rate,omitempty"` // kbps
+	NumberOfChannels                  int64                  `protobuf:"varint,4,opt,name=number_of_channels,json=numberOfChannels,proto3" json:"number_of_channels,omitempty"`
+	SamplingRate                      float64                `protobuf:"fixed64,5,opt,name=sampling_rate,json=samplingRate,proto3" json:"sampling_rate,omitempty"` // Hz
+	BitsPerSample                     int64                  `protobuf:"varint,6,opt,name=bits_per_sample,json=bitsPerSample,proto3" json:"bits_per_sample,omitempty"`
+	Duration                          string                 `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	IsPreview                         *bool                  `protobuf:"varint,8,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty"`
+	File                              *File                  `protobuf:"bytes,9,opt,name=file,proto3" json:"file,omitempty"`
+	Extensions                        []*RawElement          `protobuf:"bytes,10,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *TechnicalSoundRecordingDetails) Reset() {
+	*x = TechnicalSoundRecordingDetails{}
+	mi := &file_ern38_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TechnicalSoundRecordingDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TechnicalSoundRecordingDetails) ProtoMessage() {}
+
+func (x *TechnicalSoundRecordingDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TechnicalSoundRecordingDetails.ProtoReflect.Descriptor instead.
+func (*TechnicalSoundRecordingDetails) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TechnicalSoundRecordingDetails) GetTechnicalResourceDetailsReference() string {
+	if x != nil {
+		return x.TechnicalResourceDetailsReference
+	}
+	return ""
+}
+
+func (x *TechnicalSoundRecordingDetails) GetAudioCodecType() string {
+	if x != nil {
+		return x.AudioCodecType
+	}
+	return ""
+}
+
+func (x *TechnicalSoundRecordingDetails) GetBitRate() int64 {
+	if x != nil {
+		return x.BitRate
+	}
+	return 0
+}
+
+func (x *TechnicalSoundRecordingDetails) GetNumberOfChannels() int64 {
+	if x != nil {
+		return x.NumberOfChannels
+	}
+	return 0
+}
+
+func (x *TechnicalSoundRecordingDetails) GetSamplingRate() float64 {
+	if x != nil {
+		return x.SamplingRate
+	}
+	return 0
+}
+
+func (x *TechnicalSoundRecordingDetails) GetBitsPerSample() int64 {
+	if x != nil {
+		return x.BitsPerSample
+	}
+	return 0
+}
+
+func (x *TechnicalSoundRecordingDetails) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *TechnicalSoundRecordingDetails) GetIsPreview() bool {
+	if x != nil && x.IsPreview != nil {
+		return *x.IsPreview
+	}
+	return false
+}
+
+func (x *TechnicalSoundRecordingDetails) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *TechnicalSoundRecordingDetails) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// FulfillmentDate is the date from which a resource may be fulfilled to consumers, e.g. a
+// track available before its release. The date is a child element named FulfillmentDate as
+// well, hence the field name Date.
+type FulfillmentDate struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Date                     string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	ResourceReleaseReference string                 `protobuf:"bytes,2,opt,name=resource_release_reference,json=resourceReleaseReference,proto3" json:"resource_release_reference,omitempty"`
+	Extensions               []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *FulfillmentDate) Reset() {
+	*x = FulfillmentDate{}
+	mi := &file_ern38_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillmentDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillmentDate) ProtoMessage() {}
+
+func (x *FulfillmentDate) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillmentDate.ProtoReflect.Descriptor instead.
+func (*FulfillmentDate) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *FulfillmentDate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FulfillmentDate) GetResourceReleaseReference() string {
+	if x != nil {
+		return x.ResourceReleaseReference
+	}
+	return ""
+}
+
+func (x *FulfillmentDate) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Keywords represents keywords for enhanced search and display
+// ERN 3.8 version
+type Keywords struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Value                 string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Keywords) Reset() {
+	*x = Keywords{}
+	mi := &file_ern38_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Keywords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Keywords) ProtoMessage() {}
+
+func (x *Keywords) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Keywords.ProtoReflect.Descriptor instead.
+func (*Keywords) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Keywords) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Keywords) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+// Synopsis represents a synopsis with language attributes
+// Following ERN 3.8 standard specification
+type Synopsis struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Value                 string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	LanguageAndScriptCode string                 `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Synopsis) Reset() {
+	*x = Synopsis{}
+	mi := &file_ern38_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Synopsis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Synopsis) ProtoMessage() {}
+
+func (x *Synopsis) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Synopsis.ProtoReflect.Descriptor instead.
+func (*Synopsis) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Synopsis) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Synopsis) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+// SoundRecordingCollectionReference references a collection
+type SoundRecordingCollectionReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoundRecordingCollectionReference) Reset() {
+	*x = SoundRecordingCollectionReference{}
+	mi := &file_ern38_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoundRecordingCollectionReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoundRecordingCollectionReference) ProtoMessage() {}
+
+func (x *SoundRecordingCollectionReference) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoundRecordingCollectionReference.ProtoReflect.Descriptor instead.
+func (*SoundRecordingCollectionReference) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SoundRecordingCollectionReference) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Technical details types for ERN 3.8
+type TechnicalVideoDetails struct {
+	state                             protoimpl.MessageState `protogen:"open.v1"`
+	TechnicalResourceDetailsReference string                 `protobuf:"bytes,1,opt,name=technical_resource_details_reference,json=technicalResourceDetailsReference,proto3" json:"technical_resource_details_reference,omitempty"`
+	VideoCodecType                    string                 `protobuf:"bytes,2,opt,name=video_codec_type,json=videoCodecType,proto3" json:"video_codec_type,omitempty"`
+	VideoDefinitionType               string                 `protobuf:"bytes,3,opt,name=video_definition_type,json=videoDefinitionType,proto3" json:"video_definition_type,omitempty"`
+	Duration                          string                 `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	File                              *File                  `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`
+	Extensions                        []*RawElement          `protobuf:"bytes,6,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *TechnicalVideoDetails) Reset() {
+	*x = TechnicalVideoDetails{}
+	mi := &file_ern38_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TechnicalVideoDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TechnicalVideoDetails) ProtoMessage() {}
+
+func (x *TechnicalVideoDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TechnicalVideoDetails.ProtoReflect.Descriptor instead.
+func (*TechnicalVideoDetails) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TechnicalVideoDetails) GetTechnicalResourceDetailsReference() string {
+	if x != nil {
+		return x.TechnicalResourceDetailsReference
+	}
+	return ""
+}
+
+func (x *TechnicalVideoDetails) GetVideoCodecType() string {
+	if x != nil {
+		return x.VideoCodecType
+	}
+	return ""
+}
+
+func (x *TechnicalVideoDetails) GetVideoDefinitionType() string {
+	if x != nil {
+		return x.VideoDefinitionType
+	}
+	return ""
+}
+
+func (x *TechnicalVideoDetails) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *TechnicalVideoDetails) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *TechnicalVideoDetails) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Character represents a character in the video
+type Character struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	CharacterPartyReference string                 `protobuf:"bytes,1,opt,name=character_party_reference,json=characterPartyReference,proto3" json:"character_party_reference,omitempty"`
+	Name                    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Extensions              []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Character) Reset() {
+	*x = Character{}
+	mi := &file_ern38_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Character) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Character) ProtoMessage() {}
+
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Character) GetCharacterPartyReference() string {
+	if x != nil {
+		return x.CharacterPartyReference
+	}
+	return ""
+}
+
+func (x *Character) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Character) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type TechnicalImageDetails struct {
+	state                             protoimpl.MessageState `protogen:"open.v1"`
+	TechnicalResourceDetailsReference string                 `protobuf:"bytes,1,opt,name=technical_resource_details_reference,json=technicalResourceDetailsReference,proto3" json:"technical_resource_details_reference,omitempty"`
+	ImageCodecType                    string                 `protobuf:"bytes,2,opt,name=image_codec_type,json=imageCodecType,proto3" json:"image_codec_type,omitempty"`
+	ImageHeight                       int64                  `protobuf:"varint,3,opt,name=image_height,json=imageHeight,proto3" json:"image_height,omitempty"`
+	ImageWidth                        int64                  `protobuf:"varint,4,opt,name=image_width,json=imageWidth,proto3" json:"image_width,omitempty"`
+	File                              *File                  `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`
+	Extensions                        []*RawElement          `protobuf:"bytes,6,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *TechnicalImageDetails) Reset() {
+	*x = TechnicalImageDetails{}
+	mi := &file_ern38_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TechnicalImageDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TechnicalImageDetails) ProtoMessage() {}
+
+func (x *TechnicalImageDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TechnicalImageDetails.ProtoReflect.Descriptor instead.
+func (*TechnicalImageDetails) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TechnicalImageDetails) GetTechnicalResourceDetailsReference() string {
+	if x != nil {
+		return x.TechnicalResourceDetailsReference
+	}
+	return ""
+}
+
+func (x *TechnicalImageDetails) GetImageCodecType() string {
+	if x != nil {
+		return x.ImageCodecType
+	}
+	return ""
+}
+
+func (x *TechnicalImageDetails) GetImageHeight() int64 {
+	if x != nil {
+		return x.ImageHeight
+	}
+	return 0
+}
+
+func (x *TechnicalImageDetails) GetImageWidth() int64 {
+	if x != nil {
+		return x.ImageWidth
+	}
+	return 0
+}
+
+func (x *TechnicalImageDetails) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *TechnicalImageDetails) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type PartyName struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FullName        string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	FullNameIndexed string                 `protobuf:"bytes,2,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty"`
+	Extensions      []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PartyName) Reset() {
+	*x = PartyName{}
+	mi := &file_ern38_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyName) ProtoMessage() {}
+
+func (x *PartyName) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyName.ProtoReflect.Descriptor instead.
+func (*PartyName) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PartyName) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *PartyName) GetFullNameIndexed() string {
+	if x != nil {
+		return x.FullNameIndexed
+	}
+	return ""
+}
+
+func (x *PartyName) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type PartyId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isni          string                 `protobuf:"bytes,1,opt,name=isni,proto3" json:"isni,omitempty"`
+	Dpid          string                 `protobuf:"bytes,2,opt,name=dpid,proto3" json:"dpid,omitempty"`
+	IpiNameNumber string                 `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3" json:"ipi_name_number,omitempty"`
+	ProprietaryId []*ProprietaryId       `protobuf:"bytes,4,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyId) Reset() {
+	*x = PartyId{}
+	mi := &file_ern38_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyId) ProtoMessage() {}
+
+func (x *PartyId) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyId.ProtoReflect.Descriptor instead.
+func (*PartyId) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PartyId) GetIsni() string {
+	if x != nil {
+		return x.Isni
+	}
+	return ""
+}
+
+func (x *PartyId) GetDpid() string {
+	if x != nil {
+		return x.Dpid
+	}
+	return ""
+}
+
+func (x *PartyId) GetIpiNameNumber() string {
+	if x != nil {
+		return x.IpiNameNumber
+	}
+	return ""
+}
+
+func (x *PartyId) GetProprietaryId() []*ProprietaryId {
+	if x != nil {
+		return x.ProprietaryId
+	}
+	return nil
+}
+
+func (x *PartyId) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ReleaseResourceReference represents a single resource reference with its type
+type ReleaseResourceReference struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseResourceType string                 `protobuf:"bytes,1,opt,name=release_resource_type,json=releaseResourceType,proto3" json:"release_resource_type,omitempty"`
+	Value               string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReleaseResourceReference) Reset() {
+	*x = ReleaseResourceReference{}
+	mi := &file_ern38_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseResourceReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseResourceReference) ProtoMessage() {}
+
+func (x *ReleaseResourceReference) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseResourceReference.ProtoReflect.Descriptor instead.
+func (*ReleaseResourceReference) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ReleaseResourceReference) GetReleaseResourceType() string {
+	if x != nil {
+		return x.ReleaseResourceType
+	}
+	return ""
+}
+
+func (x *ReleaseResourceReference) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// ResourceRelatedId identifies a related resource outside the message
+type ResourceRelatedId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isrc          string                 `protobuf:"bytes,1,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	ProprietaryId []*ProprietaryId       `protobuf:"bytes,2,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceRelatedId) Reset() {
+	*x = ResourceRelatedId{}
+	mi := &file_ern38_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRelatedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRelatedId) ProtoMessage() {}
+
+func (x *ResourceRelatedId) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRelatedId.ProtoReflect.Descriptor instead.
+func (*ResourceRelatedId) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ResourceRelatedId) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *ResourceRelatedId) GetProprietaryId() []*ProprietaryId {
+	if x != nil {
+		return x.ProprietaryId
+	}
+	return nil
+}
+
+func (x *ResourceRelatedId) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// AdministratingRecordCompany represents the administrating record company
+type AdministratingRecordCompany struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartyId       []*PartyId             `protobuf:"bytes,1,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	PartyName     []*Name                `protobuf:"bytes,2,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty"`
+	TradingName   string                 `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdministratingRecordCompany) Reset() {
+	*x = AdministratingRecordCompany{}
+	mi := &file_ern38_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdministratingRecordCompany) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdministratingRecordCompany) ProtoMessage() {}
+
+func (x *AdministratingRecordCompany) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdministratingRecordCompany.ProtoReflect.Descriptor instead.
+func (*AdministratingRecordCompany) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AdministratingRecordCompany) GetPartyId() []*PartyId {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *AdministratingRecordCompany) GetPartyName() []*Name {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
+func (x *AdministratingRecordCompany) GetTradingName() string {
+	if x != nil {
+		return x.TradingName
+	}
+	return ""
+}
+
+func (x *AdministratingRecordCompany) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// RelatedRelease represents a related release
+type RelatedRelease struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	ReleaseId               *ReleaseId             `protobuf:"bytes,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	ReleaseRelationshipType string                 `protobuf:"bytes,2,opt,name=release_relationship_type,json=releaseRelationshipType,proto3" json:"release_relationship_type,omitempty"`
+	Extensions              []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RelatedRelease) Reset() {
+	*x = RelatedRelease{}
+	mi := &file_ern38_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedRelease) ProtoMessage() {}
+
+func (x *RelatedRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedRelease.ProtoReflect.Descriptor instead.
+func (*RelatedRelease) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RelatedRelease) GetReleaseId() *ReleaseId {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return nil
+}
+
+func (x *RelatedRelease) GetReleaseRelationshipType() string {
+	if x != nil {
+		return x.ReleaseRelationshipType
+	}
+	return ""
+}
+
+func (x *RelatedRelease) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ParentalWarningType represents parental warning classification
+type ParentalWarningType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParentalWarningType) Reset() {
+	*x = ParentalWarningType{}
+	mi := &file_ern38_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParentalWarningType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParentalWarningType) ProtoMessage() {}
+
+func (x *ParentalWarningType) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParentalWarningType.ProtoReflect.Descriptor instead.
+func (*ParentalWarningType) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ParentalWarningType) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// ResourceGroup represents a grouping of resources within a release
+type ResourceGroup struct {
+	state                    protoimpl.MessageState      `protogen:"open.v1"`
+	Title                    *Title                      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	SequenceNumber           int64                       `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	ResourceGroupContentItem []*ResourceGroupContentItem `protobuf:"bytes,3,rep,name=resource_group_content_item,json=resourceGroupContentItem,proto3" json:"resource_group_content_item,omitempty"`
+	Extensions               []*RawElement               `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ResourceGroup) Reset() {
+	*x = ResourceGroup{}
+	mi := &file_ern38_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceGroup) ProtoMessage() {}
+
+func (x *ResourceGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceGroup.ProtoReflect.Descriptor instead.
+func (*ResourceGroup) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ResourceGroup) GetTitle() *Title {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *ResourceGroup) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *ResourceGroup) GetResourceGroupContentItem() []*ResourceGroupContentItem {
+	if x != nil {
+		return x.ResourceGroupContentItem
+	}
+	return nil
+}
+
+func (x *ResourceGroup) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// DealTerms represents the commercial terms of a deal for ERN 3.8
+type DealTerms struct {
+	state                            protoimpl.MessageState     `protogen:"open.v1"`
+	LanguageAndScriptCode            string                     `protobuf:"bytes,1,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty"`
+	IsPreOrderDeal                   *bool                      `protobuf:"varint,2,opt,name=is_pre_order_deal,json=isPreOrderDeal,proto3,oneof" json:"is_pre_order_deal,omitempty"`                                                 // 0-1
+	CommercialModelType              []string                   `protobuf:"bytes,3,rep,name=commercial_model_type,json=commercialModelType,proto3" json:"commercial_model_type,omitempty"`                                           // 0-n
+	Usage                            []*Usage                   `protobuf:"bytes,4,rep,name=usage,proto3" json:"usage,omitempty"`                                                                                                    // 1-n (if used)
+	AllDealsCancelled                *bool                      `protobuf:"varint,5,opt,name=all_deals_cancelled,json=allDealsCancelled,proto3,oneof" json:"all_deals_cancelled,omitempty"`                                          // 1 (if used, deprecated)
+	TakeDown                         *bool                      `protobuf:"varint,6,opt,name=take_down,json=takeDown,proto3,oneof" json:"take_down,omitempty"`                                                                       // 1 (if used, deprecated)
+	TerritoryCode                    []string                   `protobuf:"bytes,7,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty"`                                                               // 1-n (if used)
+	ExcludedTerritoryCode            []string                   `protobuf:"bytes,8,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty"`                                     // 1-n (if used)
+	DistributionChannel              []*DSP                     `protobuf:"bytes,9,rep,name=distribution_channel,json=distributionChannel,proto3" json:"distribution_channel,omitempty"`                                             // 1-n (if used)
+	ExcludedDistributionChannel      []*DSP                     `protobuf:"bytes,10,rep,name=excluded_distribution_channel,json=excludedDistributionChannel,proto3" json:"excluded_distribution_channel,omitempty"`                  // 1-n (if used)
+	PriceInformation                 []*PriceInformation        `protobuf:"bytes,11,rep,name=price_information,json=priceInformation,proto3" json:"price_information,omitempty"`                                                     // 0-n
+	IsPromotional                    *bool                      `protobuf:"varint,12,opt,name=is_promotional,json=isPromotional,proto3,oneof" json:"is_promotional,omitempty"`                                                       // 1 (if used)
+	PromotionalCode                  *PromotionalCode           `protobuf:"bytes,13,opt,name=promotional_code,json=promotionalCode,proto3" json:"promotional_code,omitempty"`                                                        // 1 (if used)
+	ValidityPeriod                   []*ValidityPeriod          `protobuf:"bytes,14,rep,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty"`                                                           // 1-n
+	ConsumerRentalPeriod             *ConsumerRentalPeriod      `protobuf:"bytes,15,opt,name=consumer_rental_period,json=consumerRentalPeriod,proto3" json:"consumer_rental_period,omitempty"`                                       // 0-1
+	PreOrderReleaseDate              *EventDate                 `protobuf:"bytes,16,opt,name=pre_order_release_date,json=preOrderReleaseDate,proto3" json:"pre_order_release_date,omitempty"`                                        // 0-1
+	ReleaseDisplayStartDate          string                     `protobuf:"bytes,17,opt,name=release_display_start_date,json=releaseDisplayStartDate,proto3" json:"release_display_start_date,omitempty"`                            // 1 (if used)
+	TrackListingPreviewStartDate     string                     `protobuf:"bytes,18,opt,name=track_listing_preview_start_date,json=trackListingPreviewStartDate,proto3" json:"track_listing_preview_start_date,omitempty"`           // 1 (if used)
+	CoverArtPreviewStartDate         string                     `protobuf:"bytes,19,opt,name=cover_art_preview_start_date,json=coverArtPreviewStartDate,proto3" json:"cover_art_preview_start_date,omitempty"`                       // 1 (if used)
+	ClipPreviewStartDate             string                     `protobuf:"bytes,20,opt,name=clip_preview_start_date,json=clipPreviewStartDate,proto3" json:"clip_preview_start_date,omitempty"`                                     // 1 (if used)
+	PreOrderPreviewDate              *EventDate                 `protobuf:"bytes,21,opt,name=pre_order_preview_date,json=preOrderPreviewDate,proto3" json:"pre_order_preview_date,omitempty"`                                        // 1 (if used, deprecated)
+	PreOrderIncentiveResourceList    *DealResourceReferenceList `protobuf:"bytes,22,opt,name=pre_order_incentive_resource_list,json=preOrderIncentiveResourceList,proto3" json:"pre_order_incentive_resource_list,omitempty"`        // 0-1
+	InstantGratificationResourceList *DealResourceReferenceList `protobuf:"bytes,23,opt,name=instant_gratification_resource_list,json=instantGratificationResourceList,proto3" json:"instant_gratification_resource_list,omitempty"` // 0-1
+	IsExclusive                      *bool                      `protobuf:"varint,24,opt,name=is_exclusive,json=isExclusive,proto3,oneof" json:"is_exclusive,omitempty"`                                                             // 0-1
+	RelatedReleaseOfferSet           []*RelatedReleaseOfferSet  `protobuf:"bytes,25,rep,name=related_release_offer_set,json=relatedReleaseOfferSet,proto3" json:"related_release_offer_set,omitempty"`                               // 0-n
+	PhysicalReturns                  *PhysicalReturns           `protobuf:"bytes,26,opt,name=physical_returns,json=physicalReturns,proto3" json:"physical_returns,omitempty"`                                                        // 0-1
+	NumberOfProductsPerCarton        *int64                     `protobuf:"varint,27,opt,name=number_of_products_per_carton,json=numberOfProductsPerCarton,proto3,oneof" json:"number_of_products_per_carton,omitempty"`             // 0-1
+	RightsClaimPolicy                []*RightsClaimPolicy       `protobuf:"bytes,28,rep,name=rights_claim_policy,json=rightsClaimPolicy,proto3" json:"rights_claim_policy,omitempty"`                                                // 0-n
+	WebPolicy                        []*WebPolicy               `protobuf:"bytes,29,rep,name=web_policy,json=webPolicy,proto3" json:"web_policy,omitempty"`                                                                          // 0-n
+	Extensions                       []*RawElement              `protobuf:"bytes,30,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *DealTerms) Reset() {
+	*x = DealTerms{}
+	mi := &file_ern38_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DealTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealTerms) ProtoMessage() {}
+
+func (x *DealTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealTerms.ProtoReflect.Descriptor instead.
+func (*DealTerms) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DealTerms) GetLanguageAndScriptCode() string {
+	if x != nil {
+		return x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+func (x *DealTerms) GetIsPreOrderDeal() bool {
+	if x != nil && x.IsPreOrderDeal != nil {
+		return *x.IsPreOrderDeal
+	}
+	return false
+}
+
+func (x *DealTerms) GetCommercialModelType() []string {
+	if x != nil {
+		return x.CommercialModelType
+	}
+	return nil
+}
+
+func (x *DealTerms) GetUsage() []*Usage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *DealTerms) GetAllDealsCancelled() bool {
+	if x != nil && x.AllDealsCancelled != nil {
+		return *x.AllDealsCancelled
+	}
+	return false
+}
+
+func (x *DealTerms) GetTakeDown() bool {
+	if x != nil && x.TakeDown != nil {
+		return *x.TakeDown
+	}
+	return false
+}
+
+func (x *DealTerms) GetTerritoryCode() []string {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *DealTerms) GetExcludedTerritoryCode() []string {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
+func (x *DealTerms) GetDistributionChannel() []*DSP {
+	if x != nil {
+		return x.DistributionChannel
+	}
+	return nil
+}
+
+func (x *DealTerms) GetExcludedDistributionChannel() []*DSP {
+	if x != nil {
+		return x.ExcludedDistributionChannel
+	}
+	return nil
+}
+
+func (x *DealTerms) GetPriceInformation() []*PriceInformation {
+	if x != nil {
+		return x.PriceInformation
+	}
+	return nil
+}
+
+func (x *DealTerms) GetIsPromotional() bool {
+	if x != nil && x.IsPromotional != nil {
+		return *x.IsPromotional
+	}
+	return false
+}
+
+func (x *DealTerms) GetPromotionalCode() *PromotionalCode {
+	if x != nil {
+		return x.PromotionalCode
+	}
+	return nil
+}
+
+func (x *DealTerms) GetValidityPeriod() []*ValidityPeriod {
+	if x != nil {
+		return x.ValidityPeriod
+	}
+	return nil
+}
+
+func (x *DealTerms) GetConsumerRentalPeriod() *ConsumerRentalPeriod {
+	if x != nil {
+		return x.ConsumerRentalPeriod
+	}
+	return nil
+}
+
+func (x *DealTerms) GetPreOrderReleaseDate() *EventDate {
+	if x != nil {
+		return x.PreOrderReleaseDate
+	}
+	return nil
+}
+
+func (x *DealTerms) GetReleaseDisplayStartDate() string {
+	if x != nil {
+		return x.ReleaseDisplayStartDate
+	}
+	return ""
+}
+
+func (x *DealTerms) GetTrackListingPreviewStartDate() string {
+	if x != nil {
+		return x.TrackListingPreviewStartDate
+	}
+	return ""
+}
+
+func (x *DealTerms) GetCoverArtPreviewStartDate() string {
+	if x != nil {
+		return x.CoverArtPreviewStartDate
+	}
+	return ""
+}
+
+func (x *DealTerms) GetClipPreviewStartDate() string {
+	if x != nil {
+		return x.ClipPreviewStartDate
+	}
+	return ""
+}
+
+func (x *DealTerms) GetPreOrderPreviewDate() *EventDate {
+	if x != nil {
+		return x.PreOrderPreviewDate
+	}
+	return nil
+}
+
+func (x *DealTerms) GetPreOrderIncentiveResourceList() *DealResourceReferenceList {
+	if x != nil {
+		return x.PreOrderIncentiveResourceList
+	}
+	return nil
+}
+
+func (x *DealTerms) GetInstantGratificationResourceList() *DealResourceReferenceList {
+	if x != nil {
+		return x.InstantGratificationResourceList
+	}
+	return nil
+}
+
+func (x *DealTerms) GetIsExclusive() bool {
+	if x != nil && x.IsExclusive != nil {
+		return *x.IsExclusive
+	}
+	return false
+}
+
+func (x *DealTerms) GetRelatedReleaseOfferSet() []*RelatedReleaseOfferSet {
+	if x != nil {
+		return x.RelatedReleaseOfferSet
+	}
+	return nil
+}
+
+func (x *DealTerms) GetPhysicalReturns() *PhysicalReturns {
+	if x != nil {
+		return x.PhysicalReturns
+	}
+	return nil
+}
+
+func (x *DealTerms) GetNumberOfProductsPerCarton() int64 {
+	if x != nil && x.NumberOfProductsPerCarton != nil {
+		return *x.NumberOfProductsPerCarton
+	}
+	return 0
+}
+
+func (x *DealTerms) GetRightsClaimPolicy() []*RightsClaimPolicy {
+	if x != nil {
+		return x.RightsClaimPolicy
+	}
+	return nil
+}
+
+func (x *DealTerms) GetWebPolicy() []*WebPolicy {
+	if x != nil {
+		return x.WebPolicy
+	}
+	return nil
+}
+
+func (x *DealTerms) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// RatingAgency represents a rating agency with optional namespace
+type RatingAgency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingAgency) Reset() {
+	*x = RatingAgency{}
+	mi := &file_ern38_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingAgency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingAgency) ProtoMessage() {}
+
+func (x *RatingAgency) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingAgency.ProtoReflect.Descriptor instead.
+func (*RatingAgency) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RatingAgency) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *RatingAgency) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type File struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	HashSum       *HashSum               `protobuf:"bytes,2,opt,name=hash_sum,json=hashSum,proto3" json:"hash_sum,omitempty"`
+	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	mi := &file_ern38_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *File) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *File) GetHashSum() *HashSum {
+	if x != nil {
+		return x.HashSum
+	}
+	return nil
+}
+
+func (x *File) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *File) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ResourceGroupContentItem represents an item within a resource group
+type ResourceGroupContentItem struct {
+	state                          protoimpl.MessageState            `protogen:"open.v1"`
+	SequenceNumber                 int64                             `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	ResourceType                   string                            `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ReleaseResourceReference       *ReleaseResourceReference         `protobuf:"bytes,3,opt,name=release_resource_reference,json=releaseResourceReference,proto3" json:"release_resource_reference,omitempty"`
+	LinkedReleaseResourceReference []*LinkedReleaseResourceReference `protobuf:"bytes,4,rep,name=linked_release_resource_reference,json=linkedReleaseResourceReference,proto3" json:"linked_release_resource_reference,omitempty"`
+	Extensions                     []*RawElement                     `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *ResourceGroupContentItem) Reset() {
+	*x = ResourceGroupContentItem{}
+	mi := &file_ern38_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceGroupContentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceGroupContentItem) ProtoMessage() {}
+
+func (x *ResourceGroupContentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceGroupContentItem.ProtoReflect.Descriptor instead.
+func (*ResourceGroupContentItem) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ResourceGroupContentItem) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *ResourceGroupContentItem) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ResourceGroupContentItem) GetReleaseResourceReference() *ReleaseResourceReference {
+	if x != nil {
+		return x.ReleaseResourceReference
+	}
+	return nil
+}
+
+func (x *ResourceGroupContentItem) GetLinkedReleaseResourceReference() []*LinkedReleaseResourceReference {
+	if x != nil {
+		return x.LinkedReleaseResourceReference
+	}
+	return nil
+}
+
+func (x *ResourceGroupContentItem) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Usage represents usage types and restrictions
+type Usage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UseType       []string               `protobuf:"bytes,1,rep,name=use_type,json=useType,proto3" json:"use_type,omitempty"` // 1-n
+	Extensions    []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Usage) Reset() {
+	*x = Usage{}
+	mi := &file_ern38_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Usage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Usage) ProtoMessage() {}
+
+func (x *Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Usage.ProtoReflect.Descriptor instead.
+func (*Usage) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Usage) GetUseType() []string {
+	if x != nil {
+		return x.UseType
+	}
+	return nil
+}
+
+func (x *Usage) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// DSP represents a Digital Service Provider
+type DSP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Extensions    []*RawElement          `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DSP) Reset() {
+	*x = DSP{}
+	mi := &file_ern38_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DSP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSP) ProtoMessage() {}
+
+func (x *DSP) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSP.ProtoReflect.Descriptor instead.
+func (*DSP) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DSP) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// PriceInformation represents pricing information for a deal
+type PriceInformation struct {
+	state                          protoimpl.MessageState `protogen:"open.v1"`
+	BulkOrderWholesalePricePerUnit *string                `protobuf:"bytes,1,opt,name=bulk_order_wholesale_price_per_unit,json=bulkOrderWholesalePricePerUnit,proto3,oneof" json:"bulk_order_wholesale_price_per_unit,omitempty"`
+	Extensions                     []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *PriceInformation) Reset() {
+	*x = PriceInformation{}
+	mi := &file_ern38_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceInformation) ProtoMessage() {}
+
+func (x *PriceInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceInformation.ProtoReflect.Descriptor instead.
+func (*PriceInformation) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PriceInformation) GetBulkOrderWholesalePricePerUnit() string {
+	if x != nil && x.BulkOrderWholesalePricePerUnit != nil {
+		return *x.BulkOrderWholesalePricePerUnit
+	}
+	return ""
+}
+
+func (x *PriceInformation) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// PromotionalCode represents a promotional code composite
+type PromotionalCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Extensions    []*RawElement          `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionalCode) Reset() {
+	*x = PromotionalCode{}
+	mi := &file_ern38_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionalCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionalCode) ProtoMessage() {}
+
+func (x *PromotionalCode) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionalCode.ProtoReflect.Descriptor instead.
+func (*PromotionalCode) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PromotionalCode) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ValidityPeriod represents time period validity information
+type ValidityPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	StartDateTime string                 `protobuf:"bytes,2,opt,name=start_date_time,json=startDateTime,proto3" json:"start_date_time,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	EndDateTime   string                 `protobuf:"bytes,4,opt,name=end_date_time,json=endDateTime,proto3" json:"end_date_time,omitempty"`
+	Extensions    []*RawElement          `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidityPeriod) Reset() {
+	*x = ValidityPeriod{}
+	mi := &file_ern38_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidityPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidityPeriod) ProtoMessage() {}
+
+func (x *ValidityPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidityPeriod.ProtoReflect.Descriptor instead.
+func (*ValidityPeriod) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ValidityPeriod) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ValidityPeriod) GetStartDateTime() string {
+	if x != nil {
+		return x.StartDateTime
+	}
+	return ""
+}
+
+func (x *ValidityPeriod) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ValidityPeriod) GetEndDateTime() string {
+	if x != nil {
+		return x.EndDateTime
+	}
+	return ""
+}
+
+func (x *ValidityPeriod) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// ConsumerRentalPeriod represents the rental period for consumers
+type ConsumerRentalPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Extensions    []*RawElement          `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumerRentalPeriod) Reset() {
+	*x = ConsumerRentalPeriod{}
+	mi := &file_ern38_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerRentalPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerRentalPeriod) ProtoMessage() {}
+
+func (x *ConsumerRentalPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerRentalPeriod.ProtoReflect.Descriptor instead.
+func (*ConsumerRentalPeriod) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ConsumerRentalPeriod) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// DealResourceReferenceList represents a list of resources in a deal
+type DealResourceReferenceList struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	DealResourceReference []string               `protobuf:"bytes,1,rep,name=deal_resource_reference,json=dealResourceReference,proto3" json:"deal_resource_reference,omitempty"` // 1-n (ResourceReference of the resource)
+	Extensions            []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DealResourceReferenceList) Reset() {
+	*x = DealResourceReferenceList{}
+	mi := &file_ern38_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DealResourceReferenceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealResourceReferenceList) ProtoMessage() {}
+
+func (x *DealResourceReferenceList) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealResourceReferenceList.ProtoReflect.Descriptor instead.
+func (*DealResourceReferenceList) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DealResourceReferenceList) GetDealResourceReference() []string {
+	if x != nil {
+		return x.DealResourceReference
+	}
+	return nil
+}
+
+func (x *DealResourceReferenceList) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// RelatedReleaseOfferSet represents related offers for a release
+type RelatedReleaseOfferSet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Extensions    []*RawElement          `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedReleaseOfferSet) Reset() {
+	*x = RelatedReleaseOfferSet{}
+	mi := &file_ern38_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedReleaseOfferSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedReleaseOfferSet) ProtoMessage() {}
+
+func (x *RelatedReleaseOfferSet) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedReleaseOfferSet.ProtoReflect.Descriptor instead.
+func (*RelatedReleaseOfferSet) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RelatedReleaseOfferSet) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// PhysicalReturns represents physical returns information
+type PhysicalReturns struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Extensions    []*RawElement          `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PhysicalReturns) Reset() {
+	*x = PhysicalReturns{}
+	mi := &file_ern38_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhysicalReturns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhysicalReturns) ProtoMessage() {}
+
+func (x *PhysicalReturns) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhysicalReturns.ProtoReflect.Descriptor instead.
+func (*PhysicalReturns) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *PhysicalReturns) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// RightsClaimPolicy represents a policy for claiming rights
+type RightsClaimPolicy struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RightsClaimPolicyType string                 `protobuf:"bytes,1,opt,name=rights_claim_policy_type,json=rightsClaimPolicyType,proto3" json:"rights_claim_policy_type,omitempty"`
+	Extensions            []*RawElement          `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RightsClaimPolicy) Reset() {
+	*x = RightsClaimPolicy{}
+	mi := &file_ern38_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RightsClaimPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RightsClaimPolicy) ProtoMessage() {}
+
+func (x *RightsClaimPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RightsClaimPolicy.ProtoReflect.Descriptor instead.
+func (*RightsClaimPolicy) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RightsClaimPolicy) GetRightsClaimPolicyType() string {
+	if x != nil {
+		return x.RightsClaimPolicyType
+	}
+	return ""
+}
+
+func (x *RightsClaimPolicy) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// WebPolicy represents UserGeneratedContent permissions
+type WebPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Extensions    []*RawElement          `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebPolicy) Reset() {
+	*x = WebPolicy{}
+	mi := &file_ern38_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPolicy) ProtoMessage() {}
+
+func (x *WebPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPolicy.ProtoReflect.Descriptor instead.
+func (*WebPolicy) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *WebPolicy) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type HashSum struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	HashSum              string                 `protobuf:"bytes,1,opt,name=hash_sum,json=hashSum,proto3" json:"hash_sum,omitempty"`
+	HashSumAlgorithmType string                 `protobuf:"bytes,2,opt,name=hash_sum_algorithm_type,json=hashSumAlgorithmType,proto3" json:"hash_sum_algorithm_type,omitempty"`
+	Extensions           []*RawElement          `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *HashSum) Reset() {
+	*x = HashSum{}
+	mi := &file_ern38_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HashSum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashSum) ProtoMessage() {}
+
+func (x *HashSum) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashSum.ProtoReflect.Descriptor instead.
+func (*HashSum) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *HashSum) GetHashSum() string {
+	if x != nil {
+		return x.HashSum
+	}
+	return ""
+}
+
+func (x *HashSum) GetHashSumAlgorithmType() string {
+	if x != nil {
+		return x.HashSumAlgorithmType
+	}
+	return ""
+}
+
+func (x *HashSum) GetExtensions() []*RawElement {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// LinkedReleaseResourceReference represents a linked resource reference (e.g., cover art)
+type LinkedReleaseResourceReference struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LinkDescription string                 `protobuf:"bytes,1,opt,name=link_description,json=linkDescription,proto3" json:"link_description,omitempty"`
+	Value           string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LinkedReleaseResourceReference) Reset() {
+	*x = LinkedReleaseResourceReference{}
+	mi := &file_ern38_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkedReleaseResourceReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedReleaseResourceReference) ProtoMessage() {}
+
+func (x *LinkedReleaseResourceReference) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkedReleaseResourceReference.ProtoReflect.Descriptor instead.
+func (*LinkedReleaseResourceReference) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *LinkedReleaseResourceReference) GetLinkDescription() string {
+	if x != nil {
+		return x.LinkDescription
+	}
+	return ""
+}
+
+func (x *LinkedReleaseResourceReference) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// DateTime is a date and time with the UTC offset it was written with, so that it
+// marshals to the same XML. Its first fields are those of google.protobuf.Timestamp, which
+// can decode it, dropping the offset.
+type DateTime struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Seconds          int64                  `protobuf:"varint,1,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Nanos            int32                  `protobuf:"varint,2,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	UtcOffsetSeconds int32                  `protobuf:"varint,3,opt,name=utc_offset_seconds,json=utcOffsetSeconds,proto3" json:"utc_offset_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DateTime) Reset() {
+	*x = DateTime{}
+	mi := &file_ern38_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateTime) ProtoMessage() {}
+
+func (x *DateTime) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateTime.ProtoReflect.Descriptor instead.
+func (*DateTime) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DateTime) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *DateTime) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+func (x *DateTime) GetUtcOffsetSeconds() int32 {
+	if x != nil {
+		return x.UtcOffsetSeconds
+	}
+	return 0
+}
+
+// XmlName is the namespace and local name of an XML element or attribute
+type XmlName struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Space         string                 `protobuf:"bytes,1,opt,name=space,proto3" json:"space,omitempty"`
+	Local         string                 `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XmlName) Reset() {
+	*x = XmlName{}
+	mi := &file_ern38_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XmlName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XmlName) ProtoMessage() {}
+
+func (x *XmlName) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XmlName.ProtoReflect.Descriptor instead.
+func (*XmlName) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *XmlName) GetSpace() string {
+	if x != nil {
+		return x.Space
+	}
+	return ""
+}
+
+func (x *XmlName) GetLocal() string {
+	if x != nil {
+		return x.Local
+	}
+	return ""
+}
+
+// XmlAttr is an XML attribute
+type XmlAttr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *XmlName               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XmlAttr) Reset() {
+	*x = XmlAttr{}
+	mi := &file_ern38_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XmlAttr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XmlAttr) ProtoMessage() {}
+
+func (x *XmlAttr) ProtoReflect() protoreflect.Message {
+	mi := &file_ern38_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XmlAttr.ProtoReflect.Descriptor instead.
+func (*XmlAttr) Descriptor() ([]byte, []int) {
+	return file_ern38_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *XmlAttr) GetName() *XmlName {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *XmlAttr) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_ern38_proto protoreflect.FileDescriptor
+
+var file_ern38_proto_rawDesc = string([]byte{
+	0x0a, 0x0b, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x22, 0xbd, 0x05, 0x0a, 0x11, 0x4e, 0x65,
+	0x77, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x78, 0x6d, 0x6c, 0x6e, 0x73, 0x5f, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x78, 0x6d, 0x6c, 0x6e, 0x73, 0x45, 0x72, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x78, 0x6d, 0x6c, 0x6e, 0x73, 0x5f, 0x78, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x78, 0x6d, 0x6c, 0x6e, 0x73, 0x58, 0x73, 0x69, 0x12, 0x2e, 0x0a, 0x13, 0x78, 0x73, 0x69,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x78, 0x73, 0x69, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a,
+	0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x58,
+	0x6d, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x41, 0x74, 0x74,
+	0x72, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3d,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x09, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44,
+	0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x64, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfe, 0x04, 0x0a, 0x0d, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x11, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x62, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x53, 0x65, 0x6e,
+	0x74, 0x4f, 0x6e, 0x42, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x4f, 0x66, 0x52, 0x0e, 0x73, 0x65, 0x6e,
+	0x74, 0x4f, 0x6e, 0x42, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x4f, 0x66, 0x12, 0x49, 0x0a, 0x11, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x19, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x52, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x73,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x0e, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x27, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x65,
+	0x78, 0x74, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x80, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x74, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7e, 0x0a, 0x08, 0x44, 0x65, 0x61,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x65, 0x61, 0x6c, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x61,
+	0x6c, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7b, 0x0a, 0x0a, 0x52, 0x61, 0x77,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x58, 0x6d, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x27, 0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x58, 0x6d, 0x6c, 0x41,
+	0x74, 0x74, 0x72, 0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x5f, 0x78, 0x6d, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x58, 0x6d, 0x6c, 0x22, 0xcb, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x44, 0x52,
+	0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61,
+	0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x42,
+	0x65, 0x68, 0x61, 0x6c, 0x66, 0x4f, 0x66, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x44, 0x52, 0x07,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x44, 0x52,
+	0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61,
+	0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x12, 0x5d, 0x0a, 0x19, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x16, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xf8, 0x14, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x69, 0x73, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01,
+	0x52, 0x0f, 0x69, 0x73, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x12, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x53, 0x6f,
+	0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x52, 0x10,
+	0x73, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x58, 0x0a, 0x1b, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x49, 0x64,
+	0x52, 0x18, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x0e,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x58,
+	0x0a, 0x1b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1a, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d,
+	0x65, 0x64, 0x6c, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x08, 0x69,
+	0x73, 0x4d, 0x65, 0x64, 0x6c, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x73,
+	0x5f, 0x70, 0x6f, 0x74, 0x70, 0x6f, 0x75, 0x72, 0x72, 0x69, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x03, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x6f, 0x74, 0x70, 0x6f, 0x75, 0x72, 0x72, 0x69, 0x88,
+	0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0e, 0x69,
+	0x73, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x0c, 0x69, 0x73, 0x42, 0x61, 0x63,
+	0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x69, 0x73,
+	0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x10, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x11, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x0f, 0x69, 0x73, 0x42, 0x6f,
+	0x6e, 0x75, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3e,
+	0x0a, 0x19, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x08, 0x52, 0x16, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x08, 0x48, 0x09, 0x52, 0x0c, 0x69, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6e, 0x6f, 0x5f, 0x73,
+	0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x0a, 0x52, 0x0f, 0x6e, 0x6f, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x6f, 0x5f,
+	0x73, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x0b, 0x52, 0x0e, 0x6e, 0x6f, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x1e, 0x70, 0x65, 0x72, 0x66,
+	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x0c, 0x52, 0x1c, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f,
+	0x6f, 0x66, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x15,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x4f, 0x66,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x13, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x52, 0x11, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x41, 0x67, 0x72, 0x65, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x7c, 0x0a, 0x24, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x73, 0x69,
+	0x63, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x8e, 0x01, 0x0a, 0x2a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x26, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52,
+	0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a,
+	0x0f, 0x72, 0x65, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x72,
+	0x65, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x7c, 0x0a,
+	0x24, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x65, 0x72, 0x72,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79,
+	0x54, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x20, 0x73, 0x6f, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x42, 0x79, 0x54, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x1a, 0x74,
+	0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x18, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x40, 0x0a, 0x1a, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0d, 0x52,
+	0x17, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x1e, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x20, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x0e, 0x52, 0x1a, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x4e,
+	0x6f, 0x6e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x1c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f,
+	0x66, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0f, 0x52, 0x19, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x20, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x10, 0x52, 0x1c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
+	0x4e, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x23, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x6d, 0x65, 0x64, 0x6c,
+	0x65, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x74, 0x70, 0x6f, 0x75,
+	0x72, 0x72, 0x69, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x73, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x69, 0x73,
+	0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x70,
+	0x72, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x69,
+	0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x13, 0x0a, 0x11,
+	0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x6f, 0x66, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x73, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f,
+	0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x42, 0x1f, 0x0a, 0x1d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x42, 0x23, 0x0a, 0x21, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0xdc, 0x17, 0x0a,
+	0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x69, 0x73,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x11, 0x69, 0x73, 0x5f,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0f, 0x69, 0x73, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49,
+	0x64, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x11, 0x69, 0x6e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x49, 0x64,
+	0x52, 0x0f, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x5d, 0x0a, 0x19, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x63, 0x75, 0x65, 0x5f, 0x73, 0x68,
+	0x65, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x75, 0x65, 0x53, 0x68, 0x65, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x75,
+	0x65, 0x53, 0x68, 0x65, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x52, 0x0a, 0x1c, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x75,
+	0x65, 0x5f, 0x73, 0x68, 0x65, 0x65, 0x74, 0x5f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x18, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x46, 0x6f, 0x72, 0x43, 0x75, 0x65, 0x53, 0x68, 0x65, 0x65, 0x74, 0x41, 0x62, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x58, 0x0a, 0x1b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x1a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x09, 0x69,
+	0x73, 0x5f, 0x6d, 0x65, 0x64, 0x6c, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02,
+	0x52, 0x08, 0x69, 0x73, 0x4d, 0x65, 0x64, 0x6c, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a,
+	0x0c, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x74, 0x70, 0x6f, 0x75, 0x72, 0x72, 0x69, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x6f, 0x74, 0x70, 0x6f, 0x75, 0x72,
+	0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04,
+	0x52, 0x0e, 0x69, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x0c, 0x69, 0x73,
+	0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a,
+	0x12, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x10, 0x69, 0x73, 0x48,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2f, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x0f, 0x69,
+	0x73, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x3e, 0x0a, 0x19, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x08, 0x52, 0x16, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x48, 0x09, 0x52, 0x0c, 0x69, 0x73, 0x52, 0x65,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6e,
+	0x6f, 0x5f, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0a, 0x52, 0x0f, 0x6e, 0x6f, 0x53, 0x69, 0x6c, 0x65,
+	0x6e, 0x63, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10,
+	0x6e, 0x6f, 0x5f, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0b, 0x52, 0x0e, 0x6e, 0x6f, 0x53, 0x69, 0x6c, 0x65,
+	0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x1e, 0x70,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x0c, 0x52, 0x1c, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x18, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x4f, 0x66, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2e,
+	0x0a, 0x13, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x75,
+	0x62, 0x62, 0x69, 0x6e, 0x67, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x44, 0x75, 0x62, 0x62, 0x69, 0x6e, 0x67, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x73, 0x75, 0x62, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x13, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x52, 0x11, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x78, 0x0a, 0x1f, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x53, 0x6f,
+	0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x1c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x7c, 0x0a, 0x24, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6d, 0x75,
+	0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x6b,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x20, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x57, 0x6f,
+	0x72, 0x6b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x8e, 0x01, 0x0a, 0x2a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x26, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0d,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x21, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x60, 0x0a, 0x1a, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x65, 0x72,
+	0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x23, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x17, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42,
+	0x79, 0x54, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x1a, 0x74, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18,
+	0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x40, 0x0a, 0x1a, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0d, 0x52, 0x17,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x1e, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x26, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x0e, 0x52, 0x1a, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x4e, 0x6f,
+	0x6e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x1c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x73, 0x18, 0x27, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0f, 0x52, 0x19, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x20, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x28, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x10, 0x52, 0x1c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x4e,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x69, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x6d, 0x65, 0x64, 0x6c, 0x65,
+	0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x74, 0x70, 0x6f, 0x75, 0x72,
+	0x72, 0x69, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x61,
+	0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x69, 0x73, 0x5f,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72,
+	0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x69, 0x6c,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x6e, 0x6f, 0x5f, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f,
+	0x66, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66,
+	0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x73, 0x42, 0x1f, 0x0a, 0x1d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x42, 0x23, 0x0a, 0x21, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0xce, 0x04, 0x0a, 0x05,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x69, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0f, 0x69, 0x73, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x60, 0x0a,
+	0x1a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x62,
+	0x79, 0x5f, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72,
+	0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x17, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x73, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x22, 0x86, 0x02, 0x0a,
+	0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x4a, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x10, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52,
+	0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x84, 0x04, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x3a, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x52, 0x0c,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x12,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52,
+	0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x40, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x12, 0x6f, 0x0a, 0x1f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x65, 0x72,
+	0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72,
+	0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x1c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72, 0x72, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xad, 0x0c, 0x0a,
+	0x07, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4d, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x64, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x16,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x14,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x4a, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x10,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x3d, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x46, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x0e, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x6f, 0x0a, 0x1f,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x1c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x75, 0x0a,
+	0x21, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x1e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x0e,
+	0x69, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x66, 0x0a, 0x1c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x65, 0x72, 0x72, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x19, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x42, 0x79, 0x54, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x17,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x65, 0x72, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x75, 0x62, 0x62, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x11, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x44, 0x75, 0x62,
+	0x62, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x10, 0x73, 0x75, 0x62, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
+	0x0a, 0x06, 0x70, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x4c, 0x69, 0x6e,
+	0x65, 0x52, 0x05, 0x70, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x63, 0x4c, 0x69,
+	0x6e, 0x65, 0x12, 0x45, 0x0a, 0x13, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x11, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x1c, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x19, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x73,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a,
+	0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16,
+	0x64, 0x65, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65,
+	0x61, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x65,
+	0x61, 0x6c, 0x52, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x3d, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22,
+	0xc5, 0x01, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x73, 0x63, 0x69, 0x69, 0x12, 0x23, 0x0a,
+	0x0d, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbc, 0x02, 0x0a, 0x16, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x61,
+	0x0a, 0x23, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x1e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x4b, 0x0a, 0x23, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x72, 0x61, 0x69,
+	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x36,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x10, 0x53, 0x6f, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12,
+	0x40, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x0d, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61,
+	0x72, 0x79, 0x49, 0x64, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x0d,
+	0x4d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x73, 0x77, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x77,
+	0x63, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61,
+	0x72, 0x79, 0x49, 0x64, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x75, 0x62, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x5c, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0xa1, 0x01, 0x0a, 0x11, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x41, 0x67, 0x72, 0x65, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x77, 0x6c, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x77, 0x6c, 0x69, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x0d, 0x70,
+	0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61,
+	0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x6f, 0x0a, 0x1f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x57,
+	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x1c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x57, 0x6f, 0x72,
+	0x6b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x26, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x81, 0x01,
+	0x0a, 0x25, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x22, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x09, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0xdb, 0x0e, 0x0a, 0x20, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72, 0x72, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x5f, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x54,
+	0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x52, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f,
+	0x72, 0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x6b, 0x0a, 0x1d, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x1b, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x6f, 0x72, 0x12, 0x4d, 0x0a, 0x13, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52,
+	0x11, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x4d, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x11,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x52, 0x10, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x49, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a,
+	0x1e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x1b, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x70, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x70, 0x4c,
+	0x69, 0x6e, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79,
+	0x4c, 0x69, 0x6e, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79, 0x4c, 0x69,
+	0x6e, 0x65, 0x12, 0x2c, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x4a, 0x0a, 0x12, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63,
+	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x6f,
+	0x75, 0x6e, 0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x52, 0x10, 0x68, 0x6f, 0x73, 0x74,
+	0x53, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x11,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65,
+	0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x61,
+	0x76, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x41, 0x76, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x61, 0x76, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x75,
+	0x0a, 0x21, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x53, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x1e, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x53, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x46, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x66, 0x75,
+	0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a,
+	0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x30, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x53,
+	0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69,
+	0x73, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x21, 0x0a,
+	0x09, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x97, 0x01, 0x0a, 0x07, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63,
+	0x12, 0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x49, 0x64, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2e, 0x0a, 0x16, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x43, 0x75, 0x65, 0x53, 0x68, 0x65, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x57, 0x0a, 0x06, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x37, 0x0a,
+	0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x54, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x25, 0x53, 0x6f,
+	0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x24, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x53,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x21, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x95, 0x0f, 0x0a, 0x17,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x54, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x11, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x64,
+	0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x52, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x6f, 0x72, 0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x6b, 0x0a, 0x1d, 0x69, 0x6e, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x49, 0x6e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x1b, 0x69, 0x6e, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x4d, 0x0a, 0x13, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x52, 0x11, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x52, 0x10, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x13, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x5a, 0x0a, 0x1e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52,
+	0x1b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x06,
+	0x70, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x4c, 0x69, 0x6e, 0x65, 0x52,
+	0x05, 0x70, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x43, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x63, 0x4c, 0x69, 0x6e, 0x65,
+	0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79, 0x4c, 0x69, 0x6e,
+	0x65, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79, 0x4c, 0x69, 0x6e, 0x65, 0x12,
+	0x2c, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a,
+	0x12, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x6e, 0x64,
+	0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x52, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x6f, 0x75,
+	0x6e, 0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x11, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x67,
+	0x65, 0x6e, 0x72, 0x65, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x05, 0x67,
+	0x65, 0x6e, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x17, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x61, 0x76, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x41, 0x76, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x61, 0x76, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x59, 0x0a, 0x17, 0x74,
+	0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69,
+	0x63, 0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
+	0x15, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x10, 0x66,
+	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x52, 0x0f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x08, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69,
+	0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x53, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73, 0x52, 0x08, 0x73,
+	0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x21, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x07, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74,
+	0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61,
+	0x72, 0x79, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd1, 0x09, 0x0a,
+	0x17, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54,
+	0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x72, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x54, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x52, 0x0a, 0x14, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x6b, 0x0a,
+	0x1d, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x1b, 0x69,
+	0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x4d, 0x0a, 0x13, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x63, 0x4c,
+	0x69, 0x6e, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d,
+	0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79, 0x4c, 0x69, 0x6e, 0x65, 0x52,
+	0x0c, 0x63, 0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x49, 0x0a,
+	0x15, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a, 0x1e, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x1b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x46, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x66, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x30,
+	0x0a, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x53, 0x79,
+	0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73,
+	0x12, 0x27, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x47, 0x65, 0x6e,
+	0x72, 0x65, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x59, 0x0a,
+	0x17, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x65, 0x63, 0x68,
+	0x6e, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x15, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x40, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x22, 0x61, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x18,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x98, 0x02, 0x0a, 0x09, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x67, 0x5f, 0x72, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x67, 0x52, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x72, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x63, 0x70, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x70, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x73, 0x61, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0d, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69,
+	0x65, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x72,
+	0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x79, 0x0a, 0x09, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x41,
+	0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x0d,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x49, 0x64, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x36, 0x0a,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52,
+	0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9b, 0x02, 0x0a, 0x1c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72,
+	0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x43, 0x0a,
+	0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74,
+	0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65,
+	0x78, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x47,
+	0x65, 0x6e, 0x72, 0x65, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x60, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x36, 0x0a,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52,
+	0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7c, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74,
+	0x52, 0x09, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x68, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xba, 0x01,
+	0x0a, 0x1c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x62,
+	0x0a, 0x1a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x18, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x1e, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x1c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x1a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x96, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x18, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x23, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x23, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xfa, 0x0a, 0x0a, 0x19, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72, 0x72, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x41,
+	0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f,
+	0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x54, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x69,
+	0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x18, 0x69, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x1d, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x1b, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x3a, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x13, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a,
+	0x09, 0x61, 0x76, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x41, 0x76,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x61, 0x76, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x40, 0x0a, 0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x10, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x28, 0x0a,
+	0x06, 0x70, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x05, 0x70, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x63, 0x4c, 0x69, 0x6e,
+	0x65, 0x12, 0x38, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0b,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x15, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x52, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x08,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x6f,
+	0x70, 0x73, 0x69, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x53, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73,
+	0x52, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x73, 0x0a, 0x05, 0x50, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79,
+	0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12,
+	0x1e, 0x0a, 0x0b, 0x70, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x73, 0x0a, 0x05, 0x43, 0x4c, 0x69, 0x6e, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x63, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x4c, 0x69, 0x6e, 0x65,
+	0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x74, 0x0a, 0x04,
+	0x44, 0x65, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x0a, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x65, 0x72,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52,
+	0x09, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x43, 0x0a, 0x0d, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x43, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x72,
+	0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd6, 0x01, 0x0a,
+	0x1c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x57, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x41, 0x0a,
+	0x0f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x49,
+	0x64, 0x52, 0x0d, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x36, 0x0a,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52,
+	0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x25,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x73, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x43, 0x6f, 0x6e, 0x64, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64,
+	0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xa1, 0x04, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f,
+	0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48,
+	0x0a, 0x1e, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x61, 0x64, 0x65, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x1b, 0x68, 0x61, 0x73, 0x4d, 0x61, 0x64,
+	0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x20, 0x68, 0x61, 0x73, 0x5f,
+	0x6d, 0x61, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x1d, 0x68, 0x61, 0x73, 0x4d, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x21,
+	0x0a, 0x1f, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x61, 0x64, 0x65, 0x5f, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x23, 0x0a, 0x21, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x61, 0x64, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x02, 0x0a, 0x1b, 0x49, 0x6e, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x34, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x52, 0x07, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x22, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x1f, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x62, 0x0a, 0x11, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x82,
+	0x01, 0x0a, 0x09, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0xf4, 0x03, 0x0a, 0x10, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x44, 0x52, 0x07,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x21, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x1e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x14, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x16, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x14, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x72, 0x69, 0x67, 0x68, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x55, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42,
+	0x19, 0x0a, 0x17, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0c, 0x43,
+	0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79,
+	0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12,
+	0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x75, 0x72, 0x74, 0x65, 0x73, 0x79, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x75,
+	0x72, 0x74, 0x65, 0x73, 0x79, 0x4c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52,
+	0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc7, 0x03, 0x0a, 0x10, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x6f,
+	0x75, 0x6e, 0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x64, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64,
+	0x12, 0x40, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x0d, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x15,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x52, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x58, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x05, 0x47, 0x65,
+	0x6e, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x5f, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x54, 0x65,
+	0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x5f, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12,
+	0x3a, 0x0a, 0x19, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x17, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x08, 0x41, 0x76, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x67, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xde, 0x03, 0x0a, 0x1e, 0x54, 0x65, 0x63,
+	0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x4f, 0x0a, 0x24, 0x74,
+	0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x21, 0x74, 0x65, 0x63, 0x68, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x74, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62,
+	0x69, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09,
+	0x69, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69,
+	0x73, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x9b, 0x01, 0x0a, 0x0f, 0x46, 0x75,
+	0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x59, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x59, 0x0a, 0x08, 0x53, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x39, 0x0a,
+	0x21, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x15, 0x54, 0x65, 0x63,
+	0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x4f, 0x0a, 0x24, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x21, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a,
+	0x15, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x09,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xb4, 0x02, 0x0a, 0x15, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x4f, 0x0a, 0x24, 0x74,
+	0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x21, 0x74, 0x65, 0x63, 0x68, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x12,
+	0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x69, 0x73, 0x6e, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x70, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x70, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x69,
+	0x70, 0x69, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x70, 0x69, 0x4e, 0x61, 0x6d, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65,
+	0x74, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74,
+	0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x64, 0x0a,
+	0x18, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x72,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12, 0x40, 0x0a,
+	0x0e, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x49, 0x64,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x72, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x52, 0x07,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x19,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x17, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x2b, 0x0a, 0x13, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x57, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xfe, 0x01,
+	0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x63, 0x0a, 0x1b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x18, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcc,
+	0x10, 0x0a, 0x09, 0x44, 0x65, 0x61, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x18,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x0e, 0x69, 0x73, 0x50, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65,
+	0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63,
+	0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69, 0x61, 0x6c,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x33, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x73, 0x5f,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x01, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x5f,
+	0x64, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x08, 0x74, 0x61,
+	0x6b, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x65, 0x72,
+	0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x72,
+	0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x15, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x54, 0x65, 0x72, 0x72, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x44, 0x53, 0x50, 0x52, 0x13, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x53, 0x0a, 0x1d,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x44, 0x53, 0x50, 0x52, 0x1b, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x49, 0x0a, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x0e,
+	0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0d, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x43, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x56, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x4a, 0x0a,
+	0x16, 0x70, 0x72, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x52, 0x13, 0x70, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x20, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x1c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3e,
+	0x0a, 0x1c, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x72, 0x74, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35,
+	0x0a, 0x17, 0x63, 0x6c, 0x69, 0x70, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x63, 0x6c, 0x69, 0x70, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e,
+	0x33, 0x38, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x13, 0x70, 0x72,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x6f, 0x0a, 0x21, 0x70, 0x72, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64,
+	0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x1d, 0x70, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x74, 0x0a, 0x23, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x44, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x47,
+	0x72, 0x61, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04,
+	0x52, 0x0b, 0x69, 0x73, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x5d, 0x0a, 0x19, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x19, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x16, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12,
+	0x46, 0x0a, 0x10, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x0f, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x45, 0x0a, 0x1d, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x6f, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05,
+	0x52, 0x19, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61, 0x72, 0x74, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x4d,
+	0x0a, 0x13, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x11, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x34, 0x0a,
+	0x0a, 0x77, 0x65, 0x62, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x57,
+	0x65, 0x62, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x77, 0x65, 0x62, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65,
+	0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x69, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x61,
+	0x6c, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x73, 0x5f,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x61,
+	0x6b, 0x65, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69,
+	0x73, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x42, 0x20, 0x0a, 0x1e, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x6f, 0x6e, 0x22, 0x42, 0x0a,
+	0x0c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x22, 0xa8, 0x01, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x5f,
+	0x73, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x53, 0x75, 0x6d, 0x52, 0x07,
+	0x68, 0x61, 0x73, 0x68, 0x53, 0x75, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e,
+	0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfb, 0x02, 0x0a,
+	0x18, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x62, 0x0a, 0x1a, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x18, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x75, 0x0a, 0x21, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x1e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5a, 0x0a, 0x05, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x03, 0x44, 0x53, 0x50, 0x12, 0x36, 0x0a,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52,
+	0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x23, 0x62, 0x75,
+	0x6c, 0x6b, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x77, 0x68, 0x6f, 0x6c, 0x65, 0x73, 0x61,
+	0x6c, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x1e, 0x62, 0x75, 0x6c, 0x6b, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x57, 0x68, 0x6f, 0x6c, 0x65, 0x73, 0x61, 0x6c, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61,
+	0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x26, 0x0a, 0x24, 0x5f, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x77, 0x68, 0x6f, 0x6c, 0x65, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x49, 0x0a, 0x0f,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38,
+	0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4e, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x64, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x36,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x50, 0x0a, 0x16, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33,
+	0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x49, 0x0a, 0x0f, 0x50, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72,
+	0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x43, 0x0a, 0x09, 0x57, 0x65,
+	0x62, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52, 0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x93, 0x01, 0x0a, 0x07, 0x48, 0x61, 0x73, 0x68, 0x53, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x68,
+	0x61, 0x73, 0x68, 0x5f, 0x73, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68,
+	0x61, 0x73, 0x68, 0x53, 0x75, 0x6d, 0x12, 0x35, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x73,
+	0x75, 0x6d, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x68, 0x61, 0x73, 0x68, 0x53, 0x75, 0x6d,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e, 0x52,
+	0x61, 0x77, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61, 0x0a, 0x1e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x68, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6e,
+	0x61, 0x6e, 0x6f, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x74, 0x63, 0x5f, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x75, 0x74, 0x63, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0x35, 0x0a, 0x07, 0x58, 0x6d, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x48, 0x0a, 0x07, 0x58, 0x6d, 0x6c,
+	0x41, 0x74, 0x74, 0x72, 0x12, 0x27, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x65, 0x72, 0x6e, 0x33, 0x38, 0x2e,
+	0x58, 0x6d, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x6f, 0x73, 0x64, 0x65, 0x74, 0x69, 0x6a, 0x65, 0x72, 0x61, 0x2f,
+	0x64, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x64, 0x64,
+	0x65, 0x78, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_ern38_proto_rawDescOnce sync.Once
+	file_ern38_proto_rawDescData []byte
+)
+
+func file_ern38_proto_rawDescGZIP() []byte {
+	file_ern38_proto_rawDescOnce.Do(func() {
+		file_ern38_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ern38_proto_rawDesc), len(file_ern38_proto_rawDesc)))
+	})
+	return file_ern38_proto_rawDescData
+}
+
+var file_ern38_proto_msgTypes = make([]protoimpl.MessageInfo, 108)
+var file_ern38_proto_goTypes = []any{
+	(*NewReleaseMessage)(nil),                      // 0: ddex.ern38.NewReleaseMessage
+	(*MessageHeader)(nil),                          // 1: ddex.ern38.MessageHeader
+	(*ResourceList)(nil),                           // 2: ddex.ern38.ResourceList
+	(*CollectionList)(nil),                         // 3: ddex.ern38.CollectionList
+	(*ReleaseList)(nil),                            // 4: ddex.ern38.ReleaseList
+	(*DealList)(nil),                               // 5: ddex.ern38.DealList
+	(*RawElement)(nil),                             // 6: ddex.ern38.RawElement
+	(*MessageSender)(nil),                          // 7: ddex.ern38.MessageSender
+	(*SentOnBehalfOf)(nil),                         // 8: ddex.ern38.SentOnBehalfOf
+	(*MessageRecipient)(nil),                       // 9: ddex.ern38.MessageRecipient
+	(*MessageAuditTrail)(nil),                      // 10: ddex.ern38.MessageAuditTrail
+	(*SoundRecording)(nil),                         // 11: ddex.ern38.SoundRecording
+	(*Video)(nil),                                  // 12: ddex.ern38.Video
+	(*Image)(nil),                                  // 13: ddex.ern38.Image
+	(*Text)(nil),                                   // 14: ddex.ern38.Text
+	(*Collection)(nil),                             // 15: ddex.ern38.Collection
+	(*Release)(nil),                                // 16: ddex.ern38.Release
+	(*ReleaseDeal)(nil),                            // 17: ddex.ern38.ReleaseDeal
+	(*PartyID)(nil),                                // 18: ddex.ern38.PartyID
+	(*Name)(nil),                                   // 19: ddex.ern38.Name
+	(*MessageAuditTrailEvent)(nil),                 // 20: ddex.ern38.MessageAuditTrailEvent
+	(*SoundRecordingId)(nil),                       // 21: ddex.ern38.SoundRecordingId
+	(*MusicalWorkId)(nil),                          // 22: ddex.ern38.MusicalWorkId
+	(*ReferenceTitle)(nil),                         // 23: ddex.ern38.ReferenceTitle
+	(*Description)(nil),                            // 24: ddex.ern38.Description
+	(*RightsAgreementId)(nil),                      // 25: ddex.ern38.RightsAgreementId
+	(*ResourceMusicalWorkReferenceList)(nil),       // 26: ddex.ern38.ResourceMusicalWorkReferenceList
+	(*ResourceContainedResourceReferenceList)(nil), // 27: ddex.ern38.ResourceContainedResourceReferenceList
+	(*EventDate)(nil),                              // 28: ddex.ern38.EventDate
+	(*SoundRecordingDetailsByTerritory)(nil),       // 29: ddex.ern38.SoundRecordingDetailsByTerritory
+	(*VideoType)(nil),                              // 30: ddex.ern38.VideoType
+	(*VideoId)(nil),                                // 31: ddex.ern38.VideoId
+	(*VideoCueSheetReference)(nil),                 // 32: ddex.ern38.VideoCueSheetReference
+	(*Reason)(nil),                                 // 33: ddex.ern38.Reason
+	(*Title)(nil),                                  // 34: ddex.ern38.Title
+	(*SoundRecordingCollectionReferenceList)(nil),  // 35: ddex.ern38.SoundRecordingCollectionReferenceList
+	(*VideoDetailsByTerritory)(nil),                // 36: ddex.ern38.VideoDetailsByTerritory
+	(*ImageType)(nil),                              // 37: ddex.ern38.ImageType
+	(*ImageId)(nil),                                // 38: ddex.ern38.ImageId
+	(*ImageDetailsByTerritory)(nil),                // 39: ddex.ern38.ImageDetailsByTerritory
+	(*ResourceID)(nil),                             // 40: ddex.ern38.ResourceID
+	(*DisplayTitleText)(nil),                       // 41: ddex.ern38.DisplayTitleText
+	(*ReleaseId)(nil),                              // 42: ddex.ern38.ReleaseId
+	(*TitleText)(nil),                              // 43: ddex.ern38.TitleText
+	(*DisplayArtist)(nil),                          // 44: ddex.ern38.DisplayArtist
+	(*CollectionDetailsByTerritory)(nil),           // 45: ddex.ern38.CollectionDetailsByTerritory
+	(*ExternalResourceLink)(nil),                   // 46: ddex.ern38.ExternalResourceLink
+	(*DisplayTitle)(nil),                           // 47: ddex.ern38.DisplayTitle
+	(*AdditionalTitle)(nil),                        // 48: ddex.ern38.AdditionalTitle
+	(*ReleaseResourceReferenceList)(nil),           // 49: ddex.ern38.ReleaseResourceReferenceList
+	(*ReleaseCollectionReferenceList)(nil),         // 50: ddex.ern38.ReleaseCollectionReferenceList
+	(*RelatedResource)(nil),                        // 51: ddex.ern38.RelatedResource
+	(*ReleaseType)(nil),                            // 52: ddex.ern38.ReleaseType
+	(*ReleaseDetailsByTerritory)(nil),              // 53: ddex.ern38.ReleaseDetailsByTerritory
+	(*PLine)(nil),                                  // 54: ddex.ern38.PLine
+	(*CLine)(nil),                                  // 55: ddex.ern38.CLine
+	(*Deal)(nil),                                   // 56: ddex.ern38.Deal
+	(*CatalogNumber)(nil),                          // 57: ddex.ern38.CatalogNumber
+	(*ProprietaryId)(nil),                          // 58: ddex.ern38.ProprietaryId
+	(*ResourceMusicalWorkReference)(nil),           // 59: ddex.ern38.ResourceMusicalWorkReference
+	(*ResourceContainedResourceReference)(nil),     // 60: ddex.ern38.ResourceContainedResourceReference
+	(*DisplayConductor)(nil),                       // 61: ddex.ern38.DisplayConductor
+	(*ResourceContributor)(nil),                    // 62: ddex.ern38.ResourceContributor
+	(*IndirectResourceContributor)(nil),            // 63: ddex.ern38.IndirectResourceContributor
+	(*DisplayArtistName)(nil),                      // 64: ddex.ern38.DisplayArtistName
+	(*LabelName)(nil),                              // 65: ddex.ern38.LabelName
+	(*RightsController)(nil),                       // 66: ddex.ern38.RightsController
+	(*CourtesyLine)(nil),                           // 67: ddex.ern38.CourtesyLine
+	(*HostSoundCarrier)(nil),                       // 68: ddex.ern38.HostSoundCarrier
+	(*Comment)(nil),                                // 69: ddex.ern38.Comment
+	(*Genre)(nil),                                  // 70: ddex.ern38.Genre
+	(*AvRating)(nil),                               // 71: ddex.ern38.AvRating
+	(*TechnicalSoundRecordingDetails)(nil),         // 72: ddex.ern38.TechnicalSoundRecordingDetails
+	(*FulfillmentDate)(nil),                        // 73: ddex.ern38.FulfillmentDate
+	(*Keywords)(nil),                               // 74: ddex.ern38.Keywords
+	(*Synopsis)(nil),                               // 75: ddex.ern38.Synopsis
+	(*SoundRecordingCollectionReference)(nil),      // 76: ddex.ern38.SoundRecordingCollectionReference
+	(*TechnicalVideoDetails)(nil),                  // 77: ddex.ern38.TechnicalVideoDetails
+	(*Character)(nil),                              // 78: ddex.ern38.Character
+	(*TechnicalImageDetails)(nil),                  // 79: ddex.ern38.TechnicalImageDetails
+	(*PartyName)(nil),                              // 80: ddex.ern38.PartyName
+	(*PartyId)(nil),                                // 81: ddex.ern38.PartyId
+	(*ReleaseResourceReference)(nil),               // 82: ddex.ern38.ReleaseResourceReference
+	(*ResourceRelatedId)(nil),                      // 83: ddex.ern38.ResourceRelatedId
+	(*AdministratingRecordCompany)(nil),            // 84: ddex.ern38.AdministratingRecordCompany
+	(*RelatedRelease)(nil),                         // 85: ddex.ern38.RelatedRelease
+	(*ParentalWarningType)(nil),                    // 86: ddex.ern38.ParentalWarningType
+	(*ResourceGroup)(nil),                          // 87: ddex.ern38.ResourceGroup
+	(*DealTerms)(nil),                              // 88: ddex.ern38.DealTerms
+	(*RatingAgency)(nil),                           // 89: ddex.ern38.RatingAgency
+	(*File)(nil),                                   // 90: ddex.ern38.File
+	(*ResourceGroupContentItem)(nil),               // 91: ddex.ern38.ResourceGroupContentItem
+	(*Usage)(nil),                                  // 92: ddex.ern38.Usage
+	(*DSP)(nil),                                    // 93: ddex.ern38.DSP
+	(*PriceInformation)(nil),                       // 94: ddex.ern38.PriceInformation
+	(*PromotionalCode)(nil),                        // 95: ddex.ern38.PromotionalCode
+	(*ValidityPeriod)(nil),                         // 96: ddex.ern38.ValidityPeriod
+	(*ConsumerRentalPeriod)(nil),                   // 97: ddex.ern38.ConsumerRentalPeriod
+	(*DealResourceReferenceList)(nil),              // 98: ddex.ern38.DealResourceReferenceList
+	(*RelatedReleaseOfferSet)(nil),                 // 99: ddex.ern38.RelatedReleaseOfferSet
+	(*PhysicalReturns)(nil),                        // 100: ddex.ern38.PhysicalReturns
+	(*RightsClaimPolicy)(nil),                      // 101: ddex.ern38.RightsClaimPolicy
+	(*WebPolicy)(nil),                              // 102: ddex.ern38.WebPolicy
+	(*HashSum)(nil),                                // 103: ddex.ern38.HashSum
+	(*LinkedReleaseResourceReference)(nil),         // 104: ddex.ern38.LinkedReleaseResourceReference
+	(*DateTime)(nil),                               // 105: ddex.ern38.DateTime
+	(*XmlName)(nil),                                // 106: ddex.ern38.XmlName
+	(*XmlAttr)(nil),                                // 107: ddex.ern38.XmlAttr
+}
+var file_ern38_proto_depIdxs = []int32{
+	107, // 0: ddex.ern38.NewReleaseMessage.other_attr:type_name -> ddex.ern38.XmlAttr
+	1,   // 1: ddex.ern38.NewReleaseMessage.message_header:type_name -> ddex.ern38.MessageHeader
+	2,   // 2: ddex.ern38.NewReleaseMessage.resource_list:type_name -> ddex.ern38.ResourceList
+	3,   // 3: ddex.ern38.NewReleaseMessage.collection_list:type_name -> ddex.ern38.CollectionList
+	4,   // 4: ddex.ern38.NewReleaseMessage.release_list:type_name -> ddex.ern38.ReleaseList
+	5,   // 5: ddex.ern38.NewReleaseMessage.deal_list:type_name -> ddex.ern38.DealList
+	6,   // 6: ddex.ern38.NewReleaseMessage.extensions:type_name -> ddex.ern38.RawElement
+	7,   // 7: ddex.ern38.MessageHeader.message_sender:type_name -> ddex.ern38.MessageSender
+	8,   // 8: ddex.ern38.MessageHeader.sent_on_behalf_of:type_name -> ddex.ern38.SentOnBehalfOf
+	9,   // 9: ddex.ern38.MessageHeader.message_recipient:type_name -> ddex.ern38.MessageRecipient
+	105, // 10: ddex.ern38.MessageHeader.message_created_date_time:type_name -> ddex.ern38.DateTime
+	10,  // 11: ddex.ern38.MessageHeader.message_audit_trail:type_name -> ddex.ern38.MessageAuditTrail
+	6,   // 12: ddex.ern38.MessageHeader.extensions:type_name -> ddex.ern38.RawElement
+	11,  // 13: ddex.ern38.ResourceList.sound_recording:type_name -> ddex.ern38.SoundRecording
+	12,  // 14: ddex.ern38.ResourceList.video:type_name -> ddex.ern38.Video
+	13,  // 15: ddex.ern38.ResourceList.image:type_name -> ddex.ern38.Image
+	14,  // 16: ddex.ern38.ResourceList.text:type_name -> ddex.ern38.Text
+	6,   // 17: ddex.ern38.ResourceList.extensions:type_name -> ddex.ern38.RawElement
+	15,  // 18: ddex.ern38.CollectionList.collection:type_name -> ddex.ern38.Collection
+	6,   // 19: ddex.ern38.CollectionList.extensions:type_name -> ddex.ern38.RawElement
+	16,  // 20: ddex.ern38.ReleaseList.release:type_name -> ddex.ern38.Release
+	6,   // 21: ddex.ern38.ReleaseList.extensions:type_name -> ddex.ern38.RawElement
+	17,  // 22: ddex.ern38.DealList.release_deal:type_name -> ddex.ern38.ReleaseDeal
+	6,   // 23: ddex.ern38.DealList.extensions:type_name -> ddex.ern38.RawElement
+	106, // 24: ddex.ern38.RawElement.name:type_name -> ddex.ern38.XmlName
+	107, // 25: ddex.ern38.RawElement.attr:type_name -> ddex.ern38.XmlAttr
+	18,  // 26: ddex.ern38.MessageSender.party_id:type_name -> ddex.ern38.PartyID
+	19,  // 27: ddex.ern38.MessageSender.party_name:type_name -> ddex.ern38.Name
+	6,   // 28: ddex.ern38.MessageSender.extensions:type_name -> ddex.ern38.RawElement
+	18,  // 29: ddex.ern38.SentOnBehalfOf.party_id:type_name -> ddex.ern38.PartyID
+	19,  // 30: ddex.ern38.SentOnBehalfOf.party_name:type_name -> ddex.ern38.Name
+	6,   // 31: ddex.ern38.SentOnBehalfOf.extensions:type_name -> ddex.ern38.RawElement
+	18,  // 32: ddex.ern38.MessageRecipient.party_id:type_name -> ddex.ern38.PartyID
+	19,  // 33: ddex.ern38.MessageRecipient.party_name:type_name -> ddex.ern38.Name
+	6,   // 34: ddex.ern38.MessageRecipient.extensions:type_name -> ddex.ern38.RawElement
+	20,  // 35: ddex.ern38.MessageAuditTrail.message_audit_trail_event:type_name -> ddex.ern38.MessageAuditTrailEvent
+	6,   // 36: ddex.ern38.MessageAuditTrail.extensions:type_name -> ddex.ern38.RawElement
+	21,  // 37: ddex.ern38.SoundRecording.sound_recording_id:type_name -> ddex.ern38.SoundRecordingId
+	22,  // 38: ddex.ern38.SoundRecording.indirect_sound_recording_id:type_name -> ddex.ern38.MusicalWorkId
+	23,  // 39: ddex.ern38.SoundRecording.reference_title:type_name -> ddex.ern38.ReferenceTitle
+	24,  // 40: ddex.ern38.SoundRecording.instrumentation_description:type_name -> ddex.ern38.Description
+	25,  // 41: ddex.ern38.SoundRecording.rights_agreement_id:type_name -> ddex.ern38.RightsAgreementId
+	26,  // 42: ddex.ern38.SoundRecording.resource_musical_work_reference_list:type_name -> ddex.ern38.ResourceMusicalWorkReferenceList
+	27,  // 43: ddex.ern38.SoundRecording.resource_contained_resource_reference_list:type_name -> ddex.ern38.ResourceContainedResourceReferenceList
+	28,  // 44: ddex.ern38.SoundRecording.creation_date:type_name -> ddex.ern38.EventDate
+	28,  // 45: ddex.ern38.SoundRecording.mastered_date:type_name -> ddex.ern38.EventDate
+	28,  // 46: ddex.ern38.SoundRecording.remastered_date:type_name -> ddex.ern38.EventDate
+	29,  // 47: ddex.ern38.SoundRecording.sound_recording_details_by_territory:type_name -> ddex.ern38.SoundRecordingDetailsByTerritory
+	6,   // 48: ddex.ern38.SoundRecording.extensions:type_name -> ddex.ern38.RawElement
+	30,  // 49: ddex.ern38.Video.video_type:type_name -> ddex.ern38.VideoType
+	31,  // 50: ddex.ern38.Video.video_id:type_name -> ddex.ern38.VideoId
+	22,  // 51: ddex.ern38.Video.indirect_video_id:type_name -> ddex.ern38.MusicalWorkId
+	32,  // 52: ddex.ern38.Video.video_cue_sheet_reference:type_name -> ddex.ern38.VideoCueSheetReference
+	33,  // 53: ddex.ern38.Video.reason_for_cue_sheet_absence:type_name -> ddex.ern38.Reason
+	23,  // 54: ddex.ern38.Video.reference_title:type_name -> ddex.ern38.ReferenceTitle
+	34,  // 55: ddex.ern38.Video.title:type_name -> ddex.ern38.Title
+	24,  // 56: ddex.ern38.Video.instrumentation_description:type_name -> ddex.ern38.Description
+	25,  // 57: ddex.ern38.Video.rights_agreement_id:type_name -> ddex.ern38.RightsAgreementId
+	35,  // 58: ddex.ern38.Video.video_collection_reference_list:type_name -> ddex.ern38.SoundRecordingCollectionReferenceList
+	26,  // 59: ddex.ern38.Video.resource_musical_work_reference_list:type_name -> ddex.ern38.ResourceMusicalWorkReferenceList
+	27,  // 60: ddex.ern38.Video.resource_contained_resource_reference_list:type_name -> ddex.ern38.ResourceContainedResourceReferenceList
+	28,  // 61: ddex.ern38.Video.creation_date:type_name -> ddex.ern38.EventDate
+	28,  // 62: ddex.ern38.Video.mastered_date:type_name -> ddex.ern38.EventDate
+	28,  // 63: ddex.ern38.Video.remastered_date:type_name -> ddex.ern38.EventDate
+	36,  // 64: ddex.ern38.Video.video_details_by_territory:type_name -> ddex.ern38.VideoDetailsByTerritory
+	6,   // 65: ddex.ern38.Video.extensions:type_name -> ddex.ern38.RawElement
+	37,  // 66: ddex.ern38.Image.image_type:type_name -> ddex.ern38.ImageType
+	38,  // 67: ddex.ern38.Image.image_id:type_name -> ddex.ern38.ImageId
+	34,  // 68: ddex.ern38.Image.title:type_name -> ddex.ern38.Title
+	28,  // 69: ddex.ern38.Image.creation_date:type_name -> ddex.ern38.EventDate
+	39,  // 70: ddex.ern38.Image.image_details_by_territory:type_name -> ddex.ern38.ImageDetailsByTerritory
+	6,   // 71: ddex.ern38.Image.extensions:type_name -> ddex.ern38.RawElement
+	40,  // 72: ddex.ern38.Text.resource_id:type_name -> ddex.ern38.ResourceID
+	41,  // 73: ddex.ern38.Text.display_title_text:type_name -> ddex.ern38.DisplayTitleText
+	6,   // 74: ddex.ern38.Text.extensions:type_name -> ddex.ern38.RawElement
+	42,  // 75: ddex.ern38.Collection.collection_id:type_name -> ddex.ern38.ReleaseId
+	43,  // 76: ddex.ern38.Collection.display_title_text:type_name -> ddex.ern38.TitleText
+	44,  // 77: ddex.ern38.Collection.display_artist:type_name -> ddex.ern38.DisplayArtist
+	45,  // 78: ddex.ern38.Collection.collection_details_by_territory:type_name -> ddex.ern38.CollectionDetailsByTerritory
+	6,   // 79: ddex.ern38.Collection.extensions:type_name -> ddex.ern38.RawElement
+	42,  // 80: ddex.ern38.Release.release_id:type_name -> ddex.ern38.ReleaseId
+	46,  // 81: ddex.ern38.Release.external_resource_link:type_name -> ddex.ern38.ExternalResourceLink
+	41,  // 82: ddex.ern38.Release.display_title_text:type_name -> ddex.ern38.DisplayTitleText
+	47,  // 83: ddex.ern38.Release.display_title:type_name -> ddex.ern38.DisplayTitle
+	48,  // 84: ddex.ern38.Release.additional_title:type_name -> ddex.ern38.AdditionalTitle
+	23,  // 85: ddex.ern38.Release.reference_title:type_name -> ddex.ern38.ReferenceTitle
+	49,  // 86: ddex.ern38.Release.release_resource_reference_list:type_name -> ddex.ern38.ReleaseResourceReferenceList
+	50,  // 87: ddex.ern38.Release.release_collection_reference_list:type_name -> ddex.ern38.ReleaseCollectionReferenceList
+	51,  // 88: ddex.ern38.Release.related_resource:type_name -> ddex.ern38.RelatedResource
+	52,  // 89: ddex.ern38.Release.release_type:type_name -> ddex.ern38.ReleaseType
+	53,  // 90: ddex.ern38.Release.release_details_by_territory:type_name -> ddex.ern38.ReleaseDetailsByTerritory
+	54,  // 91: ddex.ern38.Release.p_line:type_name -> ddex.ern38.PLine
+	55,  // 92: ddex.ern38.Release.c_line:type_name -> ddex.ern38.CLine
+	28,  // 93: ddex.ern38.Release.global_release_date:type_name -> ddex.ern38.EventDate
+	28,  // 94: ddex.ern38.Release.global_original_release_date:type_name -> ddex.ern38.EventDate
+	6,   // 95: ddex.ern38.Release.extensions:type_name -> ddex.ern38.RawElement
+	56,  // 96: ddex.ern38.ReleaseDeal.deal:type_name -> ddex.ern38.Deal
+	6,   // 97: ddex.ern38.ReleaseDeal.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 98: ddex.ern38.Name.extensions:type_name -> ddex.ern38.RawElement
+	105, // 99: ddex.ern38.MessageAuditTrailEvent.message_audit_trail_event_date_time:type_name -> ddex.ern38.DateTime
+	6,   // 100: ddex.ern38.MessageAuditTrailEvent.extensions:type_name -> ddex.ern38.RawElement
+	57,  // 101: ddex.ern38.SoundRecordingId.catalog_number:type_name -> ddex.ern38.CatalogNumber
+	58,  // 102: ddex.ern38.SoundRecordingId.proprietary_id:type_name -> ddex.ern38.ProprietaryId
+	6,   // 103: ddex.ern38.SoundRecordingId.extensions:type_name -> ddex.ern38.RawElement
+	58,  // 104: ddex.ern38.MusicalWorkId.proprietary_id:type_name -> ddex.ern38.ProprietaryId
+	6,   // 105: ddex.ern38.MusicalWorkId.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 106: ddex.ern38.ReferenceTitle.extensions:type_name -> ddex.ern38.RawElement
+	58,  // 107: ddex.ern38.RightsAgreementId.proprietary_id:type_name -> ddex.ern38.ProprietaryId
+	6,   // 108: ddex.ern38.RightsAgreementId.extensions:type_name -> ddex.ern38.RawElement
+	59,  // 109: ddex.ern38.ResourceMusicalWorkReferenceList.resource_musical_work_reference:type_name -> ddex.ern38.ResourceMusicalWorkReference
+	6,   // 110: ddex.ern38.ResourceMusicalWorkReferenceList.extensions:type_name -> ddex.ern38.RawElement
+	60,  // 111: ddex.ern38.ResourceContainedResourceReferenceList.resource_contained_resource_reference:type_name -> ddex.ern38.ResourceContainedResourceReference
+	6,   // 112: ddex.ern38.ResourceContainedResourceReferenceList.extensions:type_name -> ddex.ern38.RawElement
+	34,  // 113: ddex.ern38.SoundRecordingDetailsByTerritory.title:type_name -> ddex.ern38.Title
+	44,  // 114: ddex.ern38.SoundRecordingDetailsByTerritory.display_artist:type_name -> ddex.ern38.DisplayArtist
+	61,  // 115: ddex.ern38.SoundRecordingDetailsByTerritory.display_conductor:type_name -> ddex.ern38.DisplayConductor
+	62,  // 116: ddex.ern38.SoundRecordingDetailsByTerritory.resource_contributor:type_name -> ddex.ern38.ResourceContributor
+	63,  // 117: ddex.ern38.SoundRecordingDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern38.IndirectResourceContributor
+	25,  // 118: ddex.ern38.SoundRecordingDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern38.RightsAgreementId
+	64,  // 119: ddex.ern38.SoundRecordingDetailsByTerritory.display_artist_name:type_name -> ddex.ern38.DisplayArtistName
+	65,  // 120: ddex.ern38.SoundRecordingDetailsByTerritory.label_name:type_name -> ddex.ern38.LabelName
+	66,  // 121: ddex.ern38.SoundRecordingDetailsByTerritory.rights_controller:type_name -> ddex.ern38.RightsController
+	28,  // 122: ddex.ern38.SoundRecordingDetailsByTerritory.remastered_date:type_name -> ddex.ern38.EventDate
+	28,  // 123: ddex.ern38.SoundRecordingDetailsByTerritory.resource_release_date:type_name -> ddex.ern38.EventDate
+	28,  // 124: ddex.ern38.SoundRecordingDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern38.EventDate
+	54,  // 125: ddex.ern38.SoundRecordingDetailsByTerritory.p_line:type_name -> ddex.ern38.PLine
+	67,  // 126: ddex.ern38.SoundRecordingDetailsByTerritory.courtesy_line:type_name -> ddex.ern38.CourtesyLine
+	68,  // 127: ddex.ern38.SoundRecordingDetailsByTerritory.host_sound_carrier:type_name -> ddex.ern38.HostSoundCarrier
+	69,  // 128: ddex.ern38.SoundRecordingDetailsByTerritory.marketing_comment:type_name -> ddex.ern38.Comment
+	70,  // 129: ddex.ern38.SoundRecordingDetailsByTerritory.genre:type_name -> ddex.ern38.Genre
+	71,  // 130: ddex.ern38.SoundRecordingDetailsByTerritory.av_rating:type_name -> ddex.ern38.AvRating
+	72,  // 131: ddex.ern38.SoundRecordingDetailsByTerritory.technical_sound_recording_details:type_name -> ddex.ern38.TechnicalSoundRecordingDetails
+	73,  // 132: ddex.ern38.SoundRecordingDetailsByTerritory.fulfillment_date:type_name -> ddex.ern38.FulfillmentDate
+	74,  // 133: ddex.ern38.SoundRecordingDetailsByTerritory.keywords:type_name -> ddex.ern38.Keywords
+	75,  // 134: ddex.ern38.SoundRecordingDetailsByTerritory.synopsis:type_name -> ddex.ern38.Synopsis
+	6,   // 135: ddex.ern38.SoundRecordingDetailsByTerritory.extensions:type_name -> ddex.ern38.RawElement
+	58,  // 136: ddex.ern38.VideoId.proprietary_id:type_name -> ddex.ern38.ProprietaryId
+	6,   // 137: ddex.ern38.VideoId.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 138: ddex.ern38.Title.extensions:type_name -> ddex.ern38.RawElement
+	76,  // 139: ddex.ern38.SoundRecordingCollectionReferenceList.sound_recording_collection_reference:type_name -> ddex.ern38.SoundRecordingCollectionReference
+	6,   // 140: ddex.ern38.SoundRecordingCollectionReferenceList.extensions:type_name -> ddex.ern38.RawElement
+	34,  // 141: ddex.ern38.VideoDetailsByTerritory.title:type_name -> ddex.ern38.Title
+	44,  // 142: ddex.ern38.VideoDetailsByTerritory.display_artist:type_name -> ddex.ern38.DisplayArtist
+	61,  // 143: ddex.ern38.VideoDetailsByTerritory.display_conductor:type_name -> ddex.ern38.DisplayConductor
+	62,  // 144: ddex.ern38.VideoDetailsByTerritory.resource_contributor:type_name -> ddex.ern38.ResourceContributor
+	63,  // 145: ddex.ern38.VideoDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern38.IndirectResourceContributor
+	25,  // 146: ddex.ern38.VideoDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern38.RightsAgreementId
+	64,  // 147: ddex.ern38.VideoDetailsByTerritory.display_artist_name:type_name -> ddex.ern38.DisplayArtistName
+	65,  // 148: ddex.ern38.VideoDetailsByTerritory.label_name:type_name -> ddex.ern38.LabelName
+	66,  // 149: ddex.ern38.VideoDetailsByTerritory.rights_controller:type_name -> ddex.ern38.RightsController
+	28,  // 150: ddex.ern38.VideoDetailsByTerritory.remastered_date:type_name -> ddex.ern38.EventDate
+	28,  // 151: ddex.ern38.VideoDetailsByTerritory.resource_release_date:type_name -> ddex.ern38.EventDate
+	28,  // 152: ddex.ern38.VideoDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern38.EventDate
+	54,  // 153: ddex.ern38.VideoDetailsByTerritory.p_line:type_name -> ddex.ern38.PLine
+	55,  // 154: ddex.ern38.VideoDetailsByTerritory.c_line:type_name -> ddex.ern38.CLine
+	67,  // 155: ddex.ern38.VideoDetailsByTerritory.courtesy_line:type_name -> ddex.ern38.CourtesyLine
+	68,  // 156: ddex.ern38.VideoDetailsByTerritory.host_sound_carrier:type_name -> ddex.ern38.HostSoundCarrier
+	69,  // 157: ddex.ern38.VideoDetailsByTerritory.marketing_comment:type_name -> ddex.ern38.Comment
+	70,  // 158: ddex.ern38.VideoDetailsByTerritory.genre:type_name -> ddex.ern38.Genre
+	71,  // 159: ddex.ern38.VideoDetailsByTerritory.av_rating:type_name -> ddex.ern38.AvRating
+	77,  // 160: ddex.ern38.VideoDetailsByTerritory.technical_video_details:type_name -> ddex.ern38.TechnicalVideoDetails
+	78,  // 161: ddex.ern38.VideoDetailsByTerritory.character:type_name -> ddex.ern38.Character
+	73,  // 162: ddex.ern38.VideoDetailsByTerritory.fulfillment_date:type_name -> ddex.ern38.FulfillmentDate
+	74,  // 163: ddex.ern38.VideoDetailsByTerritory.keywords:type_name -> ddex.ern38.Keywords
+	75,  // 164: ddex.ern38.VideoDetailsByTerritory.synopsis:type_name -> ddex.ern38.Synopsis
+	6,   // 165: ddex.ern38.VideoDetailsByTerritory.extensions:type_name -> ddex.ern38.RawElement
+	58,  // 166: ddex.ern38.ImageId.proprietary_id:type_name -> ddex.ern38.ProprietaryId
+	6,   // 167: ddex.ern38.ImageId.extensions:type_name -> ddex.ern38.RawElement
+	34,  // 168: ddex.ern38.ImageDetailsByTerritory.title:type_name -> ddex.ern38.Title
+	62,  // 169: ddex.ern38.ImageDetailsByTerritory.resource_contributor:type_name -> ddex.ern38.ResourceContributor
+	63,  // 170: ddex.ern38.ImageDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern38.IndirectResourceContributor
+	64,  // 171: ddex.ern38.ImageDetailsByTerritory.display_artist_name:type_name -> ddex.ern38.DisplayArtistName
+	55,  // 172: ddex.ern38.ImageDetailsByTerritory.c_line:type_name -> ddex.ern38.CLine
+	24,  // 173: ddex.ern38.ImageDetailsByTerritory.description:type_name -> ddex.ern38.Description
+	67,  // 174: ddex.ern38.ImageDetailsByTerritory.courtesy_line:type_name -> ddex.ern38.CourtesyLine
+	28,  // 175: ddex.ern38.ImageDetailsByTerritory.resource_release_date:type_name -> ddex.ern38.EventDate
+	28,  // 176: ddex.ern38.ImageDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern38.EventDate
+	73,  // 177: ddex.ern38.ImageDetailsByTerritory.fulfillment_date:type_name -> ddex.ern38.FulfillmentDate
+	74,  // 178: ddex.ern38.ImageDetailsByTerritory.keywords:type_name -> ddex.ern38.Keywords
+	75,  // 179: ddex.ern38.ImageDetailsByTerritory.synopsis:type_name -> ddex.ern38.Synopsis
+	70,  // 180: ddex.ern38.ImageDetailsByTerritory.genre:type_name -> ddex.ern38.Genre
+	79,  // 181: ddex.ern38.ImageDetailsByTerritory.technical_image_details:type_name -> ddex.ern38.TechnicalImageDetails
+	6,   // 182: ddex.ern38.ImageDetailsByTerritory.extensions:type_name -> ddex.ern38.RawElement
+	57,  // 183: ddex.ern38.ReleaseId.catalog_number:type_name -> ddex.ern38.CatalogNumber
+	58,  // 184: ddex.ern38.ReleaseId.proprietary_id:type_name -> ddex.ern38.ProprietaryId
+	6,   // 185: ddex.ern38.ReleaseId.extensions:type_name -> ddex.ern38.RawElement
+	80,  // 186: ddex.ern38.DisplayArtist.party_name:type_name -> ddex.ern38.PartyName
+	81,  // 187: ddex.ern38.DisplayArtist.party_id:type_name -> ddex.ern38.PartyId
+	6,   // 188: ddex.ern38.DisplayArtist.extensions:type_name -> ddex.ern38.RawElement
+	43,  // 189: ddex.ern38.CollectionDetailsByTerritory.display_title_text:type_name -> ddex.ern38.TitleText
+	70,  // 190: ddex.ern38.CollectionDetailsByTerritory.genre:type_name -> ddex.ern38.Genre
+	6,   // 191: ddex.ern38.CollectionDetailsByTerritory.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 192: ddex.ern38.ExternalResourceLink.extensions:type_name -> ddex.ern38.RawElement
+	43,  // 193: ddex.ern38.DisplayTitle.title_text:type_name -> ddex.ern38.TitleText
+	6,   // 194: ddex.ern38.DisplayTitle.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 195: ddex.ern38.AdditionalTitle.extensions:type_name -> ddex.ern38.RawElement
+	82,  // 196: ddex.ern38.ReleaseResourceReferenceList.release_resource_reference:type_name -> ddex.ern38.ReleaseResourceReference
+	6,   // 197: ddex.ern38.ReleaseResourceReferenceList.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 198: ddex.ern38.ReleaseCollectionReferenceList.extensions:type_name -> ddex.ern38.RawElement
+	83,  // 199: ddex.ern38.RelatedResource.resource_id:type_name -> ddex.ern38.ResourceRelatedId
+	6,   // 200: ddex.ern38.RelatedResource.extensions:type_name -> ddex.ern38.RawElement
+	64,  // 201: ddex.ern38.ReleaseDetailsByTerritory.display_artist_name:type_name -> ddex.ern38.DisplayArtistName
+	65,  // 202: ddex.ern38.ReleaseDetailsByTerritory.label_name:type_name -> ddex.ern38.LabelName
+	34,  // 203: ddex.ern38.ReleaseDetailsByTerritory.title:type_name -> ddex.ern38.Title
+	44,  // 204: ddex.ern38.ReleaseDetailsByTerritory.display_artist:type_name -> ddex.ern38.DisplayArtist
+	84,  // 205: ddex.ern38.ReleaseDetailsByTerritory.administrating_record_company:type_name -> ddex.ern38.AdministratingRecordCompany
+	52,  // 206: ddex.ern38.ReleaseDetailsByTerritory.release_type:type_name -> ddex.ern38.ReleaseType
+	85,  // 207: ddex.ern38.ReleaseDetailsByTerritory.related_release:type_name -> ddex.ern38.RelatedRelease
+	86,  // 208: ddex.ern38.ReleaseDetailsByTerritory.parental_warning_type:type_name -> ddex.ern38.ParentalWarningType
+	71,  // 209: ddex.ern38.ReleaseDetailsByTerritory.av_rating:type_name -> ddex.ern38.AvRating
+	69,  // 210: ddex.ern38.ReleaseDetailsByTerritory.marketing_comment:type_name -> ddex.ern38.Comment
+	87,  // 211: ddex.ern38.ReleaseDetailsByTerritory.resource_group:type_name -> ddex.ern38.ResourceGroup
+	70,  // 212: ddex.ern38.ReleaseDetailsByTerritory.genre:type_name -> ddex.ern38.Genre
+	54,  // 213: ddex.ern38.ReleaseDetailsByTerritory.p_line:type_name -> ddex.ern38.PLine
+	55,  // 214: ddex.ern38.ReleaseDetailsByTerritory.c_line:type_name -> ddex.ern38.CLine
+	28,  // 215: ddex.ern38.ReleaseDetailsByTerritory.release_date:type_name -> ddex.ern38.EventDate
+	28,  // 216: ddex.ern38.ReleaseDetailsByTerritory.original_release_date:type_name -> ddex.ern38.EventDate
+	74,  // 217: ddex.ern38.ReleaseDetailsByTerritory.keywords:type_name -> ddex.ern38.Keywords
+	75,  // 218: ddex.ern38.ReleaseDetailsByTerritory.synopsis:type_name -> ddex.ern38.Synopsis
+	6,   // 219: ddex.ern38.ReleaseDetailsByTerritory.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 220: ddex.ern38.PLine.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 221: ddex.ern38.CLine.extensions:type_name -> ddex.ern38.RawElement
+	88,  // 222: ddex.ern38.Deal.deal_terms:type_name -> ddex.ern38.DealTerms
+	6,   // 223: ddex.ern38.Deal.extensions:type_name -> ddex.ern38.RawElement
+	22,  // 224: ddex.ern38.ResourceMusicalWorkReference.musical_work_id:type_name -> ddex.ern38.MusicalWorkId
+	6,   // 225: ddex.ern38.ResourceMusicalWorkReference.extensions:type_name -> ddex.ern38.RawElement
+	80,  // 226: ddex.ern38.DisplayConductor.party_name:type_name -> ddex.ern38.PartyName
+	81,  // 227: ddex.ern38.DisplayConductor.party_id:type_name -> ddex.ern38.PartyId
+	6,   // 228: ddex.ern38.DisplayConductor.extensions:type_name -> ddex.ern38.RawElement
+	81,  // 229: ddex.ern38.ResourceContributor.party_id:type_name -> ddex.ern38.PartyId
+	80,  // 230: ddex.ern38.ResourceContributor.party_name:type_name -> ddex.ern38.PartyName
+	6,   // 231: ddex.ern38.ResourceContributor.extensions:type_name -> ddex.ern38.RawElement
+	80,  // 232: ddex.ern38.IndirectResourceContributor.party_name:type_name -> ddex.ern38.PartyName
+	81,  // 233: ddex.ern38.IndirectResourceContributor.party_id:type_name -> ddex.ern38.PartyId
+	6,   // 234: ddex.ern38.IndirectResourceContributor.extensions:type_name -> ddex.ern38.RawElement
+	19,  // 235: ddex.ern38.RightsController.party_name:type_name -> ddex.ern38.Name
+	18,  // 236: ddex.ern38.RightsController.party_id:type_name -> ddex.ern38.PartyID
+	6,   // 237: ddex.ern38.RightsController.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 238: ddex.ern38.CourtesyLine.extensions:type_name -> ddex.ern38.RawElement
+	42,  // 239: ddex.ern38.HostSoundCarrier.release_id:type_name -> ddex.ern38.ReleaseId
+	57,  // 240: ddex.ern38.HostSoundCarrier.catalog_number:type_name -> ddex.ern38.CatalogNumber
+	34,  // 241: ddex.ern38.HostSoundCarrier.title:type_name -> ddex.ern38.Title
+	64,  // 242: ddex.ern38.HostSoundCarrier.display_artist_name:type_name -> ddex.ern38.DisplayArtistName
+	44,  // 243: ddex.ern38.HostSoundCarrier.display_artist:type_name -> ddex.ern38.DisplayArtist
+	28,  // 244: ddex.ern38.HostSoundCarrier.original_release_date:type_name -> ddex.ern38.EventDate
+	6,   // 245: ddex.ern38.HostSoundCarrier.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 246: ddex.ern38.Genre.extensions:type_name -> ddex.ern38.RawElement
+	89,  // 247: ddex.ern38.AvRating.rating_agency:type_name -> ddex.ern38.RatingAgency
+	6,   // 248: ddex.ern38.AvRating.extensions:type_name -> ddex.ern38.RawElement
+	90,  // 249: ddex.ern38.TechnicalSoundRecordingDetails.file:type_name -> ddex.ern38.File
+	6,   // 250: ddex.ern38.TechnicalSoundRecordingDetails.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 251: ddex.ern38.FulfillmentDate.extensions:type_name -> ddex.ern38.RawElement
+	90,  // 252: ddex.ern38.TechnicalVideoDetails.file:type_name -> ddex.ern38.File
+	6,   // 253: ddex.ern38.TechnicalVideoDetails.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 254: ddex.ern38.Character.extensions:type_name -> ddex.ern38.RawElement
+	90,  // 255: ddex.ern38.TechnicalImageDetails.file:type_name -> ddex.ern38.File
+	6,   // 256: ddex.ern38.TechnicalImageDetails.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 257: ddex.ern38.PartyName.extensions:type_name -> ddex.ern38.RawElement
+	58,  // 258: ddex.ern38.PartyId.proprietary_id:type_name -> ddex.ern38.ProprietaryId
+	6,   // 259: ddex.ern38.PartyId.extensions:type_name -> ddex.ern38.RawElement
+	58,  // 260: ddex.ern38.ResourceRelatedId.proprietary_id:type_name -> ddex.ern38.ProprietaryId
+	6,   // 261: ddex.ern38.ResourceRelatedId.extensions:type_name -> ddex.ern38.RawElement
+	81,  // 262: ddex.ern38.AdministratingRecordCompany.party_id:type_name -> ddex.ern38.PartyId
+	19,  // 263: ddex.ern38.AdministratingRecordCompany.party_name:type_name -> ddex.ern38.Name
+	6,   // 264: ddex.ern38.AdministratingRecordCompany.extensions:type_name -> ddex.ern38.RawElement
+	42,  // 265: ddex.ern38.RelatedRelease.release_id:type_name -> ddex.ern38.ReleaseId
+	6,   // 266: ddex.ern38.RelatedRelease.extensions:type_name -> ddex.ern38.RawElement
+	34,  // 267: ddex.ern38.ResourceGroup.title:type_name -> ddex.ern38.Title
+	91,  // 268: ddex.ern38.ResourceGroup.resource_group_content_item:type_name -> ddex.ern38.ResourceGroupContentItem
+	6,   // 269: ddex.ern38.ResourceGroup.extensions:type_name -> ddex.ern38.RawElement
+	92,  // 270: ddex.ern38.DealTerms.usage:type_name -> ddex.ern38.Usage
+	93,  // 271: ddex.ern38.DealTerms.distribution_channel:type_name -> ddex.ern38.DSP
+	93,  // 272: ddex.ern38.DealTerms.excluded_distribution_channel:type_name -> ddex.ern38.DSP
+	94,  // 273: ddex.ern38.DealTerms.price_information:type_name -> ddex.ern38.PriceInformation
+	95,  // 274: ddex.ern38.DealTerms.promotional_code:type_name -> ddex.ern38.PromotionalCode
+	96,  // 275: ddex.ern38.DealTerms.validity_period:type_name -> ddex.ern38.ValidityPeriod
+	97,  // 276: ddex.ern38.DealTerms.consumer_rental_period:type_name -> ddex.ern38.ConsumerRentalPeriod
+	28,  // 277: ddex.ern38.DealTerms.pre_order_release_date:type_name -> ddex.ern38.EventDate
+	28,  // 278: ddex.ern38.DealTerms.pre_order_preview_date:type_name -> ddex.ern38.EventDate
+	98,  // 279: ddex.ern38.DealTerms.pre_order_incentive_resource_list:type_name -> ddex.ern38.DealResourceReferenceList
+	98,  // 280: ddex.ern38.DealTerms.instant_gratification_resource_list:type_name -> ddex.ern38.DealResourceReferenceList
+	99,  // 281: ddex.ern38.DealTerms.related_release_offer_set:type_name -> ddex.ern38.RelatedReleaseOfferSet
+	100, // 282: ddex.ern38.DealTerms.physical_returns:type_name -> ddex.ern38.PhysicalReturns
+	101, // 283: ddex.ern38.DealTerms.rights_claim_policy:type_name -> ddex.ern38.RightsClaimPolicy
+	102, // 284: ddex.ern38.DealTerms.web_policy:type_name -> ddex.ern38.WebPolicy
+	6,   // 285: ddex.ern38.DealTerms.extensions:type_name -> ddex.ern38.RawElement
+	103, // 286: ddex.ern38.File.hash_sum:type_name -> ddex.ern38.HashSum
+	6,   // 287: ddex.ern38.File.extensions:type_name -> ddex.ern38.RawElement
+	82,  // 288: ddex.ern38.ResourceGroupContentItem.release_resource_reference:type_name -> ddex.ern38.ReleaseResourceReference
+	104, // 289: ddex.ern38.ResourceGroupContentItem.linked_release_resource_reference:type_name -> ddex.ern38.LinkedReleaseResourceReference
+	6,   // 290: ddex.ern38.ResourceGroupContentItem.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 291: ddex.ern38.Usage.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 292: ddex.ern38.DSP.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 293: ddex.ern38.PriceInformation.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 294: ddex.ern38.PromotionalCode.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 295: ddex.ern38.ValidityPeriod.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 296: ddex.ern38.ConsumerRentalPeriod.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 297: ddex.ern38.DealResourceReferenceList.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 298: ddex.ern38.RelatedReleaseOfferSet.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 299: ddex.ern38.PhysicalReturns.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 300: ddex.ern38.RightsClaimPolicy.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 301: ddex.ern38.WebPolicy.extensions:type_name -> ddex.ern38.RawElement
+	6,   // 302: ddex.ern38.HashSum.extensions:type_name -> ddex.ern38.RawElement
+	106, // 303: ddex.ern38.XmlAttr.name:type_name -> ddex.ern38.XmlName
+	304, // [304:304] is the sub-list for method output_type
+	304, // [304:304] is the sub-list for method input_type
+	304, // [304:304] is the sub-list for extension type_name
+	304, // [304:304] is the sub-list for extension extendee
+	0,   // [0:304] is the sub-list for field type_name
+}
+
+func init() { file_ern38_proto_init() }
+func file_ern38_proto_init() {
+	if File_ern38_proto != nil {
+		return
+	}
+	file_ern38_proto_msgTypes[11].OneofWrappers = []any{}
+	file_ern38_proto_msgTypes[12].OneofWrappers = []any{}
+	file_ern38_proto_msgTypes[13].OneofWrappers = []any{}
+	file_ern38_proto_msgTypes[16].OneofWrappers = []any{}
+	file_ern38_proto_msgTypes[29].OneofWrappers = []any{}
+	file_ern38_proto_msgTypes[36].OneofWrappers = []any{}
+	file_ern38_proto_msgTypes[62].OneofWrappers = []any{}
+	file_ern38_proto_msgTypes[66].OneofWrappers = []any{}
+	file_ern38_proto_msgTypes[72].OneofWrappers = []any{}
+	file_ern38_proto_msgTypes[88].OneofWrappers = []any{}
+	file_ern38_proto_msgTypes[94].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ern38_proto_rawDesc), len(file_ern38_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   108,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ern38_proto_goTypes,
+		DependencyIndexes: file_ern38_proto_depIdxs,
+		MessageInfos:      file_ern38_proto_msgTypes,
+	}.Build()
+	File_ern38_proto = out.File
+	file_ern38_proto_goTypes = nil
+	file_ern38_proto_depIdxs = nil
+}
@@ -4,149 +4,165 @@ import "encoding/xml"
 
 // DealList lists all Deal composites
 type DealList struct {
-	XMLName     xml.Name      `xml:"DealList"`
-	ReleaseDeal []ReleaseDeal `xml:"ReleaseDeal"`
+	XMLName     xml.Name      `xml:"DealList" json:"-"`
+	ReleaseDeal []ReleaseDeal `xml:"ReleaseDeal" json:",omitempty"`
+	Extensions  []RawElement  `xml:",any" json:",omitempty"`
 }
 
 // ReleaseDeal represents a deal for a specific release
 type ReleaseDeal struct {
-	XMLName              xml.Name `xml:"ReleaseDeal"`
-	DealReleaseReference string   `xml:"DealReleaseReference"`
-	Deal                 []Deal   `xml:"Deal"`
+	XMLName              xml.Name     `xml:"ReleaseDeal" json:"-"`
+	DealReleaseReference string       `xml:"DealReleaseReference" json:",omitempty"`
+	Deal                 []Deal       `xml:"Deal" json:",omitempty"`
+	Extensions           []RawElement `xml:",any" json:",omitempty"`
 }
 
 // Deal represents commercial terms for a release
 type Deal struct {
-	XMLName   xml.Name   `xml:"Deal"`
-	DealTerms *DealTerms `xml:"DealTerms"`
+	XMLName    xml.Name     `xml:"Deal" json:"-"`
+	DealTerms  *DealTerms   `xml:"DealTerms" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // DealTerms represents the commercial terms of a deal for ERN 3.8
 type DealTerms struct {
-	XMLName               xml.Name `xml:"DealTerms"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"DealTerms" json:"-"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 
 	// Pre-order and deal flags
-	IsPreOrderDeal *bool `xml:"IsPreOrderDeal,omitempty"` // 0-1
+	IsPreOrderDeal *bool `xml:"IsPreOrderDeal,omitempty" json:",omitempty"` // 0-1
 
 	// Commercial model
-	CommercialModelType []string `xml:"CommercialModelType,omitempty"` // 0-n
+	CommercialModelType []string `xml:"CommercialModelType,omitempty" json:",omitempty"` // 0-n
 
 	// Usage (choice with AllDealsCancelled or TakeDown, at least one required)
-	Usage             []Usage `xml:"Usage,omitempty"`             // 1-n (if used)
-	AllDealsCancelled *bool   `xml:"AllDealsCancelled,omitempty"` // 1 (if used, deprecated)
-	TakeDown          *bool   `xml:"TakeDown,omitempty"`          // 1 (if used, deprecated)
+	Usage             []Usage `xml:"Usage,omitempty" json:",omitempty"`             // 1-n (if used)
+	AllDealsCancelled *bool   `xml:"AllDealsCancelled,omitempty" json:",omitempty"` // 1 (if used, deprecated)
+	TakeDown          *bool   `xml:"TakeDown,omitempty" json:",omitempty"`          // 1 (if used, deprecated)
 
 	// Territory (choice: TerritoryCode OR ExcludedTerritoryCode, at least one required)
-	TerritoryCode         []string `xml:"TerritoryCode,omitempty"`         // 1-n (if used)
-	ExcludedTerritoryCode []string `xml:"ExcludedTerritoryCode,omitempty"` // 1-n (if used)
+	TerritoryCode         []string `xml:"TerritoryCode,omitempty" json:",omitempty"`         // 1-n (if used)
+	ExcludedTerritoryCode []string `xml:"ExcludedTerritoryCode,omitempty" json:",omitempty"` // 1-n (if used)
 
 	// Distribution channels (choice: DistributionChannel OR ExcludedDistributionChannel)
-	DistributionChannel         []DSP `xml:"DistributionChannel,omitempty"`         // 1-n (if used)
-	ExcludedDistributionChannel []DSP `xml:"ExcludedDistributionChannel,omitempty"` // 1-n (if used)
+	DistributionChannel         []DSP `xml:"DistributionChannel,omitempty" json:",omitempty"`         // 1-n (if used)
+	ExcludedDistributionChannel []DSP `xml:"ExcludedDistributionChannel,omitempty" json:",omitempty"` // 1-n (if used)
 
 	// Pricing
-	PriceInformation []PriceInformation `xml:"PriceInformation,omitempty"` // 0-n
+	PriceInformation []PriceInformation `xml:"PriceInformation,omitempty" json:",omitempty"` // 0-n
 
 	// Promotional (choice: IsPromotional OR PromotionalCode)
-	IsPromotional   *bool            `xml:"IsPromotional,omitempty"`   // 1 (if used)
-	PromotionalCode *PromotionalCode `xml:"PromotionalCode,omitempty"` // 1 (if used)
+	IsPromotional   *bool            `xml:"IsPromotional,omitempty" json:",omitempty"`   // 1 (if used)
+	PromotionalCode *PromotionalCode `xml:"PromotionalCode,omitempty" json:",omitempty"` // 1 (if used)
 
 	// Validity period
-	ValidityPeriod []ValidityPeriod `xml:"ValidityPeriod,omitempty"` // 1-n
+	ValidityPeriod []ValidityPeriod `xml:"ValidityPeriod,omitempty" json:",omitempty"` // 1-n
 
 	// Rental and pre-order
-	ConsumerRentalPeriod *ConsumerRentalPeriod `xml:"ConsumerRentalPeriod,omitempty"` // 0-1
-	PreOrderReleaseDate  *EventDate            `xml:"PreOrderReleaseDate,omitempty"`  // 0-1
+	ConsumerRentalPeriod *ConsumerRentalPeriod `xml:"ConsumerRentalPeriod,omitempty" json:",omitempty"` // 0-1
+	PreOrderReleaseDate  *EventDate            `xml:"PreOrderReleaseDate,omitempty" json:",omitempty"`  // 0-1
 
 	// Display dates (choice: structured dates OR PreOrderPreviewDate)
-	ReleaseDisplayStartDate      string     `xml:"ReleaseDisplayStartDate,omitempty"`      // 1 (if used)
-	TrackListingPreviewStartDate string     `xml:"TrackListingPreviewStartDate,omitempty"` // 1 (if used)
-	CoverArtPreviewStartDate     string     `xml:"CoverArtPreviewStartDate,omitempty"`     // 1 (if used)
-	ClipPreviewStartDate         string     `xml:"ClipPreviewStartDate,omitempty"`         // 1 (if used)
-	PreOrderPreviewDate          *EventDate `xml:"PreOrderPreviewDate,omitempty"`          // 1 (if used, deprecated)
+	ReleaseDisplayStartDate      string     `xml:"ReleaseDisplayStartDate,omitempty" json:",omitempty"`      // 1 (if used)
+	TrackListingPreviewStartDate string     `xml:"TrackListingPreviewStartDate,omitempty" json:",omitempty"` // 1 (if used)
+	CoverArtPreviewStartDate     string     `xml:"CoverArtPreviewStartDate,omitempty" json:",omitempty"`     // 1 (if used)
+	ClipPreviewStartDate         string     `xml:"ClipPreviewStartDate,omitempty" json:",omitempty"`         // 1 (if used)
+	PreOrderPreviewDate          *EventDate `xml:"PreOrderPreviewDate,omitempty" json:",omitempty"`          // 1 (if used, deprecated)
 
 	// Incentive resources
-	PreOrderIncentiveResourceList    *DealResourceReferenceList `xml:"PreOrderIncentiveResourceList,omitempty"`    // 0-1
-	InstantGratificationResourceList *DealResourceReferenceList `xml:"InstantGratificationResourceList,omitempty"` // 0-1
+	PreOrderIncentiveResourceList    *DealResourceReferenceList `xml:"PreOrderIncentiveResourceList,omitempty" json:",omitempty"`    // 0-1
+	InstantGratificationResourceList *DealResourceReferenceList `xml:"InstantGratificationResourceList,omitempty" json:",omitempty"` // 0-1
 
 	// Exclusivity and offers
-	IsExclusive            *bool                    `xml:"IsExclusive,omitempty"`            // 0-1
-	RelatedReleaseOfferSet []RelatedReleaseOfferSet `xml:"RelatedReleaseOfferSet,omitempty"` // 0-n
+	IsExclusive            *bool                    `xml:"IsExclusive,omitempty" json:",omitempty"`            // 0-1
+	RelatedReleaseOfferSet []RelatedReleaseOfferSet `xml:"RelatedReleaseOfferSet,omitempty" json:",omitempty"` // 0-n
 
 	// Physical distribution
-	PhysicalReturns           *PhysicalReturns `xml:"PhysicalReturns,omitempty"`           // 0-1
-	NumberOfProductsPerCarton *int             `xml:"NumberOfProductsPerCarton,omitempty"` // 0-1
+	PhysicalReturns           *PhysicalReturns `xml:"PhysicalReturns,omitempty" json:",omitempty"`           // 0-1
+	NumberOfProductsPerCarton *int             `xml:"NumberOfProductsPerCarton,omitempty" json:",omitempty"` // 0-1
 
 	// Policies
-	RightsClaimPolicy []RightsClaimPolicy `xml:"RightsClaimPolicy,omitempty"` // 0-n
-	WebPolicy         []WebPolicy         `xml:"WebPolicy,omitempty"`         // 0-n
+	RightsClaimPolicy []RightsClaimPolicy `xml:"RightsClaimPolicy,omitempty" json:",omitempty"` // 0-n
+	WebPolicy         []WebPolicy         `xml:"WebPolicy,omitempty" json:",omitempty"`         // 0-n
+	Extensions        []RawElement        `xml:",any" json:",omitempty"`
 }
 
 // Usage represents usage types and restrictions
 type Usage struct {
-	XMLName xml.Name `xml:"Usage"`
-	UseType []string `xml:"UseType"` // 1-n
+	XMLName    xml.Name     `xml:"Usage" json:"-"`
+	UseType    []string     `xml:"UseType" json:",omitempty"` // 1-n
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // DSP represents a Digital Service Provider
 type DSP struct {
-	XMLName xml.Name `xml:",omitempty"`
+	XMLName xml.Name `xml:",omitempty" json:"-"`
 	// DSP fields would be defined based on ddexC:DSP composite
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // PromotionalCode represents a promotional code composite
 type PromotionalCode struct {
-	XMLName xml.Name `xml:"PromotionalCode"`
+	XMLName xml.Name `xml:"PromotionalCode" json:"-"`
 	// PromotionalCode fields would be defined based on ddexC:PromotionalCode composite
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // ConsumerRentalPeriod represents the rental period for consumers
 type ConsumerRentalPeriod struct {
-	XMLName xml.Name `xml:"ConsumerRentalPeriod"`
+	XMLName xml.Name `xml:"ConsumerRentalPeriod" json:"-"`
 	// ConsumerRentalPeriod fields would be defined based on ddexC:ConsumerRentalPeriod composite
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // DealResourceReferenceList represents a list of resources in a deal
 type DealResourceReferenceList struct {
-	XMLName xml.Name `xml:",omitempty"`
-	// DealResourceReferenceList fields would be defined based on ern:DealResourceReferenceList composite
+	XMLName               xml.Name     `xml:",omitempty" json:"-"`                     // Element name comes from the parent field
+	DealResourceReference []string     `xml:"DealResourceReference" json:",omitempty"` // 1-n (ResourceReference of the resource)
+	Extensions            []RawElement `xml:",any" json:",omitempty"`
 }
 
 // RelatedReleaseOfferSet represents related offers for a release
 type RelatedReleaseOfferSet struct {
-	XMLName xml.Name `xml:"RelatedReleaseOfferSet"`
+	XMLName xml.Name `xml:"RelatedReleaseOfferSet" json:"-"`
 	// RelatedReleaseOfferSet fields would be defined based on ern:RelatedReleaseOfferSet composite
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // PhysicalReturns represents physical returns information
 type PhysicalReturns struct {
-	XMLName xml.Name `xml:"PhysicalReturns"`
+	XMLName xml.Name `xml:"PhysicalReturns" json:"-"`
 	// PhysicalReturns fields would be defined based on ern:PhysicalReturns composite
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // WebPolicy represents UserGeneratedContent permissions
 type WebPolicy struct {
-	XMLName xml.Name `xml:"WebPolicy"`
+	XMLName xml.Name `xml:"WebPolicy" json:"-"`
 	// WebPolicy fields would be defined based on ern:WebPolicy composite
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // PriceInformation represents pricing information for a deal
 type PriceInformation struct {
-	XMLName                        xml.Name `xml:"PriceInformation"`
-	BulkOrderWholesalePricePerUnit float64  `xml:"BulkOrderWholesalePricePerUnit,omitempty"`
+	XMLName                        xml.Name     `xml:"PriceInformation" json:"-"`
+	BulkOrderWholesalePricePerUnit *Decimal     `xml:"BulkOrderWholesalePricePerUnit,omitempty" json:",omitempty"`
+	Extensions                     []RawElement `xml:",any" json:",omitempty"`
 }
 
 // ValidityPeriod represents time period validity information
 type ValidityPeriod struct {
-	XMLName       xml.Name `xml:"ValidityPeriod"`
-	StartDate     string   `xml:"StartDate,omitempty"`
-	StartDateTime string   `xml:"StartDateTime,omitempty"`
-	EndDate       string   `xml:"EndDate,omitempty"`
+	XMLName       xml.Name     `xml:"ValidityPeriod" json:"-"`
+	StartDate     string       `xml:"StartDate,omitempty" json:",omitempty"`
+	StartDateTime string       `xml:"StartDateTime,omitempty" json:",omitempty"`
+	EndDate       string       `xml:"EndDate,omitempty" json:",omitempty"`
+	EndDateTime   string       `xml:"EndDateTime,omitempty" json:",omitempty"`
+	Extensions    []RawElement `xml:",any" json:",omitempty"`
 }
 
 // RightsClaimPolicy represents a policy for claiming rights
 type RightsClaimPolicy struct {
-	XMLName               xml.Name `xml:"RightsClaimPolicy"`
-	RightsClaimPolicyType string   `xml:"RightsClaimPolicyType"`
+	XMLName               xml.Name     `xml:"RightsClaimPolicy" json:"-"`
+	RightsClaimPolicyType string       `xml:"RightsClaimPolicyType" json:",omitempty"`
+	Extensions            []RawElement `xml:",any" json:",omitempty"`
 }
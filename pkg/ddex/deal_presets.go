@@ -0,0 +1,89 @@
+package ddex
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DealPreset is a combination of commercial models, use types and rights claim policies a
+// DSP is known to accept, applied to a deal with DealBuilder.WithPreset
+type DealPreset struct {
+	Name                string // Used by manifests, e.g. youtube_content_id
+	CommercialModelType []string
+	UseType             []string
+	RightsClaimPolicy   []string
+}
+
+// Ready-made deal presets
+var (
+	// DealPresetYouTubeContentID claims and monetizes user uploads matching the resource
+	DealPresetYouTubeContentID = DealPreset{
+		Name:                "youtube_content_id",
+		CommercialModelType: []string{"RightsClaimModel"},
+		UseType:             []string{"UserMakeAvailableUserProvided", "UserMakeAvailableLabelProvided"},
+		RightsClaimPolicy:   []string{"Monetize"},
+	}
+
+	// DealPresetYouTubeStreaming makes the release available on YouTube and YouTube Music
+	DealPresetYouTubeStreaming = DealPreset{
+		Name:                "youtube_streaming",
+		CommercialModelType: []string{"AdvertisementSupportedModel", "SubscriptionModel"},
+		UseType:             []string{"OnDemandStream"},
+	}
+
+	// DealPresetStreamingStandard is the usual ad-supported and subscription streaming deal
+	DealPresetStreamingStandard = DealPreset{
+		Name:                "streaming_standard",
+		CommercialModelType: []string{"AdvertisementSupportedModel", "SubscriptionModel"},
+		UseType:             []string{"OnDemandStream", "NonInteractiveStream"},
+	}
+
+	// DealPresetDownloadToOwn sells permanent downloads
+	DealPresetDownloadToOwn = DealPreset{
+		Name:                "download_to_own",
+		CommercialModelType: []string{"PayAsYouGoModel"},
+		UseType:             []string{"PermanentDownload"},
+	}
+)
+
+var dealPresets = map[string]DealPreset{
+	DealPresetYouTubeContentID.Name:  DealPresetYouTubeContentID,
+	DealPresetYouTubeStreaming.Name:  DealPresetYouTubeStreaming,
+	DealPresetStreamingStandard.Name: DealPresetStreamingStandard,
+	DealPresetDownloadToOwn.Name:     DealPresetDownloadToOwn,
+}
+
+// DealPresetByName returns the ready-made preset with the given name
+func DealPresetByName(name string) (DealPreset, error) {
+	preset, ok := dealPresets[name]
+	if !ok {
+		names := make([]string, 0, len(dealPresets))
+		for n := range dealPresets {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return DealPreset{}, fmt.Errorf("unknown deal preset %q (known presets: %v)", name, names)
+	}
+	return preset, nil
+}
+
+// WithPreset adds the commercial models, use types and rights claim policies of a preset
+// to the deal
+func (db *DealBuilder) WithPreset(preset DealPreset) *DealBuilder {
+	for _, model := range preset.CommercialModelType {
+		db.WithCommercialModel(model)
+	}
+	for _, useType := range preset.UseType {
+		db.WithUseType(useType)
+	}
+	for _, policy := range preset.RightsClaimPolicy {
+		db.WithRightsClaimPolicy(policy)
+	}
+	return db
+}
+
+// AddDealFromPreset adds a new deal with the terms of a preset; territories and validity
+// periods are set on the returned builder
+func (rdb *ReleaseDealBuilder) AddDealFromPreset(preset DealPreset) *DealBuilder {
+	return rdb.AddDeal().WithPreset(preset)
+}
@@ -0,0 +1,143 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// Decimal is a fixed-point decimal number for percentages and prices. It keeps the digits
+// it was given, so 33.33 is written as 33.33 and never as 33.333333336 or 3.333e+01. The
+// zero value is 0.
+type Decimal struct {
+	units *big.Int // value * 10^scale, nil for 0; never modified once set
+	scale int      // digits after the decimal point
+}
+
+// decimalPattern matches the lexical forms of xs:decimal, such as 12, +5, -0.5, .5 and 5.
+var decimalPattern = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+
+// ParseDecimal parses a decimal number in any form xs:decimal allows, such as 12, 33.33,
+// -0.5, +5, .5 or 5.
+func ParseDecimal(s string) (Decimal, error) {
+	value := strings.TrimSpace(s)
+	if !decimalPattern.MatchString(value) {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	value = strings.TrimPrefix(value, "+")
+	scale := 0
+	if dot := strings.IndexByte(value, '.'); dot >= 0 {
+		scale = len(value) - dot - 1
+		value = value[:dot] + value[dot+1:]
+	}
+	units, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	return Decimal{units: units, scale: scale}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics on invalid input, for literals
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DecimalFromRat rounds r to scale digits after the decimal point, halves away from zero,
+// e.g. 1/3 with scale 2 is 0.33. A negative scale is 0.
+func DecimalFromRat(r *big.Rat, scale int) Decimal {
+	if scale < 0 {
+		scale = 0
+	}
+	numerator := new(big.Int).Mul(r.Num(), pow10(scale))
+	units, remainder := new(big.Int).QuoRem(numerator, r.Denom(), new(big.Int))
+	if remainder.Lsh(remainder.Abs(remainder), 1).Cmp(r.Denom()) >= 0 {
+		units.Add(units, big.NewInt(int64(r.Sign())))
+	}
+	return Decimal{units: units, scale: scale}
+}
+
+// DecimalFromFloat rounds f to scale digits after the decimal point, halves away from
+// zero. NaN and infinities have no decimal value and return an error.
+func DecimalFromFloat(f float64, scale int) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, fmt.Errorf("invalid decimal %v", f)
+	}
+	return DecimalFromRat(new(big.Rat).SetFloat64(f), scale), nil
+}
+
+// pow10 returns 10^n
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// String formats the decimal with its digits after the decimal point
+func (d Decimal) String() string {
+	digits := "0"
+	if d.units != nil {
+		digits = d.units.String()
+	}
+	if d.scale == 0 {
+		return digits
+	}
+
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+	point := len(digits) - d.scale
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// Rat returns the exact value of the decimal
+func (d Decimal) Rat() *big.Rat {
+	units := d.units
+	if units == nil {
+		units = new(big.Int)
+	}
+	return new(big.Rat).SetFrac(units, pow10(d.scale))
+}
+
+// Cmp compares two decimals by value, returning -1, 0 or +1
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// MarshalText implements encoding.TextMarshaler, used for JSON and attributes
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalXML writes the decimal as the text of its element
+func (d Decimal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(d.String(), start)
+}
+
+// UnmarshalXML reads the decimal from the text of its element
+func (d *Decimal) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := dec.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(text))
+}
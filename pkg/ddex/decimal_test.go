@@ -0,0 +1,173 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		rat  string
+	}{
+		{"12", "12", "12"},
+		{"33.33", "33.33", "3333/100"},
+		{"-0.5", "-0.5", "-1/2"},
+		{"+5", "5", "5"},
+		{".5", "0.5", "1/2"},
+		{"5.", "5", "5"},
+		{"-.25", "-0.25", "-1/4"},
+		{"0.00", "0.00", "0"},
+		{"007.50", "7.50", "15/2"},
+		{" 1.5 ", "1.5", "3/2"},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789", "123456789012345678901234567890123456789/1000000000"},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		if err != nil {
+			t.Errorf("ParseDecimal(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+		if got := d.Rat().RatString(); got != tt.rat {
+			t.Errorf("ParseDecimal(%q).Rat() = %s, want %s", tt.in, got, tt.rat)
+		}
+	}
+}
+
+func TestParseDecimalInvalid(t *testing.T) {
+	for _, in := range []string{"", ".", "+", "-", "1e5", "1.2.3", "0x10", "1,5", "--1", "+-1", "NaN", "INF"} {
+		if d, err := ParseDecimal(in); err == nil {
+			t.Errorf("ParseDecimal(%q) = %s, want an error", in, d)
+		}
+	}
+}
+
+func TestDecimalZeroValue(t *testing.T) {
+	var d Decimal
+	if got := d.String(); got != "0" {
+		t.Errorf("zero Decimal = %s, want 0", got)
+	}
+	if d.Rat().Sign() != 0 {
+		t.Errorf("zero Decimal Rat = %s, want 0", d.Rat())
+	}
+	if d.Cmp(MustParseDecimal("0.00")) != 0 {
+		t.Error("zero Decimal doesn't equal 0.00")
+	}
+}
+
+func TestDecimalFromRat(t *testing.T) {
+	tests := []struct {
+		r     *big.Rat
+		scale int
+		want  string
+	}{
+		{big.NewRat(1, 3), 2, "0.33"},
+		{big.NewRat(2, 3), 2, "0.67"},
+		{big.NewRat(-2, 3), 2, "-0.67"},
+		{big.NewRat(1, 8), 2, "0.13"},
+		{big.NewRat(-1, 8), 2, "-0.13"},
+		{big.NewRat(1, 3), 20, "0.33333333333333333333"},
+		{big.NewRat(100, 3), 4, "33.3333"},
+		{big.NewRat(5, 2), 0, "3"},
+		{big.NewRat(7, 1), 2, "7.00"},
+		{big.NewRat(1, 3), -1, "0"},
+		{big.NewRat(-1, 1000), 2, "0.00"},
+	}
+	for _, tt := range tests {
+		if got := DecimalFromRat(tt.r, tt.scale).String(); got != tt.want {
+			t.Errorf("DecimalFromRat(%s, %d) = %s, want %s", tt.r, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalFromFloat(t *testing.T) {
+	tests := []struct {
+		f     float64
+		scale int
+		want  string
+	}{
+		{0.1 + 0.2, 2, "0.30"},
+		{33.333333336, 2, "33.33"},
+		{100, 2, "100.00"},
+		{-1.5, 0, "-2"},
+		{1e20, 0, "100000000000000000000"},
+	}
+	for _, tt := range tests {
+		d, err := DecimalFromFloat(tt.f, tt.scale)
+		if err != nil {
+			t.Errorf("DecimalFromFloat(%v, %d) error: %v", tt.f, tt.scale, err)
+			continue
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("DecimalFromFloat(%v, %d) = %s, want %s", tt.f, tt.scale, got, tt.want)
+		}
+	}
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := DecimalFromFloat(f, 2); err == nil {
+			t.Errorf("DecimalFromFloat(%v) returned no error", f)
+		}
+	}
+}
+
+func TestDecimalXML(t *testing.T) {
+	type element struct {
+		XMLName xml.Name `xml:"RightsController"`
+		Share   *Decimal `xml:"RightSharePercentage"`
+	}
+	for in, want := range map[string]string{".5": "0.5", "+100": "100", "33.": "33", "12.50": "12.50"} {
+		var e element
+		data := "<RightsController><RightSharePercentage>" + in + "</RightSharePercentage></RightsController>"
+		if err := xml.Unmarshal([]byte(data), &e); err != nil {
+			t.Errorf("unmarshal %s: %v", in, err)
+			continue
+		}
+		out, err := xml.Marshal(e)
+		if err != nil {
+			t.Errorf("marshal %s: %v", in, err)
+			continue
+		}
+		if got := string(out); got != "<RightsController><RightSharePercentage>"+want+"</RightSharePercentage></RightsController>" {
+			t.Errorf("%s round-trips as %s", in, got)
+		}
+	}
+
+	var e element
+	if err := xml.Unmarshal([]byte("<RightsController><RightSharePercentage>1e2</RightSharePercentage></RightsController>"), &e); err == nil {
+		t.Error("unmarshal of 1e2 returned no error")
+	}
+}
+
+func TestBuilderDecimalOverloads(t *testing.T) {
+	b := NewDDEXBuilder()
+	details := b.AddSoundRecording("A1", "MusicalWorkSoundRecording").AddSoundRecordingDetailsByTerritory([]string{"Worldwide"})
+	details.WithRightsControllerShareString("Label", "PADPIDA2014120301G", "33.34").
+		WithRightsControllerShareRat("Other", "PADPIDA2014120302G", big.NewRat(200, 3), 2)
+	controllers := details.territoryDetails().RightsController
+	if len(controllers) != 2 {
+		t.Fatalf("got %d rights controllers, want 2", len(controllers))
+	}
+	if got := controllers[0].RightSharePercentage.String(); got != "33.34" {
+		t.Errorf("string share = %s, want 33.34", got)
+	}
+	if got := controllers[1].RightSharePercentage.String(); got != "66.67" {
+		t.Errorf("rat share = %s, want 66.67", got)
+	}
+	if err := b.Err(); err != nil {
+		t.Errorf("Err() = %v", err)
+	}
+
+	details.WithRightsControllerShareString("Bad", "PADPIDA2014120303G", "a third")
+	if b.Err() == nil {
+		t.Fatal("Err() is nil after an invalid share")
+	}
+	if _, err := b.ToXML(); err == nil {
+		t.Error("ToXML returned no error after an invalid share")
+	}
+}
@@ -0,0 +1,355 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AckStatus is the outcome reported by an acknowledgement
+type AckStatus int
+
+const (
+	// AckAccepted means the recipient ingested the message
+	AckAccepted AckStatus = iota
+	// AckRejected means the recipient refused the message or one of its files
+	AckRejected
+)
+
+// String returns Accepted or Rejected
+func (s AckStatus) String() string {
+	if s == AckAccepted {
+		return "Accepted"
+	}
+	return "Rejected"
+}
+
+// AckEvent is an acknowledgement returned by a recipient
+type AckEvent struct {
+	Status           AckStatus
+	MessageId        string   // MessageId of the acknowledged message, if the acknowledgement names it
+	AcknowledgedFile string   // File the acknowledgement refers to, if named
+	StatusText       string   // Status value as sent, e.g. FileOK or ResourceCorrupt
+	Errors           []string // Error texts sent with a rejection
+	File             string   // Name of the acknowledgement file
+	Raw              []byte
+}
+
+// acceptedStatuses are the status values reported for successful ingestion
+var acceptedStatuses = map[string]bool{
+	"fileok": true,
+	"successfullyingestedbyreleasedistributor": true,
+	"accepted":  true,
+	"ok":        true,
+	"success":   true,
+	"processed": true,
+	"ingested":  true,
+}
+
+// ParseAcknowledgement parses an acknowledgement message such as the ERN choreography's
+// FtpAcknowledgementMessage. Parsing is lenient about the schema version: it picks up
+// the acknowledged message ID (any *MessageId element outside MessageHeader), the
+// acknowledged file, the first FileStatus/MessageStatus/Status value and every ErrorText.
+// An acknowledgement is accepted only if its status is a known success value and it
+// carries no errors.
+func ParseAcknowledgement(data []byte) (*AckEvent, error) {
+	event := &AckEvent{Raw: data}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	var stack []string
+	var text strings.Builder
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse acknowledgement: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+			text.Reset()
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			name := t.Name.Local
+			value := strings.TrimSpace(text.String())
+			text.Reset()
+			inHeader := len(stack) >= 2 && stack[1] == "MessageHeader"
+			parent := ""
+			if len(stack) >= 2 {
+				parent = stack[len(stack)-2]
+			}
+			stack = stack[:len(stack)-1]
+			if value == "" {
+				continue
+			}
+
+			switch {
+			case strings.HasSuffix(name, "MessageId") && !inHeader:
+				if event.MessageId == "" {
+					event.MessageId = value
+				}
+			case name == "AcknowledgedFile" || (name == "FileName" && parent == "AcknowledgedFile"):
+				if event.AcknowledgedFile == "" {
+					event.AcknowledgedFile = value
+				}
+			case name == "FileStatus" || name == "MessageStatus" || name == "AcknowledgementStatus" || name == "Status":
+				if event.StatusText == "" {
+					event.StatusText = value
+				}
+			case name == "ErrorText" || name == "ErrorMessage" || name == "Error":
+				event.Errors = append(event.Errors, value)
+			}
+		}
+	}
+
+	if event.StatusText == "" && len(event.Errors) == 0 {
+		return nil, fmt.Errorf("acknowledgement has no status")
+	}
+
+	event.Status = AckRejected
+	if acceptedStatuses[strings.ToLower(event.StatusText)] && len(event.Errors) == 0 {
+		event.Status = AckAccepted
+	}
+	return event, nil
+}
+
+// AckSource is a folder that acknowledgements are returned to
+type AckSource interface {
+	// List returns the names of the files in the folder
+	List(ctx context.Context) ([]string, error)
+	// Read returns the content of a file
+	Read(ctx context.Context, name string) ([]byte, error)
+	// Remove deletes a processed file
+	Remove(ctx context.Context, name string) error
+}
+
+// DirAckSource reads acknowledgements from a local folder
+type DirAckSource struct {
+	Dir string
+}
+
+// List returns the XML files in the folder
+func (s DirAckSource) List(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.EqualFold(filepath.Ext(entry.Name()), ".xml") {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// Read returns the content of a file
+func (s DirAckSource) Read(ctx context.Context, name string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.Dir, name))
+}
+
+// Remove deletes a file
+func (s DirAckSource) Remove(ctx context.Context, name string) error {
+	return os.Remove(filepath.Join(s.Dir, name))
+}
+
+// SFTPReadClient is the subset of an SFTP client used by SFTPAckSource. A *sftp.Client
+// provides ReadDir and Remove directly; Open needs the same kind of adapter as Create:
+//
+//	func (c sftpClient) Open(path string) (io.ReadCloser, error) { return c.Client.Open(path) }
+type SFTPReadClient interface {
+	ReadDir(path string) ([]os.FileInfo, error)
+	Open(path string) (io.ReadCloser, error)
+	Remove(path string) error
+}
+
+// SFTPAckSource reads acknowledgements from a folder on an SFTP server
+type SFTPAckSource struct {
+	Client SFTPReadClient
+	Dir    string
+}
+
+// List returns the XML files in the folder
+func (s SFTPAckSource) List(ctx context.Context) ([]string, error) {
+	infos, err := s.Client.ReadDir(s.Dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, info := range infos {
+		if !info.IsDir() && strings.EqualFold(path.Ext(info.Name()), ".xml") {
+			names = append(names, info.Name())
+		}
+	}
+	return names, nil
+}
+
+// Read returns the content of a file
+func (s SFTPAckSource) Read(ctx context.Context, name string) ([]byte, error) {
+	f, err := s.Client.Open(path.Join(s.Dir, name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// Remove deletes a file
+func (s SFTPAckSource) Remove(ctx context.Context, name string) error {
+	return s.Client.Remove(path.Join(s.Dir, name))
+}
+
+// AckPoller watches an acknowledgement folder and emits an AckEvent per new file
+type AckPoller struct {
+	source   AckSource
+	interval time.Duration
+	remove   bool
+	retry    RetryPolicy
+	onError  func(error)
+	seen     map[string]bool
+	failed   map[string][sha256.Size]byte // Hash of the content of files that didn't parse
+}
+
+// NewAckPoller creates a poller scanning source every interval
+func NewAckPoller(source AckSource, interval time.Duration) *AckPoller {
+	return &AckPoller{
+		source:   source,
+		interval: interval,
+		seen:     make(map[string]bool),
+		failed:   make(map[string][sha256.Size]byte),
+	}
+}
+
+// RemoveProcessed deletes acknowledgement files once their event has been emitted.
+// Otherwise processed files are remembered and skipped for the life of the poller.
+func (p *AckPoller) RemoveProcessed() *AckPoller {
+	p.remove = true
+	return p
+}
+
+// WithRetryPolicy retries listing, reading and removing acknowledgement files, which
+// otherwise fail the poll or the file at the first error
+func (p *AckPoller) WithRetryPolicy(policy RetryPolicy) *AckPoller {
+	p.retry = policy
+	return p
+}
+
+// OnError sets a callback for errors that don't stop Run, such as an unreadable
+// folder or an unparsable file
+func (p *AckPoller) OnError(fn func(error)) *AckPoller {
+	p.onError = fn
+	return p
+}
+
+// Poll scans the folder once and returns the events of files not processed before,
+// in file name order. A file that doesn't parse, such as one still being written, is
+// read again on the next polls; its error is returned again only if its content changed.
+func (p *AckPoller) Poll(ctx context.Context) ([]AckEvent, error) {
+	var names []string
+	err := p.retry.Do(ctx, func() (err error) {
+		names, err = p.source.List(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list acknowledgements: %w", err)
+	}
+	sort.Strings(names)
+
+	var events []AckEvent
+	var errs []error
+	for _, name := range names {
+		if p.seen[name] {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return events, err
+		}
+
+		var data []byte
+		err := p.retry.Do(ctx, func() (err error) {
+			data, err = p.source.Read(ctx, name)
+			return err
+		})
+		if err != nil {
+			// Possibly still being written; retried on the next poll
+			errs = append(errs, fmt.Errorf("failed to read %s: %w", name, err))
+			continue
+		}
+
+		// A file that doesn't parse may still be being written, so it is parsed again once
+		// its content changes
+		event, err := ParseAcknowledgement(data)
+		if err != nil {
+			sum := sha256.Sum256(data)
+			if previous, ok := p.failed[name]; !ok || previous != sum {
+				p.failed[name] = sum
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			}
+			continue
+		}
+		delete(p.failed, name)
+		p.seen[name] = true
+		event.File = name
+		events = append(events, *event)
+
+		if p.remove {
+			err := p.retry.Do(ctx, func() error {
+				return p.source.Remove(ctx, name)
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to remove %s: %w", name, err))
+			} else {
+				delete(p.seen, name)
+			}
+		}
+	}
+
+	listed := make(map[string]bool, len(names))
+	for _, name := range names {
+		listed[name] = true
+	}
+	for name := range p.failed {
+		if !listed[name] {
+			delete(p.failed, name)
+		}
+	}
+
+	return events, errors.Join(errs...)
+}
+
+// Run polls until ctx is cancelled, passing each event to fn. Poll errors are passed to
+// the OnError callback and polling continues. It returns ctx.Err().
+func (p *AckPoller) Run(ctx context.Context, fn func(AckEvent)) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		events, err := p.Poll(ctx)
+		for _, event := range events {
+			fn(event)
+		}
+		if err != nil && p.onError != nil && ctx.Err() == nil {
+			p.onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
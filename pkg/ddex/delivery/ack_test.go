@@ -0,0 +1,64 @@
+package delivery
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+)
+
+// memoryAckSource is an AckSource holding its files in memory
+type memoryAckSource map[string][]byte
+
+func (s memoryAckSource) List(ctx context.Context) ([]string, error) {
+	var names []string
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (s memoryAckSource) Read(ctx context.Context, name string) ([]byte, error) {
+	return s[name], nil
+}
+
+func (s memoryAckSource) Remove(ctx context.Context, name string) error {
+	delete(s, name)
+	return nil
+}
+
+const ackXML = `<FtpAcknowledgementMessage>
+	<MessageHeader><MessageId>ACK1</MessageId></MessageHeader>
+	<AcknowledgedFile>123456789012.xml</AcknowledgedFile>
+	<FileStatus>FileOK</FileStatus>
+</FtpAcknowledgementMessage>`
+
+func TestAckPollerRetriesPartialFiles(t *testing.T) {
+	source := memoryAckSource{"ack.xml": []byte(ackXML[:40])}
+	poller := NewAckPoller(source, time.Second)
+	ctx := context.Background()
+
+	events, err := poller.Poll(ctx)
+	if len(events) != 0 || err == nil {
+		t.Fatalf("partial file: got %d events and error %v, want none and an error", len(events), err)
+	}
+	events, err = poller.Poll(ctx)
+	if len(events) != 0 || err != nil {
+		t.Fatalf("unchanged partial file: got %d events and error %v, want none", len(events), err)
+	}
+
+	source["ack.xml"] = []byte(ackXML)
+	events, err = poller.Poll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Status != AckAccepted || events[0].AcknowledgedFile != "123456789012.xml" {
+		t.Fatalf("complete file: got %+v", events)
+	}
+
+	events, err = poller.Poll(ctx)
+	if len(events) != 0 || err != nil {
+		t.Fatalf("processed file: got %d events and error %v, want none", len(events), err)
+	}
+}
@@ -0,0 +1,284 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// Deliverer is a delivery target. Paths are slash-separated and relative to the target's
+// root: <batch>/<release>/<file>.
+type Deliverer interface {
+	// Put stores size bytes read from r at remotePath, replacing any existing file.
+	// size is -1 when unknown.
+	Put(ctx context.Context, remotePath string, r io.Reader, size int64) error
+
+	// Complete signals that every file of the batch has been stored
+	Complete(ctx context.Context, batchID string) error
+
+	// Abort is called instead of Complete when the batch fails, with the paths stored so
+	// far, so the target can discard the partial batch
+	Abort(ctx context.Context, batchID string, stored []string) error
+}
+
+// DelivererFuncs adapts plain functions to the Deliverer interface, e.g. to plug in a
+// proprietary transport. A nil CompleteFunc stores the BatchComplete file; a nil
+// AbortFunc leaves the partial batch in place.
+type DelivererFuncs struct {
+	PutFunc      func(ctx context.Context, remotePath string, r io.Reader, size int64) error
+	CompleteFunc func(ctx context.Context, batchID string) error
+	AbortFunc    func(ctx context.Context, batchID string, stored []string) error
+}
+
+// Put calls PutFunc
+func (f DelivererFuncs) Put(ctx context.Context, remotePath string, r io.Reader, size int64) error {
+	return f.PutFunc(ctx, remotePath, r, size)
+}
+
+// Complete calls CompleteFunc, or stores the BatchComplete file
+func (f DelivererFuncs) Complete(ctx context.Context, batchID string) error {
+	if f.CompleteFunc == nil {
+		return PutBatchComplete(ctx, f, batchID)
+	}
+	return f.CompleteFunc(ctx, batchID)
+}
+
+// Abort calls AbortFunc if set
+func (f DelivererFuncs) Abort(ctx context.Context, batchID string, stored []string) error {
+	if f.AbortFunc == nil {
+		return nil
+	}
+	return f.AbortFunc(ctx, batchID, stored)
+}
+
+// Options configures Deliver
+type Options struct {
+	Retries    int           // Times a failed Put or Complete is retried
+	RetryDelay time.Duration // Initial delay between attempts, doubled after each one
+	Retry      *RetryPolicy  // Replaces Retries and RetryDelay, e.g. to add jitter or cap the delay
+	Progress   ProgressFunc
+	AllowLive  bool // Deliver LiveMessages; without it batches containing one fail with ErrLiveMessage
+
+	// Checksums uploads a checksum manifest of the batch's files using the algorithm
+	// before completing the batch. The files are hashed before the upload starts, which
+	// reads them once more.
+	Checksums ddex.ChecksumAlgorithm
+
+	// BytesPerSecond limits the upload bandwidth of the whole delivery; 0 is unlimited
+	BytesPerSecond int64
+
+	// Resume keeps the files of a failed batch instead of aborting it. Delivering the batch
+	// again with the same ID then skips the resource files already stored and continues
+	// the interrupted one, on Deliverers implementing Resumer. Local files must not
+	// change in between.
+	Resume bool
+
+	// Logger receives retried uploads and delivered releases and batches; nil uses the
+	// logger set with ddex.SetLogger
+	Logger *slog.Logger
+}
+
+// log logs msg to the Logger of the options, or to the ddex.SetLogger logger
+func (opts Options) log(level slog.Level, msg string, args ...interface{}) {
+	logger := opts.Logger
+	if logger == nil {
+		logger = ddex.Logger()
+	}
+	if logger != nil {
+		logger.Log(context.Background(), level, msg, args...)
+	}
+}
+
+// retryPolicy returns Retry, or the policy of Retries and RetryDelay
+func (opts Options) retryPolicy() RetryPolicy {
+	if opts.Retry != nil {
+		return *opts.Retry
+	}
+	return RetryPolicy{MaxAttempts: opts.Retries + 1, InitialDelay: opts.RetryDelay}
+}
+
+// DefaultOptions retries 3 times starting with a 1s delay
+var DefaultOptions = Options{Retries: 3, RetryDelay: time.Second}
+
+// Deliver uploads the batch to d following the ERN choreography: for each release its
+// resources, then its message; once all releases are stored, the checksum manifest if
+// opts.Checksums is set, and then d.Complete is called. If any step fails, d.Abort is
+// called with the paths stored so far, unless opts.Resume is set.
+//
+// Unless opts.AllowLive is set, a batch containing a LiveMessage, or a message without a
+// MessageControlType, is rejected with ErrLiveMessage before anything is uploaded, so
+// test runs can't reach production.
+func Deliver(ctx context.Context, d Deliverer, batch *Batch, opts Options) (err error) {
+	if !opts.AllowLive {
+		if err := batch.checkLive(); err != nil {
+			return err
+		}
+	}
+
+	batchID := batch.batchID()
+	var stored []string
+
+	var manifest *ddex.ChecksumManifest
+	if opts.Checksums != "" {
+		if manifest, err = batch.ChecksumManifest(opts.Checksums); err != nil {
+			return fmt.Errorf("failed to hash batch %s: %w", batchID, err)
+		}
+	}
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		if opts.Resume {
+			opts.log(slog.LevelWarn, "ddex: batch kept for resuming", "batch", batchID, "files", len(stored), "error", err)
+			return
+		}
+		// Clean up even when the failure was a cancelled context
+		if abortErr := d.Abort(context.WithoutCancel(ctx), batchID, stored); abortErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to abort batch %s: %w", batchID, abortErr))
+		}
+	}()
+
+	limiter := newRateLimiter(opts.BytesPerSecond)
+	for _, release := range batch.Releases {
+		releaseDir := path.Join(batchID, ddex.ReleaseFolderName(release.Identifier))
+
+		for _, file := range release.Resources {
+			remote := path.Join(releaseDir, file.Name)
+			if err := put(ctx, d, opts, limiter, batchID, release.Identifier, remote, file); err != nil {
+				return fmt.Errorf("failed to upload %s: %w", remote, err)
+			}
+			stored = append(stored, remote)
+		}
+
+		remote := path.Join(releaseDir, ddex.MessageFileName(release.Identifier))
+		if err := put(ctx, d, opts, limiter, batchID, release.Identifier, remote, File{Data: release.Message}); err != nil {
+			return fmt.Errorf("failed to upload %s: %w", remote, err)
+		}
+		stored = append(stored, remote)
+		opts.log(slog.LevelInfo, "ddex: release delivered", "batch", batchID, "release", release.Identifier,
+			"files", len(release.Resources)+1)
+	}
+
+	if manifest != nil {
+		remote := path.Join(batchID, ddex.ChecksumManifestFileName(batchID, manifest.Algorithm))
+		if err := put(ctx, d, opts, limiter, batchID, "", remote, File{Data: manifest.Bytes()}); err != nil {
+			return fmt.Errorf("failed to upload %s: %w", remote, err)
+		}
+		stored = append(stored, remote)
+	}
+
+	err = retry(ctx, opts, "BatchComplete "+batchID, func() error {
+		return d.Complete(ctx, batchID)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete batch %s: %w", batchID, err)
+	}
+
+	opts.log(slog.LevelInfo, "ddex: batch delivered", "batch", batchID, "releases", len(batch.Releases))
+	return nil
+}
+
+// PutBatchComplete stores the empty BatchComplete_<batch>.xml file that file-based
+// recipients watch for; Deliverers writing to folders or buckets use it as Complete
+func PutBatchComplete(ctx context.Context, d Deliverer, batchID string) error {
+	return d.Put(ctx, path.Join(batchID, ddex.BatchCompleteFileName(batchID)), bytes.NewReader(nil), 0)
+}
+
+// put uploads a single file with retries, reopening the source on each attempt. Local
+// files are continued from where a failed attempt stopped if d implements Resumer.
+func put(ctx context.Context, d Deliverer, opts Options, limiter *rateLimiter, batchID, releaseID, remote string, file File) error {
+	resumer, _ := d.(Resumer)
+	resume := opts.Resume
+	return retry(ctx, opts, remote, func() error {
+		var source io.Reader
+		size := int64(-1)
+		var offset int64
+		if file.Data != nil {
+			source = bytes.NewReader(file.Data)
+			size = int64(len(file.Data))
+		} else {
+			f, err := os.Open(file.LocalPath)
+			if errors.Is(err, fs.ErrNotExist) {
+				return Permanent(err)
+			}
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if info, err := f.Stat(); err == nil {
+				size = info.Size()
+			}
+			source = f
+
+			if resumer != nil && resume && size > 0 {
+				if offset, err = resumer.Stored(ctx, remote, size); err != nil {
+					return fmt.Errorf("failed to check stored bytes: %w", err)
+				}
+				if offset == size {
+					opts.log(slog.LevelInfo, "ddex: upload skipped", "target", remote, "size", size)
+					return nil
+				}
+				if offset > 0 {
+					if _, err := f.Seek(offset, io.SeekStart); err != nil {
+						return err
+					}
+					opts.log(slog.LevelInfo, "ddex: upload resumed", "target", remote, "offset", offset, "size", size)
+				}
+			}
+			// Retries continue what this attempt stores
+			resume = true
+		}
+
+		if limiter != nil {
+			source = &limitedReader{ctx: ctx, reader: source, limiter: limiter}
+		}
+		reader := &progressReader{
+			ctx:      ctx,
+			reader:   source,
+			progress: opts.Progress,
+			report:   Progress{Batch: batchID, Release: releaseID, File: remote, Sent: offset, Total: size},
+		}
+		if resumer != nil && file.Data == nil && size >= 0 {
+			return resumer.PutFrom(ctx, remote, reader, offset, size)
+		}
+		return d.Put(ctx, remote, reader, size)
+	})
+}
+
+// retry runs fn with the retry policy of opts. target names what fn uploads in the log.
+func retry(ctx context.Context, opts Options, target string, fn func() error) error {
+	return opts.retryPolicy().do(ctx, fn, func(failed Attempt, delay time.Duration) {
+		opts.log(slog.LevelWarn, "ddex: upload retried", "target", target, "attempt", failed.Number,
+			"delay", delay, "error", failed.Err)
+	})
+}
+
+// progressReader reports the bytes read and stops when the context is cancelled
+type progressReader struct {
+	ctx      context.Context
+	reader   io.Reader
+	progress ProgressFunc
+	report   Progress
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := r.reader.Read(p)
+	if n > 0 && r.progress != nil {
+		r.report.Sent += int64(n)
+		r.progress(r.report)
+	}
+	return n, err
+}
@@ -0,0 +1,168 @@
+// Package delivery uploads DDEX release packages to recipients following the ERN batch
+// choreography: each release's resource files first, its message last, and a
+// BatchComplete file once every release of the batch has been uploaded.
+//
+// Targets implement Deliverer; SFTP drop folders, S3 and Google Cloud Storage buckets
+// are provided, and continue interrupted uploads of large files through Resumer.
+// AckPoller watches the folder a recipient returns its acknowledgements to.
+package delivery
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// ErrLiveMessage is returned by Deliver for a batch containing a LiveMessage, or a message
+// without a MessageControlType, when the AllowLive option is not set
+var ErrLiveMessage = errors.New("refusing to deliver a LiveMessage without AllowLive")
+
+// File is a file of a release package
+type File struct {
+	Name      string // Path relative to the release folder, e.g. resources/123456789012_01_001.flac
+	LocalPath string // Source file on disk; ignored when Data is set
+	Data      []byte
+}
+
+// Release is the package of one release: its message and resource files
+type Release struct {
+	Identifier string // Release folder name, normally the ICPN
+	Message    []byte // Message XML
+	Resources  []File
+}
+
+// Batch groups the releases delivered together
+type Batch struct {
+	ID       string // Batch folder name; defaults to ddex.BatchFolderName(time.Now())
+	Releases []Release
+}
+
+// Progress reports the upload progress of a single file
+type Progress struct {
+	Batch   string
+	Release string
+	File    string // Remote path
+	Sent    int64  // Including the bytes stored before an upload was resumed
+	Total   int64  // -1 if unknown
+}
+
+// ProgressFunc receives progress updates while files are uploaded
+type ProgressFunc func(Progress)
+
+// NewRelease packages a message for delivery. Every file referenced by the message's
+// technical details is taken from resourceDir by its base name and placed in the
+// release's resources/ folder.
+func NewRelease(message *ddex.NewReleaseMessage, resourceDir string) (*Release, error) {
+	identifier, err := message.DeliveryIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	xmlData, err := message.ToXMLWithHeader()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	release := &Release{
+		Identifier: identifier,
+		Message:    xmlData,
+	}
+	for _, fileName := range message.ResourceFiles() {
+		base := path.Base(strings.ReplaceAll(fileName, "\\", "/"))
+		release.Resources = append(release.Resources, File{
+			Name:      path.Join(ddex.ResourcesFolder, base),
+			LocalPath: filepath.Join(resourceDir, base),
+		})
+	}
+
+	return release, nil
+}
+
+// ChecksumManifest hashes every resource file and message of the batch, listed by their
+// paths relative to the batch folder
+func (b *Batch) ChecksumManifest(algorithm ddex.ChecksumAlgorithm) (*ddex.ChecksumManifest, error) {
+	manifest, err := ddex.NewChecksumManifest(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	for _, release := range b.Releases {
+		releaseDir := ddex.ReleaseFolderName(release.Identifier)
+		for _, file := range release.Resources {
+			name := path.Join(releaseDir, file.Name)
+			if file.Data != nil {
+				err = manifest.Add(name, bytes.NewReader(file.Data))
+			} else {
+				err = manifest.AddFile(name, file.LocalPath)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		name := path.Join(releaseDir, ddex.MessageFileName(release.Identifier))
+		if err := manifest.Add(name, bytes.NewReader(release.Message)); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// batchID returns the batch ID, generating one if it is empty
+func (b *Batch) batchID() string {
+	if b.ID == "" {
+		b.ID = ddex.BatchFolderName(time.Now())
+	}
+	return b.ID
+}
+
+// checkLive returns ErrLiveMessage if a release's message is a LiveMessage. Recipients
+// treat a message without a MessageControlType as live, so it is rejected too.
+func (b *Batch) checkLive() error {
+	for _, release := range b.Releases {
+		controlType, err := messageControlType(release.Message)
+		if err != nil {
+			return fmt.Errorf("release %s: %w", release.Identifier, err)
+		}
+		if controlType != ddex.MessageControlTypeTest {
+			return fmt.Errorf("release %s: %w", release.Identifier, ErrLiveMessage)
+		}
+	}
+	return nil
+}
+
+// messageControlType reads the MessageControlType of a message's header without parsing
+// the rest of the message
+func messageControlType(data []byte) (string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to parse message: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "MessageControlType" {
+				var controlType string
+				if err := decoder.DecodeElement(&controlType, &t); err != nil {
+					return "", fmt.Errorf("failed to parse message: %w", err)
+				}
+				return strings.TrimSpace(controlType), nil
+			}
+		case xml.EndElement:
+			if t.Name.Local == "MessageHeader" {
+				return "", nil
+			}
+		}
+	}
+}
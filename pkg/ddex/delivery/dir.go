@@ -0,0 +1,150 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// DirDeliverer writes batches to a local folder, such as a mounted share or the watch
+// folder of an Aspera or other managed file transfer client. It implements Deliverer and
+// Resumer.
+type DirDeliverer struct {
+	root    string
+	partial partialUploads
+}
+
+// NewDirDeliverer creates a deliverer writing batches below root
+func NewDirDeliverer(root string) *DirDeliverer {
+	return &DirDeliverer{root: root}
+}
+
+// Put writes a file under a temporary name and renames it into place
+func (d *DirDeliverer) Put(ctx context.Context, remotePath string, r io.Reader, size int64) error {
+	target := filepath.Join(d.root, filepath.FromSlash(remotePath))
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	temp := target + partSuffix
+	f, err := os.Create(temp)
+	if err != nil {
+		return err
+	}
+
+	_, copyErr := io.Copy(f, r)
+	closeErr := f.Close()
+	if copyErr != nil || closeErr != nil {
+		os.Remove(temp)
+		if copyErr != nil {
+			return copyErr
+		}
+		return closeErr
+	}
+
+	return os.Rename(temp, target)
+}
+
+// Stored returns size if the file is in place with that size, else the size of its
+// temporary file
+func (d *DirDeliverer) Stored(ctx context.Context, remotePath string, size int64) (int64, error) {
+	target := filepath.Join(d.root, filepath.FromSlash(remotePath))
+	if info, err := os.Stat(target); err == nil && info.Size() == size {
+		return size, nil
+	}
+	info, err := os.Stat(target + partSuffix)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if info.Size() > size {
+		return 0, nil
+	}
+	return info.Size(), nil
+}
+
+// PutFrom appends to the temporary file of an interrupted upload and renames it into
+// place once complete. The temporary file is kept when the copy fails.
+func (d *DirDeliverer) PutFrom(ctx context.Context, remotePath string, r io.Reader, offset, size int64) error {
+	target := filepath.Join(d.root, filepath.FromSlash(remotePath))
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	temp := target + partSuffix
+	f, err := os.OpenFile(temp, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	d.partial.add(remotePath)
+	if err := f.Truncate(offset); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return err
+	}
+
+	_, copyErr := io.Copy(f, r)
+	closeErr := f.Close()
+	if copyErr != nil {
+		return copyErr
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	if err := os.Rename(temp, target); err != nil {
+		return err
+	}
+	d.partial.remove(remotePath)
+	return nil
+}
+
+// Complete writes the BatchComplete file
+func (d *DirDeliverer) Complete(ctx context.Context, batchID string) error {
+	return PutBatchComplete(ctx, d, batchID)
+}
+
+// Abort removes the files stored for the batch, the temporary file of an interrupted
+// upload, and the batch folder if it is left empty
+func (d *DirDeliverer) Abort(ctx context.Context, batchID string, stored []string) error {
+	var errs []error
+	for _, remotePath := range d.partial.take(batchID) {
+		temp := filepath.Join(d.root, filepath.FromSlash(remotePath)) + partSuffix
+		if err := os.Remove(temp); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	for i := len(stored) - 1; i >= 0; i-- {
+		if err := os.Remove(filepath.Join(d.root, filepath.FromSlash(stored[i]))); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	removeEmptyDirs(filepath.Join(d.root, batchID))
+	return errors.Join(errs...)
+}
+
+// removeEmptyDirs removes dir and its subfolders if they contain no files
+func removeEmptyDirs(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	empty := true
+	for _, entry := range entries {
+		if !entry.IsDir() || !removeEmptyDirs(filepath.Join(dir, entry.Name())) {
+			empty = false
+		}
+	}
+	if empty {
+		return os.Remove(dir) == nil
+	}
+	return false
+}
@@ -0,0 +1,218 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+)
+
+// TokenFunc returns an OAuth 2.0 access token. With golang.org/x/oauth2 it can be built
+// from any TokenSource:
+//
+//	func(ctx context.Context) (string, error) {
+//		token, err := source.Token()
+//		if err != nil {
+//			return "", err
+//		}
+//		return token.AccessToken, nil
+//	}
+type TokenFunc func(ctx context.Context) (string, error)
+
+// gcsEndpoint is the Cloud Storage XML API endpoint
+const gcsEndpoint = "https://storage.googleapis.com"
+
+// Resumable upload chunk sizes, which Cloud Storage requires to be multiples of 256 KiB
+const (
+	defaultChunkSize = 64 << 20
+	chunkGranularity = 256 << 10
+)
+
+// GCSDeliverer stores batches in a Google Cloud Storage bucket using the XML API with
+// OAuth 2.0 bearer tokens. It implements Deliverer and Resumer. Objects larger than the
+// chunk size are sent in chunks through a resumable upload session, which a retry
+// continues from the last chunk Cloud Storage persisted.
+type GCSDeliverer struct {
+	bucket    string
+	prefix    string
+	token     TokenFunc
+	endpoint  string
+	client    *http.Client
+	chunkSize int64
+
+	mu       sync.Mutex
+	sessions map[string]string // Resumable upload session URIs by remote path
+}
+
+// NewGCSDeliverer creates a deliverer for bucket, authenticating each request with token
+func NewGCSDeliverer(bucket string, token TokenFunc) *GCSDeliverer {
+	return &GCSDeliverer{
+		bucket:    bucket,
+		token:     token,
+		endpoint:  gcsEndpoint,
+		client:    http.DefaultClient,
+		chunkSize: defaultChunkSize,
+		sessions:  make(map[string]string),
+	}
+}
+
+// WithPrefix stores batches below prefix inside the bucket
+func (d *GCSDeliverer) WithPrefix(prefix string) *GCSDeliverer {
+	d.prefix = strings.Trim(prefix, "/")
+	return d
+}
+
+// WithEndpoint overrides the storage endpoint, e.g. for an emulator
+func (d *GCSDeliverer) WithEndpoint(endpoint string) *GCSDeliverer {
+	d.endpoint = strings.TrimRight(endpoint, "/")
+	return d
+}
+
+// WithHTTPClient sets the HTTP client used for requests
+func (d *GCSDeliverer) WithHTTPClient(client *http.Client) *GCSDeliverer {
+	d.client = client
+	return d
+}
+
+// WithChunkSize sets the chunk size of resumable uploads, 64 MiB by default. It is
+// rounded down to a multiple of 256 KiB.
+func (d *GCSDeliverer) WithChunkSize(size int64) *GCSDeliverer {
+	size -= size % chunkGranularity
+	if size < chunkGranularity {
+		size = chunkGranularity
+	}
+	d.chunkSize = size
+	return d
+}
+
+// Put uploads an object, in chunks if it is larger than the chunk size
+func (d *GCSDeliverer) Put(ctx context.Context, remotePath string, r io.Reader, size int64) error {
+	token, err := d.token(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	if size < 0 {
+		// The XML API needs a Content-Length for a single-request upload
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(data)
+		size = int64(len(data))
+	}
+	if size > d.chunkSize {
+		return d.PutFrom(ctx, remotePath, r, 0, size)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, d.objectURL(remotePath), r)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", contentType(remotePath))
+
+	return doRequest(d.client, req)
+}
+
+// Complete stores the BatchComplete object
+func (d *GCSDeliverer) Complete(ctx context.Context, batchID string) error {
+	return PutBatchComplete(ctx, d, batchID)
+}
+
+// Abort deletes the objects stored for the batch and cancels its resumable upload
+// sessions
+func (d *GCSDeliverer) Abort(ctx context.Context, batchID string, stored []string) error {
+	token, err := d.token(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	var errs []error
+	d.mu.Lock()
+	var interrupted []string
+	for remotePath := range d.sessions {
+		if strings.HasPrefix(remotePath, batchID+"/") {
+			interrupted = append(interrupted, remotePath)
+		}
+	}
+	d.mu.Unlock()
+	for _, remotePath := range interrupted {
+		d.cancelSession(ctx, remotePath)
+	}
+
+	for i := len(stored) - 1; i >= 0; i-- {
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, d.objectURL(stored[i]), nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		if err := doRequest(d.client, req); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// objectURL returns the XML API URL of an object
+func (d *GCSDeliverer) objectURL(remotePath string) string {
+	return d.endpoint + "/" + d.bucket + "/" + uriEncodePath(path.Join(d.prefix, remotePath))
+}
+
+// contentType guesses the content type of a delivered file from its extension
+func contentType(name string) string {
+	if t := mime.TypeByExtension(path.Ext(name)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+// doRequest sends req and turns non-2xx responses into errors carrying the response body
+func doRequest(client *http.Client, req *http.Request) error {
+	_, _, err := doRequestBody(client, req)
+	return err
+}
+
+// doRequestBody is doRequest returning the headers and body of the response
+func doRequestBody(client *http.Client, req *http.Request) (http.Header, []byte, error) {
+	resp, body, err := send(client, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, nil, responseError(req, resp, body)
+	}
+	return resp.Header, body, nil
+}
+
+// send sends req and reads the response body, whatever the status
+func send(client *http.Client, req *http.Request) (*http.Response, []byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
+
+// responseError describes a failed request with the start of the response body
+func responseError(req *http.Request, resp *http.Response, body []byte) error {
+	if len(body) > 4096 {
+		body = body[:4096]
+	}
+	return fmt.Errorf("%s %s: %s: %s", req.Method, req.URL.Redacted(), resp.Status, strings.TrimSpace(string(body)))
+}
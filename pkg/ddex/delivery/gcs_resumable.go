@@ -0,0 +1,201 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// statusResumeIncomplete is the status Cloud Storage answers unfinished resumable
+// uploads with
+const statusResumeIncomplete = http.StatusPermanentRedirect
+
+// Stored returns size if the object exists with that size, else the bytes Cloud Storage
+// persisted in the resumable upload session for remotePath. Sessions are only known to
+// the deliverer that started them, so uploads interrupted in an earlier process start
+// over.
+func (d *GCSDeliverer) Stored(ctx context.Context, remotePath string, size int64) (int64, error) {
+	token, err := d.token(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, d.objectURL(remotePath), nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, body, err := send(d.client, req)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode <= 299:
+		if resp.ContentLength == size {
+			return size, nil
+		}
+	case resp.StatusCode != http.StatusNotFound:
+		return 0, responseError(req, resp, body)
+	}
+
+	d.mu.Lock()
+	session := d.sessions[remotePath]
+	d.mu.Unlock()
+	if session == "" {
+		return 0, nil
+	}
+
+	// An empty PUT asks for the status of the session
+	req, err = http.NewRequestWithContext(ctx, http.MethodPut, session, http.NoBody)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+	resp, body, err = send(d.client, req)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case resp.StatusCode == statusResumeIncomplete:
+		return persistedBytes(resp.Header), nil
+	case resp.StatusCode >= 200 && resp.StatusCode <= 299:
+		d.forgetSession(remotePath)
+		return size, nil
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		// The session expired or was cancelled
+		d.forgetSession(remotePath)
+		return 0, nil
+	}
+	return 0, responseError(req, resp, body)
+}
+
+// PutFrom uploads an object larger than the chunk size in chunks through a resumable
+// upload session, continuing the session in progress from offset
+func (d *GCSDeliverer) PutFrom(ctx context.Context, remotePath string, r io.Reader, offset, size int64) error {
+	if size <= d.chunkSize {
+		if offset != 0 {
+			return fmt.Errorf("cannot resume %s: objects up to the chunk size are sent in one request", remotePath)
+		}
+		return d.Put(ctx, remotePath, r, size)
+	}
+
+	d.mu.Lock()
+	session := d.sessions[remotePath]
+	d.mu.Unlock()
+	if offset == 0 || session == "" {
+		if offset != 0 {
+			return fmt.Errorf("cannot resume %s: no upload session", remotePath)
+		}
+		if session != "" {
+			d.cancelSession(ctx, remotePath)
+		}
+		var err error
+		if session, err = d.startSession(ctx, remotePath); err != nil {
+			return err
+		}
+	}
+
+	buf := make([]byte, d.chunkSize)
+	for sent := offset; sent < size; {
+		n := d.chunkSize
+		if size-sent < n {
+			n = size - sent
+		}
+		if _, err := io.ReadFull(r, buf[:n]); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, session, bytes.NewReader(buf[:n]))
+		if err != nil {
+			return err
+		}
+		req.ContentLength = n
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", sent, sent+n-1, size))
+		resp, body, err := send(d.client, req)
+		if err != nil {
+			return err
+		}
+		switch {
+		case resp.StatusCode >= 200 && resp.StatusCode <= 299:
+			d.forgetSession(remotePath)
+			return nil
+		case resp.StatusCode != statusResumeIncomplete:
+			return responseError(req, resp, body)
+		}
+		// A retry continues from what was persisted if it is short of what was sent
+		if persisted := persistedBytes(resp.Header); persisted != sent+n {
+			return fmt.Errorf("upload session persisted %d of %d bytes", persisted, sent+n)
+		}
+		sent += n
+	}
+	return errors.New("upload session didn't finish after the last chunk")
+}
+
+// startSession starts a resumable upload session for remotePath and remembers its URI
+func (d *GCSDeliverer) startSession(ctx context.Context, remotePath string) (string, error) {
+	token, err := d.token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get access token: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.objectURL(remotePath), http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", contentType(remotePath))
+	req.Header.Set("X-Goog-Resumable", "start")
+	header, _, err := doRequestBody(d.client, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to start upload session: %w", err)
+	}
+	session := header.Get("Location")
+	if session == "" {
+		return "", errors.New("failed to start upload session: no session URI in response")
+	}
+
+	d.mu.Lock()
+	d.sessions[remotePath] = session
+	d.mu.Unlock()
+	return session, nil
+}
+
+// cancelSession cancels the upload session of remotePath, on a best-effort basis, and
+// forgets it
+func (d *GCSDeliverer) cancelSession(ctx context.Context, remotePath string) {
+	d.mu.Lock()
+	session := d.sessions[remotePath]
+	delete(d.sessions, remotePath)
+	d.mu.Unlock()
+	if session == "" {
+		return
+	}
+	if req, err := http.NewRequestWithContext(ctx, http.MethodDelete, session, nil); err == nil {
+		// Cloud Storage answers a cancellation with 499
+		send(d.client, req)
+	}
+}
+
+func (d *GCSDeliverer) forgetSession(remotePath string) {
+	d.mu.Lock()
+	delete(d.sessions, remotePath)
+	d.mu.Unlock()
+}
+
+// persistedBytes reads how many bytes a session persisted from its "Range: bytes=0-n"
+// header, which is missing when there are none
+func persistedBytes(header http.Header) int64 {
+	_, last, ok := strings.Cut(header.Get("Range"), "-")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.ParseInt(last, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n + 1
+}
@@ -0,0 +1,169 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeGCS is an in-memory Cloud Storage bucket supporting resumable upload sessions
+type fakeGCS struct {
+	server *httptest.Server
+
+	mu          sync.Mutex
+	objects     map[string][]byte
+	sessions    map[string]*gcsSession
+	chunkPuts   int
+	failChunk   int // Fails the nth chunk PUT, counting from 1
+	nextSession int
+}
+
+type gcsSession struct {
+	key       string
+	persisted []byte
+}
+
+func newFakeGCS() *fakeGCS {
+	g := &fakeGCS{objects: map[string][]byte{}, sessions: map[string]*gcsSession{}}
+	g.server = httptest.NewServer(g)
+	return g
+}
+
+func (g *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	body, _ := io.ReadAll(r.Body)
+
+	if strings.HasPrefix(r.URL.Path, "/session/") {
+		session := g.sessions[r.URL.Path]
+		if session == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var first, last, total int64
+		if _, err := fmt.Sscanf(r.Header.Get("Content-Range"), "bytes */%d", &total); err == nil {
+			// Status query
+		} else if _, err := fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &first, &last, &total); err == nil {
+			g.chunkPuts++
+			if g.chunkPuts == g.failChunk {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			if first != int64(len(session.persisted)) || last-first+1 != int64(len(body)) {
+				http.Error(w, "unexpected range", http.StatusBadRequest)
+				return
+			}
+			session.persisted = append(session.persisted, body...)
+		} else {
+			http.Error(w, "invalid Content-Range", http.StatusBadRequest)
+			return
+		}
+		if int64(len(session.persisted)) == total {
+			g.objects[session.key] = session.persisted
+			delete(g.sessions, r.URL.Path)
+			return
+		}
+		if len(session.persisted) > 0 {
+			w.Header().Set("Range", "bytes=0-"+strconv.Itoa(len(session.persisted)-1))
+		}
+		w.WriteHeader(statusResumeIncomplete)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/bucket/")
+	switch {
+	case r.Method == http.MethodHead:
+		object, ok := g.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object)))
+	case r.Method == http.MethodPost && r.Header.Get("X-Goog-Resumable") == "start":
+		g.nextSession++
+		path := fmt.Sprintf("/session/%d", g.nextSession)
+		g.sessions[path] = &gcsSession{key: key}
+		w.Header().Set("Location", g.server.URL+path)
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut:
+		g.objects[key] = body
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+func TestGCSResumeUpload(t *testing.T) {
+	bucket := newFakeGCS()
+	defer bucket.server.Close()
+	bucket.failChunk = 3
+
+	d := NewGCSDeliverer("bucket", func(ctx context.Context) (string, error) { return "token", nil }).
+		WithEndpoint(bucket.server.URL)
+	d.chunkSize = 10 // Below the 256 KiB granularity, which the fake doesn't enforce
+	ctx := context.Background()
+	data := []byte("0123456789abcdefghijABCDEFGHIJxyz")
+
+	if err := d.Put(ctx, "batch/video.mp4", bytes.NewReader(data), int64(len(data))); err == nil {
+		t.Fatal("Put succeeded, want the third chunk to fail")
+	}
+	offset, err := d.Stored(ctx, "batch/video.mp4", int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offset != 20 {
+		t.Fatalf("Stored = %d, want 20", offset)
+	}
+	if err := d.PutFrom(ctx, "batch/video.mp4", bytes.NewReader(data[offset:]), offset, int64(len(data))); err != nil {
+		t.Fatal(err)
+	}
+	if got := bucket.objects["batch/video.mp4"]; !bytes.Equal(got, data) {
+		t.Errorf("stored %q, want %q", got, data)
+	}
+	if bucket.chunkPuts != 5 {
+		t.Errorf("%d chunk uploads, want 5 (2 chunks, the failed one, then 2 more)", bucket.chunkPuts)
+	}
+	if offset, err := d.Stored(ctx, "batch/video.mp4", int64(len(data))); err != nil || offset != int64(len(data)) {
+		t.Errorf("Stored after completion = %d, %v", offset, err)
+	}
+}
+
+func TestGCSStoredExpiredSession(t *testing.T) {
+	bucket := newFakeGCS()
+	defer bucket.server.Close()
+	bucket.failChunk = 2
+
+	d := NewGCSDeliverer("bucket", func(ctx context.Context) (string, error) { return "token", nil }).
+		WithEndpoint(bucket.server.URL)
+	d.chunkSize = 10
+	ctx := context.Background()
+	data := []byte("0123456789abcdefghijABCDEFGHIJ")
+
+	if err := d.Put(ctx, "video.mp4", bytes.NewReader(data), int64(len(data))); err == nil {
+		t.Fatal("Put succeeded, want the second chunk to fail")
+	}
+	bucket.mu.Lock()
+	bucket.sessions = map[string]*gcsSession{}
+	bucket.mu.Unlock()
+
+	offset, err := d.Stored(ctx, "video.mp4", int64(len(data)))
+	if err != nil || offset != 0 {
+		t.Fatalf("Stored = %d, %v, want 0 for an expired session", offset, err)
+	}
+	if err := d.PutFrom(ctx, "video.mp4", bytes.NewReader(data), 0, int64(len(data))); err != nil {
+		t.Fatal(err)
+	}
+	if got := bucket.objects["video.mp4"]; !bytes.Equal(got, data) {
+		t.Errorf("stored %q, want %q", got, data)
+	}
+}
@@ -0,0 +1,176 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// RetryPolicy is how failed uploads and acknowledgement polls are retried: up to
+// MaxAttempts attempts, waiting InitialDelay before the first retry and Multiplier times
+// longer before each next one, up to MaxDelay. Jitter spreads each delay by up to that
+// fraction of it, so deliveries that fail together don't retry in lockstep.
+type RetryPolicy struct {
+	MaxAttempts  int // Including the first; less than 1 is 1
+	InitialDelay time.Duration
+	MaxDelay     time.Duration // 0 is no limit
+	Multiplier   float64       // 0 doubles the delay after each retry, 1 keeps it constant
+	Jitter       float64       // 0 to 1; 0.2 waits between 80% and 120% of each delay
+
+	// Retryable reports whether an error is worth another attempt; nil retries every
+	// error not marked Permanent
+	Retryable func(error) bool
+}
+
+// DefaultRetryPolicy makes 4 attempts, waiting about 1s, 2s and 4s in between
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 4, InitialDelay: time.Second, MaxDelay: time.Minute, Jitter: 0.2}
+
+// Delay returns the time to wait before a retry: 1 for the second attempt, 2 for the
+// third. With Jitter it varies from call to call.
+func (p RetryPolicy) Delay(retry int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	delay := float64(p.InitialDelay) * math.Pow(multiplier, float64(retry-1))
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay *= 1 - jitter + 2*jitter*rand.Float64()
+	}
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		return p.MaxDelay
+	}
+	if delay > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(delay)
+}
+
+// Do runs fn until it succeeds, MaxAttempts attempts have failed, it fails with an error
+// that isn't retryable, or ctx is cancelled. Once an attempt has been retried, the error
+// returned is a *RetryError with the error of every attempt.
+func (p RetryPolicy) Do(ctx context.Context, fn func() error) error {
+	return p.do(ctx, fn, nil)
+}
+
+// do is Do calling onRetry before each retry
+func (p RetryPolicy) do(ctx context.Context, fn func() error, onRetry func(failed Attempt, delay time.Duration)) error {
+	var attempts []Attempt
+	var delay time.Duration
+	for number := 1; ; number++ {
+		if err := ctx.Err(); err != nil {
+			return retryError(attempts, err, true)
+		}
+
+		start := time.Now()
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		var permanent *permanentError
+		isPermanent := errors.As(err, &permanent)
+		attempts = append(attempts, Attempt{Number: number, Delay: delay, Duration: time.Since(start), Err: err})
+
+		if isPermanent || number >= p.MaxAttempts || ctx.Err() != nil || (p.Retryable != nil && !p.Retryable(err)) {
+			return retryError(attempts, err, false)
+		}
+
+		delay = p.Delay(number)
+		if onRetry != nil {
+			onRetry(attempts[len(attempts)-1], delay)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return retryError(attempts, ctx.Err(), true)
+		case <-timer.C:
+		}
+	}
+}
+
+// retryError returns err as it is if no attempt was retried, or a *RetryError. cancelled
+// is whether err is ctx.Err() rather than the error of the last attempt.
+func retryError(attempts []Attempt, err error, cancelled bool) error {
+	if len(attempts) == 0 || len(attempts) == 1 && !cancelled {
+		return err
+	}
+	return &RetryError{Attempts: attempts, Err: err, cancelled: cancelled}
+}
+
+// Attempt is a failed attempt of a retried operation
+type Attempt struct {
+	Number   int           // 1 for the first attempt
+	Delay    time.Duration // Waited before the attempt
+	Duration time.Duration // Taken by the attempt
+	Err      error
+}
+
+// RetryError is returned by a retried operation that failed. errors.Is and errors.As
+// match the error of any attempt.
+type RetryError struct {
+	Attempts []Attempt
+	Err      error // Of the last attempt, or ctx.Err() if retrying was cancelled
+
+	cancelled bool
+}
+
+// Error lists the error of each attempt, e.g. "3 attempts failed: attempt 1: ...; attempt
+// 2: ...; attempt 3: ..."
+func (e *RetryError) Error() string {
+	var b strings.Builder
+	noun := "attempts"
+	if len(e.Attempts) == 1 {
+		noun = "attempt"
+	}
+	fmt.Fprintf(&b, "%d %s failed", len(e.Attempts), noun)
+	if e.cancelled {
+		fmt.Fprintf(&b, ", then %v", e.Err)
+	}
+	for i, attempt := range e.Attempts {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		fmt.Fprintf(&b, "attempt %d: %v", attempt.Number, attempt.Err)
+	}
+	return b.String()
+}
+
+// Unwrap returns the errors of the attempts, and ctx.Err() if retrying was cancelled
+func (e *RetryError) Unwrap() []error {
+	var errs []error
+	if e.cancelled {
+		errs = append(errs, e.Err)
+	}
+	for _, attempt := range e.Attempts {
+		errs = append(errs, attempt.Err)
+	}
+	return errs
+}
+
+// Permanent marks an error as not worth retrying, such as a missing local file. Retried
+// operations stop at it, also when it is wrapped, and return it.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
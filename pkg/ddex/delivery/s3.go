@@ -0,0 +1,313 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// unsignedPayload lets S3 accept a streamed body without hashing it up front; the
+// request is still authenticated and carried over TLS
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// emptyPayloadHash is the SHA-256 of an empty body
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// Multipart upload part sizes
+const (
+	defaultPartSize = 64 << 20
+	minPartSize     = 5 << 20 // S3's minimum for all but the last part
+)
+
+// S3Deliverer stores batches in an S3 (or S3-compatible) bucket, signing requests with
+// AWS Signature Version 4. It implements Deliverer and Resumer. Objects up to the part
+// size are uploaded with a single PUT, larger ones with a multipart upload that can be
+// continued part by part after a failure.
+type S3Deliverer struct {
+	bucket          string
+	region          string
+	prefix          string
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	endpoint        string
+	client          *http.Client
+	now             func() time.Time
+	partSize        int64
+
+	mu      sync.Mutex
+	uploads map[string]*s3Upload // Multipart uploads in progress by remote path
+}
+
+// s3Upload is a multipart upload in progress
+type s3Upload struct {
+	uploadID string
+	etags    []string // Of the parts uploaded so far, in order
+}
+
+// NewS3Deliverer creates a deliverer for bucket in region using static credentials
+func NewS3Deliverer(bucket, region, accessKeyID, secretAccessKey string) *S3Deliverer {
+	return &S3Deliverer{
+		bucket:          bucket,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		client:          http.DefaultClient,
+		now:             time.Now,
+		partSize:        defaultPartSize,
+		uploads:         make(map[string]*s3Upload),
+	}
+}
+
+// WithPrefix stores batches below prefix inside the bucket
+func (d *S3Deliverer) WithPrefix(prefix string) *S3Deliverer {
+	d.prefix = strings.Trim(prefix, "/")
+	return d
+}
+
+// WithSessionToken sets the session token of temporary credentials
+func (d *S3Deliverer) WithSessionToken(token string) *S3Deliverer {
+	d.sessionToken = token
+	return d
+}
+
+// WithEndpoint targets an S3-compatible service (e.g. https://storage.googleapis.com with
+// HMAC keys, or MinIO) using path-style URLs
+func (d *S3Deliverer) WithEndpoint(endpoint string) *S3Deliverer {
+	d.endpoint = strings.TrimRight(endpoint, "/")
+	return d
+}
+
+// WithHTTPClient sets the HTTP client used for requests
+func (d *S3Deliverer) WithHTTPClient(client *http.Client) *S3Deliverer {
+	d.client = client
+	return d
+}
+
+// WithPartSize sets the size of multipart upload parts, 64 MiB by default and at least
+// 5 MiB. Larger parts need fewer requests, smaller ones lose less on a dropped connection.
+func (d *S3Deliverer) WithPartSize(size int64) *S3Deliverer {
+	if size < minPartSize {
+		size = minPartSize
+	}
+	d.partSize = size
+	return d
+}
+
+// Put uploads an object, in parts if it is larger than the part size
+func (d *S3Deliverer) Put(ctx context.Context, remotePath string, r io.Reader, size int64) error {
+	if size < 0 {
+		// S3 requires a Content-Length on a single PUT
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(data)
+		size = int64(len(data))
+	}
+	if size > d.partSize {
+		return d.PutFrom(ctx, remotePath, r, 0, size)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, d.objectURL(remotePath), r)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType(remotePath))
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+	d.sign(req, unsignedPayload)
+
+	return doRequest(d.client, req)
+}
+
+// Complete stores the BatchComplete object
+func (d *S3Deliverer) Complete(ctx context.Context, batchID string) error {
+	return PutBatchComplete(ctx, d, batchID)
+}
+
+// Abort deletes the objects stored for the batch and aborts its multipart uploads in
+// progress, so S3 discards their parts
+func (d *S3Deliverer) Abort(ctx context.Context, batchID string, stored []string) error {
+	var errs []error
+	d.mu.Lock()
+	var interrupted []string
+	for remotePath := range d.uploads {
+		if strings.HasPrefix(remotePath, batchID+"/") {
+			interrupted = append(interrupted, remotePath)
+		}
+	}
+	d.mu.Unlock()
+	for _, remotePath := range interrupted {
+		if err := d.abortUpload(ctx, remotePath); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for i := len(stored) - 1; i >= 0; i-- {
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, d.objectURL(stored[i]), nil)
+		if err != nil {
+			return err
+		}
+		d.sign(req, emptyPayloadHash)
+		if err := doRequest(d.client, req); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// objectURL returns the virtual-hosted URL on AWS, or a path-style URL on a custom endpoint
+func (d *S3Deliverer) objectURL(remotePath string) string {
+	return d.bucketURL() + "/" + uriEncodePath(d.objectKey(remotePath))
+}
+
+// bucketURL returns the URL of the bucket, without a trailing slash
+func (d *S3Deliverer) bucketURL() string {
+	if d.endpoint != "" {
+		return d.endpoint + "/" + d.bucket
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com", d.bucket, d.region)
+}
+
+// objectKey returns the key of a remote path inside the bucket
+func (d *S3Deliverer) objectKey(remotePath string) string {
+	return path.Join(d.prefix, remotePath)
+}
+
+// sign adds the SigV4 headers to req
+func (d *S3Deliverer) sign(req *http.Request, payloadHash string) {
+	now := d.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if d.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", d.sessionToken)
+	}
+
+	signedHeaders, canonicalHeaders := canonicalHeaders(req.Header)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + d.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+d.secretAccessKey), date)
+	key = hmacSHA256(key, d.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		d.accessKeyID, scope, signedHeaders, signature))
+	// net/http sends the Host from req.Host, not the header map
+	req.Header.Del("Host")
+}
+
+// canonicalHeaders returns the signed header names and the canonical header block,
+// which always includes host
+func canonicalHeaders(header http.Header) (string, string) {
+	values := make(map[string]string, len(header))
+	names := make([]string, 0, len(header))
+	for name, vals := range header {
+		lower := strings.ToLower(name)
+		trimmed := make([]string, len(vals))
+		for i, v := range vals {
+			trimmed[i] = strings.Join(strings.Fields(v), " ")
+		}
+		values[lower] = strings.Join(trimmed, ",")
+		names = append(names, lower)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		sb.WriteString(name)
+		sb.WriteByte(':')
+		sb.WriteString(values[name])
+		sb.WriteByte('\n')
+	}
+	return strings.Join(names, ";"), sb.String()
+}
+
+// canonicalQuery sorts and encodes query parameters as SigV4 requires
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, key := range keys {
+		vals := append([]string(nil), query[key]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			parts = append(parts, uriEncode(key)+"="+uriEncode(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncodePath encodes each segment of an object key, keeping the slashes
+func uriEncodePath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// uriEncode percent-encodes everything except the RFC 3986 unreserved characters
+func uriEncode(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,300 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// Stored returns size if the object exists with that size, else the bytes covered by
+// the parts of a multipart upload in progress. Uploads started by an earlier process
+// are found by listing the bucket's multipart uploads.
+func (d *S3Deliverer) Stored(ctx context.Context, remotePath string, size int64) (int64, error) {
+	length, found, err := d.head(ctx, remotePath)
+	if err != nil {
+		return 0, err
+	}
+	if found && length == size {
+		return size, nil
+	}
+	if size <= d.partSize {
+		return 0, nil
+	}
+
+	d.mu.Lock()
+	upload := d.uploads[remotePath]
+	d.mu.Unlock()
+	if upload == nil {
+		if upload, err = d.findUpload(ctx, remotePath); err != nil || upload == nil {
+			return 0, err
+		}
+		d.mu.Lock()
+		d.uploads[remotePath] = upload
+		d.mu.Unlock()
+	}
+
+	// An upload with every part stored failed to complete; sending the last part again
+	// completes it
+	if int64(len(upload.etags))*d.partSize >= size {
+		upload.etags = upload.etags[:len(upload.etags)-1]
+	}
+	return int64(len(upload.etags)) * d.partSize, nil
+}
+
+// PutFrom uploads an object larger than the part size in parts, continuing the multipart
+// upload in progress from offset, which must be a multiple of the part size
+func (d *S3Deliverer) PutFrom(ctx context.Context, remotePath string, r io.Reader, offset, size int64) error {
+	if size <= d.partSize {
+		if offset != 0 {
+			return fmt.Errorf("cannot resume %s: objects up to the part size are sent in one request", remotePath)
+		}
+		return d.Put(ctx, remotePath, r, size)
+	}
+
+	d.mu.Lock()
+	upload := d.uploads[remotePath]
+	d.mu.Unlock()
+	if offset == 0 || upload == nil {
+		if upload != nil {
+			// Start over; the old parts are only discarded on a best-effort basis
+			d.abortUpload(ctx, remotePath)
+		}
+		var err error
+		if upload, err = d.createUpload(ctx, remotePath); err != nil {
+			return err
+		}
+		d.mu.Lock()
+		d.uploads[remotePath] = upload
+		d.mu.Unlock()
+	}
+	if stored := int64(len(upload.etags)) * d.partSize; offset != stored {
+		return fmt.Errorf("cannot resume %s at byte %d: %d bytes are stored in parts", remotePath, offset, stored)
+	}
+
+	buf := make([]byte, d.partSize)
+	for sent := offset; sent < size; {
+		n := d.partSize
+		if size-sent < n {
+			n = size - sent
+		}
+		if _, err := io.ReadFull(r, buf[:n]); err != nil {
+			return err
+		}
+		etag, err := d.uploadPart(ctx, remotePath, upload.uploadID, len(upload.etags)+1, buf[:n])
+		if err != nil {
+			return fmt.Errorf("failed to upload part %d: %w", len(upload.etags)+1, err)
+		}
+		upload.etags = append(upload.etags, etag)
+		sent += n
+	}
+
+	if err := d.completeUpload(ctx, remotePath, upload); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	delete(d.uploads, remotePath)
+	d.mu.Unlock()
+	return nil
+}
+
+// head returns the size of an object and whether it exists
+func (d *S3Deliverer) head(ctx context.Context, remotePath string) (int64, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, d.objectURL(remotePath), nil)
+	if err != nil {
+		return 0, false, err
+	}
+	d.sign(req, emptyPayloadHash)
+	resp, body, err := send(d.client, req)
+	if err != nil {
+		return 0, false, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return 0, false, nil
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return 0, false, responseError(req, resp, body)
+	}
+	return resp.ContentLength, true, nil
+}
+
+// createUpload starts a multipart upload
+func (d *S3Deliverer) createUpload(ctx context.Context, remotePath string) (*s3Upload, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.objectURL(remotePath)+"?uploads", http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType(remotePath))
+	d.sign(req, emptyPayloadHash)
+	_, body, err := doRequestBody(d.client, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start multipart upload: %w", err)
+	}
+
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+	if err := xml.Unmarshal(body, &result); err != nil || result.UploadID == "" {
+		return nil, fmt.Errorf("failed to start multipart upload: no upload ID in response")
+	}
+	return &s3Upload{uploadID: result.UploadID}, nil
+}
+
+// uploadPart stores a part and returns its ETag
+func (d *S3Deliverer) uploadPart(ctx context.Context, remotePath, uploadID string, number int, data []byte) (string, error) {
+	query := url.Values{"partNumber": {strconv.Itoa(number)}, "uploadId": {uploadID}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, d.objectURL(remotePath)+"?"+query.Encode(), bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = int64(len(data))
+	d.sign(req, unsignedPayload)
+	header, _, err := doRequestBody(d.client, req)
+	if err != nil {
+		return "", err
+	}
+	etag := header.Get("ETag")
+	if etag == "" {
+		return "", errors.New("no ETag in response")
+	}
+	return etag, nil
+}
+
+// completeUpload assembles the uploaded parts into the object
+func (d *S3Deliverer) completeUpload(ctx context.Context, remotePath string, upload *s3Upload) error {
+	type part struct {
+		PartNumber int
+		ETag       string
+	}
+	complete := struct {
+		XMLName xml.Name `xml:"CompleteMultipartUpload"`
+		Part    []part
+	}{}
+	for i, etag := range upload.etags {
+		complete.Part = append(complete.Part, part{PartNumber: i + 1, ETag: etag})
+	}
+	payload, err := xml.Marshal(complete)
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{"uploadId": {upload.uploadID}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.objectURL(remotePath)+"?"+query.Encode(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(payload))
+	req.Header.Set("Content-Type", "application/xml")
+	d.sign(req, hexSHA256(payload))
+	_, body, err := doRequestBody(d.client, req)
+	if err != nil {
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+	// S3 can report a failure after answering 200
+	if bytes.Contains(body, []byte("<Error>")) {
+		return fmt.Errorf("failed to complete multipart upload: %s", bytes.TrimSpace(body))
+	}
+	return nil
+}
+
+// abortUpload aborts the multipart upload in progress for remotePath and forgets it
+func (d *S3Deliverer) abortUpload(ctx context.Context, remotePath string) error {
+	d.mu.Lock()
+	upload := d.uploads[remotePath]
+	delete(d.uploads, remotePath)
+	d.mu.Unlock()
+	if upload == nil {
+		return nil
+	}
+
+	query := url.Values{"uploadId": {upload.uploadID}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, d.objectURL(remotePath)+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	d.sign(req, emptyPayloadHash)
+	return doRequest(d.client, req)
+}
+
+// findUpload looks up the latest multipart upload in progress for remotePath and the
+// parts it has, keeping the consecutive full-size parts from the first on
+func (d *S3Deliverer) findUpload(ctx context.Context, remotePath string) (*s3Upload, error) {
+	key := d.objectKey(remotePath)
+	query := url.Values{"uploads": {""}, "prefix": {key}}
+	var uploads struct {
+		Upload []struct {
+			Key       string
+			UploadID  string `xml:"UploadId"`
+			Initiated string
+		}
+	}
+	if err := d.list(ctx, d.bucketURL()+"/?"+query.Encode(), &uploads); err != nil {
+		return nil, fmt.Errorf("failed to list multipart uploads: %w", err)
+	}
+	var upload *s3Upload
+	var initiated string
+	for _, u := range uploads.Upload {
+		if u.Key == key && u.Initiated >= initiated {
+			upload, initiated = &s3Upload{uploadID: u.UploadID}, u.Initiated
+		}
+	}
+	if upload == nil {
+		return nil, nil
+	}
+
+	type part struct {
+		PartNumber int
+		ETag       string
+		Size       int64
+	}
+	var parts []part
+	marker := ""
+	for {
+		query := url.Values{"uploadId": {upload.uploadID}}
+		if marker != "" {
+			query.Set("part-number-marker", marker)
+		}
+		var page struct {
+			Part                 []part
+			IsTruncated          bool
+			NextPartNumberMarker string
+		}
+		if err := d.list(ctx, d.objectURL(remotePath)+"?"+query.Encode(), &page); err != nil {
+			return nil, fmt.Errorf("failed to list parts: %w", err)
+		}
+		parts = append(parts, page.Part...)
+		if !page.IsTruncated || page.NextPartNumberMarker == "" {
+			break
+		}
+		marker = page.NextPartNumberMarker
+	}
+
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	for i, p := range parts {
+		if p.PartNumber != i+1 || p.Size != d.partSize {
+			break
+		}
+		upload.etags = append(upload.etags, p.ETag)
+	}
+	return upload, nil
+}
+
+// list sends a signed GET and decodes the XML response into v
+func (d *S3Deliverer) list(ctx context.Context, rawURL string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	d.sign(req, emptyPayloadHash)
+	_, body, err := doRequestBody(d.client, req)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(body, v)
+}
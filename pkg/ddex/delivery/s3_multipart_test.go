@@ -0,0 +1,201 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeS3 is an in-memory S3 bucket supporting single PUTs and multipart uploads
+type fakeS3 struct {
+	mu          sync.Mutex
+	objects     map[string][]byte
+	uploads     map[string]map[int][]byte // Parts by upload ID
+	uploadKeys  map[string]string
+	nextUpload  int
+	partPuts    int
+	failPartPut int // Fails the nth part PUT, counting from 1
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}, uploads: map[string]map[int][]byte{}, uploadKeys: map[string]string{}}
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+		http.Error(w, "unsigned request", http.StatusForbidden)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/bucket/")
+	query := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+	uploadID := query.Get("uploadId")
+
+	switch {
+	case r.Method == http.MethodHead:
+		object, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object)))
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.nextUpload++
+		id := fmt.Sprintf("upload-%d", s.nextUpload)
+		s.uploads[id] = map[int][]byte{}
+		s.uploadKeys[id] = key
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", id)
+	case r.Method == http.MethodPut && uploadID != "":
+		s.partPuts++
+		if s.partPuts == s.failPartPut {
+			http.Error(w, "connection reset", http.StatusInternalServerError)
+			return
+		}
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		s.uploads[uploadID][number] = body
+		w.Header().Set("ETag", fmt.Sprintf(`"%s-%d"`, uploadID, number))
+	case r.Method == http.MethodPost && uploadID != "":
+		var complete struct {
+			Part []struct {
+				PartNumber int
+				ETag       string
+			}
+		}
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var object []byte
+		for i, part := range complete.Part {
+			if part.PartNumber != i+1 || part.ETag != fmt.Sprintf(`"%s-%d"`, uploadID, i+1) {
+				http.Error(w, "invalid part list", http.StatusBadRequest)
+				return
+			}
+			object = append(object, s.uploads[uploadID][part.PartNumber]...)
+		}
+		s.objects[key] = object
+		delete(s.uploads, uploadID)
+		fmt.Fprint(w, "<CompleteMultipartUploadResult></CompleteMultipartUploadResult>")
+	case r.Method == http.MethodGet && query.Has("uploads"):
+		fmt.Fprint(w, "<ListMultipartUploadsResult>")
+		for id := range s.uploads {
+			fmt.Fprintf(w, "<Upload><Key>%s</Key><UploadId>%s</UploadId><Initiated>2024-01-01T00:00:00Z</Initiated></Upload>", s.uploadKeys[id], id)
+		}
+		fmt.Fprint(w, "</ListMultipartUploadsResult>")
+	case r.Method == http.MethodGet && uploadID != "":
+		var numbers []int
+		for number := range s.uploads[uploadID] {
+			numbers = append(numbers, number)
+		}
+		sort.Ints(numbers)
+		fmt.Fprint(w, "<ListPartsResult>")
+		for _, number := range numbers {
+			fmt.Fprintf(w, `<Part><PartNumber>%d</PartNumber><ETag>"%s-%d"</ETag><Size>%d</Size></Part>`,
+				number, uploadID, number, len(s.uploads[uploadID][number]))
+		}
+		fmt.Fprint(w, "</ListPartsResult>")
+	case r.Method == http.MethodPut:
+		s.objects[key] = body
+	case r.Method == http.MethodDelete && uploadID != "":
+		delete(s.uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+func TestS3ResumeMultipartUpload(t *testing.T) {
+	tests := []struct {
+		name       string
+		newProcess bool // Resume with a new deliverer, which has to list the uploads
+	}{
+		{"same deliverer", false},
+		{"new deliverer", true},
+	}
+	for _, tt := range tests {
+		bucket := newFakeS3()
+		bucket.failPartPut = 3
+		server := httptest.NewServer(bucket)
+		newDeliverer := func() *S3Deliverer {
+			d := NewS3Deliverer("bucket", "us-east-1", "AKID", "SECRET").WithEndpoint(server.URL)
+			d.partSize = 10 // Below S3's minimum, which the fake doesn't enforce
+			return d
+		}
+		ctx := context.Background()
+		data := []byte("0123456789abcdefghijABCDEFGHIJxyz")
+
+		d := newDeliverer()
+		if err := d.Put(ctx, "batch/video.mp4", bytes.NewReader(data), int64(len(data))); err == nil {
+			t.Fatalf("%s: Put succeeded, want the third part to fail", tt.name)
+		}
+		if tt.newProcess {
+			d = newDeliverer()
+		}
+
+		offset, err := d.Stored(ctx, "batch/video.mp4", int64(len(data)))
+		if err != nil {
+			t.Fatalf("%s: Stored: %v", tt.name, err)
+		}
+		if offset != 20 {
+			t.Fatalf("%s: Stored = %d, want 20", tt.name, offset)
+		}
+		if err := d.PutFrom(ctx, "batch/video.mp4", bytes.NewReader(data[offset:]), offset, int64(len(data))); err != nil {
+			t.Fatalf("%s: PutFrom: %v", tt.name, err)
+		}
+
+		if got := bucket.objects["batch/video.mp4"]; !bytes.Equal(got, data) {
+			t.Errorf("%s: stored %q, want %q", tt.name, got, data)
+		}
+		if bucket.partPuts != 5 {
+			t.Errorf("%s: %d part uploads, want 5 (2 parts, the failed one, then 2 more)", tt.name, bucket.partPuts)
+		}
+		if offset, err := d.Stored(ctx, "batch/video.mp4", int64(len(data))); err != nil || offset != int64(len(data)) {
+			t.Errorf("%s: Stored after completion = %d, %v", tt.name, offset, err)
+		}
+		server.Close()
+	}
+}
+
+func TestS3StoredCompleteUpload(t *testing.T) {
+	// All parts stored but the upload never completed: the last part is sent again
+	bucket := newFakeS3()
+	server := httptest.NewServer(bucket)
+	defer server.Close()
+	ctx := context.Background()
+	data := []byte("0123456789abcdefghij")
+
+	d := NewS3Deliverer("bucket", "us-east-1", "AKID", "SECRET").WithEndpoint(server.URL)
+	d.partSize = 10
+	upload, err := d.createUpload(ctx, "video.mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := d.uploadPart(ctx, "video.mp4", upload.uploadID, i+1, data[i*10:(i+1)*10]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	offset, err := d.Stored(ctx, "video.mp4", int64(len(data)))
+	if err != nil || offset != 10 {
+		t.Fatalf("Stored = %d, %v, want 10", offset, err)
+	}
+	if err := d.PutFrom(ctx, "video.mp4", bytes.NewReader(data[offset:]), offset, int64(len(data))); err != nil {
+		t.Fatal(err)
+	}
+	if got := bucket.objects["video.mp4"]; !bytes.Equal(got, data) {
+		t.Errorf("stored %q, want %q", got, data)
+	}
+}
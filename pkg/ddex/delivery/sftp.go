@@ -0,0 +1,230 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// SFTPClient is the subset of an SFTP client used by SFTPUploader. A *sftp.Client from
+// github.com/pkg/sftp satisfies it with a one-method adapter:
+//
+//	type sftpClient struct{ *sftp.Client }
+//
+//	func (c sftpClient) Create(path string) (io.WriteCloser, error) { return c.Client.Create(path) }
+type SFTPClient interface {
+	MkdirAll(path string) error
+	Create(path string) (io.WriteCloser, error)
+	Rename(oldpath, newpath string) error
+	Remove(path string) error
+}
+
+// SFTPResumeClient is an SFTPClient that can also continue interrupted uploads. With
+// github.com/pkg/sftp the adapter needs two more one-line methods:
+//
+//	func (c sftpClient) Stat(path string) (os.FileInfo, error) { return c.Client.Stat(path) }
+//	func (c sftpClient) OpenFile(path string, flag int) (io.WriteCloser, error) {
+//		return c.Client.OpenFile(path, flag)
+//	}
+type SFTPResumeClient interface {
+	SFTPClient
+	Stat(path string) (os.FileInfo, error)
+	OpenFile(path string, flag int) (io.WriteCloser, error)
+}
+
+// partSuffix is appended to files while they are uploaded so the recipient never
+// ingests a partially written file
+const partSuffix = ".part"
+
+// SFTPUploader delivers batches to an SFTP drop folder. It implements Deliverer and,
+// with an SFTPResumeClient, continues interrupted uploads through Resumer.
+type SFTPUploader struct {
+	client  SFTPClient
+	root    string
+	options Options
+	partial partialUploads
+}
+
+// NewSFTPUploader creates an uploader writing batches below root on the server.
+// Failed file uploads are retried 3 times, starting with a 1s delay that doubles each attempt.
+func NewSFTPUploader(client SFTPClient, root string) *SFTPUploader {
+	return &SFTPUploader{
+		client:  client,
+		root:    root,
+		options: DefaultOptions,
+	}
+}
+
+// WithRetry sets how many times a failed file upload is retried and the initial delay
+func (u *SFTPUploader) WithRetry(retries int, delay time.Duration) *SFTPUploader {
+	u.options.Retries = retries
+	u.options.RetryDelay = delay
+	u.options.Retry = nil
+	return u
+}
+
+// WithRetryPolicy sets how failed file uploads are retried, with jitter and a maximum delay
+func (u *SFTPUploader) WithRetryPolicy(policy RetryPolicy) *SFTPUploader {
+	u.options.Retry = &policy
+	return u
+}
+
+// WithProgress sets a callback receiving upload progress
+func (u *SFTPUploader) WithProgress(fn ProgressFunc) *SFTPUploader {
+	u.options.Progress = fn
+	return u
+}
+
+// WithBandwidthLimit caps the upload rate of a delivery at bytesPerSecond
+func (u *SFTPUploader) WithBandwidthLimit(bytesPerSecond int64) *SFTPUploader {
+	u.options.BytesPerSecond = bytesPerSecond
+	return u
+}
+
+// WithResume keeps the files of a failed batch so delivering it again with the same batch
+// ID continues where it stopped (see Options.Resume)
+func (u *SFTPUploader) WithResume() *SFTPUploader {
+	u.options.Resume = true
+	return u
+}
+
+// WithChecksums uploads a checksum manifest of each batch using algorithm
+func (u *SFTPUploader) WithChecksums(algorithm ddex.ChecksumAlgorithm) *SFTPUploader {
+	u.options.Checksums = algorithm
+	return u
+}
+
+// WithAllowLive allows delivering LiveMessages, which are rejected by default
+func (u *SFTPUploader) WithAllowLive() *SFTPUploader {
+	u.options.AllowLive = true
+	return u
+}
+
+// Deliver uploads the batch: for each release its resources, then its message, and finally
+// the BatchComplete file. Files are written under a temporary name and renamed once complete.
+func (u *SFTPUploader) Deliver(ctx context.Context, batch *Batch) error {
+	return Deliver(ctx, u, batch, u.options)
+}
+
+// Put writes a file under a temporary name and renames it into place
+func (u *SFTPUploader) Put(ctx context.Context, remotePath string, r io.Reader, size int64) error {
+	remote := path.Join(u.root, remotePath)
+	if err := u.client.MkdirAll(path.Dir(remote)); err != nil {
+		return fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	temp := remote + partSuffix
+	w, err := u.client.Create(temp)
+	if err != nil {
+		return err
+	}
+
+	_, copyErr := io.Copy(w, r)
+	closeErr := w.Close()
+	if copyErr != nil || closeErr != nil {
+		u.client.Remove(temp)
+		if copyErr != nil {
+			return copyErr
+		}
+		return closeErr
+	}
+
+	// Some servers refuse to rename over an existing file (redeliveries)
+	u.client.Remove(remote)
+	if err := u.client.Rename(temp, remote); err != nil {
+		return fmt.Errorf("failed to rename %s: %w", temp, err)
+	}
+	return nil
+}
+
+// Stored returns size if the file is in place with that size, else the size of its
+// temporary file. It is always 0 if the client isn't an SFTPResumeClient.
+func (u *SFTPUploader) Stored(ctx context.Context, remotePath string, size int64) (int64, error) {
+	client, ok := u.client.(SFTPResumeClient)
+	if !ok {
+		return 0, nil
+	}
+	remote := path.Join(u.root, remotePath)
+	if info, err := client.Stat(remote); err == nil && info.Size() == size {
+		return size, nil
+	}
+	info, err := client.Stat(remote + partSuffix)
+	if err != nil || info.Size() > size {
+		// Servers differ in the errors they return for missing files
+		return 0, nil
+	}
+	return info.Size(), nil
+}
+
+// PutFrom appends to the temporary file of an interrupted upload and renames it into
+// place once complete. The temporary file is kept when the copy fails. Without an
+// SFTPResumeClient it falls back to Put, which only accepts an offset of 0.
+func (u *SFTPUploader) PutFrom(ctx context.Context, remotePath string, r io.Reader, offset, size int64) error {
+	client, ok := u.client.(SFTPResumeClient)
+	if !ok {
+		if offset != 0 {
+			return fmt.Errorf("cannot resume %s: the SFTP client doesn't implement SFTPResumeClient", remotePath)
+		}
+		return u.Put(ctx, remotePath, r, size)
+	}
+
+	remote := path.Join(u.root, remotePath)
+	if err := client.MkdirAll(path.Dir(remote)); err != nil {
+		return fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	temp := remote + partSuffix
+	flag := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	if offset == 0 {
+		flag |= os.O_TRUNC
+	}
+	w, err := client.OpenFile(temp, flag)
+	if err != nil {
+		return err
+	}
+	u.partial.add(remotePath)
+
+	_, copyErr := io.Copy(w, r)
+	closeErr := w.Close()
+	if copyErr != nil {
+		return copyErr
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	u.client.Remove(remote)
+	if err := u.client.Rename(temp, remote); err != nil {
+		return fmt.Errorf("failed to rename %s: %w", temp, err)
+	}
+	u.partial.remove(remotePath)
+	return nil
+}
+
+// Complete writes the BatchComplete file
+func (u *SFTPUploader) Complete(ctx context.Context, batchID string) error {
+	return PutBatchComplete(ctx, u, batchID)
+}
+
+// Abort removes the files stored for the batch in reverse order, so each message goes
+// before the resources it references, after the temporary file of an interrupted upload
+func (u *SFTPUploader) Abort(ctx context.Context, batchID string, stored []string) error {
+	var errs []error
+	for _, remotePath := range u.partial.take(batchID) {
+		if err := u.client.Remove(path.Join(u.root, remotePath) + partSuffix); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for i := len(stored) - 1; i >= 0; i-- {
+		if err := u.client.Remove(path.Join(u.root, stored[i])); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
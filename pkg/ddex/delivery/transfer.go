@@ -0,0 +1,131 @@
+package delivery
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Resumer is implemented by Deliverers that can continue an interrupted upload instead of
+// starting over, which matters for multi-gigabyte video files on unreliable links.
+// Deliver uses it for resource files whenever a retry follows a failed upload, and with
+// Options.Resume also to skip or continue the files of an earlier, failed delivery of the
+// same batch.
+type Resumer interface {
+	// Stored returns how many bytes of the size-byte file at remotePath are stored: size
+	// once the file is complete, the length of a partial upload that PutFrom can
+	// continue, or 0
+	Stored(ctx context.Context, remotePath string, size int64) (int64, error)
+
+	// PutFrom stores the file at remotePath like Put, with r reading it from offset on.
+	// An offset of 0 discards any partial upload and starts a new one.
+	PutFrom(ctx context.Context, remotePath string, r io.Reader, offset, size int64) error
+}
+
+// rateLimiter spreads reads over time so the delivery doesn't exceed a bandwidth limit.
+// It is shared by all the files of a delivery.
+type rateLimiter struct {
+	bytesPerSecond int64
+
+	mu   sync.Mutex
+	next time.Time // When the bytes granted so far have been sent at the limit
+}
+
+// newRateLimiter returns a limiter for bytesPerSecond, or nil if it is not positive
+func newRateLimiter(bytesPerSecond int64) *rateLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{bytesPerSecond: bytesPerSecond}
+}
+
+// chunk returns how many bytes a single read may take, about a tenth of a second's worth
+func (l *rateLimiter) chunk() int {
+	chunk := l.bytesPerSecond / 10
+	if chunk < 1024 {
+		chunk = 1024
+	}
+	return int(chunk)
+}
+
+// wait blocks until n more bytes can be sent without exceeding the limit
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		// Unused bandwidth isn't saved up for a burst
+		l.next = now
+	}
+	l.next = l.next.Add(time.Duration(n) * time.Second / time.Duration(l.bytesPerSecond))
+	delay := l.next.Sub(now)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// limitedReader reads through a rateLimiter
+type limitedReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *rateLimiter
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if chunk := r.limiter.chunk(); len(p) > chunk {
+		p = p[:chunk]
+	}
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		if waitErr := r.limiter.wait(r.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
+
+// partialUploads tracks the uploads a Deliverer has in progress, so Abort can discard
+// the one a failed batch was interrupted in
+type partialUploads struct {
+	mu    sync.Mutex
+	paths map[string]bool
+}
+
+func (p *partialUploads) add(remotePath string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.paths == nil {
+		p.paths = make(map[string]bool)
+	}
+	p.paths[remotePath] = true
+}
+
+func (p *partialUploads) remove(remotePath string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.paths, remotePath)
+}
+
+// take returns and forgets the partial uploads below the batch folder
+func (p *partialUploads) take(batchID string) []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var paths []string
+	for remotePath := range p.paths {
+		if strings.HasPrefix(remotePath, batchID+"/") {
+			paths = append(paths, remotePath)
+			delete(p.paths, remotePath)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,325 @@
+package ddex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangeKind classifies a Difference
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "Added"
+	ChangeRemoved  ChangeKind = "Removed"
+	ChangeModified ChangeKind = "Changed"
+)
+
+// Difference is a semantic change between two messages. Path names the element using
+// release and resource identifiers rather than message references, e.g.
+// Release[123456789012].Deal[SubscriptionModel/OnDemandStream].TerritoryCode.
+type Difference struct {
+	Kind ChangeKind
+	Path string
+	Old  string
+	New  string
+}
+
+// String formats the difference as "+ path: new", "- path: old" or "~ path: old -> new"
+func (d Difference) String() string {
+	switch d.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("+ %s: %s", d.Path, d.New)
+	case ChangeRemoved:
+		return fmt.Sprintf("- %s: %s", d.Path, d.Old)
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", d.Path, d.Old, d.New)
+	}
+}
+
+// Diff compares two messages for catalog QA. Releases are matched by ICPN, GRid, ISRC or
+// proprietary ID (falling back to the release reference), resources by ISRC, and deals by
+// commercial model and use type, so renumbered references don't show up as changes.
+// It reports releases, resources and deals added or removed, and changes to titles,
+// release types, release dates, territories and deal validity periods.
+func Diff(old, new *NewReleaseMessage) []Difference {
+	var diffs []Difference
+	add := func(kind ChangeKind, path, oldValue, newValue string) {
+		diffs = append(diffs, Difference{Kind: kind, Path: path, Old: oldValue, New: newValue})
+	}
+
+	oldReleases, oldDeals := diffReleases(old)
+	newReleases, newDeals := diffReleases(new)
+	for _, key := range unionKeys(oldReleases, newReleases) {
+		o, inOld := oldReleases[key]
+		n, inNew := newReleases[key]
+		path := "Release[" + key + "]"
+		switch {
+		case !inNew:
+			add(ChangeRemoved, path, o.title, "")
+			continue
+		case !inOld:
+			add(ChangeAdded, path, "", n.title)
+			continue
+		}
+
+		compareValue(add, path+".Title", o.title, n.title)
+		compareValue(add, path+".ReleaseType", o.releaseType, n.releaseType)
+		compareValue(add, path+".ReleaseDate", o.releaseDate, n.releaseDate)
+		compareValue(add, path+".TerritoryCode", o.territories, n.territories)
+
+		od, nd := oldDeals[o.reference], newDeals[n.reference]
+		for _, dealKey := range unionKeys(od, nd) {
+			odeal, inOld := od[dealKey]
+			ndeal, inNew := nd[dealKey]
+			dealPath := path + ".Deal[" + dealKey + "]"
+			switch {
+			case !inNew:
+				add(ChangeRemoved, dealPath, odeal.territories, "")
+			case !inOld:
+				add(ChangeAdded, dealPath, "", ndeal.territories)
+			default:
+				compareValue(add, dealPath+".TerritoryCode", odeal.territories, ndeal.territories)
+				compareValue(add, dealPath+".ValidityPeriod", odeal.validity, ndeal.validity)
+				compareValue(add, dealPath+".RightsClaimPolicy", odeal.policies, ndeal.policies)
+				compareValue(add, dealPath+".TakeDown", odeal.takeDown, ndeal.takeDown)
+			}
+		}
+	}
+
+	oldResources, newResources := diffResources(old), diffResources(new)
+	for _, key := range unionKeys(oldResources, newResources) {
+		o, inOld := oldResources[key]
+		n, inNew := newResources[key]
+		path := "Resource[" + key + "]"
+		switch {
+		case !inNew:
+			add(ChangeRemoved, path, o.title, "")
+		case !inOld:
+			add(ChangeAdded, path, "", n.title)
+		default:
+			compareValue(add, path+".Title", o.title, n.title)
+			compareValue(add, path+".Duration", o.duration, n.duration)
+		}
+	}
+
+	return diffs
+}
+
+func compareValue(add func(ChangeKind, string, string, string), path, oldValue, newValue string) {
+	if oldValue != newValue {
+		add(ChangeModified, path, oldValue, newValue)
+	}
+}
+
+// diffRelease holds the compared fields of a release
+type diffRelease struct {
+	reference   string
+	title       string
+	releaseType string
+	releaseDate string
+	territories string
+}
+
+// diffDeal holds the compared fields of a deal
+type diffDeal struct {
+	territories string
+	validity    string
+	policies    string
+	takeDown    string
+}
+
+// diffReleases indexes the releases of a message by identifier, and their deals by release
+// reference and deal key
+func diffReleases(nrm *NewReleaseMessage) (map[string]diffRelease, map[string]map[string]diffDeal) {
+	releases := make(map[string]diffRelease)
+	if nrm.ReleaseList != nil {
+		for _, release := range nrm.ReleaseList.Release {
+			r := diffRelease{reference: release.ReleaseReference}
+			if release.ReferenceTitle != nil {
+				r.title = release.ReferenceTitle.TitleText
+			}
+			var types []string
+			for _, releaseType := range release.ReleaseType {
+				types = append(types, releaseType.Value)
+			}
+			r.releaseType = strings.Join(types, ", ")
+			if release.GlobalReleaseDate != nil {
+				r.releaseDate = release.GlobalReleaseDate.Value
+			}
+
+			var territories []string
+			for _, details := range release.ReleaseDetailsByTerritory {
+				territories = append(territories, details.TerritoryCode...)
+				for _, code := range details.ExcludedTerritoryCode {
+					territories = append(territories, "-"+code)
+				}
+				if r.releaseDate == "" && details.ReleaseDate != nil {
+					r.releaseDate = details.ReleaseDate.Value
+				}
+			}
+			r.territories = sortedList(territories)
+
+			releases[releaseKey(release)] = r
+		}
+	}
+
+	deals := make(map[string]map[string]diffDeal)
+	if nrm.DealList != nil {
+		for _, releaseDeal := range nrm.DealList.ReleaseDeal {
+			byKey := deals[releaseDeal.DealReleaseReference]
+			if byKey == nil {
+				byKey = make(map[string]diffDeal)
+				deals[releaseDeal.DealReleaseReference] = byKey
+			}
+			for _, deal := range releaseDeal.Deal {
+				if deal.DealTerms == nil {
+					continue
+				}
+				key, d := diffDealTerms(deal.DealTerms)
+				// Deals sharing models and use types are told apart by position
+				base := key
+				for i := 2; hasKey(byKey, key); i++ {
+					key = fmt.Sprintf("%s#%d", base, i)
+				}
+				byKey[key] = d
+			}
+		}
+	}
+
+	return releases, deals
+}
+
+// diffDealTerms returns the matching key and compared fields of a deal
+func diffDealTerms(terms *DealTerms) (string, diffDeal) {
+	var useTypes []string
+	for _, usage := range terms.Usage {
+		useTypes = append(useTypes, usage.UseType...)
+	}
+	key := sortedList(terms.CommercialModelType) + "/" + sortedList(useTypes)
+	if terms.TakeDown != nil && *terms.TakeDown {
+		key = "TakeDown"
+	}
+
+	var territories []string
+	territories = append(territories, terms.TerritoryCode...)
+	for _, code := range terms.ExcludedTerritoryCode {
+		territories = append(territories, "-"+code)
+	}
+
+	var periods []string
+	for _, period := range terms.ValidityPeriod {
+		start := period.StartDate
+		if start == "" {
+			start = period.StartDateTime
+		}
+		end := period.EndDate
+		if end == "" {
+			end = period.EndDateTime
+		}
+		periods = append(periods, start+".."+end)
+	}
+
+	var policies []string
+	for _, policy := range terms.RightsClaimPolicy {
+		policies = append(policies, policy.RightsClaimPolicyType)
+	}
+
+	d := diffDeal{
+		territories: sortedList(territories),
+		validity:    strings.Join(periods, ", "),
+		policies:    sortedList(policies),
+	}
+	if terms.TakeDown != nil {
+		d.takeDown = fmt.Sprint(*terms.TakeDown)
+	}
+	return key, d
+}
+
+// releaseKey returns the first identifier of a release, or its reference
+func releaseKey(release Release) string {
+	for _, id := range release.ReleaseId {
+		switch {
+		case id.ICPN != "":
+			return id.ICPN
+		case id.GRid != "":
+			return id.GRid
+		case id.ISRC != "":
+			return id.ISRC
+		case len(id.ProprietaryId) > 0:
+			return id.ProprietaryId[0].Value
+		}
+	}
+	return release.ReleaseReference
+}
+
+// diffResource holds the compared fields of a resource
+type diffResource struct {
+	title    string
+	duration string
+}
+
+// diffResources indexes sound recordings and videos by ISRC, falling back to the reference
+func diffResources(nrm *NewReleaseMessage) map[string]diffResource {
+	resources := make(map[string]diffResource)
+	if nrm.ResourceList == nil {
+		return resources
+	}
+
+	for _, recording := range nrm.ResourceList.SoundRecording {
+		key := recording.ResourceReference
+		for _, id := range recording.SoundRecordingId {
+			if id.ISRC != "" {
+				key = id.ISRC
+				break
+			}
+		}
+		r := diffResource{duration: recording.Duration}
+		if recording.ReferenceTitle != nil {
+			r.title = recording.ReferenceTitle.TitleText
+		}
+		resources[key] = r
+	}
+
+	for _, video := range nrm.ResourceList.Video {
+		key := video.ResourceReference
+		if video.VideoId != nil && video.VideoId.ISRC != "" {
+			key = video.VideoId.ISRC
+		}
+		r := diffResource{duration: video.Duration}
+		if video.ReferenceTitle != nil {
+			r.title = video.ReferenceTitle.TitleText
+		}
+		resources[key] = r
+	}
+
+	return resources
+}
+
+// sortedList joins values in sorted order so reordering isn't reported as a change
+func sortedList(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ", ")
+}
+
+func hasKey[V any](m map[string]V, key string) bool {
+	_, ok := m[key]
+	return ok
+}
+
+// unionKeys returns the keys of both maps in sorted order
+func unionKeys[V any](a, b map[string]V) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var keys []string
+	for _, m := range []map[string]V{a, b} {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
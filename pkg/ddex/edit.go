@@ -0,0 +1,154 @@
+package ddex
+
+import "fmt"
+
+// NewBuilderFromXML parses a previously delivered message into a Builder, so it can be
+// changed with the same fluent methods it was built with and written again: reopen its
+// items with EditRelease, EditReleaseDeal and the other Edit methods, or add new ones.
+// Gzip-compressed data is decompressed first.
+//
+//	b, err := ddex.NewBuilderFromXML(data)
+//	rdb, err := b.EditReleaseDeal("R1")
+//	deal, err := rdb.EditDeal(0)
+//	deal.WithTerritories([]string{"CA"}).WithValidityPeriodEndDate("2027-12-31")
+//	updated, err := b.WithUpdateIndicator("UpdateMessage").ToXML()
+func NewBuilderFromXML(data []byte) (*Builder, error) {
+	message, err := FromXML(data)
+	if err != nil {
+		return nil, err
+	}
+	return NewBuilderFromMessage(message), nil
+}
+
+// NewBuilderFromMessage returns a Builder that changes message in place
+func NewBuilderFromMessage(message *NewReleaseMessage) *Builder {
+	if message.ResourceList == nil {
+		message.ResourceList = &ResourceList{}
+	}
+	if message.ReleaseList == nil {
+		message.ReleaseList = &ReleaseList{}
+	}
+	if message.DealList == nil {
+		message.DealList = &DealList{}
+	}
+	return &Builder{Message: message}
+}
+
+// EditVideo returns a VideoBuilder for the video with the resource reference
+func (b *Builder) EditVideo(resourceRef string) (*VideoBuilder, error) {
+	for i := range b.Message.ResourceList.Video {
+		if b.Message.ResourceList.Video[i].ResourceReference == resourceRef {
+			return &VideoBuilder{builder: b, index: i}, nil
+		}
+	}
+	return nil, &ErrDanglingReference{Ref: resourceRef}
+}
+
+// EditSoundRecording returns a SoundRecordingBuilder for the sound recording with the
+// resource reference
+func (b *Builder) EditSoundRecording(resourceRef string) (*SoundRecordingBuilder, error) {
+	for i := range b.Message.ResourceList.SoundRecording {
+		if b.Message.ResourceList.SoundRecording[i].ResourceReference == resourceRef {
+			return &SoundRecordingBuilder{builder: b, index: i}, nil
+		}
+	}
+	return nil, &ErrDanglingReference{Ref: resourceRef}
+}
+
+// EditImage returns an ImageBuilder for the image with the resource reference
+func (b *Builder) EditImage(resourceRef string) (*ImageBuilder, error) {
+	for i := range b.Message.ResourceList.Image {
+		if b.Message.ResourceList.Image[i].ResourceReference == resourceRef {
+			return &ImageBuilder{builder: b, index: i}, nil
+		}
+	}
+	return nil, &ErrDanglingReference{Ref: resourceRef}
+}
+
+// EditRelease returns a ReleaseBuilder for the release with the reference
+func (b *Builder) EditRelease(releaseRef string) (*ReleaseBuilder, error) {
+	for i := range b.Message.ReleaseList.Release {
+		if b.Message.ReleaseList.Release[i].ReleaseReference == releaseRef {
+			return &ReleaseBuilder{builder: b, index: i}, nil
+		}
+	}
+	return nil, &ErrDanglingReference{Ref: releaseRef}
+}
+
+// EditReleaseDeal returns a ReleaseDealBuilder for the first ReleaseDeal of the release
+// with the reference
+func (b *Builder) EditReleaseDeal(releaseRef string) (*ReleaseDealBuilder, error) {
+	for i := range b.Message.DealList.ReleaseDeal {
+		if b.Message.DealList.ReleaseDeal[i].DealReleaseReference == releaseRef {
+			return &ReleaseDealBuilder{builder: b, index: i}, nil
+		}
+	}
+	return nil, &ErrDanglingReference{Ref: releaseRef}
+}
+
+// EditDeal returns a DealBuilder for the deal at index i of the release deal, counted from 0
+// in message order
+func (rdb *ReleaseDealBuilder) EditDeal(i int) (*DealBuilder, error) {
+	releaseDeal := rdb.releaseDeal()
+	if i < 0 || i >= len(releaseDeal.Deal) {
+		return nil, fmt.Errorf("release %s has no deal %d", releaseDeal.DealReleaseReference, i)
+	}
+	return &DealBuilder{builder: rdb.builder, releaseDealBuilder: rdb, index: i}, nil
+}
+
+// EditReleaseDetailsByTerritory returns a builder for the first ReleaseDetailsByTerritory
+// entry listing the territory code
+func (rb *ReleaseBuilder) EditReleaseDetailsByTerritory(territoryCode string) (*ReleaseDetailsByTerritoryBuilder, error) {
+	release := rb.release()
+	for i, details := range release.ReleaseDetailsByTerritory {
+		if containsString(details.TerritoryCode, territoryCode) {
+			return &ReleaseDetailsByTerritoryBuilder{releaseBuilder: rb, index: i}, nil
+		}
+	}
+	return nil, fmt.Errorf("release %s has no ReleaseDetailsByTerritory for %s", release.ReleaseReference, territoryCode)
+}
+
+// EditVideoDetailsByTerritory returns a builder for the first VideoDetailsByTerritory entry
+// listing the territory code
+func (vb *VideoBuilder) EditVideoDetailsByTerritory(territoryCode string) (*VideoDetailsByTerritoryBuilder, error) {
+	video := vb.video()
+	for i, details := range video.VideoDetailsByTerritory {
+		if containsString(details.TerritoryCode, territoryCode) {
+			return &VideoDetailsByTerritoryBuilder{videoBuilder: vb, index: i}, nil
+		}
+	}
+	return nil, fmt.Errorf("video %s has no VideoDetailsByTerritory for %s", video.ResourceReference, territoryCode)
+}
+
+// EditSoundRecordingDetailsByTerritory returns a builder for the first
+// SoundRecordingDetailsByTerritory entry listing the territory code
+func (sb *SoundRecordingBuilder) EditSoundRecordingDetailsByTerritory(territoryCode string) (*SoundRecordingDetailsByTerritoryBuilder, error) {
+	recording := sb.soundRecording()
+	for i, details := range recording.SoundRecordingDetailsByTerritory {
+		if containsString(details.TerritoryCode, territoryCode) {
+			return &SoundRecordingDetailsByTerritoryBuilder{soundRecordingBuilder: sb, index: i}, nil
+		}
+	}
+	return nil, fmt.Errorf("sound recording %s has no SoundRecordingDetailsByTerritory for %s", recording.ResourceReference, territoryCode)
+}
+
+// EditImageDetailsByTerritory returns a builder for the first ImageDetailsByTerritory entry
+// listing the territory code
+func (ib *ImageBuilder) EditImageDetailsByTerritory(territoryCode string) (*ImageDetailsByTerritoryBuilder, error) {
+	image := ib.image()
+	for i, details := range image.ImageDetailsByTerritory {
+		if containsString(details.TerritoryCode, territoryCode) {
+			return &ImageDetailsByTerritoryBuilder{imageBuilder: ib, index: i}, nil
+		}
+	}
+	return nil, fmt.Errorf("image %s has no ImageDetailsByTerritory for %s", image.ResourceReference, territoryCode)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
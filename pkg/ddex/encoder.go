@@ -0,0 +1,225 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sync"
+	"unicode"
+)
+
+// maxPooledBufferSize caps the buffers kept in the pool so that one very large catalog
+// message doesn't pin its buffer in memory for the lifetime of the process
+const maxPooledBufferSize = 16 << 20
+
+// bufferPool holds the buffers messages are marshaled into
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// encodeXML marshals v into a pooled buffer, optionally preceded by the XML declaration,
+// and passes the encoded bytes to fn. The slice is only valid for the duration of fn.
+// Only the buffer is reused: a reused indenting encoder would start the next document with
+// a line break.
+func encodeXML(v interface{}, indent string, withHeader bool, fn func([]byte) error) error {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			bufferPool.Put(buf)
+		}
+	}()
+
+	if withHeader {
+		buf.WriteString(xml.Header)
+	}
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", indent)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	return fn(buf.Bytes())
+}
+
+// marshalXML marshals v into a pooled buffer and returns a copy of the encoded bytes
+func marshalXML(v interface{}, indent string, withHeader bool) ([]byte, error) {
+	var out []byte
+	err := encodeXML(v, indent, withHeader, func(data []byte) error {
+		out = make([]byte, len(data))
+		copy(out, data)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// deterministicIndent is the indentation used by MarshalDeterministic; it is part of the
+// output contract and must not change
+const deterministicIndent = "  "
+
+// MarshalDeterministic marshals the message into bytes that only depend on its content, for
+// golden files kept in version control: the XML declaration, two-space indentation,
+// attributes and namespace declarations in canonical order (see Canonicalize), explicit end
+// tags for empty elements, MessageCreatedDateTime in UTC and a trailing newline. Content is
+// not changed, so golden tests should fix the message and thread IDs and the creation time.
+func (nrm *NewReleaseMessage) MarshalDeterministic() ([]byte, error) {
+	message := *nrm
+	if nrm.MessageHeader != nil && nrm.MessageHeader.MessageCreatedDateTime != nil {
+		header := *nrm.MessageHeader
+		header.MessageCreatedDateTime = &DateTime{Time: nrm.MessageHeader.MessageCreatedDateTime.UTC()}
+		message.MessageHeader = &header
+	}
+
+	data, err := marshalXML(&message, deterministicIndent, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal XML: %w", err)
+	}
+	canonical, err := Canonicalize(data)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(xml.Header)+len(canonical)+1)
+	out = append(out, xml.Header...)
+	out = append(out, canonical...)
+	return append(out, '\n'), nil
+}
+
+// MarshalDeterministic marshals the message with NewReleaseMessage.MarshalDeterministic
+func (b *Builder) MarshalDeterministic() ([]byte, error) {
+	return b.Message.MarshalDeterministic()
+}
+
+// MarshalOptions controls how the root element of a message is serialized. The zero value
+// keeps the default output: the ern: prefix and xsi:schemaLocation.
+type MarshalOptions struct {
+	// Prefix replaces the ern prefix of the root element and its namespace declaration,
+	// e.g. "ernm". Ignored when DefaultNamespace is set.
+	Prefix string
+
+	// DefaultNamespace declares the ERN namespace as the default namespace
+	// (xmlns="http://ddex.net/xml/ern/382") with an unprefixed root element. Child
+	// elements then belong to the ERN namespace too, as some recipients expect.
+	DefaultNamespace bool
+
+	// OmitSchemaLocation leaves out xsi:schemaLocation and the xsi namespace declaration
+	OmitSchemaLocation bool
+}
+
+// isDefault reports whether the options leave the output unchanged
+func (o MarshalOptions) isDefault() bool {
+	return (o.Prefix == "" || o.Prefix == "ern") && !o.DefaultNamespace && !o.OmitSchemaLocation
+}
+
+// MarshalWithOptions marshals the message like ToXMLWithHeader with the root element
+// rewritten according to opts
+func (nrm *NewReleaseMessage) MarshalWithOptions(opts MarshalOptions) ([]byte, error) {
+	data, err := nrm.ToXMLWithHeader()
+	if err != nil {
+		return nil, err
+	}
+	return applyMarshalOptions(data, opts)
+}
+
+// applyMarshalOptions rewrites the root start and end tags of marshaled XML
+func applyMarshalOptions(data []byte, opts MarshalOptions) ([]byte, error) {
+	if opts.isDefault() {
+		return data, nil
+	}
+	if opts.Prefix != "" && !opts.DefaultNamespace && !isXMLName(opts.Prefix) {
+		return nil, fmt.Errorf("invalid namespace prefix %q", opts.Prefix)
+	}
+
+	// Locate the root start tag, skipping the XML declaration and comments
+	start := -1
+	for i := 0; i < len(data)-1; i++ {
+		if data[i] == '<' && data[i+1] != '?' && data[i+1] != '!' {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("no root element")
+	}
+	end := bytes.IndexByte(data[start:], '>')
+	if end < 0 {
+		return nil, fmt.Errorf("unterminated root element")
+	}
+	end += start + 1
+	selfClosing := data[end-2] == '/'
+
+	token, err := xml.NewDecoder(bytes.NewReader(data[start:end])).RawToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse root element: %w", err)
+	}
+	root, ok := token.(xml.StartElement)
+	if !ok {
+		return nil, fmt.Errorf("no root element")
+	}
+
+	newPrefix := ""
+	if !opts.DefaultNamespace {
+		newPrefix = opts.Prefix
+		if newPrefix == "" {
+			newPrefix = root.Name.Space
+		}
+	}
+	oldName := rawName(root.Name)
+	newName := rawName(xml.Name{Space: newPrefix, Local: root.Name.Local})
+
+	var tag bytes.Buffer
+	tag.WriteString("<" + newName)
+	for _, attr := range root.Attr {
+		name := rawName(attr.Name)
+		switch {
+		case attr.Name.Space == "xmlns" && attr.Name.Local == root.Name.Space:
+			// The declaration of the root element's own prefix
+			name = rawName(xml.Name{Space: "xmlns", Local: newPrefix})
+			if newPrefix == "" {
+				name = "xmlns"
+			}
+		case opts.OmitSchemaLocation && attr.Name.Space == "xsi" && attr.Name.Local == "schemaLocation",
+			opts.OmitSchemaLocation && attr.Name.Space == "xmlns" && attr.Name.Local == "xsi":
+			continue
+		}
+		tag.WriteString(" " + name + `="`)
+		xml.EscapeText(&tag, []byte(attr.Value))
+		tag.WriteByte('"')
+	}
+	if selfClosing {
+		tag.WriteString("/>")
+	} else {
+		tag.WriteByte('>')
+	}
+
+	out := make([]byte, 0, len(data)+tag.Len())
+	out = append(out, data[:start]...)
+	out = append(out, tag.Bytes()...)
+	rest := data[end:]
+	if !selfClosing {
+		closing := []byte("</" + oldName + ">")
+		i := bytes.LastIndex(rest, closing)
+		if i < 0 {
+			return nil, fmt.Errorf("no end tag for root element <%s>", oldName)
+		}
+		out = append(out, rest[:i]...)
+		out = append(out, "</"+newName+">"...)
+		rest = rest[i+len(closing):]
+	}
+	return append(out, rest...), nil
+}
+
+// isXMLName reports whether s is a valid unprefixed XML name
+func isXMLName(s string) bool {
+	for i, r := range s {
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+		case i > 0 && (r == '-' || r == '.' || unicode.IsDigit(r)):
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,64 @@
+package ddex
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// largeDealListMessage returns an album whose DealList has releaseDeals copies of its
+// first ReleaseDeal, the part of catalog messages that grows with the number of territories
+func largeDealListMessage(tb testing.TB, releaseDeals int) *NewReleaseMessage {
+	tb.Helper()
+	message, err := GenerateSample(SampleAudioAlbum, 1)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	template := message.DealList.ReleaseDeal[0]
+	message.DealList.ReleaseDeal = make([]ReleaseDeal, releaseDeals)
+	for i := range message.DealList.ReleaseDeal {
+		message.DealList.ReleaseDeal[i] = template
+	}
+	return message
+}
+
+func BenchmarkToXMLLargeDealList(b *testing.B) {
+	for _, releaseDeals := range []int{100, 1000} {
+		message := largeDealListMessage(b, releaseDeals)
+		b.Run(fmt.Sprintf("ReleaseDeals=%d", releaseDeals), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := message.ToXML(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeXMLReuse(t *testing.T) {
+	message := largeDealListMessage(t, 3)
+	first, err := message.ToXMLWithHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		again, err := message.ToXMLWithHeader()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(again, first) {
+			t.Fatalf("marshal %d differs from the first", i+2)
+		}
+		plain, err := message.ToXML()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasPrefix(plain, []byte("<ern:NewReleaseMessage")) {
+			t.Fatalf("marshal %d without header starts with %q", i+2, plain[:20])
+		}
+	}
+	if !bytes.HasPrefix(first, []byte(`<?xml version="1.0" encoding="UTF-8"?>`+"\n<ern:NewReleaseMessage")) {
+		t.Errorf("marshal with header starts with %q", first[:60])
+	}
+}
@@ -0,0 +1,52 @@
+package ddex
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors that Validate and the checks wrap, so callers can tell problems apart with
+// errors.Is instead of matching messages. A ValidationErrors matches if any of its entries
+// does.
+var (
+	// ErrMissingMessageHeader is a missing MessageHeader or mandatory header element
+	ErrMissingMessageHeader = errors.New("missing message header")
+	// ErrMissingRelease is a message without releases
+	ErrMissingRelease = errors.New("missing release")
+	// ErrMissingDeal is a message without deals, or a release without a deal
+	ErrMissingDeal = errors.New("missing deal")
+	// ErrInvalidISRC is an ISRC that isn't 12 characters of the form CCXXXYYNNNNN
+	ErrInvalidISRC = errors.New("invalid ISRC")
+	// ErrInvalidICPN is an ICPN that isn't a UPC or EAN with a valid check digit
+	ErrInvalidICPN = errors.New("invalid ICPN")
+)
+
+// ErrDanglingReference is a reference to a resource, release or party the message doesn't
+// contain. Match it with errors.As.
+type ErrDanglingReference struct {
+	Ref string
+}
+
+func (e *ErrDanglingReference) Error() string {
+	return fmt.Sprintf("dangling reference %s", e.Ref)
+}
+
+// wrappedError is an error with its own message that still matches err
+type wrappedError struct {
+	message string
+	err     error
+}
+
+func (e *wrappedError) Error() string {
+	return e.message
+}
+
+func (e *wrappedError) Unwrap() error {
+	return e.err
+}
+
+// wrapf returns an error with the formatted message that errors.Is and errors.As match
+// against err
+func wrapf(err error, format string, args ...interface{}) error {
+	return &wrappedError{message: fmt.Sprintf(format, args...), err: err}
+}
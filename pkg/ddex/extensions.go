@@ -0,0 +1,163 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// RawElement is a child element the model has no field for, such as a proprietary or
+// not-yet-modeled element of a third-party message. FromXML keeps these in the Extensions
+// field of the enclosing composite, and they are written back verbatim after the modeled
+// children when the message is marshaled.
+//
+// Name and Attr use the literal prefixed form ("yt:Policy", "xmlns:yt") where the prefix is
+// declared on the element itself or is a well-known one; otherwise Name.Space holds the
+// namespace URI and it is redeclared on output. InnerXML is the raw content, so prefixes
+// used inside it must be declared on the element or on the message root.
+type RawElement struct {
+	Name     xml.Name
+	Attr     []xml.Attr `json:",omitempty"`
+	InnerXML string     `json:",omitempty"`
+}
+
+// NewExtension returns a proprietary element to add to the Extensions of a composite.
+// name may be prefixed ("yt:AssetPolicy"); with a namespace, the element declares it for
+// that prefix, or as default namespace without one. Leave namespace empty when the prefix
+// is declared on the message root (see DeclareNamespace). innerXML is written verbatim.
+func NewExtension(namespace, name, innerXML string) RawElement {
+	element := RawElement{Name: xml.Name{Local: name}, InnerXML: innerXML}
+	if namespace != "" {
+		declaration := "xmlns"
+		if prefix, _, ok := strings.Cut(name, ":"); ok {
+			declaration += ":" + prefix
+		}
+		element.Attr = []xml.Attr{{Name: xml.Name{Local: declaration}, Value: namespace}}
+	}
+	return element
+}
+
+// ParseExtension parses a single XML element, such as a snippet from a DSP's
+// specification, into a RawElement. Prefixes must be declared within the fragment or on
+// the message root.
+func ParseExtension(fragment string) (RawElement, error) {
+	var element RawElement
+	decoder := xml.NewDecoder(strings.NewReader(fragment))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return RawElement{}, fmt.Errorf("failed to parse extension: %w", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			if err := element.UnmarshalXML(decoder, start); err != nil {
+				return RawElement{}, fmt.Errorf("failed to parse extension: %w", err)
+			}
+			return element, nil
+		}
+	}
+}
+
+// DeclareNamespace declares prefix for namespace on the message root, so extensions
+// anywhere in the message can use it without declaring it themselves. An existing
+// declaration of prefix is replaced.
+func (nrm *NewReleaseMessage) DeclareNamespace(prefix, namespace string) {
+	name := xml.Name{Local: "xmlns:" + prefix}
+	for i, attr := range nrm.OtherAttr {
+		if attr.Name == name {
+			nrm.OtherAttr[i].Value = namespace
+			return
+		}
+	}
+	nrm.OtherAttr = append(nrm.OtherAttr, xml.Attr{Name: name, Value: namespace})
+}
+
+// wellKnownPrefixes maps namespace URIs to the prefixes used for them when an element does
+// not declare its own
+var wellKnownPrefixes = map[string]string{
+	"http://www.w3.org/XML/1998/namespace": "xml",
+	XmlnsXsi:                               "xsi",
+}
+
+// UnmarshalXML captures the element's name, attributes and raw content
+func (re *RawElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var content struct {
+		InnerXML string `xml:",innerxml"`
+	}
+	if err := d.DecodeElement(&content, &start); err != nil {
+		return err
+	}
+
+	prefixes := declaredPrefixes(start.Attr)
+	re.Name = literalName(start.Name, prefixes)
+	re.Attr = literalAttrs(start.Attr, prefixes)
+	re.InnerXML = content.InnerXML
+	return nil
+}
+
+// MarshalXML writes the element back as it was parsed
+func (re RawElement) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	attrs := re.Attr
+	if re.Name.Space != "" {
+		// The encoder declares the element's namespace itself
+		attrs = nil
+		for _, attr := range re.Attr {
+			if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+				continue
+			}
+			attrs = append(attrs, attr)
+		}
+	}
+
+	return e.Encode(struct {
+		XMLName  xml.Name
+		Attr     []xml.Attr `xml:",any,attr"`
+		InnerXML string     `xml:",innerxml"`
+	}{re.Name, attrs, re.InnerXML})
+}
+
+// declaredPrefixes maps the namespace URIs declared in attrs to their prefixes
+func declaredPrefixes(attrs []xml.Attr) map[string]string {
+	prefixes := make(map[string]string)
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" {
+			prefixes[attr.Value] = attr.Name.Local
+		}
+	}
+	return prefixes
+}
+
+// literalName turns a namespace-resolved name back into its prefixed form, so the encoder
+// writes it as is. Names in an unknown namespace are returned unchanged.
+func literalName(name xml.Name, prefixes map[string]string) xml.Name {
+	if name.Space == "" {
+		return name
+	}
+	if name.Space == XmlnsErn {
+		// ERN elements are written unqualified under the ern:-prefixed root
+		return xml.Name{Local: name.Local}
+	}
+	prefix, ok := prefixes[name.Space]
+	if !ok {
+		prefix, ok = wellKnownPrefixes[name.Space]
+	}
+	if !ok {
+		return name
+	}
+	return xml.Name{Local: prefix + ":" + name.Local}
+}
+
+// literalAttrs returns attrs with namespace declarations and prefixed attribute names in
+// their literal form
+func literalAttrs(attrs []xml.Attr, prefixes map[string]string) []xml.Attr {
+	var literal []xml.Attr
+	for _, attr := range attrs {
+		switch {
+		case attr.Name.Space == "xmlns":
+			attr.Name = xml.Name{Local: "xmlns:" + attr.Name.Local}
+		case attr.Name.Space != "" && !strings.Contains(attr.Name.Local, ":"):
+			attr.Name = literalName(attr.Name, prefixes)
+		}
+		literal = append(literal, attr)
+	}
+	return literal
+}
@@ -0,0 +1,95 @@
+package ddex
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+)
+
+// isGzip reports whether data starts with the gzip magic number
+func isGzip(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+// ErrDecompressedTooLarge is gzip-compressed input that decompresses to more than the
+// limit given to FromXMLLimit
+type ErrDecompressedTooLarge struct {
+	Limit int64
+}
+
+func (e *ErrDecompressedTooLarge) Error() string {
+	return fmt.Sprintf("gzip data decompresses to more than %d bytes", e.Limit)
+}
+
+// gunzipIfNeeded returns data decompressed if it is gzip-compressed, or as is otherwise
+func gunzipIfNeeded(data []byte) ([]byte, error) {
+	if !isGzip(data) {
+		return data, nil
+	}
+	reader, err := newGunzipReader(bytes.NewReader(data), 0)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// gunzipReader decompresses a gzip stream as it is read, failing with an
+// *ErrDecompressedTooLarge once it decompresses to more than limit bytes. A limit of 0 or
+// less means no limit.
+type gunzipReader struct {
+	gz    *gzip.Reader
+	limit int64
+	read  int64
+}
+
+func newGunzipReader(r io.Reader, limit int64) (*gunzipReader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress gzip data: %w", err)
+	}
+	return &gunzipReader{gz: gz, limit: limit}, nil
+}
+
+func (g *gunzipReader) Read(p []byte) (int, error) {
+	n, err := g.gz.Read(p)
+	g.read += int64(n)
+	if g.limit > 0 && g.read > g.limit {
+		return 0, &ErrDecompressedTooLarge{Limit: g.limit}
+	}
+	if err != nil && err != io.EOF {
+		err = fmt.Errorf("failed to decompress gzip data: %w", err)
+	}
+	return n, err
+}
+
+func (g *gunzipReader) Close() error {
+	return g.gz.Close()
+}
+
+// WriteToFileGzip writes the message to a gzip-compressed XML file, e.g. message.xml.gz.
+// FromXML reads such files directly.
+func (b *Builder) WriteToFileGzip(filename string) error {
+	return b.writeFile(filename, writeGzipFile)
+}
+
+// writeGzipFile compresses data into filename
+func writeGzipFile(filename string, data []byte) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	writer := gzip.NewWriter(file)
+	if _, err := writer.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,73 @@
+package ddex
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"testing"
+)
+
+func gzipData(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestFromXMLLimit(t *testing.T) {
+	message, err := GenerateSample(SampleAudioAlbum, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xmlData, err := message.ToXML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := int64(len(xmlData))
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		data     []byte
+		maxSize  int64
+		tooLarge bool
+		wantErr  error
+	}{
+		{name: "plain", ctx: context.Background(), data: xmlData},
+		{name: "plain over the limit", ctx: context.Background(), data: xmlData, maxSize: size / 2},
+		{name: "gzip", ctx: context.Background(), data: gzipData(t, xmlData)},
+		{name: "gzip at the limit", ctx: context.Background(), data: gzipData(t, xmlData), maxSize: size},
+		{name: "gzip over the limit", ctx: context.Background(), data: gzipData(t, xmlData), maxSize: size - 1, tooLarge: true},
+		{name: "cancelled", ctx: cancelled, data: xmlData, wantErr: context.Canceled},
+		{name: "gzip cancelled", ctx: cancelled, data: gzipData(t, xmlData), wantErr: context.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromXMLLimit(tt.ctx, tt.data, tt.maxSize)
+			var tooLarge *ErrDecompressedTooLarge
+			switch {
+			case tt.tooLarge:
+				if !errors.As(err, &tooLarge) || tooLarge.Limit != tt.maxSize {
+					t.Fatalf("error = %v, want an ErrDecompressedTooLarge with limit %d", err, tt.maxSize)
+				}
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+			case err != nil:
+				t.Fatal(err)
+			case got.MessageHeader == nil || got.MessageHeader.MessageId != message.MessageHeader.MessageId:
+				t.Fatalf("parsed message header %+v, want MessageId %s", got.MessageHeader, message.MessageHeader.MessageId)
+			}
+		})
+	}
+}
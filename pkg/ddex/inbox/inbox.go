@@ -0,0 +1,417 @@
+// Package inbox ingests the ERN messages labels and aggregators drop into a folder. A
+// Watcher scans the inbox, parses and validates each new message, and emits an Event per
+// file: MessageReceived, MessageInvalid or MessageFailed.
+//
+//	watcher := inbox.NewWatcher("/srv/sftp/acme", 10*time.Second).
+//		WithValidationOptions(ddex.YouTubeValidationOptions).
+//		MoveProcessed("/srv/ingest/accepted", "/srv/ingest/rejected").
+//		OnError(func(err error) { log.Print(err) })
+//	err := watcher.Run(ctx, inbox.Handlers{
+//		Received: func(e inbox.Event) { ingest(e.Message) },
+//		Invalid:  func(e inbox.Event) { reject(e.Path, e.Problems) },
+//	}.Handle)
+//
+// Files still being uploaded are left alone: a message is processed once it hasn't been
+// modified for the settle time. With WaitForBatches, messages in batch folders wait for
+// the batch's BatchComplete file instead, as the ERN choreography specifies.
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// DefaultSettleTime is how long a message must be unmodified before it is processed
+const DefaultSettleTime = 5 * time.Second
+
+// EventType is the outcome of processing a message file
+type EventType int
+
+const (
+	// MessageReceived is a message that parsed and validated
+	MessageReceived EventType = iota
+	// MessageInvalid is a message that parsed but has validation problems
+	MessageInvalid
+	// MessageFailed is a file that could not be read or parsed
+	MessageFailed
+)
+
+// String returns Received, Invalid or Failed
+func (t EventType) String() string {
+	switch t {
+	case MessageReceived:
+		return "Received"
+	case MessageInvalid:
+		return "Invalid"
+	}
+	return "Failed"
+}
+
+// Event is a processed message file
+type Event struct {
+	Type     EventType
+	Path     string // Where the file is now: in the inbox, or where MoveProcessed moved it
+	Batch    string // Name of the batch folder the file was delivered in, with WaitForBatches
+	Message  *ddex.NewReleaseMessage
+	Problems []ddex.ValidationProblem // Of MessageInvalid
+	Err      error                    // Of MessageFailed
+}
+
+// Handlers dispatches events to a callback per type. Nil callbacks ignore their events.
+type Handlers struct {
+	Received func(Event)
+	Invalid  func(Event)
+	Failed   func(Event)
+}
+
+// Handle passes an event to the callback of its type; pass it to Run
+func (h Handlers) Handle(event Event) {
+	var fn func(Event)
+	switch event.Type {
+	case MessageReceived:
+		fn = h.Received
+	case MessageInvalid:
+		fn = h.Invalid
+	case MessageFailed:
+		fn = h.Failed
+	}
+	if fn != nil {
+		fn(event)
+	}
+}
+
+// Watcher scans an inbox folder and emits an Event per new message file
+type Watcher struct {
+	dir         string
+	interval    time.Duration
+	settle      time.Duration
+	opts        ddex.ValidationOptions
+	batches     bool
+	acceptedDir string
+	rejectedDir string
+	onError     func(error)
+	seen        map[string]fileVersion
+}
+
+// fileVersion identifies a version of a file, so a file uploaded again is processed again
+type fileVersion struct {
+	size    int64
+	modTime time.Time
+}
+
+// NewWatcher creates a watcher scanning dir every interval
+func NewWatcher(dir string, interval time.Duration) *Watcher {
+	return &Watcher{
+		dir:      dir,
+		interval: interval,
+		settle:   DefaultSettleTime,
+		seen:     make(map[string]fileVersion),
+	}
+}
+
+// WithSettleTime sets how long a message must be unmodified before it is processed,
+// DefaultSettleTime unless set
+func (w *Watcher) WithSettleTime(d time.Duration) *Watcher {
+	w.settle = d
+	return w
+}
+
+// WithValidationOptions sets the options messages are validated with
+func (w *Watcher) WithValidationOptions(opts ddex.ValidationOptions) *Watcher {
+	w.opts = opts
+	return w
+}
+
+// WaitForBatches processes the messages in a folder of the inbox only once the folder
+// holds a BatchComplete file, all in the same scan, regardless of the settle time.
+// Messages directly in the inbox are still processed once settled.
+func (w *Watcher) WaitForBatches() *Watcher {
+	w.batches = true
+	return w
+}
+
+// MoveProcessed moves processed messages out of the inbox: received ones to acceptedDir
+// and the others to rejectedDir, at the same path relative to the inbox. With
+// WaitForBatches whole batch folders are moved, with their resources and BatchComplete
+// file, to rejectedDir if any of their messages is rejected. Otherwise processed files
+// are remembered and skipped for the life of the watcher unless they change.
+func (w *Watcher) MoveProcessed(acceptedDir, rejectedDir string) *Watcher {
+	w.acceptedDir = acceptedDir
+	w.rejectedDir = rejectedDir
+	return w
+}
+
+// OnError sets a callback for errors that don't stop Run, such as an unreadable inbox or
+// a file that couldn't be moved
+func (w *Watcher) OnError(fn func(error)) *Watcher {
+	w.onError = fn
+	return w
+}
+
+// candidate is a message file found by a scan
+type candidate struct {
+	path    string
+	rel     string // Relative to the inbox
+	batch   string // Top-level folder, empty for files directly in the inbox
+	version fileVersion
+}
+
+// Poll scans the inbox once and returns the events of the messages ready to process, in
+// path order
+func (w *Watcher) Poll(ctx context.Context) ([]Event, error) {
+	candidates, complete, err := w.scan()
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan inbox: %w", err)
+	}
+
+	var events []Event
+	var errs []error
+	batches := make(map[string][]candidate)
+	for _, c := range candidates {
+		if err := ctx.Err(); err != nil {
+			return events, err
+		}
+		if version, ok := w.seen[c.path]; ok && version == c.version {
+			continue
+		}
+		if w.batches && c.batch != "" {
+			if complete[c.batch] {
+				batches[c.batch] = append(batches[c.batch], c)
+			}
+			continue
+		}
+		if time.Since(c.version.modTime) < w.settle {
+			continue
+		}
+
+		event := w.process(c)
+		if w.moving() {
+			dest, err := w.move(c.path, c.rel, event.Type == MessageReceived)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			if err == nil && dest != c.path {
+				event.Path = dest
+			} else {
+				w.seen[c.path] = c.version
+			}
+		} else {
+			w.seen[c.path] = c.version
+		}
+		events = append(events, event)
+	}
+
+	names := make([]string, 0, len(batches))
+	for name := range batches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		batchEvents, err := w.processBatch(name, batches[name])
+		events = append(events, batchEvents...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return events, errors.Join(errs...)
+}
+
+// scan returns the message files of the inbox and which top-level folders hold a
+// BatchComplete file. Hidden files and folders and the destinations of MoveProcessed
+// are skipped.
+func (w *Watcher) scan() ([]candidate, map[string]bool, error) {
+	skip := make(map[string]bool)
+	for _, dir := range []string{w.acceptedDir, w.rejectedDir} {
+		if dir != "" {
+			if abs, err := filepath.Abs(dir); err == nil {
+				skip[abs] = true
+			}
+		}
+	}
+
+	var candidates []candidate
+	complete := make(map[string]bool)
+	err := filepath.WalkDir(w.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := entry.Name()
+		if path != w.dir && strings.HasPrefix(name, ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			if abs, err := filepath.Abs(path); err == nil && skip[abs] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(w.dir, path)
+		if err != nil {
+			return err
+		}
+		batch := ""
+		if parts := strings.SplitN(filepath.ToSlash(rel), "/", 2); len(parts) == 2 {
+			batch = parts[0]
+		}
+		if strings.HasPrefix(name, "BatchComplete_") {
+			if batch != "" && filepath.Dir(rel) == batch {
+				complete[batch] = true
+			}
+			return nil
+		}
+		lower := strings.ToLower(name)
+		if !strings.HasSuffix(lower, ".xml") && !strings.HasSuffix(lower, ".xml.gz") {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		candidates = append(candidates, candidate{
+			path:    path,
+			rel:     rel,
+			batch:   batch,
+			version: fileVersion{size: info.Size(), modTime: info.ModTime()},
+		})
+		return nil
+	})
+	return candidates, complete, err
+}
+
+// process parses and validates a message file
+func (w *Watcher) process(c candidate) Event {
+	event := Event{Path: c.path}
+	if w.batches {
+		event.Batch = c.batch
+	}
+	data, err := os.ReadFile(c.path)
+	if err == nil {
+		event.Message, err = ddex.FromXML(data)
+	}
+	if err != nil {
+		event.Type = MessageFailed
+		event.Err = err
+		return event
+	}
+	event.Problems = event.Message.ValidationProblems(w.opts)
+	if len(event.Problems) > 0 {
+		event.Type = MessageInvalid
+	}
+	return event
+}
+
+// processBatch processes the messages of a complete batch folder and moves the folder
+func (w *Watcher) processBatch(name string, candidates []candidate) ([]Event, error) {
+	var events []Event
+	accepted := true
+	for _, c := range candidates {
+		event := w.process(c)
+		accepted = accepted && event.Type == MessageReceived
+		events = append(events, event)
+	}
+
+	if !w.moving() {
+		for _, c := range candidates {
+			w.seen[c.path] = c.version
+		}
+		return events, nil
+	}
+	path := filepath.Join(w.dir, name)
+	dest, err := w.move(path, name, accepted)
+	if err != nil || dest == path {
+		for _, c := range candidates {
+			w.seen[c.path] = c.version
+		}
+		return events, err
+	}
+	for i := range events {
+		events[i].Path = filepath.Join(dest, candidates[i].rel[len(name)+1:])
+	}
+	return events, nil
+}
+
+func (w *Watcher) moving() bool {
+	return w.acceptedDir != "" || w.rejectedDir != ""
+}
+
+// move moves a file or folder of the inbox to the accepted or rejected folder and returns
+// its new path, or path if that folder isn't set. A path that is taken gets a numbered
+// name, such as 123456789012_2.xml.
+func (w *Watcher) move(path, rel string, accepted bool) (string, error) {
+	dir := w.rejectedDir
+	if accepted {
+		dir = w.acceptedDir
+	}
+	if dir == "" {
+		return path, nil
+	}
+
+	dest := filepath.Join(dir, rel)
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return "", fmt.Errorf("failed to move %s: %w", path, err)
+	}
+	dest = availablePath(dest)
+	if err := os.Rename(path, dest); err != nil {
+		return "", fmt.Errorf("failed to move %s: %w", path, err)
+	}
+	return dest, nil
+}
+
+// availablePath returns path, or path with a number before its extension if it exists
+func availablePath(path string) string {
+	if _, err := os.Lstat(path); os.IsNotExist(err) {
+		return path
+	}
+	base := path
+	ext := ""
+	for _, e := range []string{".xml.gz", filepath.Ext(path)} {
+		if e != "" && strings.HasSuffix(strings.ToLower(path), e) {
+			base, ext = path[:len(path)-len(e)], path[len(path)-len(e):]
+			break
+		}
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s_%d%s", base, i, ext)
+		if _, err := os.Lstat(candidate); os.IsNotExist(err) {
+			return candidate
+		}
+	}
+}
+
+// Run polls until ctx is cancelled, passing each event to fn. Poll errors are passed to
+// the OnError callback and polling continues. It returns ctx.Err().
+func (w *Watcher) Run(ctx context.Context, fn func(Event)) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		events, err := w.Poll(ctx)
+		for _, event := range events {
+			fn(event)
+		}
+		if err != nil && w.onError != nil && ctx.Err() == nil {
+			w.onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package inbox
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// writeMessage writes a valid sample message to path, modified at modTime
+func writeMessage(t *testing.T, path string, modTime time.Time) {
+	t.Helper()
+	message, err := ddex.GenerateSample(ddex.SampleAudioAlbum, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := message.ToXMLWithHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, path, data, modTime)
+}
+
+func writeFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func poll(t *testing.T, w *Watcher) []Event {
+	t.Helper()
+	events, err := w.Poll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
+
+func TestPollSettleTime(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "123456789012.xml")
+	writeMessage(t, path, time.Now())
+	w := NewWatcher(dir, time.Second).WithSettleTime(time.Hour)
+
+	if events := poll(t, w); len(events) != 0 {
+		t.Fatalf("unsettled file: got %d events, want none", len(events))
+	}
+
+	settled := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(path, settled, settled); err != nil {
+		t.Fatal(err)
+	}
+	events := poll(t, w)
+	if len(events) != 1 || events[0].Type != MessageReceived || events[0].Path != path {
+		t.Fatalf("settled file: got %+v, want one Received event for %s", events, path)
+	}
+
+	if events := poll(t, w); len(events) != 0 {
+		t.Fatalf("processed file: got %d events, want none", len(events))
+	}
+
+	writeMessage(t, path, settled.Add(time.Minute))
+	if events := poll(t, w); len(events) != 1 {
+		t.Fatalf("file uploaded again: got %d events, want one", len(events))
+	}
+}
+
+func TestPollWaitsForBatchComplete(t *testing.T) {
+	dir := t.TempDir()
+	settled := time.Now().Add(-time.Hour)
+	writeMessage(t, filepath.Join(dir, "20240131154500123", "123456789012", "123456789012.xml"), settled)
+	writeMessage(t, filepath.Join(dir, "20240131154500123", "123456789029", "123456789029.xml"), settled)
+	w := NewWatcher(dir, time.Second).WithSettleTime(time.Hour).WaitForBatches()
+
+	if events := poll(t, w); len(events) != 0 {
+		t.Fatalf("incomplete batch: got %d events, want none", len(events))
+	}
+
+	writeFile(t, filepath.Join(dir, "20240131154500123", "BatchComplete_20240131154500123.xml"), []byte("<BatchComplete/>"), time.Now())
+	events := poll(t, w)
+	if len(events) != 2 {
+		t.Fatalf("complete batch: got %d events, want 2", len(events))
+	}
+	for _, event := range events {
+		if event.Type != MessageReceived || event.Batch != "20240131154500123" {
+			t.Errorf("complete batch: got %s event of batch %q for %s", event.Type, event.Batch, event.Path)
+		}
+	}
+}
+
+func TestPollMovesProcessed(t *testing.T) {
+	settled := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name     string
+		files    map[string]bool // Relative path, whether it is a valid message
+		batches  bool
+		accepted []string // Relative to the accepted folder
+		rejected []string // Relative to the rejected folder
+	}{
+		{
+			name:     "loose files",
+			files:    map[string]bool{"123456789012.xml": true, "broken.xml": false},
+			accepted: []string{"123456789012.xml"},
+			rejected: []string{"broken.xml"},
+		},
+		{
+			name: "accepted batch",
+			files: map[string]bool{
+				"batch1/123456789012/123456789012.xml": true,
+				"batch1/BatchComplete_batch1.xml":      true,
+			},
+			batches:  true,
+			accepted: []string{"batch1/123456789012/123456789012.xml", "batch1/BatchComplete_batch1.xml"},
+		},
+		{
+			name: "batch with a broken message",
+			files: map[string]bool{
+				"batch1/123456789012/123456789012.xml": true,
+				"batch1/123456789029/123456789029.xml": false,
+				"batch1/BatchComplete_batch1.xml":      true,
+			},
+			batches: true,
+			rejected: []string{
+				"batch1/123456789012/123456789012.xml",
+				"batch1/123456789029/123456789029.xml",
+				"batch1/BatchComplete_batch1.xml",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, acceptedDir, rejectedDir := t.TempDir(), t.TempDir(), t.TempDir()
+			for rel, valid := range tt.files {
+				path := filepath.Join(dir, filepath.FromSlash(rel))
+				if valid {
+					writeMessage(t, path, settled)
+				} else {
+					writeFile(t, path, []byte("<NewReleaseMessage"), settled)
+				}
+			}
+			w := NewWatcher(dir, time.Second).MoveProcessed(acceptedDir, rejectedDir)
+			if tt.batches {
+				w.WaitForBatches()
+			}
+
+			moved := make(map[string]bool)
+			for folder, files := range map[string][]string{acceptedDir: tt.accepted, rejectedDir: tt.rejected} {
+				for _, rel := range files {
+					moved[filepath.Join(folder, filepath.FromSlash(rel))] = true
+				}
+			}
+
+			events := poll(t, w)
+			if want := countMessages(tt.accepted) + countMessages(tt.rejected); len(events) != want {
+				t.Errorf("got %d events, want %d", len(events), want)
+			}
+			for _, event := range events {
+				if !moved[event.Path] {
+					t.Errorf("%s event for %s, want a path in the accepted or rejected folder", event.Type, event.Path)
+				}
+			}
+			for path := range moved {
+				if _, err := os.Stat(path); err != nil {
+					t.Errorf("not moved: %v", err)
+				}
+			}
+			for rel := range tt.files {
+				if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(rel))); !os.IsNotExist(err) {
+					t.Errorf("%s is still in the inbox", rel)
+				}
+			}
+			if events := poll(t, w); len(events) != 0 {
+				t.Errorf("second poll: got %d events, want none", len(events))
+			}
+		})
+	}
+}
+
+// countMessages counts the message files among paths, leaving out BatchComplete files
+func countMessages(paths []string) int {
+	n := 0
+	for _, path := range paths {
+		if !strings.HasPrefix(filepath.Base(path), "BatchComplete_") {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,114 @@
+package ddex
+
+// IndexedResource is a resource of any type; exactly one of its fields is set
+type IndexedResource struct {
+	SoundRecording *SoundRecording
+	Video          *Video
+	Image          *Image
+	Text           *Text
+}
+
+// Reference returns the resource's ResourceReference
+func (r IndexedResource) Reference() string {
+	switch {
+	case r.SoundRecording != nil:
+		return r.SoundRecording.ResourceReference
+	case r.Video != nil:
+		return r.Video.ResourceReference
+	case r.Image != nil:
+		return r.Image.ResourceReference
+	case r.Text != nil:
+		return r.Text.ResourceReference
+	}
+	return ""
+}
+
+// Type returns the resource's element name: SoundRecording, Video, Image or Text
+func (r IndexedResource) Type() string {
+	switch {
+	case r.SoundRecording != nil:
+		return "SoundRecording"
+	case r.Video != nil:
+		return "Video"
+	case r.Image != nil:
+		return "Image"
+	case r.Text != nil:
+		return "Text"
+	}
+	return ""
+}
+
+// MessageIndex maps the references and identifiers of a message to its composites. The
+// pointers point into the message, so edits through them change it; the index is stale
+// once resources or releases are added or removed.
+type MessageIndex struct {
+	Resources       map[string]IndexedResource // By ResourceReference
+	Releases        map[string]*Release        // By ReleaseReference
+	ResourcesByISRC map[string]IndexedResource // Sound recordings and videos by ISRC
+	ReleasesByICPN  map[string]*Release
+}
+
+// Index builds lookup maps over the message's resources and releases. When several
+// composites share an identifier, the first one wins.
+func (nrm *NewReleaseMessage) Index() *MessageIndex {
+	index := &MessageIndex{
+		Resources:       make(map[string]IndexedResource),
+		Releases:        make(map[string]*Release),
+		ResourcesByISRC: make(map[string]IndexedResource),
+		ReleasesByICPN:  make(map[string]*Release),
+	}
+
+	addResource := func(resource IndexedResource, isrcs ...string) {
+		if ref := resource.Reference(); ref != "" {
+			if _, ok := index.Resources[ref]; !ok {
+				index.Resources[ref] = resource
+			}
+		}
+		for _, isrc := range isrcs {
+			if _, ok := index.ResourcesByISRC[isrc]; isrc != "" && !ok {
+				index.ResourcesByISRC[isrc] = resource
+			}
+		}
+	}
+
+	if list := nrm.ResourceList; list != nil {
+		for i := range list.SoundRecording {
+			recording := &list.SoundRecording[i]
+			var isrcs []string
+			for _, id := range recording.SoundRecordingId {
+				isrcs = append(isrcs, id.ISRC)
+			}
+			addResource(IndexedResource{SoundRecording: recording}, isrcs...)
+		}
+		for i := range list.Video {
+			video := &list.Video[i]
+			var isrcs []string
+			if video.VideoId != nil {
+				isrcs = append(isrcs, video.VideoId.ISRC)
+			}
+			addResource(IndexedResource{Video: video}, isrcs...)
+		}
+		for i := range list.Image {
+			addResource(IndexedResource{Image: &list.Image[i]})
+		}
+		for i := range list.Text {
+			addResource(IndexedResource{Text: &list.Text[i]})
+		}
+	}
+
+	if nrm.ReleaseList != nil {
+		for i := range nrm.ReleaseList.Release {
+			release := &nrm.ReleaseList.Release[i]
+			if _, ok := index.Releases[release.ReleaseReference]; release.ReleaseReference != "" && !ok {
+				index.Releases[release.ReleaseReference] = release
+			}
+			for _, id := range release.ReleaseId {
+				if _, ok := index.ReleasesByICPN[id.ICPN]; id.ICPN != "" && !ok {
+					index.ReleasesByICPN[id.ICPN] = release
+				}
+			}
+		}
+	}
+
+	return index
+}
@@ -0,0 +1,93 @@
+package ddex
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var isrcRegistrantPattern = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{3}$`)
+
+// IsrcGenerator issues sequential ISRCs for a registrant and year of reference, skipping
+// designation codes that are already in use. It is safe for concurrent use.
+type IsrcGenerator struct {
+	mu         sync.Mutex
+	registrant string
+	year       int
+	next       int
+	used       map[int]bool
+}
+
+// NewIsrcGenerator returns a generator for a registrant prefix (country code and registrant
+// code, e.g. USRC1 or US-RC1) and a year of reference, given as 2024 or 24. Designation
+// codes start at 00001.
+func NewIsrcGenerator(registrant string, year int) (*IsrcGenerator, error) {
+	registrant = strings.ToUpper(strings.ReplaceAll(registrant, "-", ""))
+	if !isrcRegistrantPattern.MatchString(registrant) {
+		return nil, fmt.Errorf("invalid ISRC registrant %q: expected a 2-letter country code and a 3-character registrant code", registrant)
+	}
+	if year < 0 || year > 9999 {
+		return nil, fmt.Errorf("invalid ISRC year %d", year)
+	}
+	return &IsrcGenerator{registrant: registrant, year: year % 100, next: 1, used: make(map[int]bool)}, nil
+}
+
+// MarkUsed records ISRCs that were already issued so Next skips them. Codes for another
+// registrant or year are ignored; malformed codes are an error.
+func (g *IsrcGenerator) MarkUsed(isrcs ...string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, isrc := range isrcs {
+		if !ValidateISRC(isrc) {
+			return wrapf(ErrInvalidISRC, "invalid ISRC %q", isrc)
+		}
+		clean := NormalizeISRC(isrc)
+		if clean[:5] != g.registrant || clean[5:7] != fmt.Sprintf("%02d", g.year) {
+			continue
+		}
+		designation, _ := strconv.Atoi(clean[7:])
+		g.used[designation] = true
+	}
+	return nil
+}
+
+// Next returns the next unused ISRC, without hyphens, and records it as used. It fails
+// once all 99999 designation codes of the year are taken.
+func (g *IsrcGenerator) Next() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for ; g.next <= 99999; g.next++ {
+		if !g.used[g.next] {
+			g.used[g.next] = true
+			return g.format(g.next), nil
+		}
+	}
+	return "", fmt.Errorf("no ISRC designation codes left for %s in %02d", g.registrant, g.year)
+}
+
+// Used returns the ISRCs of the registrant and year issued or marked as used, sorted
+func (g *IsrcGenerator) Used() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	designations := make([]int, 0, len(g.used))
+	for designation := range g.used {
+		designations = append(designations, designation)
+	}
+	sort.Ints(designations)
+
+	isrcs := make([]string, len(designations))
+	for i, designation := range designations {
+		isrcs[i] = g.format(designation)
+	}
+	return isrcs
+}
+
+func (g *IsrcGenerator) format(designation int) string {
+	return fmt.Sprintf("%s%02d%05d", g.registrant, g.year, designation)
+}
@@ -0,0 +1,32 @@
+package ddex
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JSON field names match the DDEX element and attribute names used in the XML.
+// XMLName fields are not serialized; element names are restored from the struct
+// tags when the message is marshaled back to XML, so XML -> JSON -> XML is lossless.
+
+// ToJSON converts the NewReleaseMessage to indented JSON
+func (nrm *NewReleaseMessage) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(nrm, "", "  ")
+}
+
+// FromJSON parses JSON produced by ToJSON into a NewReleaseMessage
+func FromJSON(data []byte) (*NewReleaseMessage, error) {
+	var nrm NewReleaseMessage
+	if err := json.Unmarshal(data, &nrm); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+	return &nrm, nil
+}
+
+// ToJSON converts the message to JSON bytes
+func (b *Builder) ToJSON() ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.Message.ToJSON()
+}
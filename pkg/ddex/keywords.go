@@ -0,0 +1,120 @@
+package ddex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeywordLimits are the keyword limits of a recipient. A zero limit is no limit.
+type KeywordLimits struct {
+	MaxKeywords    int `toml:"max_keywords" yaml:"max_keywords" json:"max_keywords,omitempty"`             // keywords per territory branch
+	MaxLength      int `toml:"max_length" yaml:"max_length" json:"max_length,omitempty"`                   // characters per keyword
+	MaxTotalLength int `toml:"max_total_length" yaml:"max_total_length" json:"max_total_length,omitempty"` // characters of all keywords of a branch, with a separator between them
+}
+
+// YouTubeKeywordLimits are the limits YouTube applies to the tags of a video
+var YouTubeKeywordLimits = KeywordLimits{MaxTotalLength: 500}
+
+// NormalizeKeywords cleans up the Keywords of every release and resource territory branch:
+// whitespace is trimmed and collapsed, empty keywords are dropped, and a keyword repeating
+// an earlier one in the same language (ignoring case) is removed. Keywords are then cut
+// down to limits: longer keywords are truncated, and keywords beyond MaxKeywords or
+// MaxTotalLength are dropped, keeping the first ones. Every truncation or drop forced by
+// limits is returned as a warning; clean-up alone is silent.
+func (nrm *NewReleaseMessage) NormalizeKeywords(limits KeywordLimits) ValidationErrors {
+	var warnings ValidationErrors
+
+	if nrm.ReleaseList != nil {
+		for i := range nrm.ReleaseList.Release {
+			release := &nrm.ReleaseList.Release[i]
+			for j := range release.ReleaseDetailsByTerritory {
+				path := fmt.Sprintf("Release[%s].ReleaseDetailsByTerritory[%d]", release.ReleaseReference, j)
+				details := &release.ReleaseDetailsByTerritory[j]
+				details.Keywords = normalizeKeywords(&warnings, path, details.Keywords, limits)
+			}
+		}
+	}
+	if nrm.ResourceList != nil {
+		for i := range nrm.ResourceList.SoundRecording {
+			sr := &nrm.ResourceList.SoundRecording[i]
+			for j := range sr.SoundRecordingDetailsByTerritory {
+				path := fmt.Sprintf("SoundRecording[%s].SoundRecordingDetailsByTerritory[%d]", sr.ResourceReference, j)
+				details := &sr.SoundRecordingDetailsByTerritory[j]
+				details.Keywords = normalizeKeywords(&warnings, path, details.Keywords, limits)
+			}
+		}
+		for i := range nrm.ResourceList.Video {
+			v := &nrm.ResourceList.Video[i]
+			for j := range v.VideoDetailsByTerritory {
+				path := fmt.Sprintf("Video[%s].VideoDetailsByTerritory[%d]", v.ResourceReference, j)
+				details := &v.VideoDetailsByTerritory[j]
+				details.Keywords = normalizeKeywords(&warnings, path, details.Keywords, limits)
+			}
+		}
+		for i := range nrm.ResourceList.Image {
+			image := &nrm.ResourceList.Image[i]
+			for j := range image.ImageDetailsByTerritory {
+				path := fmt.Sprintf("Image[%s].ImageDetailsByTerritory[%d]", image.ResourceReference, j)
+				details := &image.ImageDetailsByTerritory[j]
+				details.Keywords = normalizeKeywords(&warnings, path, details.Keywords, limits)
+			}
+		}
+	}
+
+	return warnings
+}
+
+// normalizeKeywords returns the cleaned-up keywords of one territory branch
+func normalizeKeywords(warnings *ValidationErrors, path string, keywords []Keywords, limits KeywordLimits) []Keywords {
+	var kept []Keywords
+	seen := make(map[string]bool)
+	total, dropped := 0, 0
+	for i, keyword := range keywords {
+		value := strings.Join(strings.Fields(keyword.Value), " ")
+		if value == "" {
+			continue
+		}
+		if limits.MaxLength > 0 {
+			if runes := []rune(value); len(runes) > limits.MaxLength {
+				value = strings.TrimSpace(string(runes[:limits.MaxLength]))
+				warnings.add(fmt.Sprintf("%s.Keywords[%d]", path, i), "keyword %q truncated to %d characters", keyword.Value, limits.MaxLength)
+			}
+		}
+
+		key := keyword.LanguageAndScriptCode + "\x00" + strings.ToLower(value)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		length := len([]rune(value))
+		if len(kept) > 0 {
+			length++ // separator
+		}
+		if limits.MaxKeywords > 0 && len(kept) >= limits.MaxKeywords ||
+			limits.MaxTotalLength > 0 && total+length > limits.MaxTotalLength {
+			dropped++
+			continue
+		}
+		total += length
+		keyword.Value = value
+		kept = append(kept, keyword)
+	}
+
+	if dropped > 0 {
+		warnings.add(path+".Keywords", "%d keywords dropped to stay within %s", dropped, describeKeywordLimits(limits))
+	}
+	return kept
+}
+
+// describeKeywordLimits describes the count and total length limits for a warning
+func describeKeywordLimits(limits KeywordLimits) string {
+	var parts []string
+	if limits.MaxKeywords > 0 {
+		parts = append(parts, fmt.Sprintf("%d keywords", limits.MaxKeywords))
+	}
+	if limits.MaxTotalLength > 0 {
+		parts = append(parts, fmt.Sprintf("%d characters in total", limits.MaxTotalLength))
+	}
+	return strings.Join(parts, " and ")
+}
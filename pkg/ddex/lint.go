@@ -0,0 +1,96 @@
+package ddex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LintRule is a recommended field Lint looks for, named as in SpecFields, and how much it
+// counts toward the score
+type LintRule struct {
+	Field  string
+	Weight int
+}
+
+// DefaultLintRules are the fields DSPs don't require but use for search, recommendations
+// and artist pages. Genres and artist IDs weigh most, as missing ones put releases on the
+// wrong shelf or the wrong artist page.
+var DefaultLintRules = []LintRule{
+	{"Release.Genre", 3},
+	{"Release.ArtistId", 3},
+	{"Release.OriginalReleaseDate", 2},
+	{"Release.LabelName", 2},
+	{"Release.PLine", 2},
+	{"Release.CLine", 2},
+	{"Release.Keywords", 1},
+	{"Release.MarketingComment", 1},
+	{"SoundRecording.Genre", 2},
+	{"SoundRecording.ArtistId", 3},
+	{"SoundRecording.LanguageOfPerformance", 2},
+	{"SoundRecording.PLine", 1},
+	{"SoundRecording.ParentalWarningType", 1},
+	{"SoundRecording.Keywords", 1},
+	{"Video.Genre", 2},
+	{"Video.ArtistId", 3},
+	{"Video.LanguageOfPerformance", 1},
+	{"Video.Keywords", 1},
+}
+
+// LintFinding is a recommended field missing from an element
+type LintFinding struct {
+	Path   string // Element missing the field, e.g. SoundRecording[A1]
+	Field  string
+	Weight int
+}
+
+// String formats the finding as "path: no field (weight n)"
+func (f LintFinding) String() string {
+	return fmt.Sprintf("%s: no %s (weight %d)", f.Path, f.Field, f.Weight)
+}
+
+// LintReport is the result of Lint. Every rule is counted once for the main release and
+// once per resource of its kind, so a single-track release with missing genres scores
+// lower than an album where one track lacks one.
+type LintReport struct {
+	Possible int // Total weight of the fields looked for
+	Present  int // Weight of the fields found
+	Missing  []LintFinding
+}
+
+// Score returns the weighted share of recommended fields present, from 0 to 100. A message
+// without anything to check scores 100.
+func (r *LintReport) Score() float64 {
+	if r.Possible == 0 {
+		return 100
+	}
+	return 100 * float64(r.Present) / float64(r.Possible)
+}
+
+// Lint scores how complete the metadata of the message is with DefaultLintRules. Unlike
+// Validate it doesn't look for mandatory elements, only for fields that improve how the
+// release is presented; run both before a delivery.
+func (nrm *NewReleaseMessage) Lint() *LintReport {
+	return nrm.LintWithRules(DefaultLintRules)
+}
+
+// LintWithRules scores the message with rules instead of DefaultLintRules. Rules naming a
+// field SpecFields doesn't know are ignored.
+func (nrm *NewReleaseMessage) LintWithRules(rules []LintRule) *LintReport {
+	report := &LintReport{}
+	names := make([]string, len(rules))
+	for i, rule := range rules {
+		names[i] = rule.Field
+	}
+
+	checkSpecFields(nrm, names, func(i int, path string, has bool) {
+		rule := rules[i]
+		report.Possible += rule.Weight
+		if has {
+			report.Present += rule.Weight
+			return
+		}
+		_, field, _ := strings.Cut(rule.Field, ".")
+		report.Missing = append(report.Missing, LintFinding{Path: path, Field: field, Weight: rule.Weight})
+	})
+	return report
+}
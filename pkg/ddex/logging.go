@@ -0,0 +1,49 @@
+package ddex
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+var (
+	loggerMu      sync.RWMutex
+	defaultLogger *slog.Logger
+)
+
+// SetLogger sets the logger that builders, Validate, pipelines, packagers and the delivery
+// package report events to when they have no logger of their own. Events are logged with
+// a "ddex: " prefix: built messages and copied files at Debug, finished packages and
+// deliveries at Info, validation failures and retried uploads at Warn. A nil logger, the
+// default, logs nothing.
+func SetLogger(logger *slog.Logger) {
+	loggerMu.Lock()
+	defer loggerMu.Unlock()
+	defaultLogger = logger
+}
+
+// Logger returns the logger set with SetLogger, or nil
+func Logger() *slog.Logger {
+	loggerMu.RLock()
+	defer loggerMu.RUnlock()
+	return defaultLogger
+}
+
+// logEvent logs msg to logger, or to the SetLogger logger when logger is nil
+func logEvent(logger *slog.Logger, level slog.Level, msg string, args ...interface{}) {
+	if logger == nil {
+		logger = Logger()
+	}
+	if logger == nil {
+		return
+	}
+	logger.Log(context.Background(), level, msg, args...)
+}
+
+// messageId returns the MessageId of a message for log attributes
+func (nrm *NewReleaseMessage) messageId() string {
+	if nrm == nil || nrm.MessageHeader == nil {
+		return ""
+	}
+	return nrm.MessageHeader.MessageId
+}
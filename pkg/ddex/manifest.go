@@ -0,0 +1,547 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest is a declarative description of a single release (artists, tracks, assets, deals)
+// that can be written in YAML or JSON by non-Go tooling. Manifest.Builder drives the fluent
+// Builder so the DDEX structure (references, territory details, resource groups) is handled
+// by the package.
+//
+// Example (YAML):
+//
+//	message:
+//	  sender: {dpid: PADPIDA0000000001, name: My Label}
+//	  sent_on_behalf_of: {dpid: PADPIDA0000000002, name: Sub Label} # optional
+//	  recipients:
+//	    - {dpid: PADPIDA2013020802I, name: YouTube}
+//	release:
+//	  type: Album
+//	  title: Greatest Hits
+//	  icpn: "123456789012"
+//	  display_artist: The Band
+//	  tracks:
+//	    - title: Song One
+//	      isrc: USRC17607839
+//	      duration: PT3M30S
+//	      file: resources/track1.flac
+//	  assets:
+//	    - type: FrontCoverImage
+//	      file: resources/cover.jpg
+//	deals:
+//	  - territories: [Worldwide]
+//	    commercial_models: [SubscriptionModel]
+//	    use_types: [OnDemandStream]
+//	    start_date: "2024-01-01"
+//	  - preset: youtube_content_id
+type Manifest struct {
+	Message ManifestMessage `yaml:"message" json:"message"`
+	Release ManifestRelease `yaml:"release" json:"release"`
+	Deals   []ManifestDeal  `yaml:"deals,omitempty" json:"deals,omitempty"`
+}
+
+// ManifestMessage describes the message header
+type ManifestMessage struct {
+	MessageId   string          `yaml:"message_id,omitempty" json:"message_id,omitempty"`     // Generated with GenerateMessageID if empty
+	ThreadId    string          `yaml:"thread_id,omitempty" json:"thread_id,omitempty"`       // Generated with GenerateThreadID if empty
+	ControlType string          `yaml:"control_type,omitempty" json:"control_type,omitempty"` // TestMessage (the default) or LiveMessage
+	Sender      ManifestParty   `yaml:"sender" json:"sender"`
+	OnBehalfOf  *ManifestParty  `yaml:"sent_on_behalf_of,omitempty" json:"sent_on_behalf_of,omitempty"`
+	Recipients  []ManifestParty `yaml:"recipients" json:"recipients"`
+}
+
+// ManifestParty identifies a message sender or recipient
+type ManifestParty struct {
+	DPID string `yaml:"dpid" json:"dpid"`
+	Name string `yaml:"name" json:"name"`
+}
+
+// ManifestArtist describes a display artist or contributor
+type ManifestArtist struct {
+	Name  string   `yaml:"name" json:"name"`
+	Roles []string `yaml:"roles,omitempty" json:"roles,omitempty"`
+}
+
+// ManifestLine describes a P-Line or C-Line
+type ManifestLine struct {
+	Year int    `yaml:"year,omitempty" json:"year,omitempty"`
+	Text string `yaml:"text" json:"text"`
+}
+
+// ManifestRelease describes the release and its resources
+type ManifestRelease struct {
+	Reference           string           `yaml:"reference,omitempty" json:"reference,omitempty"` // Defaults to R1
+	Type                string           `yaml:"type" json:"type"`
+	Title               string           `yaml:"title" json:"title"`
+	Subtitle            string           `yaml:"subtitle,omitempty" json:"subtitle,omitempty"`
+	ICPN                string           `yaml:"icpn,omitempty" json:"icpn,omitempty"`
+	GRid                string           `yaml:"grid,omitempty" json:"grid,omitempty"`
+	CatalogNumber       string           `yaml:"catalog_number,omitempty" json:"catalog_number,omitempty"`
+	Territories         []string         `yaml:"territories,omitempty" json:"territories,omitempty"` // Defaults to Worldwide
+	Language            string           `yaml:"language,omitempty" json:"language,omitempty"`
+	DisplayArtist       string           `yaml:"display_artist,omitempty" json:"display_artist,omitempty"`
+	Artists             []ManifestArtist `yaml:"artists,omitempty" json:"artists,omitempty"`
+	Label               string           `yaml:"label,omitempty" json:"label,omitempty"`
+	Genre               string           `yaml:"genre,omitempty" json:"genre,omitempty"`
+	SubGenre            string           `yaml:"sub_genre,omitempty" json:"sub_genre,omitempty"`
+	ReleaseDate         string           `yaml:"release_date,omitempty" json:"release_date,omitempty"`
+	OriginalReleaseDate string           `yaml:"original_release_date,omitempty" json:"original_release_date,omitempty"`
+	ParentalWarning     string           `yaml:"parental_warning,omitempty" json:"parental_warning,omitempty"`
+	PLine               *ManifestLine    `yaml:"pline,omitempty" json:"pline,omitempty"`
+	CLine               *ManifestLine    `yaml:"cline,omitempty" json:"cline,omitempty"`
+	MarketingComment    string           `yaml:"marketing_comment,omitempty" json:"marketing_comment,omitempty"`
+	Keywords            []string         `yaml:"keywords,omitempty" json:"keywords,omitempty"`
+	Tracks              []ManifestTrack  `yaml:"tracks" json:"tracks"`
+	Assets              []ManifestAsset  `yaml:"assets,omitempty" json:"assets,omitempty"`
+}
+
+// Track kinds accepted in ManifestTrack.Kind
+const (
+	ManifestTrackSoundRecording = "SoundRecording"
+	ManifestTrackVideo          = "Video"
+)
+
+// ManifestTrack describes a primary resource of the release (a sound recording or a video)
+type ManifestTrack struct {
+	Reference       string           `yaml:"reference,omitempty" json:"reference,omitempty"` // Defaults to A<n>
+	Kind            string           `yaml:"kind,omitempty" json:"kind,omitempty"`           // SoundRecording (default) or Video
+	Type            string           `yaml:"type,omitempty" json:"type,omitempty"`           // e.g. MusicalWorkSoundRecording, ShortFormMusicalWorkVideo
+	Title           string           `yaml:"title" json:"title"`
+	Subtitle        string           `yaml:"subtitle,omitempty" json:"subtitle,omitempty"`
+	ISRC            string           `yaml:"isrc,omitempty" json:"isrc,omitempty"`
+	Duration        string           `yaml:"duration" json:"duration"` // ISO 8601 (PT3M30S) or seconds
+	DisplayArtist   string           `yaml:"display_artist,omitempty" json:"display_artist,omitempty"`
+	Artists         []ManifestArtist `yaml:"artists,omitempty" json:"artists,omitempty"`
+	Contributors    []ManifestArtist `yaml:"contributors,omitempty" json:"contributors,omitempty"`
+	Label           string           `yaml:"label,omitempty" json:"label,omitempty"`
+	Genre           string           `yaml:"genre,omitempty" json:"genre,omitempty"`
+	ParentalWarning string           `yaml:"parental_warning,omitempty" json:"parental_warning,omitempty"`
+	PLine           *ManifestLine    `yaml:"pline,omitempty" json:"pline,omitempty"`
+	File            string           `yaml:"file,omitempty" json:"file,omitempty"`
+}
+
+// ManifestAsset describes a secondary image resource such as cover art
+type ManifestAsset struct {
+	Reference     string `yaml:"reference,omitempty" json:"reference,omitempty"` // Defaults to A<n>
+	Type          string `yaml:"type" json:"type"`                               // e.g. FrontCoverImage, VideoScreenCapture
+	File          string `yaml:"file,omitempty" json:"file,omitempty"`
+	IdNamespace   string `yaml:"id_namespace,omitempty" json:"id_namespace,omitempty"`
+	ProprietaryId string `yaml:"proprietary_id,omitempty" json:"proprietary_id,omitempty"`
+}
+
+// ManifestDeal describes a deal for the release
+type ManifestDeal struct {
+	Preset              string   `yaml:"preset,omitempty" json:"preset,omitempty"`           // Name of a DealPreset, e.g. youtube_content_id
+	Territories         []string `yaml:"territories,omitempty" json:"territories,omitempty"` // Defaults to Worldwide
+	CommercialModels    []string `yaml:"commercial_models,omitempty" json:"commercial_models,omitempty"`
+	UseTypes            []string `yaml:"use_types,omitempty" json:"use_types,omitempty"`
+	RightsClaimPolicies []string `yaml:"rights_claim_policies,omitempty" json:"rights_claim_policies,omitempty"`
+	StartDate           string   `yaml:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate             string   `yaml:"end_date,omitempty" json:"end_date,omitempty"`
+	TakeDown            bool     `yaml:"take_down,omitempty" json:"take_down,omitempty"`
+}
+
+// LoadManifest reads a manifest file, choosing the format from the extension (.yaml, .yml or .json)
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return ParseManifestJSON(data)
+	case ".yaml", ".yml":
+		return ParseManifestYAML(data)
+	default:
+		return nil, fmt.Errorf("unsupported manifest extension: %s", filepath.Ext(path))
+	}
+}
+
+// ParseManifestYAML parses a YAML manifest. Unknown keys are rejected to catch typos.
+func ParseManifestYAML(data []byte) (*Manifest, error) {
+	var m Manifest
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML manifest: %w", err)
+	}
+	return &m, nil
+}
+
+// ParseManifestJSON parses a JSON manifest. Unknown keys are rejected to catch typos.
+func ParseManifestJSON(data []byte) (*Manifest, error) {
+	var m Manifest
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON manifest: %w", err)
+	}
+	return &m, nil
+}
+
+// Validate checks that the manifest contains the fields required to build a message
+func (m *Manifest) Validate() error {
+	if m.Message.Sender.DPID == "" {
+		return fmt.Errorf("message.sender.dpid is required")
+	}
+	if len(m.Message.Recipients) == 0 {
+		return fmt.Errorf("at least one message.recipients entry is required")
+	}
+	if m.Release.Title == "" {
+		return fmt.Errorf("release.title is required")
+	}
+	if len(m.Release.Tracks) == 0 {
+		return fmt.Errorf("at least one release.tracks entry is required")
+	}
+
+	for i, track := range m.Release.Tracks {
+		if track.Title == "" {
+			return fmt.Errorf("release.tracks[%d].title is required", i)
+		}
+		if track.Duration == "" {
+			return fmt.Errorf("release.tracks[%d].duration is required", i)
+		}
+		if _, err := manifestDuration(track.Duration); err != nil {
+			return fmt.Errorf("release.tracks[%d].duration: %w", i, err)
+		}
+		if track.Kind != "" && track.Kind != ManifestTrackSoundRecording && track.Kind != ManifestTrackVideo {
+			return fmt.Errorf("release.tracks[%d].kind must be %s or %s", i, ManifestTrackSoundRecording, ManifestTrackVideo)
+		}
+	}
+
+	for i, asset := range m.Release.Assets {
+		if asset.Type == "" {
+			return fmt.Errorf("release.assets[%d].type is required", i)
+		}
+	}
+
+	for i, deal := range m.Deals {
+		if deal.Preset == "" {
+			continue
+		}
+		if _, err := DealPresetByName(deal.Preset); err != nil {
+			return fmt.Errorf("deals[%d].preset: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Builder validates the manifest and drives a Builder with its contents. Resources without
+// an explicit reference are numbered A1, A2, ... (tracks first, then assets) and technical
+// details are referenced as T1, T2, ... in the same order.
+func (m *Manifest) Builder() (*Builder, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	messageId := m.Message.MessageId
+	if messageId == "" {
+		messageId = GenerateMessageID("")
+	}
+	threadId := m.Message.ThreadId
+	if threadId == "" {
+		threadId = GenerateThreadID("")
+	}
+
+	b := NewDDEXBuilder().WithMessageHeader(messageId, threadId, m.Message.Sender.DPID, m.Message.Sender.Name)
+	if m.Message.ControlType != "" {
+		b.Message.MessageHeader.MessageControlType = m.Message.ControlType
+	}
+	if m.Message.OnBehalfOf != nil {
+		b.WithSentOnBehalfOf(m.Message.OnBehalfOf.DPID, m.Message.OnBehalfOf.Name)
+	}
+	for _, recipient := range m.Message.Recipients {
+		b.AddRecipient(recipient.DPID, recipient.Name)
+	}
+
+	rb := b.addManifestRelease(m.Release, m.Message.Sender.DPID)
+	releaseRef := rb.release().ReleaseReference
+
+	// Deals
+	for _, deal := range m.Deals {
+		dealTerritories := deal.Territories
+		if len(dealTerritories) == 0 {
+			dealTerritories = []string{"Worldwide"}
+		}
+
+		db := b.AddReleaseDeal(releaseRef).AddDeal().WithTerritories(dealTerritories)
+		if deal.Preset != "" {
+			preset, _ := DealPresetByName(deal.Preset)
+			db.WithPreset(preset)
+		}
+		for _, model := range deal.CommercialModels {
+			db.WithCommercialModel(model)
+		}
+		for _, useType := range deal.UseTypes {
+			db.WithUseType(useType)
+		}
+		for _, policy := range deal.RightsClaimPolicies {
+			db.WithRightsClaimPolicy(policy)
+		}
+		if deal.StartDate != "" {
+			db.WithValidityPeriodStartDate(deal.StartDate)
+		}
+		if deal.EndDate != "" {
+			db.WithValidityPeriodEndDate(deal.EndDate)
+		}
+		if deal.TakeDown {
+			db.IsTakedown(true)
+		}
+		db.Done().Done()
+	}
+
+	return b, nil
+}
+
+// addManifestRelease adds the resources and release described by a ManifestRelease and
+// returns the ReleaseBuilder so callers can refine it. Generated resource references
+// continue after the resources already present in the message.
+func (b *Builder) addManifestRelease(release ManifestRelease, senderDPID string) *ReleaseBuilder {
+	language := release.Language
+	if language == "" {
+		language = "en"
+	}
+	territories := release.Territories
+	if len(territories) == 0 {
+		territories = []string{"Worldwide"}
+	}
+
+	// Resources
+	resourceNumber := b.resourceCount()
+	nextReference := func(explicit string) (string, string) {
+		resourceNumber++
+		if explicit == "" {
+			explicit = fmt.Sprintf("A%d", resourceNumber)
+		}
+		return explicit, fmt.Sprintf("T%d", resourceNumber)
+	}
+
+	trackRefs := make([]string, len(release.Tracks))
+	trackKinds := make([]string, len(release.Tracks))
+	for i, track := range release.Tracks {
+		ref, techRef := nextReference(track.Reference)
+		trackRefs[i] = ref
+		trackKinds[i] = track.Kind
+		if trackKinds[i] == "" {
+			trackKinds[i] = ManifestTrackSoundRecording
+		}
+
+		duration, _ := manifestDuration(track.Duration)
+		displayArtist := track.DisplayArtist
+		if displayArtist == "" {
+			displayArtist = release.DisplayArtist
+		}
+		label := track.Label
+		if label == "" {
+			label = release.Label
+		}
+		genre := track.Genre
+		if genre == "" {
+			genre = release.Genre
+		}
+
+		if trackKinds[i] == ManifestTrackVideo {
+			vb := b.AddVideo(ref, track.Type).
+				WithReferenceTitle(track.Title, track.Subtitle).
+				WithDuration(duration)
+			if track.ISRC != "" {
+				vb.WithISRC(track.ISRC)
+			}
+			td := vb.AddVideoDetailsByTerritory(territories).
+				AddTitle(track.Title, track.Subtitle, language, "DisplayTitle")
+			if displayArtist != "" {
+				td.WithDisplayArtistName(displayArtist, language)
+			}
+			for seq, artist := range track.Artists {
+				td.WithArtist(artist.Name, artist.Roles, seq+1)
+			}
+			for seq, contributor := range track.Contributors {
+				td.WithResourceContributor(contributor.Name, contributor.Roles, seq+1)
+			}
+			if label != "" {
+				td.WithLabel(label, "", language)
+			}
+			if track.PLine != nil {
+				td.WithPLine(track.PLine.Year, track.PLine.Text)
+			}
+			if genre != "" {
+				td.WithGenre(genre)
+			}
+			if track.ParentalWarning != "" {
+				td.WithParentalWarning(track.ParentalWarning)
+			}
+			if track.File != "" {
+				td.WithTechnicalDetails(techRef, track.File)
+			}
+			td.Done().Done()
+			continue
+		}
+
+		sb := b.AddSoundRecording(ref, track.Type).
+			WithReferenceTitle(track.Title, track.Subtitle).
+			WithDuration(duration)
+		if track.ISRC != "" {
+			sb.WithISRC(track.ISRC)
+		}
+		td := sb.AddSoundRecordingDetailsByTerritory(territories).
+			AddTitle(track.Title, track.Subtitle, language, "DisplayTitle").
+			WithSequenceNumber(i + 1)
+		if displayArtist != "" {
+			td.WithDisplayArtistName(displayArtist, language)
+		}
+		for seq, artist := range track.Artists {
+			td.WithArtist(artist.Name, artist.Roles, seq+1)
+		}
+		for seq, contributor := range track.Contributors {
+			td.WithResourceContributor(contributor.Name, contributor.Roles, seq+1)
+		}
+		if label != "" {
+			td.WithLabel(label, "", language)
+		}
+		if track.PLine != nil {
+			td.WithPLine(track.PLine.Year, track.PLine.Text)
+		}
+		if genre != "" {
+			td.WithGenre(genre)
+		}
+		if track.ParentalWarning != "" {
+			td.WithParentalWarning(track.ParentalWarning)
+		}
+		if track.File != "" {
+			td.WithTechnicalDetails(techRef, track.File)
+		}
+		td.Done().Done()
+	}
+
+	assetRefs := make([]string, len(release.Assets))
+	for i, asset := range release.Assets {
+		ref, techRef := nextReference(asset.Reference)
+		assetRefs[i] = ref
+
+		ib := b.AddImage(ref, asset.Type)
+		if asset.ProprietaryId != "" {
+			namespace := asset.IdNamespace
+			if namespace == "" {
+				namespace = "DPID:" + senderDPID
+			}
+			ib.WithProprietaryId(namespace, asset.ProprietaryId)
+		}
+		itd := ib.AddImageDetailsByTerritory(territories)
+		if asset.File != "" {
+			itd.WithTechnicalDetails(techRef, asset.File)
+		}
+		itd.Done().Done()
+	}
+
+	// Release
+	releaseRef := release.Reference
+	if releaseRef == "" {
+		releaseRef = "R1"
+	}
+
+	rb := b.AddRelease(releaseRef, release.Type).
+		SetMainRelease(true).
+		WithTitle(release.Title, release.Subtitle)
+	if release.ICPN != "" {
+		rb.WithICPN(release.ICPN)
+	}
+	if release.GRid != "" {
+		rb.WithGRid(release.GRid)
+	}
+	if release.CatalogNumber != "" {
+		rb.WithCatalogNumber(release.CatalogNumber, "")
+	}
+	for _, ref := range trackRefs {
+		rb.AddReleaseResourceReference(ref, "PrimaryResource")
+	}
+	for _, ref := range assetRefs {
+		rb.AddReleaseResourceReference(ref, "SecondaryResource")
+	}
+	if release.PLine != nil {
+		rb.WithPLine(release.PLine.Year, release.PLine.Text)
+	}
+	if release.CLine != nil {
+		rb.WithCLine(release.CLine.Year, release.CLine.Text)
+	}
+
+	rtb := rb.AddReleaseDetailsByTerritory(territories).
+		AddTitle(release.Title, release.Subtitle, language, "DisplayTitle")
+	if release.DisplayArtist != "" {
+		rtb.WithDisplayArtistName(release.DisplayArtist, language)
+	}
+	for seq, artist := range release.Artists {
+		rtb.WithArtist(artist.Name, artist.Roles, seq+1)
+	}
+	if release.Label != "" {
+		rtb.WithLabel(release.Label, language)
+	}
+	if release.Genre != "" {
+		if release.SubGenre != "" {
+			rtb.WithGenreAndSubGenre(release.Genre, release.SubGenre)
+		} else {
+			rtb.WithGenre(release.Genre)
+		}
+	}
+	if release.ReleaseDate != "" {
+		rtb.WithReleaseDate(release.ReleaseDate)
+	}
+	if release.OriginalReleaseDate != "" {
+		rtb.WithOriginalReleaseDate(release.OriginalReleaseDate)
+	}
+	if release.ParentalWarning != "" {
+		rtb.WithParentalWarning(release.ParentalWarning)
+	}
+	if release.MarketingComment != "" {
+		rtb.WithMarketingComment(release.MarketingComment, language)
+	}
+	if len(release.Keywords) > 0 {
+		rtb.AddKeywordsWithLanguage(release.Keywords, language)
+	}
+
+	group := rtb.AddResourceGroup(release.Title, "", 1)
+	for i, ref := range trackRefs {
+		group.AddContentItem(i+1, trackKinds[i], ref, "PrimaryResource")
+	}
+	for i, ref := range assetRefs {
+		group.AddContentItem(len(trackRefs)+i+1, "Image", ref, "SecondaryResource")
+	}
+	group.Done().Done()
+
+	return rb
+}
+
+// resourceCount returns the number of resources already added to the message
+func (b *Builder) resourceCount() int {
+	resources := b.Message.ResourceList
+	if resources == nil {
+		return 0
+	}
+	return len(resources.SoundRecording) + len(resources.Video) + len(resources.Image) + len(resources.Text)
+}
+
+// manifestDuration accepts an ISO 8601 duration or a number of seconds
+func manifestDuration(value string) (string, error) {
+	if strings.HasPrefix(value, "PT") {
+		if _, err := ParseDuration(value); err != nil {
+			return "", err
+		}
+		return value, nil
+	}
+
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid duration %q: expected ISO 8601 (PT3M30S) or seconds", value)
+	}
+	return FormatDuration(seconds), nil
+}
@@ -0,0 +1,344 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// referenceKind is the kind of composite a message-local reference identifies
+type referenceKind int
+
+const (
+	resourceReference referenceKind = iota
+	releaseReference
+	collectionReference
+	technicalDetailsReference
+)
+
+// referencePrefixes are used to rename references without a prefix of their own
+var referencePrefixes = map[referenceKind]string{
+	resourceReference:         "A",
+	releaseReference:          "R",
+	collectionReference:       "X",
+	technicalDetailsReference: "T",
+}
+
+// Merge adds the resources, collections, releases and deals of src to dst, for assembling
+// one delivery from several upstream feeds, along with the extensions of src's message
+// and lists and the namespaces its root declares. The message header of dst is kept.
+// References of src that dst already uses (e.g. both have an A1 or R1) are renamed to the
+// next free number with the same prefix, everywhere src uses them. If dst already has a
+// main release, the releases of src are no longer marked as main. src is not modified,
+// and neither is dst if a prefix is declared for different namespaces in the two.
+func Merge(dst, src *NewReleaseMessage) error {
+	if dst == nil || src == nil {
+		return fmt.Errorf("cannot merge a nil message")
+	}
+
+	// Namespaces declared on the src root, which its extensions may use
+	var namespaces []xml.Attr
+	for _, attr := range src.OtherAttr {
+		prefix, ok := strings.CutPrefix(attr.Name.Local, "xmlns:")
+		if attr.Name.Space != "" || !ok {
+			continue
+		}
+		declared := false
+		for _, existing := range dst.OtherAttr {
+			if existing.Name == attr.Name {
+				if existing.Value != attr.Value {
+					return fmt.Errorf("cannot merge: namespace prefix %q is %s in one message and %s in the other", prefix, existing.Value, attr.Value)
+				}
+				declared = true
+			}
+		}
+		if !declared {
+			namespaces = append(namespaces, attr)
+		}
+	}
+	src = src.clone()
+
+	used := make(map[referenceKind]map[string]bool)
+	for kind := range referencePrefixes {
+		used[kind] = make(map[string]bool)
+	}
+	dst.forEachReference(func(kind referenceKind, ref *string) {
+		used[kind][*ref] = true
+	})
+
+	// References of src that dst uses too get new ones, allocated in document order; the
+	// new ones must not clash with the other references of src either
+	renamed := make(map[referenceKind]map[string]string)
+	for kind := range referencePrefixes {
+		renamed[kind] = make(map[string]string)
+	}
+	src.forEachReference(func(kind referenceKind, ref *string) {
+		if used[kind][*ref] {
+			renamed[kind][*ref] = ""
+		}
+	})
+	src.forEachReference(func(kind referenceKind, ref *string) {
+		if _, ok := renamed[kind][*ref]; !ok {
+			used[kind][*ref] = true
+		}
+	})
+	src.forEachReference(func(kind referenceKind, ref *string) {
+		newRef, ok := renamed[kind][*ref]
+		if !ok {
+			return
+		}
+		if newRef == "" {
+			newRef = freeReference(*ref, referencePrefixes[kind], used[kind])
+			used[kind][newRef] = true
+			renamed[kind][*ref] = newRef
+		}
+		*ref = newRef
+	})
+
+	if src.ResourceList != nil {
+		if dst.ResourceList == nil {
+			dst.ResourceList = &ResourceList{}
+		}
+		dst.ResourceList.SoundRecording = append(dst.ResourceList.SoundRecording, src.ResourceList.SoundRecording...)
+		dst.ResourceList.Video = append(dst.ResourceList.Video, src.ResourceList.Video...)
+		dst.ResourceList.Image = append(dst.ResourceList.Image, src.ResourceList.Image...)
+		dst.ResourceList.Text = append(dst.ResourceList.Text, src.ResourceList.Text...)
+		dst.ResourceList.Extensions = append(dst.ResourceList.Extensions, src.ResourceList.Extensions...)
+	}
+	if src.CollectionList != nil {
+		if dst.CollectionList == nil {
+			dst.CollectionList = &CollectionList{}
+		}
+		dst.CollectionList.Collection = append(dst.CollectionList.Collection, src.CollectionList.Collection...)
+		dst.CollectionList.Extensions = append(dst.CollectionList.Extensions, src.CollectionList.Extensions...)
+	}
+	if src.ReleaseList != nil {
+		if dst.ReleaseList == nil {
+			dst.ReleaseList = &ReleaseList{}
+		}
+		hasMain := false
+		for _, release := range dst.ReleaseList.Release {
+			hasMain = hasMain || release.IsMainRelease
+		}
+		for _, release := range src.ReleaseList.Release {
+			if hasMain {
+				release.IsMainRelease = false
+			}
+			dst.ReleaseList.Release = append(dst.ReleaseList.Release, release)
+		}
+		dst.ReleaseList.Extensions = append(dst.ReleaseList.Extensions, src.ReleaseList.Extensions...)
+	}
+	if src.DealList != nil {
+		if dst.DealList == nil {
+			dst.DealList = &DealList{}
+		}
+		dst.DealList.ReleaseDeal = append(dst.DealList.ReleaseDeal, src.DealList.ReleaseDeal...)
+		dst.DealList.Extensions = append(dst.DealList.Extensions, src.DealList.Extensions...)
+	}
+	dst.Extensions = append(dst.Extensions, src.Extensions...)
+	dst.OtherAttr = append(dst.OtherAttr, namespaces...)
+
+	return nil
+}
+
+// clone returns a deep copy of the message
+func (nrm *NewReleaseMessage) clone() *NewReleaseMessage {
+	return deepCopy(reflect.ValueOf(nrm)).Interface().(*NewReleaseMessage)
+}
+
+// deepCopy returns a copy of v that shares no pointers, slices or maps with it. Structs
+// with unexported fields, such as Decimal and time.Time, are immutable values and are
+// copied as they are.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Type().Elem())
+		copied.Elem().Set(deepCopy(v.Elem()))
+		return copied
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return copied
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			copied.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return copied
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(deepCopy(v.Elem()))
+		return copied
+	case reflect.Struct:
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				copied.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+		return copied
+	}
+	return v
+}
+
+// freeReference returns the first reference with the prefix of ref, or prefix if ref has
+// none, and a number that isn't used
+func freeReference(ref, prefix string, used map[string]bool) string {
+	if p := strings.TrimRight(ref, "0123456789"); p != "" {
+		prefix = p
+	}
+	for n := 1; ; n++ {
+		candidate := prefix + strconv.Itoa(n)
+		if !used[candidate] {
+			return candidate
+		}
+	}
+}
+
+// forEachReference calls fn with every non-empty message-local reference of the message,
+// both where a composite declares it and where others refer to it
+func (nrm *NewReleaseMessage) forEachReference(fn func(kind referenceKind, ref *string)) {
+	visit := func(kind referenceKind, ref *string) {
+		if *ref != "" {
+			fn(kind, ref)
+		}
+	}
+	visitFulfillment := func(date *FulfillmentDate) {
+		if date != nil {
+			visit(releaseReference, &date.ResourceReleaseReference)
+		}
+	}
+	visitContained := func(list *ResourceContainedResourceReferenceList) {
+		if list == nil {
+			return
+		}
+		for i := range list.ResourceContainedResourceReference {
+			visit(resourceReference, &list.ResourceContainedResourceReference[i].ResourceContainedResourceReference)
+		}
+	}
+
+	if list := nrm.ResourceList; list != nil {
+		for i := range list.SoundRecording {
+			recording := &list.SoundRecording[i]
+			visit(resourceReference, &recording.ResourceReference)
+			visitContained(recording.ResourceContainedResourceReferenceList)
+			for j := range recording.SoundRecordingDetailsByTerritory {
+				details := &recording.SoundRecordingDetailsByTerritory[j]
+				visitFulfillment(details.FulfillmentDate)
+				for k := range details.TechnicalSoundRecordingDetails {
+					visit(technicalDetailsReference, &details.TechnicalSoundRecordingDetails[k].TechnicalResourceDetailsReference)
+				}
+			}
+		}
+		for i := range list.Video {
+			video := &list.Video[i]
+			visit(resourceReference, &video.ResourceReference)
+			visitContained(video.ResourceContainedResourceReferenceList)
+			if video.VideoCollectionReferenceList != nil {
+				refs := video.VideoCollectionReferenceList.SoundRecordingCollectionReference
+				for j := range refs {
+					visit(collectionReference, &refs[j].Value)
+				}
+			}
+			for j := range video.VideoDetailsByTerritory {
+				details := &video.VideoDetailsByTerritory[j]
+				visitFulfillment(details.FulfillmentDate)
+				for k := range details.TechnicalVideoDetails {
+					visit(technicalDetailsReference, &details.TechnicalVideoDetails[k].TechnicalResourceDetailsReference)
+				}
+			}
+		}
+		for i := range list.Image {
+			image := &list.Image[i]
+			visit(resourceReference, &image.ResourceReference)
+			for j := range image.ImageDetailsByTerritory {
+				details := &image.ImageDetailsByTerritory[j]
+				visitFulfillment(details.FulfillmentDate)
+				for k := range details.TechnicalImageDetails {
+					visit(technicalDetailsReference, &details.TechnicalImageDetails[k].TechnicalResourceDetailsReference)
+				}
+			}
+		}
+		for i := range list.Text {
+			visit(resourceReference, &list.Text[i].ResourceReference)
+		}
+	}
+
+	if nrm.CollectionList != nil {
+		for i := range nrm.CollectionList.Collection {
+			visit(collectionReference, &nrm.CollectionList.Collection[i].CollectionReference)
+		}
+	}
+
+	if nrm.ReleaseList != nil {
+		for i := range nrm.ReleaseList.Release {
+			release := &nrm.ReleaseList.Release[i]
+			visit(releaseReference, &release.ReleaseReference)
+			if release.ReleaseResourceReferenceList != nil {
+				refs := release.ReleaseResourceReferenceList.ReleaseResourceReference
+				for j := range refs {
+					visit(resourceReference, &refs[j].Value)
+				}
+			}
+			for j := range release.RelatedResource {
+				visit(resourceReference, &release.RelatedResource[j].ResourceRelatedResourceReference)
+			}
+			if release.ReleaseCollectionReferenceList != nil {
+				refs := release.ReleaseCollectionReferenceList.ReleaseCollectionReference
+				for j := range refs {
+					visit(collectionReference, &refs[j])
+				}
+			}
+			for j := range release.ReleaseDetailsByTerritory {
+				groups := release.ReleaseDetailsByTerritory[j].ResourceGroup
+				for k := range groups {
+					items := groups[k].ResourceGroupContentItem
+					for l := range items {
+						visit(resourceReference, &items[l].ReleaseResourceReference.Value)
+						for m := range items[l].LinkedReleaseResourceReference {
+							visit(resourceReference, &items[l].LinkedReleaseResourceReference[m].Value)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if nrm.DealList != nil {
+		for i := range nrm.DealList.ReleaseDeal {
+			releaseDeal := &nrm.DealList.ReleaseDeal[i]
+			visit(releaseReference, &releaseDeal.DealReleaseReference)
+			for j := range releaseDeal.Deal {
+				terms := releaseDeal.Deal[j].DealTerms
+				if terms == nil {
+					continue
+				}
+				for _, list := range []*DealResourceReferenceList{terms.PreOrderIncentiveResourceList, terms.InstantGratificationResourceList} {
+					if list == nil {
+						continue
+					}
+					for k := range list.DealResourceReference {
+						visit(resourceReference, &list.DealResourceReference[k])
+					}
+				}
+			}
+		}
+	}
+}
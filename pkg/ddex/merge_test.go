@@ -0,0 +1,143 @@
+package ddex
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func mergeTestMessage(t *testing.T, profile SampleProfile, extension string) *NewReleaseMessage {
+	t.Helper()
+	message, err := GenerateSample(profile, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message.DeclareNamespace("yt", "http://www.youtube.com/ddex")
+	message.Extensions = []RawElement{NewExtension("", "yt:"+extension, "")}
+	message.ResourceList.Extensions = []RawElement{NewExtension("", "yt:Resources"+extension, "")}
+	return message
+}
+
+func TestMerge(t *testing.T) {
+	dst := mergeTestMessage(t, SampleAudioAlbum, "Dst")
+	src := mergeTestMessage(t, SampleVideoSingle, "Src")
+	before, err := src.ToXML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	images := len(dst.ResourceList.Image) + len(src.ResourceList.Image)
+	releases := len(dst.ReleaseList.Release) + len(src.ReleaseList.Release)
+
+	if err := Merge(dst, src); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dst.ResourceList.Image) != images || len(dst.ReleaseList.Release) != releases {
+		t.Errorf("%d images and %d releases, want %d and %d", len(dst.ResourceList.Image), len(dst.ReleaseList.Release), images, releases)
+	}
+	var references []string
+	for _, recording := range dst.ResourceList.SoundRecording {
+		references = append(references, recording.ResourceReference)
+	}
+	for _, video := range dst.ResourceList.Video {
+		references = append(references, video.ResourceReference)
+	}
+	for _, image := range dst.ResourceList.Image {
+		references = append(references, image.ResourceReference)
+	}
+	seen := map[string]bool{}
+	for _, ref := range references {
+		if seen[ref] {
+			t.Errorf("resource reference %s is used twice", ref)
+		}
+		seen[ref] = true
+	}
+	mainReleases := 0
+	for _, release := range dst.ReleaseList.Release {
+		if release.IsMainRelease {
+			mainReleases++
+		}
+	}
+	if mainReleases != 1 {
+		t.Errorf("%d main releases, want 1", mainReleases)
+	}
+	if len(dst.Extensions) != 2 || dst.Extensions[1].Name.Local != "yt:Src" {
+		t.Errorf("message extensions = %v, want yt:Dst and yt:Src", dst.Extensions)
+	}
+	if len(dst.ResourceList.Extensions) != 2 || dst.ResourceList.Extensions[1].Name.Local != "yt:ResourcesSrc" {
+		t.Errorf("resource list extensions = %v, want yt:ResourcesDst and yt:ResourcesSrc", dst.ResourceList.Extensions)
+	}
+	if len(dst.OtherAttr) != 1 {
+		t.Errorf("root attributes = %v, want the yt declaration once", dst.OtherAttr)
+	}
+	if err := dst.Validate(); err != nil {
+		t.Errorf("merged message is invalid: %v", err)
+	}
+
+	after, err := src.ToXML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(after) != string(before) {
+		t.Error("Merge modified src")
+	}
+}
+
+func TestMergeNamespaces(t *testing.T) {
+	dst := mergeTestMessage(t, SampleAudioAlbum, "Dst")
+	src := mergeTestMessage(t, SampleVideoSingle, "Src")
+	src.DeclareNamespace("x", "urn:x")
+	if err := Merge(dst, src); err != nil {
+		t.Fatal(err)
+	}
+	if len(dst.OtherAttr) != 2 || dst.OtherAttr[1].Name.Local != "xmlns:x" || dst.OtherAttr[1].Value != "urn:x" {
+		t.Errorf("root attributes = %v, want the yt and x declarations", dst.OtherAttr)
+	}
+
+	dst = mergeTestMessage(t, SampleAudioAlbum, "Dst")
+	src = mergeTestMessage(t, SampleVideoSingle, "Src")
+	src.DeclareNamespace("yt", "urn:other")
+	recordings := len(dst.ResourceList.SoundRecording)
+	err := Merge(dst, src)
+	if err == nil || !strings.Contains(err.Error(), `prefix "yt"`) {
+		t.Fatalf("Merge with conflicting prefixes: error %v", err)
+	}
+	if len(dst.ResourceList.SoundRecording) != recordings || len(dst.Extensions) != 1 {
+		t.Error("a failed Merge modified dst")
+	}
+}
+
+func TestClone(t *testing.T) {
+	message, err := GenerateSample(SampleAudioAlbum, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created := time.Date(2022, 7, 1, 18, 59, 21, 0, time.FixedZone("", 2*60*60))
+	message.MessageHeader.MessageCreatedDateTime = &DateTime{Time: created}
+	share := MustParseDecimal("33.33")
+	details := &message.ResourceList.SoundRecording[0].SoundRecordingDetailsByTerritory[0]
+	details.RightsController = []RightsController{{RightSharePercentage: &share}}
+	message.Extensions = []RawElement{NewExtension("urn:x", "x:Extra", "<x:Value>1</x:Value>")}
+	want, err := message.ToXML()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	copied := message.clone()
+	got, err := copied.ToXML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("clone differs:\n%s\nwant\n%s", got, want)
+	}
+
+	copied.MessageHeader.MessageId = "OTHER"
+	copied.ResourceList.SoundRecording[0].ReferenceTitle.TitleText = "Other"
+	copied.ResourceList.SoundRecording[0].SoundRecordingDetailsByTerritory[0].RightsController[0].RightSharePercentage = nil
+	copied.Extensions[0].Attr[0].Value = "urn:other"
+	copied.ReleaseList.Release = append(copied.ReleaseList.Release[:0], copied.ReleaseList.Release[1:]...)
+	if after, _ := message.ToXML(); string(after) != string(want) {
+		t.Error("changing the clone changed the original")
+	}
+}
@@ -8,47 +8,68 @@ import (
 // MessageHeader contains information about the sender and recipient, including their unique
 // DDEX Party IDs (DPIDs), and a timestamp indicating when the message was created.
 type MessageHeader struct {
-	XMLName                xml.Name           `xml:"MessageHeader"`
-	MessageThreadId        string             `xml:"MessageThreadId"`
-	MessageId              string             `xml:"MessageId"`
-	MessageFileName        string             `xml:"MessageFileName,omitempty"`
-	MessageSender          *MessageSender     `xml:"MessageSender"`
-	SentOnBehalfOf         string             `xml:"SentOnBehalfOf,omitempty"`
-	MessageRecipient       []*MessageRecipient  `xml:"MessageRecipient"`
-	MessageCreatedDateTime *DateTime          `xml:"MessageCreatedDateTime"`
-	MessageControlType     string             `xml:"MessageControlType,omitempty"`
-	MessageAuditTrail      *MessageAuditTrail `xml:"MessageAuditTrail,omitempty"`
-	Comment                string             `xml:"Comment,omitempty"`
+	XMLName                xml.Name            `xml:"MessageHeader" json:"-"`
+	MessageThreadId        string              `xml:"MessageThreadId" json:",omitempty"`
+	MessageId              string              `xml:"MessageId" json:",omitempty"`
+	MessageFileName        string              `xml:"MessageFileName,omitempty" json:",omitempty"`
+	MessageSender          *MessageSender      `xml:"MessageSender" json:",omitempty"`
+	SentOnBehalfOf         *SentOnBehalfOf     `xml:"SentOnBehalfOf,omitempty" json:",omitempty"`
+	MessageRecipient       []*MessageRecipient `xml:"MessageRecipient" json:",omitempty"`
+	MessageCreatedDateTime *DateTime           `xml:"MessageCreatedDateTime" json:",omitempty"`
+	MessageAuditTrail      *MessageAuditTrail  `xml:"MessageAuditTrail,omitempty" json:",omitempty"`
+	Comment                string              `xml:"Comment,omitempty" json:",omitempty"`
+	MessageControlType     string              `xml:"MessageControlType,omitempty" json:",omitempty"`
+	Extensions             []RawElement        `xml:",any" json:",omitempty"`
 }
 
+// MessageControlType values
+const (
+	MessageControlTypeTest = "TestMessage"
+	MessageControlTypeLive = "LiveMessage"
+)
+
 // MessageSender represents the sender of the DDEX message
 type MessageSender struct {
-	XMLName     xml.Name  `xml:"MessageSender"`
-	PartyId     []PartyID `xml:"PartyId"`
-	PartyName   []Name    `xml:"PartyName,omitempty"`
-	TradingName string    `xml:"TradingName,omitempty"`
+	XMLName     xml.Name     `xml:"MessageSender" json:"-"`
+	PartyId     []PartyID    `xml:"PartyId" json:",omitempty"`
+	PartyName   []Name       `xml:"PartyName,omitempty" json:",omitempty"`
+	TradingName string       `xml:"TradingName,omitempty" json:",omitempty"`
+	Extensions  []RawElement `xml:",any" json:",omitempty"`
+}
+
+// SentOnBehalfOf represents the party the sender sends the message for, such as a label an
+// aggregator delivers on behalf of
+type SentOnBehalfOf struct {
+	XMLName     xml.Name     `xml:"SentOnBehalfOf" json:"-"`
+	PartyId     []PartyID    `xml:"PartyId" json:",omitempty"`
+	PartyName   []Name       `xml:"PartyName,omitempty" json:",omitempty"`
+	TradingName string       `xml:"TradingName,omitempty" json:",omitempty"`
+	Extensions  []RawElement `xml:",any" json:",omitempty"`
 }
 
 // MessageRecipient represents the recipient of the DDEX message
 type MessageRecipient struct {
-	XMLName     xml.Name  `xml:"MessageRecipient"`
-	PartyId     []PartyID `xml:"PartyId"`
-	PartyName   []Name    `xml:"PartyName,omitempty"`
-	TradingName string    `xml:"TradingName,omitempty"`
+	XMLName     xml.Name     `xml:"MessageRecipient" json:"-"`
+	PartyId     []PartyID    `xml:"PartyId" json:",omitempty"`
+	PartyName   []Name       `xml:"PartyName,omitempty" json:",omitempty"`
+	TradingName string       `xml:"TradingName,omitempty" json:",omitempty"`
+	Extensions  []RawElement `xml:",any" json:",omitempty"`
 }
 
 // MessageAuditTrail represents audit trail information for the message
 type MessageAuditTrail struct {
-	XMLName                xml.Name                 `xml:"MessageAuditTrail"`
-	MessageAuditTrailEvent []MessageAuditTrailEvent `xml:"MessageAuditTrailEvent"`
+	XMLName                xml.Name                 `xml:"MessageAuditTrail" json:"-"`
+	MessageAuditTrailEvent []MessageAuditTrailEvent `xml:"MessageAuditTrailEvent" json:",omitempty"`
+	Extensions             []RawElement             `xml:",any" json:",omitempty"`
 }
 
 // MessageAuditTrailEvent represents a single audit trail event
 type MessageAuditTrailEvent struct {
-	XMLName                        xml.Name  `xml:"MessageAuditTrailEvent"`
-	MessagingPartyReference        string    `xml:"MessagingPartyReference"`
-	MessageAuditTrailEventDateTime *DateTime `xml:"MessageAuditTrailEventDateTime"`
-	MessageAuditTrailEventTypeCode string    `xml:"MessageAuditTrailEventTypeCode"`
+	XMLName                        xml.Name     `xml:"MessageAuditTrailEvent" json:"-"`
+	MessagingPartyReference        string       `xml:"MessagingPartyReference" json:",omitempty"`
+	MessageAuditTrailEventDateTime *DateTime    `xml:"MessageAuditTrailEventDateTime" json:",omitempty"`
+	MessageAuditTrailEventTypeCode string       `xml:"MessageAuditTrailEventTypeCode" json:",omitempty"`
+	Extensions                     []RawElement `xml:",any" json:",omitempty"`
 }
 
 // NewMessageHeader creates a new MessageHeader with required fields for YouTube DDEX
@@ -60,12 +81,12 @@ func NewMessageHeader(threadId, messageId string, sender *MessageSender) *Messag
 		MessageId:              messageId,
 		MessageSender:          sender,
 		MessageCreatedDateTime: now,
-		MessageControlType:     "TestMessage", // Default to test, should be changed for production
+		MessageControlType:     MessageControlTypeTest, // Default to test, should be changed for production
 	}
 }
 
 func (m *MessageHeader) AddMessageRecipient(recipient *MessageRecipient) {
-    m.MessageRecipient = append(m.MessageRecipient, recipient)
+	m.MessageRecipient = append(m.MessageRecipient, recipient)
 }
 
 // NewMessageSender creates a new MessageSender with DPID for YouTube
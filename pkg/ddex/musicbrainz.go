@@ -0,0 +1,282 @@
+package ddex
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MusicBrainzRelease is the subset of a MusicBrainz web service release
+// (/ws/2/release/<mbid>?inc=artist-credits+labels+recordings+isrcs+release-groups&fmt=json)
+// used to bootstrap a DDEX release
+type MusicBrainzRelease struct {
+	ID                 string                         `json:"id"`
+	Title              string                         `json:"title"`
+	Disambiguation     string                         `json:"disambiguation,omitempty"`
+	Status             string                         `json:"status,omitempty"`
+	Date               string                         `json:"date,omitempty"` // YYYY, YYYY-MM or YYYY-MM-DD
+	Country            string                         `json:"country,omitempty"`
+	Barcode            string                         `json:"barcode,omitempty"`
+	ArtistCredit       []MusicBrainzArtistCredit      `json:"artist-credit,omitempty"`
+	LabelInfo          []MusicBrainzLabelInfo         `json:"label-info,omitempty"`
+	ReleaseGroup       *MusicBrainzReleaseGroup       `json:"release-group,omitempty"`
+	Media              []MusicBrainzMedium            `json:"media,omitempty"`
+	TextRepresentation *MusicBrainzTextRepresentation `json:"text-representation,omitempty"`
+}
+
+// MusicBrainzArtistCredit is one entry of an artist credit; the display name is the
+// concatenation of each entry's Name and JoinPhrase
+type MusicBrainzArtistCredit struct {
+	Name       string            `json:"name"`
+	JoinPhrase string            `json:"joinphrase,omitempty"`
+	Artist     MusicBrainzArtist `json:"artist"`
+}
+
+// MusicBrainzArtist identifies a MusicBrainz artist
+type MusicBrainzArtist struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	SortName string `json:"sort-name,omitempty"`
+}
+
+// MusicBrainzLabelInfo holds a release label and its catalog number
+type MusicBrainzLabelInfo struct {
+	CatalogNumber string            `json:"catalog-number,omitempty"`
+	Label         *MusicBrainzLabel `json:"label,omitempty"`
+}
+
+// MusicBrainzLabel identifies a MusicBrainz label
+type MusicBrainzLabel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// MusicBrainzReleaseGroup carries the release type information
+type MusicBrainzReleaseGroup struct {
+	ID             string   `json:"id"`
+	Title          string   `json:"title,omitempty"`
+	PrimaryType    string   `json:"primary-type,omitempty"`
+	SecondaryTypes []string `json:"secondary-types,omitempty"`
+}
+
+// MusicBrainzMedium is a disc or other medium of a release
+type MusicBrainzMedium struct {
+	Position int                `json:"position"`
+	Format   string             `json:"format,omitempty"`
+	Tracks   []MusicBrainzTrack `json:"tracks,omitempty"`
+}
+
+// MusicBrainzTrack is a track on a medium
+type MusicBrainzTrack struct {
+	ID           string                    `json:"id"`
+	Number       string                    `json:"number,omitempty"`
+	Position     int                       `json:"position"`
+	Title        string                    `json:"title"`
+	Length       *int                      `json:"length,omitempty"` // Milliseconds
+	ArtistCredit []MusicBrainzArtistCredit `json:"artist-credit,omitempty"`
+	Recording    MusicBrainzRecording      `json:"recording"`
+}
+
+// MusicBrainzRecording is the recording behind a track
+type MusicBrainzRecording struct {
+	ID           string                    `json:"id"`
+	Title        string                    `json:"title"`
+	Length       *int                      `json:"length,omitempty"` // Milliseconds
+	Video        bool                      `json:"video,omitempty"`
+	ISRCs        []string                  `json:"isrcs,omitempty"`
+	ArtistCredit []MusicBrainzArtistCredit `json:"artist-credit,omitempty"`
+}
+
+// MusicBrainzTextRepresentation holds the release language (ISO 639-3) and script
+type MusicBrainzTextRepresentation struct {
+	Language string `json:"language,omitempty"`
+	Script   string `json:"script,omitempty"`
+}
+
+// MusicBrainzOptions configures how a MusicBrainz release is mapped
+type MusicBrainzOptions struct {
+	ReleaseReference string   // Defaults to R1
+	Territories      []string // Defaults to Worldwide
+	Language         string   // Defaults to the release language if known, else en
+}
+
+// ParseMusicBrainzRelease parses a MusicBrainz web service release JSON document
+func ParseMusicBrainzRelease(data []byte) (*MusicBrainzRelease, error) {
+	var release MusicBrainzRelease
+	if err := json.Unmarshal(data, &release); err != nil {
+		return nil, fmt.Errorf("failed to parse MusicBrainz release: %w", err)
+	}
+	return &release, nil
+}
+
+// ManifestRelease maps the MusicBrainz release onto a ManifestRelease: titles, artist
+// credits, label and catalog number, barcode, release date, track order across media and
+// ISRCs. Year-only and year-month release dates are kept as they are.
+// Tracks without a length are mapped with an empty duration and must be completed
+// before the message will validate.
+func (mb *MusicBrainzRelease) ManifestRelease(opts MusicBrainzOptions) ManifestRelease {
+	release := ManifestRelease{
+		Reference:     opts.ReleaseReference,
+		Type:          mb.releaseType(),
+		Title:         mb.Title,
+		Subtitle:      mb.Disambiguation,
+		ICPN:          mb.Barcode,
+		Territories:   opts.Territories,
+		Language:      opts.Language,
+		DisplayArtist: musicBrainzCreditName(mb.ArtistCredit),
+		Artists:       musicBrainzArtists(mb.ArtistCredit),
+	}
+
+	if release.Language == "" && mb.TextRepresentation != nil {
+		release.Language = musicBrainzLanguages[mb.TextRepresentation.Language]
+	}
+
+	// A ReleaseDate can be partial (YYYY or YYYY-MM) like MusicBrainz dates
+	if (EventDate{Value: mb.Date}).Validate() == nil {
+		release.ReleaseDate = mb.Date
+	}
+
+	for _, info := range mb.LabelInfo {
+		if info.Label != nil && release.Label == "" {
+			release.Label = info.Label.Name
+		}
+		if info.CatalogNumber != "" && release.CatalogNumber == "" {
+			release.CatalogNumber = info.CatalogNumber
+		}
+	}
+
+	media := make([]MusicBrainzMedium, len(mb.Media))
+	copy(media, mb.Media)
+	sort.SliceStable(media, func(i, j int) bool { return media[i].Position < media[j].Position })
+
+	for _, medium := range media {
+		tracks := make([]MusicBrainzTrack, len(medium.Tracks))
+		copy(tracks, medium.Tracks)
+		sort.SliceStable(tracks, func(i, j int) bool { return tracks[i].Position < tracks[j].Position })
+
+		for _, track := range tracks {
+			release.Tracks = append(release.Tracks, musicBrainzTrack(track, release.DisplayArtist))
+		}
+	}
+
+	return release
+}
+
+// AddMusicBrainzRelease adds the release's recordings as resources and the release itself,
+// returning the pre-filled ReleaseBuilder so callers can add what MusicBrainz doesn't carry
+// (P/C lines, genre, deals)
+func (b *Builder) AddMusicBrainzRelease(mb *MusicBrainzRelease, opts MusicBrainzOptions) (*ReleaseBuilder, error) {
+	if mb == nil {
+		return nil, fmt.Errorf("MusicBrainz release is nil")
+	}
+	if mb.Title == "" {
+		return nil, fmt.Errorf("MusicBrainz release %s has no title", mb.ID)
+	}
+
+	release := mb.ManifestRelease(opts)
+	if len(release.Tracks) == 0 {
+		return nil, fmt.Errorf("MusicBrainz release %s has no tracks; request it with inc=recordings", mb.ID)
+	}
+
+	// MusicBrainz releases carry no image assets, so no proprietary ID namespace is needed
+	return b.addManifestRelease(release, ""), nil
+}
+
+// releaseType maps the release group's primary type onto a DDEX ReleaseType
+func (mb *MusicBrainzRelease) releaseType() string {
+	if mb.ReleaseGroup == nil {
+		return "Album"
+	}
+	switch mb.ReleaseGroup.PrimaryType {
+	case "Single":
+		return "Single"
+	case "EP":
+		return "EP"
+	default:
+		return "Album"
+	}
+}
+
+// musicBrainzTrack maps a track, preferring the track's own title and artist credit
+// over the recording's as MusicBrainz does when displaying a tracklist
+func musicBrainzTrack(track MusicBrainzTrack, releaseArtist string) ManifestTrack {
+	result := ManifestTrack{
+		Kind:  ManifestTrackSoundRecording,
+		Type:  "MusicalWorkSoundRecording",
+		Title: track.Title,
+	}
+	if result.Title == "" {
+		result.Title = track.Recording.Title
+	}
+	if track.Recording.Video {
+		result.Kind = ManifestTrackVideo
+		result.Type = "ShortFormMusicalWorkVideo"
+	}
+	if len(track.Recording.ISRCs) > 0 {
+		result.ISRC = track.Recording.ISRCs[0]
+	}
+
+	length := track.Length
+	if length == nil {
+		length = track.Recording.Length
+	}
+	if length != nil {
+		result.Duration = FormatDuration(float64(*length) / 1000)
+	}
+
+	credit := track.ArtistCredit
+	if len(credit) == 0 {
+		credit = track.Recording.ArtistCredit
+	}
+	if name := musicBrainzCreditName(credit); name != "" && name != releaseArtist {
+		result.DisplayArtist = name
+		result.Artists = musicBrainzArtists(credit)
+	}
+
+	return result
+}
+
+// musicBrainzCreditName joins an artist credit into its display name
+func musicBrainzCreditName(credit []MusicBrainzArtistCredit) string {
+	var sb strings.Builder
+	for _, entry := range credit {
+		name := entry.Name
+		if name == "" {
+			name = entry.Artist.Name
+		}
+		sb.WriteString(name)
+		sb.WriteString(entry.JoinPhrase)
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// musicBrainzArtists lists each credited artist as a MainArtist
+func musicBrainzArtists(credit []MusicBrainzArtistCredit) []ManifestArtist {
+	var artists []ManifestArtist
+	for _, entry := range credit {
+		name := entry.Artist.Name
+		if name == "" {
+			name = entry.Name
+		}
+		artists = append(artists, ManifestArtist{Name: name, Roles: []string{"MainArtist"}})
+	}
+	return artists
+}
+
+// musicBrainzLanguages maps common ISO 639-3 codes used by MusicBrainz to the
+// ISO 639-1 codes used in LanguageAndScriptCode
+var musicBrainzLanguages = map[string]string{
+	"eng": "en",
+	"spa": "es",
+	"por": "pt",
+	"fra": "fr",
+	"deu": "de",
+	"ita": "it",
+	"nld": "nl",
+	"swe": "sv",
+	"jpn": "ja",
+	"kor": "ko",
+	"zho": "zh",
+	"rus": "ru",
+}
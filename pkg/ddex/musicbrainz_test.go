@@ -0,0 +1,41 @@
+package ddex
+
+import "testing"
+
+func TestMusicBrainzReleaseDate(t *testing.T) {
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2019-06-14", "2019-06-14"},
+		{"2019-06", "2019-06"},
+		{"1979", "1979"},
+		{"", ""},
+		{"2019-13", ""},
+		{"June 2019", ""},
+	}
+	for _, tt := range tests {
+		mb := &MusicBrainzRelease{
+			Title: "Album",
+			Date:  tt.date,
+			Media: []MusicBrainzMedium{{Position: 1, Tracks: []MusicBrainzTrack{{Position: 1, Title: "Track"}}}},
+		}
+		if got := mb.ManifestRelease(MusicBrainzOptions{}).ReleaseDate; got != tt.want {
+			t.Errorf("date %q: ReleaseDate = %q, want %q", tt.date, got, tt.want)
+		}
+
+		builder := NewDDEXBuilder()
+		rb, err := builder.AddMusicBrainzRelease(mb, MusicBrainzOptions{Territories: []string{"Worldwide"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		details := rb.release().ReleaseDetailsByTerritory
+		var got string
+		if len(details) > 0 && details[0].ReleaseDate != nil {
+			got = details[0].ReleaseDate.Value
+		}
+		if got != tt.want {
+			t.Errorf("date %q: built ReleaseDate = %q, want %q", tt.date, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package ddex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReplacePLine sets the P line of every release to a single one and replaces the P lines
+// of every territory branch of releases, sound recordings and videos that has any. It
+// returns the number of P line lists replaced.
+func (nrm *NewReleaseMessage) ReplacePLine(year int, text string) int {
+	pline := func() []PLine {
+		return []PLine{{Year: year, PLineText: text}}
+	}
+	replaced := 0
+
+	if nrm.ReleaseList != nil {
+		for i := range nrm.ReleaseList.Release {
+			release := &nrm.ReleaseList.Release[i]
+			release.PLine = pline()
+			replaced++
+			for j := range release.ReleaseDetailsByTerritory {
+				if details := &release.ReleaseDetailsByTerritory[j]; len(details.PLine) > 0 {
+					details.PLine = pline()
+					replaced++
+				}
+			}
+		}
+	}
+
+	if nrm.ResourceList != nil {
+		for i := range nrm.ResourceList.SoundRecording {
+			recording := &nrm.ResourceList.SoundRecording[i]
+			for j := range recording.SoundRecordingDetailsByTerritory {
+				if details := &recording.SoundRecordingDetailsByTerritory[j]; len(details.PLine) > 0 {
+					details.PLine = pline()
+					replaced++
+				}
+			}
+		}
+		for i := range nrm.ResourceList.Video {
+			video := &nrm.ResourceList.Video[i]
+			for j := range video.VideoDetailsByTerritory {
+				if details := &video.VideoDetailsByTerritory[j]; len(details.PLine) > 0 {
+					details.PLine = pline()
+					replaced++
+				}
+			}
+		}
+	}
+
+	return replaced
+}
+
+// ReplaceCLine sets the C line of every release to a single one and replaces the C lines
+// of every territory branch of releases, videos and images that has any. It returns the
+// number of C line lists replaced.
+func (nrm *NewReleaseMessage) ReplaceCLine(year int, text string) int {
+	cline := func() []CLine {
+		return []CLine{{Year: year, CLineText: text}}
+	}
+	replaced := 0
+
+	if nrm.ReleaseList != nil {
+		for i := range nrm.ReleaseList.Release {
+			release := &nrm.ReleaseList.Release[i]
+			release.CLine = cline()
+			replaced++
+			for j := range release.ReleaseDetailsByTerritory {
+				if details := &release.ReleaseDetailsByTerritory[j]; len(details.CLine) > 0 {
+					details.CLine = cline()
+					replaced++
+				}
+			}
+		}
+	}
+
+	if nrm.ResourceList != nil {
+		for i := range nrm.ResourceList.Video {
+			video := &nrm.ResourceList.Video[i]
+			for j := range video.VideoDetailsByTerritory {
+				if details := &video.VideoDetailsByTerritory[j]; len(details.CLine) > 0 {
+					details.CLine = cline()
+					replaced++
+				}
+			}
+		}
+		for i := range nrm.ResourceList.Image {
+			image := &nrm.ResourceList.Image[i]
+			for j := range image.ImageDetailsByTerritory {
+				if details := &image.ImageDetailsByTerritory[j]; len(details.CLine) > 0 {
+					details.CLine = cline()
+					replaced++
+				}
+			}
+		}
+	}
+
+	return replaced
+}
+
+// AddTerritoryToAllDeals extends every deal to a territory: it is added to the deal's
+// TerritoryCode list, or removed from its ExcludedTerritoryCode list for deals granted by
+// exclusion. Deals already covering the territory, take-downs and cancellations are left
+// alone. It returns the number of deals changed; releases need ReleaseDetailsByTerritory
+// for the territory too (see CheckDealTerritories).
+func (nrm *NewReleaseMessage) AddTerritoryToAllDeals(territory string) int {
+	if nrm.DealList == nil {
+		return 0
+	}
+
+	changed := 0
+	for i := range nrm.DealList.ReleaseDeal {
+		releaseDeal := &nrm.DealList.ReleaseDeal[i]
+		for j := range releaseDeal.Deal {
+			terms := releaseDeal.Deal[j].DealTerms
+			if terms == nil || terms.TakeDown != nil && *terms.TakeDown || terms.AllDealsCancelled != nil && *terms.AllDealsCancelled {
+				continue
+			}
+			if territoryMatch(terms.TerritoryCode, terms.ExcludedTerritoryCode, territory) > 0 {
+				continue
+			}
+
+			if len(terms.ExcludedTerritoryCode) > 0 {
+				var excluded []string
+				for _, code := range terms.ExcludedTerritoryCode {
+					if !strings.EqualFold(code, territory) {
+						excluded = append(excluded, code)
+					}
+				}
+				terms.ExcludedTerritoryCode = excluded
+				if len(terms.TerritoryCode) == 0 {
+					terms.TerritoryCode = []string{"Worldwide"}
+				}
+			} else {
+				terms.TerritoryCode = append(terms.TerritoryCode, territory)
+			}
+			changed++
+		}
+	}
+	return changed
+}
+
+// RetitleRelease changes the title of a release: its ReferenceTitle and every display
+// title and territory title with the old title text. Localized titles differing from the
+// old title, and subtitles, are kept. Resource groups titled after the release are renamed
+// too.
+func (nrm *NewReleaseMessage) RetitleRelease(releaseRef, title string) error {
+	var release *Release
+	if nrm.ReleaseList != nil {
+		for i := range nrm.ReleaseList.Release {
+			if nrm.ReleaseList.Release[i].ReleaseReference == releaseRef {
+				release = &nrm.ReleaseList.Release[i]
+				break
+			}
+		}
+	}
+	if release == nil {
+		return fmt.Errorf("release %s not found", releaseRef)
+	}
+
+	if release.ReferenceTitle == nil {
+		release.ReferenceTitle = &ReferenceTitle{}
+	}
+	old := release.ReferenceTitle.TitleText
+	release.ReferenceTitle.TitleText = title
+
+	retitle := func(text *string) {
+		if old == "" || *text == old {
+			*text = title
+		}
+	}
+	for i := range release.DisplayTitleText {
+		retitle(&release.DisplayTitleText[i].Value)
+	}
+	for i := range release.DisplayTitle {
+		for j := range release.DisplayTitle[i].TitleText {
+			retitle(&release.DisplayTitle[i].TitleText[j].Value)
+		}
+	}
+	for i := range release.ReleaseDetailsByTerritory {
+		details := &release.ReleaseDetailsByTerritory[i]
+		for j := range details.Title {
+			retitle(&details.Title[j].TitleText)
+		}
+		for j := range details.ResourceGroup {
+			if old != "" && details.ResourceGroup[j].Title.TitleText == old {
+				details.ResourceGroup[j].Title.TitleText = title
+			}
+		}
+	}
+	return nil
+}
+
+// RollUpParentalWarnings sets the ParentalWarningType of every ReleaseDetailsByTerritory
+// entry from the sound recordings and videos of the release, as they apply in the entry's
+// (first) territory: Explicit if any of them is explicit, else ExplicitContentEdited if any
+// is an edited version, else NotExplicit if all are marked not explicit, else
+// NoAdviceAvailable. Releases without sound recordings or videos are left alone. It
+// returns the number of entries changed.
+func (nrm *NewReleaseMessage) RollUpParentalWarnings() int {
+	if nrm.ReleaseList == nil {
+		return 0
+	}
+
+	index := nrm.Index()
+	changed := 0
+	for i := range nrm.ReleaseList.Release {
+		release := &nrm.ReleaseList.Release[i]
+		tracks := releaseTracks(release, index)
+		if len(tracks) == 0 {
+			continue
+		}
+
+		for j := range release.ReleaseDetailsByTerritory {
+			details := &release.ReleaseDetailsByTerritory[j]
+			territory := "Worldwide"
+			if len(details.TerritoryCode) > 0 {
+				territory = details.TerritoryCode[0]
+			}
+
+			explicit, edited, notExplicit := false, false, 0
+			for _, track := range tracks {
+				marked := false
+				for _, warning := range trackParentalWarnings(track, territory) {
+					switch warning {
+					case ParentalWarningExplicit:
+						explicit = true
+					case ParentalWarningExplicitContentEdited:
+						edited = true
+					case ParentalWarningNotExplicit:
+						marked = true
+					}
+				}
+				if marked {
+					notExplicit++
+				}
+			}
+
+			warning := ParentalWarningNoAdviceAvailable
+			switch {
+			case explicit:
+				warning = ParentalWarningExplicit
+			case edited:
+				warning = ParentalWarningExplicitContentEdited
+			case notExplicit >= len(tracks):
+				warning = ParentalWarningNotExplicit
+			}
+			if len(details.ParentalWarningType) == 1 && details.ParentalWarningType[0].Value == warning {
+				continue
+			}
+			details.ParentalWarningType = []ParentalWarningType{{Value: warning}}
+			changed++
+		}
+	}
+	return changed
+}
+
+// releaseTracks returns the sound recordings and videos a release lists, each once
+func releaseTracks(release *Release, index *MessageIndex) []IndexedResource {
+	var tracks []IndexedResource
+	seen := make(map[string]bool)
+	add := func(ref string) {
+		resource, ok := index.Resources[ref]
+		if !ok || seen[ref] || resource.SoundRecording == nil && resource.Video == nil {
+			return
+		}
+		seen[ref] = true
+		tracks = append(tracks, resource)
+	}
+
+	if release.ReleaseResourceReferenceList != nil {
+		for _, ref := range release.ReleaseResourceReferenceList.ReleaseResourceReference {
+			add(ref.Value)
+		}
+	}
+	for _, details := range release.ReleaseDetailsByTerritory {
+		for _, group := range details.ResourceGroup {
+			for _, item := range group.ResourceGroupContentItem {
+				add(item.ReleaseResourceReference.Value)
+			}
+		}
+	}
+	return tracks
+}
+
+// trackParentalWarnings returns the parental warnings of a sound recording or video in a
+// territory
+func trackParentalWarnings(track IndexedResource, territory string) []string {
+	switch {
+	case track.SoundRecording != nil:
+		details, _ := track.SoundRecording.EffectiveDetails(territory)
+		return details.ParentalWarningType
+	case track.Video != nil:
+		details, _ := track.Video.EffectiveDetails(territory)
+		return details.ParentalWarningType
+	}
+	return nil
+}
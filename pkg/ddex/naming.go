@@ -0,0 +1,159 @@
+package ddex
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Delivery layout following the DDEX ERN choreography for file-based deliveries:
+//
+//	<batch>/                       BatchFolderName, e.g. 20240131154500123
+//	  <ICPN>/                      ReleaseFolderName
+//	    <ICPN>.xml                 MessageFileName
+//	    resources/                 ResourcesFolder
+//	      <ICPN>_01_001.flac       ResourceFileName
+//	      <ICPN>.jpg               ImageFileName
+//	  Checksums_<batch>.sha256     ChecksumManifestFileName (optional)
+//	  BatchComplete_<batch>.xml    BatchCompleteFileName
+
+// ResourcesFolder is the subfolder of a release folder holding the resource files
+const ResourcesFolder = "resources"
+
+// BatchFolderName returns the batch folder name for t: the UTC timestamp with
+// millisecond precision (YYYYMMDDhhmmssnnn)
+func BatchFolderName(t time.Time) string {
+	t = t.UTC()
+	return t.Format("20060102150405") + fmt.Sprintf("%03d", t.Nanosecond()/int(time.Millisecond))
+}
+
+// deliveryIdentifierPattern matches identifiers that are safe as folder and file names
+var deliveryIdentifierPattern = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+
+// ReleaseFolderName returns the folder name of a release, which is its identifier (normally the ICPN).
+// The identifier is used as is; DeliveryIdentifier returns only letters and digits.
+func ReleaseFolderName(identifier string) string {
+	return identifier
+}
+
+// MessageFileName returns the file name of the message describing a release
+func MessageFileName(identifier string) string {
+	return identifier + ".xml"
+}
+
+// ResourceFileName returns the file name of a track resource: <ICPN>_<disc>_<track>.<ext>
+// with the disc padded to two and the track to three digits
+func ResourceFileName(identifier string, discNumber, trackNumber int, extension string) string {
+	return fmt.Sprintf("%s_%02d_%03d.%s", identifier, discNumber, trackNumber, strings.TrimPrefix(extension, "."))
+}
+
+// ImageFileName returns the file name of the release's front cover image: <ICPN>.<ext>
+func ImageFileName(identifier, extension string) string {
+	return identifier + "." + strings.TrimPrefix(extension, ".")
+}
+
+// BatchCompleteFileName returns the name of the file signalling a batch is complete
+func BatchCompleteFileName(batchId string) string {
+	return "BatchComplete_" + batchId + ".xml"
+}
+
+// DeliveryIdentifier returns the identifier naming the message's delivery folder: the ICPN
+// of the main release, falling back to its GRid and then its ISRC (video singles). Hyphens
+// are removed; an identifier with characters other than letters and digits is an error, so
+// it can't name a path outside the delivery folder.
+func (nrm *NewReleaseMessage) DeliveryIdentifier() (string, error) {
+	release := nrm.mainRelease()
+	if release == nil {
+		return "", fmt.Errorf("message has no releases")
+	}
+
+	for _, pick := range []func(ReleaseId) string{
+		func(id ReleaseId) string { return id.ICPN },
+		func(id ReleaseId) string { return id.GRid },
+		func(id ReleaseId) string { return id.ISRC },
+	} {
+		for _, id := range release.ReleaseId {
+			value := pick(id)
+			if value == "" {
+				continue
+			}
+			identifier := strings.ReplaceAll(value, "-", "")
+			if !deliveryIdentifierPattern.MatchString(identifier) {
+				return "", fmt.Errorf("main release %s: identifier %q can't name a delivery folder: it may only contain letters and digits",
+					release.ReleaseReference, value)
+			}
+			return identifier, nil
+		}
+	}
+
+	return "", fmt.Errorf("main release %s has no ICPN, GRid or ISRC to name the delivery", release.ReleaseReference)
+}
+
+// ResourceFiles returns the FileName of every technical details File in the message,
+// in document order and without duplicates
+func (nrm *NewReleaseMessage) ResourceFiles() []string {
+	if nrm.ResourceList == nil {
+		return nil
+	}
+
+	var files []string
+	seen := make(map[string]bool)
+	add := func(file *File) {
+		if file != nil && file.FileName != "" && !seen[file.FileName] {
+			seen[file.FileName] = true
+			files = append(files, file.FileName)
+		}
+	}
+
+	for _, recording := range nrm.ResourceList.SoundRecording {
+		for _, details := range recording.SoundRecordingDetailsByTerritory {
+			for _, technical := range details.TechnicalSoundRecordingDetails {
+				add(technical.File)
+			}
+		}
+	}
+	for _, video := range nrm.ResourceList.Video {
+		for _, details := range video.VideoDetailsByTerritory {
+			for _, technical := range details.TechnicalVideoDetails {
+				add(technical.File)
+			}
+		}
+	}
+	for _, image := range nrm.ResourceList.Image {
+		for _, details := range image.ImageDetailsByTerritory {
+			for _, technical := range details.TechnicalImageDetails {
+				add(technical.File)
+			}
+		}
+	}
+
+	return files
+}
+
+// WriteToDelivery writes the message into dir following the DDEX delivery layout: it creates
+// <dir>/<ICPN>/ with a resources/ subfolder and writes <ICPN>.xml. Resource files are not
+// copied. It returns the path of the written message.
+func (b *Builder) WriteToDelivery(dir string) (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+	identifier, err := b.Message.DeliveryIdentifier()
+	if err != nil {
+		return "", err
+	}
+
+	releaseDir := filepath.Join(dir, ReleaseFolderName(identifier))
+	if err := os.MkdirAll(filepath.Join(releaseDir, ResourcesFolder), 0755); err != nil {
+		return "", fmt.Errorf("failed to create delivery folder: %w", err)
+	}
+
+	path := filepath.Join(releaseDir, MessageFileName(identifier))
+	if err := b.WriteToFile(path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
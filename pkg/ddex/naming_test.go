@@ -0,0 +1,91 @@
+package ddex
+
+import (
+	"os"
+	"testing"
+)
+
+func TestDeliveryIdentifier(t *testing.T) {
+	tests := []struct {
+		name     string
+		releases []Release
+		want     string
+		wantErr  bool
+	}{
+		{name: "no releases", wantErr: true},
+		{
+			name:     "ICPN",
+			releases: []Release{{ReleaseReference: "R0", ReleaseId: []ReleaseId{{ICPN: "0123456789012", GRid: "A10302B0001234567X"}}}},
+			want:     "0123456789012",
+		},
+		{
+			name: "main release",
+			releases: []Release{
+				{ReleaseReference: "R1", ReleaseId: []ReleaseId{{ICPN: "0123456789029"}}},
+				{ReleaseReference: "R0", IsMainRelease: true, ReleaseId: []ReleaseId{{ICPN: "0123456789012"}}},
+			},
+			want: "0123456789012",
+		},
+		{
+			name:     "GRid with hyphens",
+			releases: []Release{{ReleaseReference: "R0", ReleaseId: []ReleaseId{{GRid: "A1-0302B-0001234567-X"}}}},
+			want:     "A10302B0001234567X",
+		},
+		{
+			name:     "ISRC",
+			releases: []Release{{ReleaseReference: "R0", ReleaseId: []ReleaseId{{ISRC: "US-RC1-24-00001"}}}},
+			want:     "USRC12400001",
+		},
+		{
+			name:     "no identifier",
+			releases: []Release{{ReleaseReference: "R0", ReleaseId: []ReleaseId{{CatalogNumber: &CatalogNumber{Value: "CAT1"}}}}},
+			wantErr:  true,
+		},
+		{
+			name:     "parent folder",
+			releases: []Release{{ReleaseReference: "R0", ReleaseId: []ReleaseId{{ICPN: "../../etc"}}}},
+			wantErr:  true,
+		},
+		{
+			name:     "absolute path",
+			releases: []Release{{ReleaseReference: "R0", ReleaseId: []ReleaseId{{ICPN: "/tmp/x"}}}},
+			wantErr:  true,
+		},
+		{
+			name:     "separator",
+			releases: []Release{{ReleaseReference: "R0", ReleaseId: []ReleaseId{{GRid: `A1\\0302B`}}}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := &NewReleaseMessage{}
+			if tt.releases != nil {
+				message.ReleaseList = &ReleaseList{Release: tt.releases}
+			}
+			got, err := message.DeliveryIdentifier()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeliveryIdentifier error = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DeliveryIdentifier = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteToDeliveryRejectsUnsafeIdentifier(t *testing.T) {
+	dir := t.TempDir()
+	builder := NewDDEXBuilder()
+	builder.AddRelease("R0", "Album").WithICPN("../escaped")
+	if path, err := builder.WriteToDelivery(dir); err == nil {
+		t.Fatalf("WriteToDelivery wrote %s, want an error", path)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("delivery folder has %d entries, want none", len(entries))
+	}
+}
@@ -1,52 +1,62 @@
 package ddex
 
 import (
+	"bytes"
+	"context"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 )
 
 // NewReleaseMessage represents the complete DDEX ERN 3.8 NewReleaseMessage structure
 // specifically configured for YouTube delivery
 type NewReleaseMessage struct {
-	XMLName                xml.Name        `xml:"ern:NewReleaseMessage"`
-	XmlnsErn               string          `xml:"xmlns:ern,attr"`
-	XmlnsXsi               string          `xml:"xmlns:xsi,attr,omitempty"`
-	XsiSchemaLocation      string          `xml:"xsi:schemaLocation,attr,omitempty"`
-	MessageSchemaVersionId string          `xml:"MessageSchemaVersionId,attr"`
-	LanguageAndScriptCode  string          `xml:"LanguageAndScriptCode,attr,omitempty"`
-	MessageHeader          *MessageHeader  `xml:"MessageHeader"`
-	UpdateIndicator        string          `xml:"UpdateIndicator,omitempty"` // Deprecated: OriginalMessage or UpdateMessage
-	ResourceList           *ResourceList   `xml:"ResourceList,omitempty"`
-	CollectionList         *CollectionList `xml:"CollectionList,omitempty"`
-	ReleaseList            *ReleaseList    `xml:"ReleaseList"`
-	DealList               *DealList       `xml:"DealList"`
+	XMLName                xml.Name        `xml:"ern:NewReleaseMessage" json:"-"`
+	XmlnsErn               string          `xml:"xmlns:ern,attr" json:",omitempty"`
+	XmlnsXsi               string          `xml:"xmlns:xsi,attr,omitempty" json:",omitempty"`
+	XsiSchemaLocation      string          `xml:"xsi:schemaLocation,attr,omitempty" json:",omitempty"`
+	MessageSchemaVersionId string          `xml:"MessageSchemaVersionId,attr" json:",omitempty"`
+	LanguageAndScriptCode  string          `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+	OtherAttr              []xml.Attr      `xml:",any,attr" json:",omitempty"` // Other namespace declarations and attributes of the root, kept by FromXML
+	MessageHeader          *MessageHeader  `xml:"MessageHeader" json:",omitempty"`
+	UpdateIndicator        string          `xml:"UpdateIndicator,omitempty" json:",omitempty"` // Deprecated: OriginalMessage or UpdateMessage
+	ResourceList           *ResourceList   `xml:"ResourceList,omitempty" json:",omitempty"`
+	CollectionList         *CollectionList `xml:"CollectionList,omitempty" json:",omitempty"`
+	ReleaseList            *ReleaseList    `xml:"ReleaseList" json:",omitempty"`
+	DealList               *DealList       `xml:"DealList" json:",omitempty"`
+	Extensions             []RawElement    `xml:",any" json:",omitempty"`
 }
 
 // CollectionList represents collections (playlists, compilations)
 type CollectionList struct {
-	XMLName    xml.Name     `xml:"CollectionList"`
-	Collection []Collection `xml:"Collection"`
+	XMLName    xml.Name     `xml:"CollectionList" json:"-"`
+	Collection []Collection `xml:"Collection" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // Collection represents a collection of releases
 type Collection struct {
-	XMLName                      xml.Name                       `xml:"Collection"`
-	CollectionReference          string                         `xml:"CollectionReference"`
-	CollectionType               string                         `xml:"CollectionType,omitempty"`
-	CollectionId                 []ReleaseId                    `xml:"CollectionId,omitempty"`
-	DisplayTitleText             []TitleText                    `xml:"DisplayTitleText"`
-	DisplayArtistName            []string                       `xml:"DisplayArtistName,omitempty"`
-	DisplayArtist                []DisplayArtist                `xml:"DisplayArtist,omitempty"`
-	CollectionDetailsByTerritory []CollectionDetailsByTerritory `xml:"CollectionDetailsByTerritory,omitempty"`
+	XMLName                      xml.Name                       `xml:"Collection" json:"-"`
+	CollectionReference          string                         `xml:"CollectionReference" json:",omitempty"`
+	CollectionType               string                         `xml:"CollectionType,omitempty" json:",omitempty"`
+	CollectionId                 []ReleaseId                    `xml:"CollectionId,omitempty" json:",omitempty"`
+	DisplayTitleText             []TitleText                    `xml:"DisplayTitleText" json:",omitempty"`
+	DisplayArtistName            []string                       `xml:"DisplayArtistName,omitempty" json:",omitempty"`
+	DisplayArtist                []DisplayArtist                `xml:"DisplayArtist,omitempty" json:",omitempty"`
+	CollectionDetailsByTerritory []CollectionDetailsByTerritory `xml:"CollectionDetailsByTerritory,omitempty" json:",omitempty"`
+	Extensions                   []RawElement                   `xml:",any" json:",omitempty"`
 }
 
 // CollectionDetailsByTerritory represents territory-specific collection details
 type CollectionDetailsByTerritory struct {
-	XMLName           xml.Name    `xml:"CollectionDetailsByTerritory"`
-	TerritoryCode     string      `xml:"TerritoryCode"`
-	DisplayTitleText  []TitleText `xml:"DisplayTitleText,omitempty"`
-	DisplayArtistName []string    `xml:"DisplayArtistName,omitempty"`
-	Genre             []Genre     `xml:"Genre,omitempty"`
+	XMLName           xml.Name     `xml:"CollectionDetailsByTerritory" json:"-"`
+	TerritoryCode     string       `xml:"TerritoryCode" json:",omitempty"`
+	DisplayTitleText  []TitleText  `xml:"DisplayTitleText,omitempty" json:",omitempty"`
+	DisplayArtistName []string     `xml:"DisplayArtistName,omitempty" json:",omitempty"`
+	Genre             []Genre      `xml:"Genre,omitempty" json:",omitempty"`
+	Extensions        []RawElement `xml:",any" json:",omitempty"`
 }
 
 // YouTube-specific constants for ERN 3.8
@@ -154,58 +164,184 @@ func (b *NewReleaseMessageBuilder) Build() *NewReleaseMessage {
 
 // ToXML converts the NewReleaseMessage to XML
 func (nrm *NewReleaseMessage) ToXML() ([]byte, error) {
-	return xml.MarshalIndent(nrm, "", "  ")
+	return marshalXML(nrm, "  ", false)
 }
 
 // ToXMLWithHeader converts the NewReleaseMessage to XML with XML declaration
 func (nrm *NewReleaseMessage) ToXMLWithHeader() ([]byte, error) {
-	xmlData, err := nrm.ToXML()
-	if err != nil {
-		return nil, err
-	}
-
-	header := `<?xml version="1.0" encoding="UTF-8"?>` + "\n"
-	return append([]byte(header), xmlData...), nil
+	return marshalXML(nrm, "  ", true)
 }
 
-// FromXML parses XML data into a NewReleaseMessage
+// FromXML parses XML data into a NewReleaseMessage. Gzip-compressed data is detected and
+// decompressed first. Messages of another ERN version than 3.8 fail with an
+// *ErrUnsupportedVersion (see DetectVersion).
 func FromXML(data []byte) (*NewReleaseMessage, error) {
+	return FromXMLContext(context.Background(), data)
+}
+
+// FromXMLContext is FromXML for large files: parsing stops with the context's error once
+// ctx is cancelled or times out
+func FromXMLContext(ctx context.Context, data []byte) (*NewReleaseMessage, error) {
+	return FromXMLLimit(ctx, data, 0)
+}
+
+// FromXMLLimit is FromXMLContext for untrusted input: gzip-compressed data that
+// decompresses to more than maxSize bytes fails with an *ErrDecompressedTooLarge. A
+// maxSize of 0 or less means no limit. Gzip data is decompressed as it is parsed, so a
+// cancelled context also stops the decompression.
+func FromXMLLimit(ctx context.Context, data []byte, maxSize int64) (*NewReleaseMessage, error) {
+	var source io.Reader = bytes.NewReader(data)
+	if isGzip(data) {
+		reader, err := newGunzipReader(source, maxSize)
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		source = reader
+	}
+
 	var nrm NewReleaseMessage
-	err := xml.Unmarshal(data, &nrm)
-	if err != nil {
+	decoder := xml.NewDecoder(&contextReader{ctx: ctx, r: source})
+	if err := decoder.Decode(&nrm); err != nil {
+		var tooLarge *ErrDecompressedTooLarge
+		if errors.As(err, &tooLarge) {
+			return nil, tooLarge
+		}
 		return nil, fmt.Errorf("failed to unmarshal XML: %w", err)
 	}
 	return &nrm, nil
 }
 
+// UnmarshalXML decodes a NewReleaseMessage regardless of the namespace prefix used on the
+// root element. The decoder resolves "ern:NewReleaseMessage" to its namespace URI, which
+// never matches the literal prefixed name used for marshaling.
+func (nrm *NewReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Local != "NewReleaseMessage" {
+		return fmt.Errorf("expected element type <NewReleaseMessage> but have <%s>", start.Name.Local)
+	}
+	if err := checkVersion(rootVersion(start)); err != nil {
+		return err
+	}
+
+	// message has the same fields but none of the methods, so decoding does not recurse
+	type message NewReleaseMessage
+	root := xml.StartElement{Name: xml.Name{Local: "ern:NewReleaseMessage"}}
+	if err := d.DecodeElement((*message)(nrm), &root); err != nil {
+		return err
+	}
+
+	nrm.setRootAttributes(start)
+	return nil
+}
+
+// setRootAttributes copies the namespace declarations and attributes of the root element
+func (nrm *NewReleaseMessage) setRootAttributes(start xml.StartElement) {
+	prefixes := declaredPrefixes(start.Attr)
+	for _, attr := range start.Attr {
+		switch {
+		case attr.Name.Space == "xmlns" && attr.Name.Local == "xsi":
+			nrm.XmlnsXsi = attr.Value
+		case attr.Name.Space == "xmlns" && attr.Value == start.Name.Space:
+			nrm.XmlnsErn = attr.Value
+		case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+			nrm.XmlnsErn = attr.Value
+		case attr.Name.Local == "schemaLocation":
+			nrm.XsiSchemaLocation = attr.Value
+		case attr.Name.Local == "MessageSchemaVersionId":
+			nrm.MessageSchemaVersionId = attr.Value
+		case attr.Name.Local == "LanguageAndScriptCode":
+			nrm.LanguageAndScriptCode = attr.Value
+		default:
+			nrm.OtherAttr = append(nrm.OtherAttr, literalAttrs([]xml.Attr{attr}, prefixes)...)
+		}
+	}
+
+	if nrm.XmlnsErn == "" {
+		nrm.XmlnsErn = start.Name.Space
+	}
+}
+
+// validationCheck is a check Validate runs once the message has the mandatory structure
+type validationCheck struct {
+	name string
+	run  func(nrm *NewReleaseMessage) ValidationErrors
+}
+
+// validationChecks are the checks of Validate in the order they run
+var validationChecks = []validationCheck{
+	{"CheckIdentifiers", (*NewReleaseMessage).CheckIdentifiers},
+	{"CheckTerritoryOverlaps", (*NewReleaseMessage).CheckTerritoryOverlaps},
+	{"CheckDealTerritories", (*NewReleaseMessage).CheckDealTerritories},
+	{"CheckEventDates", (*NewReleaseMessage).CheckEventDates},
+	{"CheckValidityPeriods", (*NewReleaseMessage).CheckValidityPeriods},
+	{"CheckResourceReferences", (*NewReleaseMessage).CheckResourceReferences},
+	{"CheckDealResourceReferences", (*NewReleaseMessage).CheckDealResourceReferences},
+	{"CheckOrphanResources", (*NewReleaseMessage).CheckOrphanResources},
+	{"CheckFileNames", (*NewReleaseMessage).CheckFileNames},
+	{"CheckCharacterParties", (*NewReleaseMessage).CheckCharacterParties},
+	{"CheckAvRatings", (*NewReleaseMessage).CheckAvRatings},
+	{"CheckLanguageCodes", (*NewReleaseMessage).CheckLanguageCodes},
+	{"CheckRightShares", func(nrm *NewReleaseMessage) ValidationErrors { return nrm.CheckRightShares(false) }},
+	{"CheckAllowedValues", (*NewReleaseMessage).CheckAllowedValues},
+}
+
 // Validate performs basic validation on the NewReleaseMessage structure
 func (nrm *NewReleaseMessage) Validate() error {
+	err := nrm.validate()
+	if err != nil {
+		count := 1
+		if errs, ok := err.(ValidationErrors); ok {
+			count = len(errs)
+		}
+		logEvent(nil, slog.LevelWarn, "ddex: validation failed", "message_id", nrm.messageId(),
+			"errors", count, "error", err)
+	}
+	return err
+}
+
+// validate runs the checks of Validate
+func (nrm *NewReleaseMessage) validate() error {
+	if err := nrm.checkStructure(); err != nil {
+		return err
+	}
+
+	for _, check := range validationChecks {
+		if errs := check.run(nrm); len(errs) > 0 {
+			return errs
+		}
+	}
+	return nil
+}
+
+// checkStructure checks the mandatory header elements, and that there are releases and
+// each has a deal
+func (nrm *NewReleaseMessage) checkStructure() error {
 	if nrm.MessageHeader == nil {
-		return fmt.Errorf("MessageHeader is required")
+		return wrapf(ErrMissingMessageHeader, "MessageHeader is required")
 	}
 
 	if nrm.MessageHeader.MessageId == "" {
-		return fmt.Errorf("MessageHeader.MessageId is required")
+		return wrapf(ErrMissingMessageHeader, "MessageHeader.MessageId is required")
 	}
 
 	if nrm.MessageHeader.MessageThreadId == "" {
-		return fmt.Errorf("MessageHeader.MessageThreadId is required")
+		return wrapf(ErrMissingMessageHeader, "MessageHeader.MessageThreadId is required")
 	}
 
 	if nrm.MessageHeader.MessageSender == nil {
-		return fmt.Errorf("MessageHeader.MessageSender is required")
+		return wrapf(ErrMissingMessageHeader, "MessageHeader.MessageSender is required")
 	}
 
 	if nrm.MessageHeader.MessageRecipient == nil {
-		return fmt.Errorf("MessageHeader.MessageRecipient is required")
+		return wrapf(ErrMissingMessageHeader, "MessageHeader.MessageRecipient is required")
 	}
 
 	if nrm.ReleaseList == nil || len(nrm.ReleaseList.Release) == 0 {
-		return fmt.Errorf("at least one Release is required")
+		return wrapf(ErrMissingRelease, "at least one Release is required")
 	}
 
 	if nrm.DealList == nil || len(nrm.DealList.ReleaseDeal) == 0 {
-		return fmt.Errorf("at least one Deal is required")
+		return wrapf(ErrMissingDeal, "at least one Deal is required")
 	}
 
 	// Validate that all releases have corresponding deals
@@ -216,7 +352,42 @@ func (nrm *NewReleaseMessage) Validate() error {
 
 	for _, release := range nrm.ReleaseList.Release {
 		if !dealReleaseRefs[release.ReleaseReference] {
-			return fmt.Errorf("no deal found for release reference: %s", release.ReleaseReference)
+			return wrapf(ErrMissingDeal, "no deal found for release reference: %s", release.ReleaseReference)
+		}
+	}
+
+	return nil
+}
+
+// ValidationOptions enables checks that only some recipients require
+type ValidationOptions struct {
+	// RequireChecksums requires a HashSum and FileSize on the File of every technical
+	// details entry (see CheckFileChecksums)
+	RequireChecksums bool
+
+	// RequireFullRightShares requires the right shares of every territory branch with
+	// RightsControllers to add up to exactly 100% (see CheckRightShares)
+	RequireFullRightShares bool
+}
+
+// YouTubeValidationOptions are the checks YouTube applies on top of Validate
+var YouTubeValidationOptions = ValidationOptions{RequireChecksums: true}
+
+// ValidateWithOptions runs Validate and then the checks enabled by opts
+func (nrm *NewReleaseMessage) ValidateWithOptions(opts ValidationOptions) error {
+	if err := nrm.Validate(); err != nil {
+		return err
+	}
+
+	if opts.RequireChecksums {
+		if errs := nrm.CheckFileChecksums(); len(errs) > 0 {
+			return errs
+		}
+	}
+
+	if opts.RequireFullRightShares {
+		if errs := nrm.CheckRightShares(true); len(errs) > 0 {
+			return errs
 		}
 	}
 
@@ -252,6 +423,19 @@ func (nrm *NewReleaseMessage) GetMainRelease() *Release {
 	return nil
 }
 
+// mainRelease returns the release marked IsMainRelease, the first release, or nil
+func (nrm *NewReleaseMessage) mainRelease() *Release {
+	if nrm.ReleaseList == nil || len(nrm.ReleaseList.Release) == 0 {
+		return nil
+	}
+	for i := range nrm.ReleaseList.Release {
+		if nrm.ReleaseList.Release[i].IsMainRelease {
+			return &nrm.ReleaseList.Release[i]
+		}
+	}
+	return &nrm.ReleaseList.Release[0]
+}
+
 // SetMessageControlType sets the message control type (TestMessage or LiveMessage)
 func (nrm *NewReleaseMessage) SetMessageControlType(controlType string) {
 	if nrm.MessageHeader != nil {
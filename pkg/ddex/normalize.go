@@ -0,0 +1,97 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"reflect"
+)
+
+var xmlMarshalerType = reflect.TypeOf((*xml.Marshaler)(nil)).Elem()
+
+// Normalize prunes empty composites from the message so that it doesn't marshal empty
+// elements strict validators reject: a composite without attributes, text or non-empty
+// children is removed, whether it is optional (e.g. an empty ValidityPeriod or DealTerms)
+// or a container left without items (e.g. a ResourceList with no resources). Pruning works
+// bottom-up, so a Deal whose only child was an empty DealTerms goes too. Scalar values such
+// as a false IsPreOrderDeal are kept. Normalize modifies the message and returns it.
+func (nrm *NewReleaseMessage) Normalize() *NewReleaseMessage {
+	pruneEmpty(reflect.ValueOf(nrm).Elem())
+	return nrm
+}
+
+// Normalize prunes empty composites from the message (see NewReleaseMessage.Normalize).
+// Empty elements added on purpose, like WithEmptyValidityPeriod, are removed too.
+func (b *Builder) Normalize() *Builder {
+	b.Message.Normalize()
+	return b
+}
+
+// pruneEmpty removes empty composites below the struct v and reports whether v itself is
+// empty
+func pruneEmpty(v reflect.Value) bool {
+	empty := true
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Name == "XMLName" || field.Tag.Get("xml") == "-" {
+			continue
+		}
+		if !pruneField(v.Field(i)) {
+			empty = false
+		}
+	}
+	return empty
+}
+
+// pruneField prunes a field value and reports whether it is empty afterwards
+func pruneField(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return true
+		}
+		if !isComposite(v.Type().Elem()) {
+			// Pointers to scalars are set on purpose, even to their zero value
+			return false
+		}
+		if pruneEmpty(v.Elem()) {
+			v.Set(reflect.Zero(v.Type()))
+			return true
+		}
+		return false
+
+	case reflect.Slice:
+		elem := v.Type().Elem()
+		if !isComposite(elem) && !(elem.Kind() == reflect.Ptr && isComposite(elem.Elem())) {
+			return v.Len() == 0
+		}
+		kept := 0
+		for i := 0; i < v.Len(); i++ {
+			if pruneField(v.Index(i)) {
+				continue
+			}
+			v.Index(kept).Set(v.Index(i))
+			kept++
+		}
+		if kept == 0 {
+			v.Set(reflect.Zero(v.Type()))
+			return true
+		}
+		v.SetLen(kept)
+		return false
+
+	case reflect.Struct:
+		if !isComposite(v.Type()) {
+			return v.IsZero()
+		}
+		return pruneEmpty(v)
+
+	default:
+		return v.IsZero()
+	}
+}
+
+// isComposite reports whether t is a model struct whose fields are marshaled as elements,
+// rather than a value with its own XML encoding such as DateTime
+func isComposite(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && !t.Implements(xmlMarshalerType) && !reflect.PtrTo(t).Implements(xmlMarshalerType)
+}
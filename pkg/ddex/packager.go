@@ -0,0 +1,250 @@
+package ddex
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Packager stages release packages in a delivery folder: the resource files renamed after
+// the DDEX conventions, the message with its technical details pointing at them, and their
+// checksums. The result is ready for upload with the delivery subpackage.
+type Packager struct {
+	dir    string
+	naming FileNaming
+	logger *slog.Logger
+}
+
+// NewPackager creates a packager writing release folders below dir, normally a batch
+// folder named with BatchFolderName
+func NewPackager(dir string) *Packager {
+	return &Packager{dir: dir, naming: DDEXFileNaming{}}
+}
+
+// WithFileNaming names the release folder and files with naming instead of the DDEX
+// choreography, e.g. the FileNaming of a RecipientProfile
+func (p *Packager) WithFileNaming(naming FileNaming) *Packager {
+	p.naming = naming
+	return p
+}
+
+// WithLogger reports copied files and written packages to logger instead of the SetLogger
+// logger
+func (p *Packager) WithLogger(logger *slog.Logger) *Packager {
+	p.logger = logger
+	return p
+}
+
+// Package writes <dir>/<ICPN>/ with the message and its resources/ folder and returns the
+// path of the written message. files maps resource references to the local files to
+// deliver for them; each is copied to resources/ as ResourceFileName for sound recordings
+// and videos, numbered by their sequence in the main release's resource group, or
+// ImageFileName for the first image (later images get an _<nn> suffix).
+//
+// The message is updated in place: every technical details File of a packaged resource
+// gets the new FileName, its FileSize and an MD5 HashSum. A resource without technical
+// details gets them in its first DetailsByTerritory.
+func (p *Packager) Package(message *NewReleaseMessage, files map[string]string) (string, error) {
+	return p.PackageContext(context.Background(), message, files)
+}
+
+// PackageContext is Package for large media files: copying stops with the context's error
+// once ctx is cancelled or times out, leaving the files copied so far in place
+func (p *Packager) PackageContext(ctx context.Context, message *NewReleaseMessage, files map[string]string) (string, error) {
+	identifier, err := message.DeliveryIdentifier()
+	if err != nil {
+		return "", err
+	}
+
+	index := message.Index()
+	for ref := range files {
+		if _, ok := index.Resources[ref]; !ok {
+			return "", fmt.Errorf("resource %s is not in the message", ref)
+		}
+	}
+
+	releaseDir := filepath.Join(p.dir, p.naming.ReleaseFolderName(identifier))
+	resourcesDir := filepath.Join(releaseDir, ResourcesFolder)
+	if err := os.MkdirAll(resourcesDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create delivery folder: %w", err)
+	}
+
+	sequence := message.resourceSequence()
+	technicalRefs := make(map[string]bool)
+	message.forEachReference(func(kind referenceKind, ref *string) {
+		if kind == technicalDetailsReference {
+			technicalRefs[*ref] = true
+		}
+	})
+
+	track, image := 0, 0
+	for _, resource := range message.FindResources() {
+		localPath, ok := files[resource.Reference()]
+		if !ok {
+			continue
+		}
+
+		extension := strings.TrimPrefix(filepath.Ext(localPath), ".")
+		var name string
+		switch resource.Type() {
+		case "SoundRecording", "Video":
+			track++
+			number := track
+			if n, ok := sequence[resource.Reference()]; ok {
+				number = n
+			}
+			name = p.naming.ResourceFileName(identifier, 1, number, extension)
+		case "Image":
+			image++
+			if image == 1 {
+				name = p.naming.ImageFileName(identifier, extension)
+			} else {
+				name = p.naming.ImageFileName(fmt.Sprintf("%s_%02d", identifier, image), extension)
+			}
+		default:
+			return "", fmt.Errorf("resource %s: %s resources have no technical details to describe a file", resource.Reference(), resource.Type())
+		}
+
+		size, hashSum, err := copyFileMD5(ctx, localPath, filepath.Join(resourcesDir, name))
+		if err != nil {
+			return "", fmt.Errorf("resource %s: %w", resource.Reference(), err)
+		}
+		logEvent(p.logger, slog.LevelDebug, "ddex: resource file copied", "resource", resource.Reference(),
+			"source", localPath, "file", name, "bytes", size)
+		file := File{
+			FileName: name,
+			HashSum:  &HashSum{HashSum: hashSum, HashSumAlgorithmType: "MD5"},
+			FileSize: int(size),
+		}
+		if err := setResourceFile(resource, file, technicalRefs); err != nil {
+			return "", err
+		}
+	}
+
+	path := filepath.Join(releaseDir, p.naming.MessageFileName(identifier))
+	data, err := message.ToXMLWithHeader()
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal message: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+
+	logEvent(p.logger, slog.LevelInfo, "ddex: package written", "message_id", message.messageId(),
+		"path", path, "files", len(files))
+	return path, nil
+}
+
+// resourceSequence maps the resources of the main release's first resource group to
+// their sequence numbers
+func (nrm *NewReleaseMessage) resourceSequence() map[string]int {
+	sequence := make(map[string]int)
+	if nrm.ReleaseList == nil {
+		return sequence
+	}
+	for _, release := range nrm.ReleaseList.Release {
+		if !release.IsMainRelease && len(nrm.ReleaseList.Release) > 1 {
+			continue
+		}
+		for _, details := range release.ReleaseDetailsByTerritory {
+			if len(details.ResourceGroup) == 0 {
+				continue
+			}
+			for _, item := range details.ResourceGroup[0].ResourceGroupContentItem {
+				if item.SequenceNumber > 0 {
+					sequence[item.ReleaseResourceReference.Value] = item.SequenceNumber
+				}
+			}
+			return sequence
+		}
+	}
+	return sequence
+}
+
+// setResourceFile sets file on every technical details entry of a resource, adding one to
+// its first DetailsByTerritory if it has none
+func setResourceFile(resource IndexedResource, file File, technicalRefs map[string]bool) error {
+	newRef := func() string {
+		ref := freeReference("T", "T", technicalRefs)
+		technicalRefs[ref] = true
+		return ref
+	}
+	missing := fmt.Errorf("resource %s has no DetailsByTerritory to describe its file", resource.Reference())
+
+	switch {
+	case resource.SoundRecording != nil:
+		details := resource.SoundRecording.SoundRecordingDetailsByTerritory
+		if len(details) == 0 {
+			return missing
+		}
+		if len(details[0].TechnicalSoundRecordingDetails) == 0 {
+			details[0].TechnicalSoundRecordingDetails = []TechnicalSoundRecordingDetails{{TechnicalResourceDetailsReference: newRef()}}
+		}
+		for i := range details {
+			for j := range details[i].TechnicalSoundRecordingDetails {
+				f := file
+				details[i].TechnicalSoundRecordingDetails[j].File = &f
+			}
+		}
+	case resource.Video != nil:
+		details := resource.Video.VideoDetailsByTerritory
+		if len(details) == 0 {
+			return missing
+		}
+		if len(details[0].TechnicalVideoDetails) == 0 {
+			details[0].TechnicalVideoDetails = []TechnicalVideoDetails{{TechnicalResourceDetailsReference: newRef()}}
+		}
+		for i := range details {
+			for j := range details[i].TechnicalVideoDetails {
+				f := file
+				details[i].TechnicalVideoDetails[j].File = &f
+			}
+		}
+	case resource.Image != nil:
+		details := resource.Image.ImageDetailsByTerritory
+		if len(details) == 0 {
+			return missing
+		}
+		if len(details[0].TechnicalImageDetails) == 0 {
+			details[0].TechnicalImageDetails = []TechnicalImageDetails{{TechnicalResourceDetailsReference: newRef()}}
+		}
+		for i := range details {
+			for j := range details[i].TechnicalImageDetails {
+				f := file
+				details[i].TechnicalImageDetails[j].File = &f
+			}
+		}
+	}
+	return nil
+}
+
+// copyFileMD5 copies src to dst and returns its size and hex-encoded MD5 checksum
+func copyFileMD5(ctx context.Context, src, dst string) (int64, string, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, "", err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return 0, "", err
+	}
+
+	hash := md5.New()
+	size, err := io.Copy(io.MultiWriter(out, hash), &contextReader{ctx: ctx, r: in})
+	if err != nil {
+		out.Close()
+		return 0, "", fmt.Errorf("failed to copy %s: %w", src, err)
+	}
+	if err := out.Close(); err != nil {
+		return 0, "", err
+	}
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -4,64 +4,82 @@ import "encoding/xml"
 
 // PartyList contains all Party composites - used in ERN 3.8 and ERN 4.x
 type PartyList struct {
-	XMLName xml.Name `xml:"PartyList"`
-	Party   []Party  `xml:"Party"`
+	XMLName    xml.Name     `xml:"PartyList" json:"-"`
+	Party      []Party      `xml:"Party" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // Party represents a party (artist, writer, label, etc.) in the DDEX message for ERN 3.8
 type Party struct {
-	XMLName        xml.Name   `xml:"Party"`
-	PartyReference string     `xml:"PartyReference"`
-	PartyName      *PartyName `xml:"PartyName,omitempty"`
-	PartyId        []PartyId  `xml:"PartyId,omitempty"`
+	XMLName        xml.Name     `xml:"Party" json:"-"`
+	PartyReference string       `xml:"PartyReference" json:",omitempty"`
+	PartyName      *PartyName   `xml:"PartyName,omitempty" json:",omitempty"`
+	PartyId        []PartyId    `xml:"PartyId,omitempty" json:",omitempty"`
+	Extensions     []RawElement `xml:",any" json:",omitempty"`
 }
 
 type PartyId struct {
-	XMLName       xml.Name        `xml:"PartyId"`
-	ISNI          string          `xml:"ISNI,omitempty"`
-	DPID          string          `xml:"DPID,omitempty"`
-	IpiNameNumber string          `xml:"IpiNameNumber,omitempty"`
-	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty"`
+	XMLName       xml.Name        `xml:"PartyId" json:"-"`
+	ISNI          string          `xml:"ISNI,omitempty" json:",omitempty"`
+	DPID          string          `xml:"DPID,omitempty" json:",omitempty"`
+	IpiNameNumber string          `xml:"IpiNameNumber,omitempty" json:",omitempty"`
+	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty" json:",omitempty"`
+	Extensions    []RawElement    `xml:",any" json:",omitempty"`
 }
 
 type PartyName struct {
-	XMLName         xml.Name `xml:"PartyName"`
-	FullName        string   `xml:"FullName"`
-	FullNameIndexed string   `xml:"FullNameIndexed,omitempty"`
+	XMLName         xml.Name     `xml:"PartyName" json:"-"`
+	FullName        string       `xml:"FullName" json:",omitempty"`
+	FullNameIndexed string       `xml:"FullNameIndexed,omitempty" json:",omitempty"`
+	Extensions      []RawElement `xml:",any" json:",omitempty"`
 }
 
 // DisplayArtist represents how an artist should be displayed
 type DisplayArtist struct {
-	XMLName        xml.Name    `xml:"DisplayArtist"`
-	SequenceNumber int         `xml:"SequenceNumber,attr,omitempty"`
-	PartyName      []PartyName `xml:"PartyName,omitempty"`
-	PartyId        []PartyId   `xml:"PartyId,omitempty"`
-	ArtistRole     []string    `xml:"ArtistRole,omitempty"`
+	XMLName        xml.Name     `xml:"DisplayArtist" json:"-"`
+	SequenceNumber int          `xml:"SequenceNumber,attr,omitempty" json:",omitempty"`
+	PartyName      []PartyName  `xml:"PartyName,omitempty" json:",omitempty"`
+	PartyId        []PartyId    `xml:"PartyId,omitempty" json:",omitempty"`
+	ArtistRole     []string     `xml:"ArtistRole,omitempty" json:",omitempty"`
+	Extensions     []RawElement `xml:",any" json:",omitempty"`
+}
+
+// DisplayConductor represents the conductor of a resource, shown like a display artist
+type DisplayConductor struct {
+	XMLName        xml.Name     `xml:"DisplayConductor" json:"-"`
+	SequenceNumber int          `xml:"SequenceNumber,attr,omitempty" json:",omitempty"`
+	PartyName      []PartyName  `xml:"PartyName,omitempty" json:",omitempty"`
+	PartyId        []PartyId    `xml:"PartyId,omitempty" json:",omitempty"`
+	ArtistRole     []string     `xml:"ArtistRole,omitempty" json:",omitempty"`
+	Extensions     []RawElement `xml:",any" json:",omitempty"`
 }
 
 // Location represents location information for a party
 type Location struct {
-	XMLName       xml.Name `xml:"Location"`
-	CountryCode   string   `xml:"CountryCode,omitempty"`
-	TerritoryCode string   `xml:"TerritoryCode,omitempty"`
-	Address       *Address `xml:"Address,omitempty"`
+	XMLName       xml.Name     `xml:"Location" json:"-"`
+	CountryCode   string       `xml:"CountryCode,omitempty" json:",omitempty"`
+	TerritoryCode string       `xml:"TerritoryCode,omitempty" json:",omitempty"`
+	Address       *Address     `xml:"Address,omitempty" json:",omitempty"`
+	Extensions    []RawElement `xml:",any" json:",omitempty"`
 }
 
 // Address represents physical address information
 type Address struct {
-	XMLName     xml.Name `xml:"Address"`
-	AddressLine []string `xml:"AddressLine,omitempty"`
-	City        string   `xml:"City,omitempty"`
-	PostalCode  string   `xml:"PostalCode,omitempty"`
-	Country     string   `xml:"Country,omitempty"`
+	XMLName     xml.Name     `xml:"Address" json:"-"`
+	AddressLine []string     `xml:"AddressLine,omitempty" json:",omitempty"`
+	City        string       `xml:"City,omitempty" json:",omitempty"`
+	PostalCode  string       `xml:"PostalCode,omitempty" json:",omitempty"`
+	Country     string       `xml:"Country,omitempty" json:",omitempty"`
+	Extensions  []RawElement `xml:",any" json:",omitempty"`
 }
 
 // ContactInformation represents contact details for a party
 type ContactInformation struct {
-	XMLName      xml.Name `xml:"ContactInformation"`
-	EmailAddress []string `xml:"EmailAddress,omitempty"`
-	PhoneNumber  []string `xml:"PhoneNumber,omitempty"`
-	WebPage      []string `xml:"WebPage,omitempty"`
+	XMLName      xml.Name     `xml:"ContactInformation" json:"-"`
+	EmailAddress []string     `xml:"EmailAddress,omitempty" json:",omitempty"`
+	PhoneNumber  []string     `xml:"PhoneNumber,omitempty" json:",omitempty"`
+	WebPage      []string     `xml:"WebPage,omitempty" json:",omitempty"`
+	Extensions   []RawElement `xml:",any" json:",omitempty"`
 }
 
 // NewParty creates a new Party with the specified reference and name
@@ -74,8 +92,12 @@ func NewParty(reference, name string) *Party {
 	}
 }
 
-// NewPartyWithIndexedName creates a new Party with full name and indexed name
+// NewPartyWithIndexedName creates a new Party with full name and indexed name. An empty
+// indexedName is generated with SortName.
 func NewPartyWithIndexedName(reference, name, indexedName string) *Party {
+	if indexedName == "" {
+		indexedName = SortName(name)
+	}
 	return &Party{
 		PartyReference: reference,
 		PartyName: &PartyName{
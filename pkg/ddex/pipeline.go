@@ -0,0 +1,175 @@
+package ddex
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Pipeline validates and marshals many messages concurrently using a fixed pool of workers
+type Pipeline struct {
+	workers        int
+	skipValidation bool
+	recipient      RecipientProfile
+	logger         *slog.Logger
+}
+
+// PipelineResult holds the outcome of processing a single message
+type PipelineResult struct {
+	Index     int    // Position of the message in the input slice
+	MessageId string // MessageHeader.MessageId, if present
+	XML       []byte // Marshaled XML with declaration (nil on failure)
+	Err       error  // Validation or marshaling error
+}
+
+// PipelineError aggregates the failures of a pipeline run
+type PipelineError struct {
+	Failures []PipelineResult
+}
+
+// Error summarizes the failed messages
+func (e *PipelineError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d message(s) failed", len(e.Failures))
+	for _, failure := range e.Failures {
+		fmt.Fprintf(&sb, "\n  [%d] %s: %v", failure.Index, failure.MessageId, failure.Err)
+	}
+	return sb.String()
+}
+
+// Unwrap returns the individual message errors so errors.Is/As can inspect them
+func (e *PipelineError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		errs = append(errs, failure.Err)
+	}
+	return errs
+}
+
+// NewPipeline creates a pipeline with the given number of workers.
+// A value <= 0 uses runtime.GOMAXPROCS(0).
+func NewPipeline(workers int) *Pipeline {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return &Pipeline{workers: workers}
+}
+
+// SkipValidation disables the Validate step, only marshaling the messages
+func (p *Pipeline) SkipValidation() *Pipeline {
+	p.skipValidation = true
+	return p
+}
+
+// WithRecipient prepares every message for a recipient: MutateMessage is applied to the
+// message in place before it is validated, and the profile's Validate replaces Validate
+func (p *Pipeline) WithRecipient(profile RecipientProfile) *Pipeline {
+	p.recipient = profile
+	return p
+}
+
+// WithLogger reports failed messages and finished runs to logger instead of the SetLogger
+// logger
+func (p *Pipeline) WithLogger(logger *slog.Logger) *Pipeline {
+	p.logger = logger
+	return p
+}
+
+// Run validates and marshals every message. Results are returned in input order;
+// if any message failed the returned error is a *PipelineError listing all failures.
+func (p *Pipeline) Run(messages []*NewReleaseMessage) ([]PipelineResult, error) {
+	return p.RunContext(context.Background(), messages)
+}
+
+// RunContext is Run for jobs that can be cancelled: once ctx is done, messages not yet
+// started fail with the context's error, so errors.Is(err, context.Canceled) reports an
+// interrupted run. Messages already being processed are finished.
+func (p *Pipeline) RunContext(ctx context.Context, messages []*NewReleaseMessage) ([]PipelineResult, error) {
+	results := make([]PipelineResult, len(messages))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < p.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = p.process(i, messages[i])
+			}
+		}()
+	}
+
+dispatch:
+	for i := range messages {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			for j := i; j < len(messages); j++ {
+				results[j] = PipelineResult{Index: j, Err: ctx.Err()}
+				if messages[j] != nil && messages[j].MessageHeader != nil {
+					results[j].MessageId = messages[j].MessageHeader.MessageId
+				}
+			}
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	var failures []PipelineResult
+	for _, result := range results {
+		if result.Err != nil {
+			failures = append(failures, result)
+			logEvent(p.logger, slog.LevelWarn, "ddex: pipeline message failed", "index", result.Index,
+				"message_id", result.MessageId, "error", result.Err)
+		}
+	}
+	logEvent(p.logger, slog.LevelInfo, "ddex: pipeline finished", "messages", len(messages), "failed", len(failures))
+	if len(failures) > 0 {
+		return results, &PipelineError{Failures: failures}
+	}
+
+	return results, nil
+}
+
+// process validates and marshals a single message
+func (p *Pipeline) process(index int, message *NewReleaseMessage) PipelineResult {
+	result := PipelineResult{Index: index}
+	if message == nil {
+		result.Err = fmt.Errorf("message is nil")
+		return result
+	}
+	if message.MessageHeader != nil {
+		result.MessageId = message.MessageHeader.MessageId
+	}
+
+	if p.recipient != nil {
+		if err := p.recipient.MutateMessage(message); err != nil {
+			result.Err = fmt.Errorf("failed to prepare message for %s: %w", p.recipient.Name(), err)
+			return result
+		}
+	}
+
+	if !p.skipValidation {
+		validate := message.Validate
+		if p.recipient != nil {
+			validate = func() error { return p.recipient.Validate(message) }
+		}
+		if err := validate(); err != nil {
+			result.Err = fmt.Errorf("validation failed: %w", err)
+			return result
+		}
+	}
+
+	xmlData, err := message.ToXMLWithHeader()
+	if err != nil {
+		result.Err = fmt.Errorf("failed to marshal XML: %w", err)
+		return result
+	}
+	result.XML = xmlData
+
+	return result
+}
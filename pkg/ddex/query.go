@@ -0,0 +1,165 @@
+package ddex
+
+import "strings"
+
+// ReleaseSelector matches releases for FindReleases
+type ReleaseSelector func(*Release) bool
+
+// ResourceSelector matches resources for FindResources
+type ResourceSelector func(IndexedResource) bool
+
+// FindReleases returns the releases matching every selector, in message order. The
+// releases point into the message.
+func (nrm *NewReleaseMessage) FindReleases(selectors ...ReleaseSelector) []*Release {
+	var found []*Release
+	if nrm.ReleaseList == nil {
+		return found
+	}
+	for i := range nrm.ReleaseList.Release {
+		release := &nrm.ReleaseList.Release[i]
+		if matchRelease(release, selectors) {
+			found = append(found, release)
+		}
+	}
+	return found
+}
+
+// FindResources returns the resources matching every selector: sound recordings, videos,
+// images and texts, in that order. The resources point into the message.
+func (nrm *NewReleaseMessage) FindResources(selectors ...ResourceSelector) []IndexedResource {
+	var found []IndexedResource
+	list := nrm.ResourceList
+	if list == nil {
+		return found
+	}
+
+	add := func(resource IndexedResource) {
+		if matchResource(resource, selectors) {
+			found = append(found, resource)
+		}
+	}
+	for i := range list.SoundRecording {
+		add(IndexedResource{SoundRecording: &list.SoundRecording[i]})
+	}
+	for i := range list.Video {
+		add(IndexedResource{Video: &list.Video[i]})
+	}
+	for i := range list.Image {
+		add(IndexedResource{Image: &list.Image[i]})
+	}
+	for i := range list.Text {
+		add(IndexedResource{Text: &list.Text[i]})
+	}
+	return found
+}
+
+func matchRelease(release *Release, selectors []ReleaseSelector) bool {
+	for _, selector := range selectors {
+		if !selector(release) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchResource(resource IndexedResource, selectors []ResourceSelector) bool {
+	for _, selector := range selectors {
+		if !selector(resource) {
+			return false
+		}
+	}
+	return true
+}
+
+// ByReleaseReference matches the release with a ReleaseReference
+func ByReleaseReference(ref string) ReleaseSelector {
+	return func(r *Release) bool {
+		return r.ReleaseReference == ref
+	}
+}
+
+// ByICPN matches releases with an ICPN (UPC or EAN)
+func ByICPN(icpn string) ReleaseSelector {
+	return func(r *Release) bool {
+		for _, id := range r.ReleaseId {
+			if id.ICPN == icpn {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ByGRid matches releases with a GRid
+func ByGRid(grid string) ReleaseSelector {
+	return func(r *Release) bool {
+		for _, id := range r.ReleaseId {
+			if strings.EqualFold(strings.ReplaceAll(id.GRid, "-", ""), strings.ReplaceAll(grid, "-", "")) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ByReleaseType matches releases of a type, e.g. Album or VideoSingle
+func ByReleaseType(releaseType string) ReleaseSelector {
+	return func(r *Release) bool {
+		for _, t := range r.ReleaseType {
+			if t.Value == releaseType {
+				return true
+			}
+		}
+		for _, details := range r.ReleaseDetailsByTerritory {
+			for _, t := range details.ReleaseType {
+				if t.Value == releaseType {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// ByReleaseTerritory matches releases with ReleaseDetailsByTerritory covering a territory
+func ByReleaseTerritory(territory string) ReleaseSelector {
+	return func(r *Release) bool {
+		_, ok := r.EffectiveDetails(territory)
+		return ok
+	}
+}
+
+// ByResourceReference matches the resource with a ResourceReference
+func ByResourceReference(ref string) ResourceSelector {
+	return func(r IndexedResource) bool {
+		return r.Reference() == ref
+	}
+}
+
+// ByISRC matches sound recordings and videos with an ISRC, ignoring hyphens and case
+func ByISRC(isrc string) ResourceSelector {
+	want := NormalizeISRC(isrc)
+	matches := func(value string) bool {
+		return value != "" && NormalizeISRC(value) == want
+	}
+	return func(r IndexedResource) bool {
+		switch {
+		case r.SoundRecording != nil:
+			for _, id := range r.SoundRecording.SoundRecordingId {
+				if matches(id.ISRC) {
+					return true
+				}
+			}
+		case r.Video != nil:
+			return r.Video.VideoId != nil && matches(r.Video.VideoId.ISRC)
+		}
+		return false
+	}
+}
+
+// ByType matches resources by element name: SoundRecording, Video, Image or Text
+func ByType(resourceType string) ResourceSelector {
+	return func(r IndexedResource) bool {
+		return r.Type() == resourceType
+	}
+}
@@ -0,0 +1,185 @@
+package ddex
+
+import "log/slog"
+
+// DPIDs of the YouTube recipients
+const (
+	YouTubeDPID          = "PADPIDA2013020802I"
+	YouTubeContentIDDPID = "PADPIDA2015120100H"
+)
+
+// RecipientProfile encapsulates what one recipient expects on top of the ERN standard, so
+// delivery code can prepare, check and name a message for any partner the same way
+type RecipientProfile interface {
+	// Name identifies the profile in logs and errors
+	Name() string
+
+	// MutateMessage adapts the message in place to the recipient, e.g. by adding the
+	// recipient party or cutting down text the recipient limits
+	MutateMessage(message *NewReleaseMessage) error
+
+	// Validate returns the first problem that would make the recipient reject the message
+	Validate(message *NewReleaseMessage) error
+
+	// FileNaming returns the names the recipient expects for delivery folders and files
+	FileNaming() FileNaming
+}
+
+// FileNaming names the folders and files of a release package (see Packager)
+type FileNaming interface {
+	ReleaseFolderName(identifier string) string
+	MessageFileName(identifier string) string
+	ResourceFileName(identifier string, discNumber, trackNumber int, extension string) string
+	ImageFileName(identifier, extension string) string
+}
+
+// DDEXFileNaming is the naming of the DDEX ERN choreography, which most recipients use
+type DDEXFileNaming struct{}
+
+// ReleaseFolderName calls ReleaseFolderName
+func (DDEXFileNaming) ReleaseFolderName(identifier string) string {
+	return ReleaseFolderName(identifier)
+}
+
+// MessageFileName calls MessageFileName
+func (DDEXFileNaming) MessageFileName(identifier string) string {
+	return MessageFileName(identifier)
+}
+
+// ResourceFileName calls ResourceFileName
+func (DDEXFileNaming) ResourceFileName(identifier string, discNumber, trackNumber int, extension string) string {
+	return ResourceFileName(identifier, discNumber, trackNumber, extension)
+}
+
+// ImageFileName calls ImageFileName
+func (DDEXFileNaming) ImageFileName(identifier, extension string) string {
+	return ImageFileName(identifier, extension)
+}
+
+// DSPProfile is a RecipientProfile configured with values, for partners that only differ
+// in their party, limits and required checks. Zero fields are skipped.
+type DSPProfile struct {
+	ProfileName string
+	DPID        string // added as MessageRecipient unless the message already has it
+	PartyName   string
+	Keywords    KeywordLimits
+	Text        TextLimits
+	Validation  ValidationOptions
+	Naming      FileNaming // nil is DDEXFileNaming
+}
+
+// Name returns ProfileName, or PartyName without one
+func (p *DSPProfile) Name() string {
+	if p.ProfileName != "" {
+		return p.ProfileName
+	}
+	return p.PartyName
+}
+
+// MutateMessage adds the recipient party and applies the keyword limits with
+// NormalizeKeywords, logging each keyword it had to drop or truncate
+func (p *DSPProfile) MutateMessage(message *NewReleaseMessage) error {
+	if p.DPID != "" && !message.hasRecipient(p.DPID) {
+		if message.MessageHeader == nil {
+			message.MessageHeader = &MessageHeader{}
+		}
+		message.MessageHeader.MessageRecipient = append(message.MessageHeader.MessageRecipient, &MessageRecipient{
+			PartyId:   []PartyID{{Value: p.DPID}},
+			PartyName: []Name{{FullName: p.PartyName}},
+		})
+	}
+
+	if p.Keywords != (KeywordLimits{}) {
+		for _, warning := range message.NormalizeKeywords(p.Keywords) {
+			logEvent(nil, slog.LevelWarn, "ddex: keywords cut for recipient", "recipient", p.Name(),
+				"message_id", message.messageId(), "path", warning.Path, "warning", warning.Message)
+		}
+	}
+	return nil
+}
+
+// Validate runs ValidateWithOptions with the profile's options, then CheckTextLengths
+func (p *DSPProfile) Validate(message *NewReleaseMessage) error {
+	if err := message.ValidateWithOptions(p.Validation); err != nil {
+		return err
+	}
+	if errs := message.CheckTextLengths(p.Text); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// FileNaming returns Naming, or DDEXFileNaming without one
+func (p *DSPProfile) FileNaming() FileNaming {
+	if p.Naming == nil {
+		return DDEXFileNaming{}
+	}
+	return p.Naming
+}
+
+// YouTubeProfile is the RecipientProfile of YouTube: the YouTube recipient party, tag and
+// title limits of YouTube videos, and checksums on every file. With ContentID the message
+// is also addressed to YouTube Content ID.
+type YouTubeProfile struct {
+	ContentID bool
+}
+
+// Name returns "YouTube" or "YouTube_ContentID"
+func (p YouTubeProfile) Name() string {
+	if p.ContentID {
+		return "YouTube_ContentID"
+	}
+	return "YouTube"
+}
+
+// MutateMessage adds the YouTube recipients and cuts keywords down to YouTubeKeywordLimits
+func (p YouTubeProfile) MutateMessage(message *NewReleaseMessage) error {
+	if err := p.dsp().MutateMessage(message); err != nil {
+		return err
+	}
+	if p.ContentID {
+		contentID := &DSPProfile{DPID: YouTubeContentIDDPID, PartyName: "YouTube_ContentID"}
+		return contentID.MutateMessage(message)
+	}
+	return nil
+}
+
+// Validate runs Validate with YouTubeValidationOptions and checks YouTubeTextLimits
+func (p YouTubeProfile) Validate(message *NewReleaseMessage) error {
+	return p.dsp().Validate(message)
+}
+
+// FileNaming returns DDEXFileNaming
+func (p YouTubeProfile) FileNaming() FileNaming {
+	return DDEXFileNaming{}
+}
+
+// dsp returns the YouTube settings as a DSPProfile
+func (p YouTubeProfile) dsp() *DSPProfile {
+	return &DSPProfile{
+		ProfileName: p.Name(),
+		DPID:        YouTubeDPID,
+		PartyName:   "YouTube",
+		Keywords:    YouTubeKeywordLimits,
+		Text:        YouTubeTextLimits,
+		Validation:  YouTubeValidationOptions,
+	}
+}
+
+// hasRecipient reports whether the message is addressed to the party with the DPID
+func (nrm *NewReleaseMessage) hasRecipient(dpid string) bool {
+	if nrm.MessageHeader == nil {
+		return false
+	}
+	for _, recipient := range nrm.MessageHeader.MessageRecipient {
+		if recipient == nil {
+			continue
+		}
+		for _, id := range recipient.PartyId {
+			if id.Value == dpid {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// RedeliveryAction is what PlanRedelivery decided to send for a release
+type RedeliveryAction string
+
+const (
+	RedeliverNothing  RedeliveryAction = "Nothing"   // unchanged since the previous delivery
+	RedeliverFull     RedeliveryAction = "Full"      // new, or its metadata or resources changed
+	RedeliverDeals    RedeliveryAction = "DealsOnly" // only its deals changed; still sent in full
+	RedeliverTakeDown RedeliveryAction = "TakeDown"  // delivered before but gone from the catalog
+)
+
+// RedeliveryPlan is the decision for one release of the catalog
+type RedeliveryPlan struct {
+	Release string // ICPN, GRid, ISRC or proprietary ID of the main release, as in Diff paths
+	Action  RedeliveryAction
+	Reasons []Difference // What Diff found; may be empty, as Diff doesn't compare every element
+
+	// Message to send, nil for RedeliverNothing. It is a copy with a new MessageId, and
+	// UpdateMessage as update indicator unless the release is new.
+	Message *NewReleaseMessage
+}
+
+// PlanRedelivery compares the previous and current state of a catalog, given as the
+// messages delivered before and the messages as they would be built now, and decides per
+// release what to send. Messages are matched by the identifier of their main release,
+// so a catalog message holding several products should be split with SplitByRelease
+// first.
+//
+// A release whose resources or metadata changed is sent in full, and so is a release whose
+// deals alone changed: ERN 3.8 requires every message to carry its resources and the
+// ResourceGroups of its releases, so there is no valid message with the deals alone. The
+// action tells the two apart for recipients with their own way of updating deals.
+// Releases missing from current get the message delivered last, with its deals replaced
+// by a worldwide take-down. Plans are in the order of current, followed by the
+// take-downs.
+func PlanRedelivery(previous, current []*NewReleaseMessage) ([]RedeliveryPlan, error) {
+	delivered := make(map[string]*NewReleaseMessage)
+	for _, msg := range previous {
+		if key, ok := redeliveryKey(msg); ok {
+			delivered[key] = msg
+		}
+	}
+
+	var plans []RedeliveryPlan
+	seen := make(map[string]bool)
+	for _, msg := range current {
+		key, ok := redeliveryKey(msg)
+		if !ok {
+			return nil, fmt.Errorf("message %s has no releases", messageID(msg))
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("release %s is in more than one message", key)
+		}
+		seen[key] = true
+
+		plan := RedeliveryPlan{Release: key, Action: RedeliverNothing}
+		old, ok := delivered[key]
+		if !ok {
+			plan.Action = RedeliverFull
+			plan.Message = redeliveryMessage(msg, "")
+			plans = append(plans, plan)
+			continue
+		}
+
+		sameContent, sameDeals, err := compareDeliveries(old, msg)
+		if err != nil {
+			return nil, fmt.Errorf("release %s: %w", key, err)
+		}
+		switch {
+		case !sameContent:
+			plan.Action = RedeliverFull
+		case !sameDeals:
+			plan.Action = RedeliverDeals
+		}
+		if plan.Action != RedeliverNothing {
+			plan.Message = redeliveryMessage(msg, "UpdateMessage")
+			plan.Reasons = Diff(old, msg)
+		}
+		plans = append(plans, plan)
+	}
+
+	for _, msg := range previous {
+		key, ok := redeliveryKey(msg)
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		message := redeliveryMessage(msg, "UpdateMessage")
+		takeDown := true
+		var releaseDeals []ReleaseDeal
+		for _, release := range message.ReleaseList.Release {
+			releaseDeals = append(releaseDeals, ReleaseDeal{
+				DealReleaseReference: release.ReleaseReference,
+				Deal: []Deal{{DealTerms: &DealTerms{
+					TakeDown:      &takeDown,
+					TerritoryCode: []string{"Worldwide"},
+				}}},
+			})
+		}
+		message.DealList = &DealList{ReleaseDeal: releaseDeals}
+		plans = append(plans, RedeliveryPlan{
+			Release: key,
+			Action:  RedeliverTakeDown,
+			Reasons: []Difference{{Kind: ChangeRemoved, Path: "Release[" + key + "]"}},
+			Message: message,
+		})
+	}
+
+	return plans, nil
+}
+
+// redeliveryKey returns the identifier matching a message across deliveries
+func redeliveryKey(msg *NewReleaseMessage) (string, bool) {
+	release := msg.mainRelease()
+	if release == nil {
+		return "", false
+	}
+	return releaseKey(*release), true
+}
+
+// messageID returns the MessageId of msg, if it has a header
+func messageID(msg *NewReleaseMessage) string {
+	if msg.MessageHeader == nil {
+		return ""
+	}
+	return msg.MessageHeader.MessageId
+}
+
+// compareDeliveries reports whether everything but the header and deals, and the deals,
+// are the same in two messages
+func compareDeliveries(old, new *NewReleaseMessage) (sameContent, sameDeals bool, err error) {
+	oldContent, oldDeals, err := deliveryContent(old)
+	if err != nil {
+		return false, false, err
+	}
+	newContent, newDeals, err := deliveryContent(new)
+	if err != nil {
+		return false, false, err
+	}
+	return bytes.Equal(oldContent, newContent), bytes.Equal(oldDeals, newDeals), nil
+}
+
+// deliveryContent serializes a message without its header and update indicator, and its
+// deal list on its own
+func deliveryContent(msg *NewReleaseMessage) (content, deals []byte, err error) {
+	stripped := *msg
+	stripped.MessageHeader = nil
+	stripped.UpdateIndicator = ""
+	stripped.DealList = nil
+	if content, err = json.Marshal(&stripped); err != nil {
+		return nil, nil, fmt.Errorf("failed to compare messages: %w", err)
+	}
+	if deals, err = json.Marshal(msg.DealList); err != nil {
+		return nil, nil, fmt.Errorf("failed to compare messages: %w", err)
+	}
+	return content, deals, nil
+}
+
+// redeliveryMessage copies msg with a new MessageId and the update indicator, if set
+func redeliveryMessage(msg *NewReleaseMessage, updateIndicator string) *NewReleaseMessage {
+	message := msg.clone()
+	if updateIndicator != "" {
+		message.UpdateIndicator = updateIndicator
+	}
+	if message.MessageHeader != nil {
+		message.MessageHeader.MessageId = GenerateMessageID("")
+		message.MessageHeader.MessageFileName = ""
+	}
+	return message
+}
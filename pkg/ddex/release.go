@@ -4,193 +4,244 @@ import "encoding/xml"
 
 // ReleaseList lists all the Release composites
 type ReleaseList struct {
-	XMLName xml.Name  `xml:"ReleaseList"`
-	Release []Release `xml:"Release"`
+	XMLName    xml.Name     `xml:"ReleaseList" json:"-"`
+	Release    []Release    `xml:"Release" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // Release represents a single release for ERN 3.8
 // Following ERN 3.8 specification with mandatory ReferenceTitle and ReleaseDetailsByTerritory
 type Release struct {
-	XMLName                        xml.Name                        `xml:"Release"`
-	LanguageAndScriptCode          string                          `xml:"LanguageAndScriptCode,attr,omitempty"`
-	IsMainRelease                  bool                            `xml:"IsMainRelease,attr,omitempty"`
-	ReleaseId                      []ReleaseId                     `xml:"ReleaseId"`                                // 1-n
-	ReleaseReference               string                          `xml:"ReleaseReference,omitempty"`               // Mandatory (ID)
-	DisplayTitleText               []DisplayTitleText              `xml:"DisplayTitleText,omitempty"`               // 0-n
-	DisplayTitle                   []DisplayTitle                  `xml:"DisplayTitle,omitempty"`                   // 0-n
-	AdditionalTitle                []AdditionalTitle               `xml:"AdditionalTitle,omitempty"`                // 0-n
-	ExternalResourceLink           []ExternalResourceLink          `xml:"ExternalResourceLink,omitempty"`           // 0-n
-	ReferenceTitle                 *ReferenceTitle                 `xml:"ReferenceTitle"`                           // Mandatory (1)
-	ReleaseResourceReferenceList   *ReleaseResourceReferenceList   `xml:"ReleaseResourceReferenceList,omitempty"`   // 0-1
-	ReleaseCollectionReferenceList *ReleaseCollectionReferenceList `xml:"ReleaseCollectionReferenceList,omitempty"` // 0-1
-	IsCompilation                  *bool                           `xml:"IsCompilation,omitempty"`                  // 0-1
-	ReleaseType                    []ReleaseType                   `xml:"ReleaseType,omitempty"`                    // 0-n
-	ReleaseDetailsByTerritory      []ReleaseDetailsByTerritory     `xml:"ReleaseDetailsByTerritory"`                // 1-n (Mandatory)
-	LanguageOfPerformance          []string                        `xml:"LanguageOfPerformance,omitempty"`          // 0-n
-	LanguageOfDubbing              []string                        `xml:"LanguageOfDubbing,omitempty"`              // 0-n
-	SubTitleLanguage               []string                        `xml:"SubTitleLanguage,omitempty"`               // 0-n
-	Duration                       string                          `xml:"Duration,omitempty"`                       // 0-1
-	PLine                          []PLine                         `xml:"PLine,omitempty"`                          // 0-n
-	CLine                          []CLine                         `xml:"CLine,omitempty"`                          // 0-n
-	GlobalReleaseDate              *EventDate                      `xml:"GlobalReleaseDate,omitempty"`              // 0-1
-	GlobalOriginalReleaseDate      *EventDate                      `xml:"GlobalOriginalReleaseDate,omitempty"`      // 0-1
+	XMLName                        xml.Name                        `xml:"Release" json:"-"`
+	LanguageAndScriptCode          string                          `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+	IsMainRelease                  bool                            `xml:"IsMainRelease,attr,omitempty" json:",omitempty"`
+	ReleaseId                      []ReleaseId                     `xml:"ReleaseId" json:",omitempty"`                                // 1-n
+	ReleaseReference               string                          `xml:"ReleaseReference,omitempty" json:",omitempty"`               // Mandatory (ID)
+	ExternalResourceLink           []ExternalResourceLink          `xml:"ExternalResourceLink,omitempty" json:",omitempty"`           // 0-n
+	DisplayTitleText               []DisplayTitleText              `xml:"DisplayTitleText,omitempty" json:",omitempty"`               // 0-n
+	DisplayTitle                   []DisplayTitle                  `xml:"DisplayTitle,omitempty" json:",omitempty"`                   // 0-n
+	AdditionalTitle                []AdditionalTitle               `xml:"AdditionalTitle,omitempty" json:",omitempty"`                // 0-n
+	ReferenceTitle                 *ReferenceTitle                 `xml:"ReferenceTitle" json:",omitempty"`                           // Mandatory (1)
+	ReleaseResourceReferenceList   *ReleaseResourceReferenceList   `xml:"ReleaseResourceReferenceList,omitempty" json:",omitempty"`   // 0-1
+	ReleaseCollectionReferenceList *ReleaseCollectionReferenceList `xml:"ReleaseCollectionReferenceList,omitempty" json:",omitempty"` // 0-1
+	RelatedResource                []RelatedResource               `xml:"RelatedResource,omitempty" json:",omitempty"`                // 0-n
+	IsCompilation                  *bool                           `xml:"IsCompilation,omitempty" json:",omitempty"`                  // 0-1
+	ReleaseType                    []ReleaseType                   `xml:"ReleaseType,omitempty" json:",omitempty"`                    // 0-n
+	ReleaseDetailsByTerritory      []ReleaseDetailsByTerritory     `xml:"ReleaseDetailsByTerritory" json:",omitempty"`                // 1-n (Mandatory)
+	LanguageOfPerformance          []string                        `xml:"LanguageOfPerformance,omitempty" json:",omitempty"`          // 0-n
+	LanguageOfDubbing              []string                        `xml:"LanguageOfDubbing,omitempty" json:",omitempty"`              // 0-n
+	SubTitleLanguage               []string                        `xml:"SubTitleLanguage,omitempty" json:",omitempty"`               // 0-n
+	Duration                       string                          `xml:"Duration,omitempty" json:",omitempty"`                       // 0-1
+	PLine                          []PLine                         `xml:"PLine,omitempty" json:",omitempty"`                          // 0-n
+	CLine                          []CLine                         `xml:"CLine,omitempty" json:",omitempty"`                          // 0-n
+	GlobalReleaseDate              *EventDate                      `xml:"GlobalReleaseDate,omitempty" json:",omitempty"`              // 0-1
+	GlobalOriginalReleaseDate      *EventDate                      `xml:"GlobalOriginalReleaseDate,omitempty" json:",omitempty"`      // 0-1
+	Extensions                     []RawElement                    `xml:",any" json:",omitempty"`
 }
 
 // ReleaseResourceReferenceList represents a list of resource references
 type ReleaseResourceReferenceList struct {
-	XMLName                  xml.Name                   `xml:"ReleaseResourceReferenceList"`
-	ReleaseResourceReference []ReleaseResourceReference `xml:"ReleaseResourceReference"`
+	XMLName                  xml.Name                   `xml:"ReleaseResourceReferenceList" json:"-"`
+	ReleaseResourceReference []ReleaseResourceReference `xml:"ReleaseResourceReference" json:",omitempty"`
+	Extensions               []RawElement               `xml:",any" json:",omitempty"`
 }
 
 // ReleaseResourceReference represents a single resource reference with its type
 type ReleaseResourceReference struct {
-	ReleaseResourceType string `xml:"ReleaseResourceType,attr,omitempty"`
-	Value               string `xml:",chardata"`
+	ReleaseResourceType string `xml:"ReleaseResourceType,attr,omitempty" json:",omitempty"`
+	Value               string `xml:",chardata" json:",omitempty"`
 }
 
 // ReleaseCollectionReferenceList represents a list of collection references
 type ReleaseCollectionReferenceList struct {
-	XMLName                    xml.Name `xml:"ReleaseCollectionReferenceList"`
-	ReleaseCollectionReference []string `xml:"ReleaseCollectionReference"`
+	XMLName                    xml.Name     `xml:"ReleaseCollectionReferenceList" json:"-"`
+	ReleaseCollectionReference []string     `xml:"ReleaseCollectionReference" json:",omitempty"`
+	Extensions                 []RawElement `xml:",any" json:",omitempty"`
 }
 
 // ReferenceTitle represents the reference title of a release (mandatory in ERN 3.8)
 type ReferenceTitle struct {
-	XMLName   xml.Name `xml:"ReferenceTitle"`
-	TitleText string   `xml:"TitleText"`
-	SubTitle  string   `xml:"SubTitle,omitempty"`
+	XMLName    xml.Name     `xml:"ReferenceTitle" json:"-"`
+	TitleText  string       `xml:"TitleText" json:",omitempty"`
+	SubTitle   string       `xml:"SubTitle,omitempty" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // ReleaseType represents the form in which a release is offered
 type ReleaseType struct {
-	XMLName xml.Name `xml:"ReleaseType"`
-	Value   string   `xml:",chardata"`
+	XMLName xml.Name `xml:"ReleaseType" json:"-"`
+	Value   string   `xml:",chardata" json:",omitempty"`
 }
 
 // ExternalResourceLink represents promotional or other material related to the release
 type ExternalResourceLink struct {
-	XMLName xml.Name `xml:"ExternalResourceLink"`
-	URL     string   `xml:"URL"`
+	XMLName    xml.Name     `xml:"ExternalResourceLink" json:"-"`
+	URL        string       `xml:"URL" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // ReleaseDetailsByTerritory contains territory-specific release details (mandatory in ERN 3.8)
 type ReleaseDetailsByTerritory struct {
-	XMLName                     xml.Name                      `xml:"ReleaseDetailsByTerritory"`
-	LanguageAndScriptCode       string                        `xml:"LanguageAndScriptCode,attr,omitempty"`
-	TerritoryCode               []string                      `xml:"TerritoryCode,omitempty"`
-	ExcludedTerritoryCode       []string                      `xml:"ExcludedTerritoryCode,omitempty"`
-	DisplayArtistName           []DisplayArtistName           `xml:"DisplayArtistName,omitempty"`
-	LabelName                   []LabelName                   `xml:"LabelName,omitempty"`
-	Title                       []Title                       `xml:"Title,omitempty"`
-	DisplayArtist               []DisplayArtist               `xml:"DisplayArtist,omitempty"`
-	IsMultiArtistCompilation    bool                          `xml:"IsMultiArtistCompilation,omitempty"`
-	AdministratingRecordCompany []AdministratingRecordCompany `xml:"AdministratingRecordCompany,omitempty"`
-	ReleaseType                 []ReleaseType                 `xml:"ReleaseType,omitempty"`
-	RelatedRelease              []RelatedRelease              `xml:"RelatedRelease,omitempty"`
-	ParentalWarningType         []ParentalWarningType         `xml:"ParentalWarningType,omitempty"`
-	AvRating                    []AvRating                    `xml:"AvRating,omitempty"`
-	MarketingComment            *Comment                      `xml:"MarketingComment,omitempty"`
-	ResourceGroup               []ResourceGroup               `xml:"ResourceGroup,omitempty"`
-	Genre                       []Genre                       `xml:"Genre,omitempty"`
-	PLine                       []PLine                       `xml:"PLine,omitempty"`
-	CLine                       []CLine                       `xml:"CLine,omitempty"`
-	ReleaseDate                 *EventDate                    `xml:"ReleaseDate,omitempty"`
-	OriginalReleaseDate         *EventDate                    `xml:"OriginalReleaseDate,omitempty"`
-	Keywords                    []Keywords                    `xml:"Keywords,omitempty"`
-	Synopsis                    *Synopsis                     `xml:"Synopsis,omitempty"`
+	XMLName                     xml.Name                      `xml:"ReleaseDetailsByTerritory" json:"-"`
+	LanguageAndScriptCode       string                        `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+	TerritoryCode               []string                      `xml:"TerritoryCode,omitempty" json:",omitempty"`
+	ExcludedTerritoryCode       []string                      `xml:"ExcludedTerritoryCode,omitempty" json:",omitempty"`
+	DisplayArtistName           []DisplayArtistName           `xml:"DisplayArtistName,omitempty" json:",omitempty"`
+	LabelName                   []LabelName                   `xml:"LabelName,omitempty" json:",omitempty"`
+	Title                       []Title                       `xml:"Title,omitempty" json:",omitempty"`
+	DisplayArtist               []DisplayArtist               `xml:"DisplayArtist,omitempty" json:",omitempty"`
+	IsMultiArtistCompilation    bool                          `xml:"IsMultiArtistCompilation,omitempty" json:",omitempty"`
+	AdministratingRecordCompany []AdministratingRecordCompany `xml:"AdministratingRecordCompany,omitempty" json:",omitempty"`
+	ReleaseType                 []ReleaseType                 `xml:"ReleaseType,omitempty" json:",omitempty"`
+	RelatedRelease              []RelatedRelease              `xml:"RelatedRelease,omitempty" json:",omitempty"`
+	ParentalWarningType         []ParentalWarningType         `xml:"ParentalWarningType,omitempty" json:",omitempty"`
+	AvRating                    []AvRating                    `xml:"AvRating,omitempty" json:",omitempty"`
+	MarketingComment            *Comment                      `xml:"MarketingComment,omitempty" json:",omitempty"`
+	ResourceGroup               []ResourceGroup               `xml:"ResourceGroup,omitempty" json:",omitempty"`
+	Genre                       []Genre                       `xml:"Genre,omitempty" json:",omitempty"`
+	PLine                       []PLine                       `xml:"PLine,omitempty" json:",omitempty"`
+	CLine                       []CLine                       `xml:"CLine,omitempty" json:",omitempty"`
+	ReleaseDate                 *EventDate                    `xml:"ReleaseDate,omitempty" json:",omitempty"`
+	OriginalReleaseDate         *EventDate                    `xml:"OriginalReleaseDate,omitempty" json:",omitempty"`
+	Keywords                    []Keywords                    `xml:"Keywords,omitempty" json:",omitempty"`
+	Synopsis                    *Synopsis                     `xml:"Synopsis,omitempty" json:",omitempty"`
+	Extensions                  []RawElement                  `xml:",any" json:",omitempty"`
 }
 
 // LabelName represents the label name
 type LabelName struct {
-	XMLName               xml.Name `xml:"LabelName"`
-	LabelNameType         string   `xml:"LabelNameType,attr,omitempty"`
-	Value                 string   `xml:",chardata"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"LabelName" json:"-"`
+	LabelNameType         string   `xml:"LabelNameType,attr,omitempty" json:",omitempty"`
+	Value                 string   `xml:",chardata" json:",omitempty"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 }
 
 // Title represents a title (different from DisplayTitle)
 type Title struct {
-	XMLName               xml.Name `xml:"Title"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
-	TitleType             string   `xml:"TitleType,attr,omitempty"`
-	TitleText             string   `xml:"TitleText"`
-	SubTitle              string   `xml:"SubTitle,omitempty"`
+	XMLName               xml.Name     `xml:"Title" json:"-"`
+	LanguageAndScriptCode string       `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+	TitleType             string       `xml:"TitleType,attr,omitempty" json:",omitempty"`
+	TitleText             string       `xml:"TitleText" json:",omitempty"`
+	SubTitle              string       `xml:"SubTitle,omitempty" json:",omitempty"`
+	Extensions            []RawElement `xml:",any" json:",omitempty"`
 }
 
 // AdministratingRecordCompany represents the administrating record company
 type AdministratingRecordCompany struct {
-	XMLName     xml.Name  `xml:"AdministratingRecordCompany"`
-	PartyId     []PartyId `xml:"PartyId,omitempty"`
-	PartyName   []Name    `xml:"PartyName,omitempty"`
-	TradingName string    `xml:"TradingName,omitempty"`
+	XMLName     xml.Name     `xml:"AdministratingRecordCompany" json:"-"`
+	PartyId     []PartyId    `xml:"PartyId,omitempty" json:",omitempty"`
+	PartyName   []Name       `xml:"PartyName,omitempty" json:",omitempty"`
+	TradingName string       `xml:"TradingName,omitempty" json:",omitempty"`
+	Extensions  []RawElement `xml:",any" json:",omitempty"`
 }
 
 // ParentalWarningType represents parental warning classification
 type ParentalWarningType struct {
-	XMLName xml.Name `xml:"ParentalWarningType"`
-	Value   string   `xml:",chardata"`
+	XMLName xml.Name `xml:"ParentalWarningType" json:"-"`
+	Value   string   `xml:",chardata" json:",omitempty"`
 }
 
+// Parental warning types
+const (
+	ParentalWarningExplicit              = "Explicit"
+	ParentalWarningExplicitContentEdited = "ExplicitContentEdited"
+	ParentalWarningNotExplicit           = "NotExplicit"
+	ParentalWarningNoAdviceAvailable     = "NoAdviceAvailable"
+)
+
 // Comment represents a comment (used for MarketingComment, etc.)
 type Comment struct {
-	XMLName               xml.Name `xml:",omitempty"`
-	Value                 string   `xml:",chardata"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:",omitempty" json:"-"`
+	Value                 string   `xml:",chardata" json:",omitempty"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 }
 
 // RelatedRelease represents a related release
 type RelatedRelease struct {
-	XMLName                 xml.Name  `xml:"RelatedRelease"`
-	ReleaseId               ReleaseId `xml:"ReleaseId"`
-	ReleaseRelationshipType string    `xml:"ReleaseRelationshipType"`
+	XMLName                 xml.Name     `xml:"RelatedRelease" json:"-"`
+	ReleaseId               ReleaseId    `xml:"ReleaseId" json:",omitempty"`
+	ReleaseRelationshipType string       `xml:"ReleaseRelationshipType" json:",omitempty"`
+	Extensions              []RawElement `xml:",any" json:",omitempty"`
+}
+
+// Resource relationship types for RelatedResource
+const (
+	ResourceRelationshipHasContentFrom      = "HasContentFrom"
+	ResourceRelationshipIsDerivedFrom       = "IsDerivedFrom"
+	ResourceRelationshipIsDifferentEncoding = "IsDifferentEncoding"
+	ResourceRelationshipIsEquivalentToAudio = "IsEquivalentToAudio"
+	ResourceRelationshipIsRelatedTo         = "IsRelatedTo"
+)
+
+// RelatedResource identifies a resource the release relates to, e.g. the sound recording
+// a music video has content from. The resource is identified by its ResourceId when it
+// is not delivered in the message, or by ResourceRelatedResourceReference when it is.
+type RelatedResource struct {
+	XMLName                          xml.Name           `xml:"RelatedResource" json:"-"`
+	ResourceRelationshipType         string             `xml:"ResourceRelationshipType" json:",omitempty"`
+	ResourceRelatedResourceReference string             `xml:"ResourceRelatedResourceReference,omitempty" json:",omitempty"` // 0-1
+	ResourceId                       *ResourceRelatedId `xml:"ResourceId,omitempty" json:",omitempty"`                       // 0-1
+	Extensions                       []RawElement       `xml:",any" json:",omitempty"`
+}
+
+// ResourceRelatedId identifies a related resource outside the message
+type ResourceRelatedId struct {
+	XMLName       xml.Name        `xml:"ResourceId" json:"-"`
+	ISRC          string          `xml:"ISRC,omitempty" json:",omitempty"`
+	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty" json:",omitempty"`
+	Extensions    []RawElement    `xml:",any" json:",omitempty"`
 }
 
 // ReleaseId represents release identification (ICPN, GRid, ISRC, etc.) for ERN 3.8
 type ReleaseId struct {
-	XMLName       xml.Name        `xml:"ReleaseId"`
-	GRid          string          `xml:"GRid,omitempty"`          // 0-1
-	ISRC          string          `xml:"ISRC,omitempty"`          // 0-1
-	ICPN          string          `xml:"ICPN,omitempty"`          // 0-1
-	ISAN          string          `xml:"ISAN,omitempty"`          // 0-1
-	CatalogNumber *CatalogNumber  `xml:"CatalogNumber,omitempty"` // 0-1
-	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty"` // 0-n
+	XMLName       xml.Name        `xml:"ReleaseId" json:"-"`
+	GRid          string          `xml:"GRid,omitempty" json:",omitempty"`          // 0-1
+	ISRC          string          `xml:"ISRC,omitempty" json:",omitempty"`          // 0-1
+	ICPN          string          `xml:"ICPN,omitempty" json:",omitempty"`          // 0-1
+	ISAN          string          `xml:"ISAN,omitempty" json:",omitempty"`          // 0-1
+	CatalogNumber *CatalogNumber  `xml:"CatalogNumber,omitempty" json:",omitempty"` // 0-1
+	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty" json:",omitempty"` // 0-n
+	Extensions    []RawElement    `xml:",any" json:",omitempty"`
 }
 
 // CatalogNumber represents a catalog number
 type CatalogNumber struct {
-	XMLName   xml.Name `xml:"CatalogNumber"`
-	Value     string   `xml:",chardata"`
-	Namespace string   `xml:"Namespace,attr,omitempty"`
+	XMLName   xml.Name `xml:"CatalogNumber" json:"-"`
+	Value     string   `xml:",chardata" json:",omitempty"`
+	Namespace string   `xml:"Namespace,attr,omitempty" json:",omitempty"`
 }
 
 // ReleaseLabelReference has been simplified to just string in ERN 3.8
 
 // ResourceGroup represents a grouping of resources within a release
 type ResourceGroup struct {
-	XMLName                  xml.Name                   `xml:"ResourceGroup"`
-	Title                    Title                      `xml:"Title,omitempty"`
-	SequenceNumber           int                        `xml:"SequenceNumber,omitempty"`
-	ResourceGroupContentItem []ResourceGroupContentItem `xml:"ResourceGroupContentItem"`
+	XMLName                  xml.Name                   `xml:"ResourceGroup" json:"-"`
+	Title                    Title                      `xml:"Title,omitempty" json:",omitempty"`
+	SequenceNumber           int                        `xml:"SequenceNumber,omitempty" json:",omitempty"`
+	ResourceGroupContentItem []ResourceGroupContentItem `xml:"ResourceGroupContentItem" json:",omitempty"`
+	Extensions               []RawElement               `xml:",any" json:",omitempty"`
 }
 
 // AdditionalTitle represents additional title information
 type AdditionalTitle struct {
-	XMLName   xml.Name `xml:"AdditionalTitle"`
-	TitleText string   `xml:"TitleText"`
+	XMLName    xml.Name     `xml:"AdditionalTitle" json:"-"`
+	TitleText  string       `xml:"TitleText" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // ResourceGroupContentItem represents an item within a resource group
 type ResourceGroupContentItem struct {
-	XMLName                        xml.Name                         `xml:"ResourceGroupContentItem"`
-	SequenceNumber                 int                              `xml:"SequenceNumber,omitempty"`
-	ResourceType                   string                           `xml:"ResourceType,omitempty"`
-	ReleaseResourceReference       ReleaseResourceReference         `xml:"ReleaseResourceReference"`
-	LinkedReleaseResourceReference []LinkedReleaseResourceReference `xml:"LinkedReleaseResourceReference,omitempty"`
+	XMLName                        xml.Name                         `xml:"ResourceGroupContentItem" json:"-"`
+	SequenceNumber                 int                              `xml:"SequenceNumber,omitempty" json:",omitempty"`
+	ResourceType                   string                           `xml:"ResourceType,omitempty" json:",omitempty"`
+	ReleaseResourceReference       ReleaseResourceReference         `xml:"ReleaseResourceReference" json:",omitempty"`
+	LinkedReleaseResourceReference []LinkedReleaseResourceReference `xml:"LinkedReleaseResourceReference,omitempty" json:",omitempty"`
+	Extensions                     []RawElement                     `xml:",any" json:",omitempty"`
 }
 
 // LinkedReleaseResourceReference represents a linked resource reference (e.g., cover art)
 type LinkedReleaseResourceReference struct {
-	XMLName         xml.Name `xml:"LinkedReleaseResourceReference"`
-	LinkDescription string   `xml:"LinkDescription,attr,omitempty"`
-	Value           string   `xml:",chardata"`
+	XMLName         xml.Name `xml:"LinkedReleaseResourceReference" json:"-"`
+	LinkDescription string   `xml:"LinkDescription,attr,omitempty" json:",omitempty"`
+	Value           string   `xml:",chardata" json:",omitempty"`
 }
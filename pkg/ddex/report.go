@@ -0,0 +1,273 @@
+package ddex
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Rules of ValidationProblem that are not a Check method
+const (
+	RuleStructure  = "Structure"  // Mandatory header elements, releases and deals
+	RuleParseError = "ParseError" // The file could not be read as an ERN 3.8 message
+)
+
+// ruleDescriptions describe each rule for the tool section of SARIF logs
+var ruleDescriptions = map[string]string{
+	RuleStructure:                 "The MessageHeader has its mandatory elements, and there are releases, each with a deal",
+	RuleParseError:                "The file is a readable ERN 3.8 message",
+	"CheckIdentifiers":            "ISRCs are well-formed and ICPNs are UPCs or EANs with a valid check digit",
+	"CheckTerritoryOverlaps":      "DetailsByTerritory entries don't claim a territory another entry covers",
+	"CheckDealTerritories":        "Deals only grant territories their release has details for",
+	"CheckEventDates":             "Dates are ISO 8601 and original release dates don't follow release dates",
+	"CheckValidityPeriods":        "Validity periods are well-formed and deals for a release don't overlap",
+	"CheckResourceReferences":     "The ResourceList isn't empty and releases only list resources it contains",
+	"CheckDealResourceReferences": "Pre-order incentive and instant gratification resources belong to the deal's release",
+	"CheckOrphanResources":        "Every resource is listed by a release",
+	"CheckFileNames":              "File names are unique relative paths matching their codec",
+	"CheckCharacterParties":       "Characters refer to parties of the PartyList",
+	"CheckAvRatings":              "AvRatings apply to the territories of their details",
+	"CheckLanguageCodes":          "Language codes are ISO 639-2",
+	"CheckRightShares":            "Right shares of a territory add up to at most 100%, or exactly 100% where required",
+	"CheckAllowedValues":          "Types and codes are in their DDEX allowed-value set",
+	"CheckFileChecksums":          "Every file has a HashSum and FileSize",
+}
+
+// ValidationProblem is a problem found by a validation check, as reported by
+// ValidationProblems
+type ValidationProblem struct {
+	Rule    string // Check that found it, e.g. CheckIdentifiers, or RuleStructure
+	Path    string // Element with the problem; empty for structural problems
+	Message string
+}
+
+// ValidationProblems runs the checks of ValidateWithOptions and returns everything they
+// find. Unlike ValidateWithOptions it doesn't stop at the first check that fails, so that
+// reports list every problem of the message at once.
+func (nrm *NewReleaseMessage) ValidationProblems(opts ValidationOptions) []ValidationProblem {
+	var problems []ValidationProblem
+	if err := nrm.checkStructure(); err != nil {
+		problems = append(problems, ValidationProblem{Rule: RuleStructure, Message: err.Error()})
+	}
+
+	checks := validationChecks
+	if opts.RequireChecksums {
+		checks = append(checks[:len(checks):len(checks)], validationCheck{"CheckFileChecksums", (*NewReleaseMessage).CheckFileChecksums})
+	}
+	for _, check := range checks {
+		var errs ValidationErrors
+		if check.name == "CheckRightShares" && opts.RequireFullRightShares {
+			errs = nrm.CheckRightShares(true)
+		} else {
+			errs = check.run(nrm)
+		}
+		for _, err := range errs {
+			problems = append(problems, ValidationProblem{Rule: check.name, Path: err.Path, Message: err.Message})
+		}
+	}
+	return problems
+}
+
+// ValidationReport collects the validation problems of a set of files in a form CI
+// systems and catalog dashboards can consume: WriteJSON writes a stable JSON document and
+// WriteSARIF a SARIF 2.1.0 log
+type ValidationReport struct {
+	Files []FileReport
+}
+
+// FileReport is the validation result of one file
+type FileReport struct {
+	File      string
+	MessageId string
+	Problems  []ValidationProblem
+}
+
+// Add validates message, read from file, with opts and adds its problems to the report
+func (r *ValidationReport) Add(file string, message *NewReleaseMessage, opts ValidationOptions) *FileReport {
+	r.Files = append(r.Files, FileReport{
+		File:      file,
+		MessageId: message.messageId(),
+		Problems:  message.ValidationProblems(opts),
+	})
+	return &r.Files[len(r.Files)-1]
+}
+
+// AddError adds a file that could not be read or parsed, with err as its only problem
+func (r *ValidationReport) AddError(file string, err error) *FileReport {
+	r.Files = append(r.Files, FileReport{
+		File:     file,
+		Problems: []ValidationProblem{{Rule: RuleParseError, Message: err.Error()}},
+	})
+	return &r.Files[len(r.Files)-1]
+}
+
+// Valid reports whether no file of the report has a problem
+func (r *ValidationReport) Valid() bool {
+	for _, file := range r.Files {
+		if len(file.Problems) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidationReportVersion is the version of the JSON document of WriteJSON. It changes
+// only when fields are removed or change meaning; new fields may be added within a version.
+const ValidationReportVersion = 1
+
+type jsonReport struct {
+	Version int              `json:"version"`
+	Valid   bool             `json:"valid"`
+	Files   []jsonFileReport `json:"files"`
+}
+
+type jsonFileReport struct {
+	File      string        `json:"file"`
+	MessageId string        `json:"messageId,omitempty"`
+	Valid     bool          `json:"valid"`
+	Problems  []jsonProblem `json:"problems"`
+}
+
+type jsonProblem struct {
+	Rule    string `json:"rule"`
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message"`
+}
+
+// WriteJSON writes the report as JSON:
+//
+//	{"version": 1, "valid": false, "files": [{"file": "...", "messageId": "...", "valid": false,
+//	  "problems": [{"rule": "CheckIdentifiers", "path": "...", "message": "..."}]}]}
+//
+// files and problems are always arrays, in the order the files were added and the checks
+// ran.
+func (r *ValidationReport) WriteJSON(w io.Writer) error {
+	report := jsonReport{Version: ValidationReportVersion, Valid: r.Valid(), Files: []jsonFileReport{}}
+	for _, file := range r.Files {
+		fileReport := jsonFileReport{
+			File:      file.File,
+			MessageId: file.MessageId,
+			Valid:     len(file.Problems) == 0,
+			Problems:  []jsonProblem{},
+		}
+		for _, problem := range file.Problems {
+			fileReport.Problems = append(fileReport.Problems, jsonProblem(problem))
+		}
+		report.Files = append(report.Files, fileReport)
+	}
+	return encodeIndented(w, report)
+}
+
+// SARIF 2.1.0 log, limited to what WriteSARIF emits
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// WriteSARIF writes the report as a SARIF 2.1.0 log with a result of level "error" per
+// problem. Each result is located in its file and, when the problem has a path, at the
+// element of the path as a logical location.
+func (r *ValidationReport) WriteSARIF(w io.Writer) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "ddex",
+			InformationURI: "https://github.com/manosdetijera/ddex",
+			Rules:          []sarifRule{},
+		}},
+		Results: []sarifResult{},
+	}
+
+	used := make(map[string]bool)
+	for _, file := range r.Files {
+		for _, problem := range file.Problems {
+			used[problem.Rule] = true
+			location := sarifLocation{
+				PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: file.File}},
+			}
+			if problem.Path != "" {
+				location.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: problem.Path, Kind: "element"}}
+			}
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    problem.Rule,
+				Level:     "error",
+				Message:   sarifMessage{Text: problem.Message},
+				Locations: []sarifLocation{location},
+			})
+		}
+	}
+
+	rules := make([]string, 0, len(used))
+	for rule := range used {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	for _, rule := range rules {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: rule, ShortDescription: sarifMessage{Text: ruleDescriptions[rule]}})
+	}
+
+	return encodeIndented(w, sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	})
+}
+
+func encodeIndented(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to write validation report: %w", err)
+	}
+	return nil
+}
@@ -4,422 +4,573 @@ import "encoding/xml"
 
 // ResourceList lists all Resources composites in a release
 type ResourceList struct {
-	XMLName        xml.Name         `xml:"ResourceList"`
-	SoundRecording []SoundRecording `xml:"SoundRecording,omitempty"`
-	Video          []Video          `xml:"Video,omitempty"`
-	Image          []Image          `xml:"Image,omitempty"`
-	Text           []Text           `xml:"Text,omitempty"`
+	XMLName        xml.Name         `xml:"ResourceList" json:"-"`
+	SoundRecording []SoundRecording `xml:"SoundRecording,omitempty" json:",omitempty"`
+	Video          []Video          `xml:"Video,omitempty" json:",omitempty"`
+	Image          []Image          `xml:"Image,omitempty" json:",omitempty"`
+	Text           []Text           `xml:"Text,omitempty" json:",omitempty"`
+	Extensions     []RawElement     `xml:",any" json:",omitempty"`
 }
 
 // Video represents a video resource for ERN 3.8
 type Video struct {
-	XMLName               xml.Name `xml:"Video"`
-	IsUpdated             *bool    `xml:"IsUpdated,attr,omitempty"` // Deprecated
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"Video" json:"-"`
+	IsUpdated             *bool    `xml:"IsUpdated,attr,omitempty" json:",omitempty"` // Deprecated
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 
-	VideoType         *VideoType      `xml:"VideoType,omitempty"`
-	IsArtistRelated   *bool           `xml:"IsArtistRelated,omitempty"`
-	VideoId           *VideoId        `xml:"VideoId,omitempty"`         // 0-1
-	IndirectVideoId   []MusicalWorkId `xml:"IndirectVideoId,omitempty"` // 0-n
-	ResourceReference string          `xml:"ResourceReference"`         // Mandatory (ID)
+	VideoType         *VideoType      `xml:"VideoType,omitempty" json:",omitempty"`
+	IsArtistRelated   *bool           `xml:"IsArtistRelated,omitempty" json:",omitempty"`
+	VideoId           *VideoId        `xml:"VideoId,omitempty" json:",omitempty"`         // 0-1
+	IndirectVideoId   []MusicalWorkId `xml:"IndirectVideoId,omitempty" json:",omitempty"` // 0-n
+	ResourceReference string          `xml:"ResourceReference" json:",omitempty"`         // Mandatory (ID)
 
 	// Choice: VideoCueSheetReference OR ReasonForCueSheetAbsence
-	VideoCueSheetReference   []VideoCueSheetReference `xml:"VideoCueSheetReference,omitempty"`   // 1-n if present
-	ReasonForCueSheetAbsence *Reason                  `xml:"ReasonForCueSheetAbsence,omitempty"` // 1 if present
+	VideoCueSheetReference   []VideoCueSheetReference `xml:"VideoCueSheetReference,omitempty" json:",omitempty"`   // 1-n if present
+	ReasonForCueSheetAbsence *Reason                  `xml:"ReasonForCueSheetAbsence,omitempty" json:",omitempty"` // 1 if present
 
-	ReferenceTitle             *ReferenceTitle `xml:"ReferenceTitle,omitempty"`
-	Title                      []Title         `xml:"Title,omitempty"` // 0-n
-	InstrumentationDescription *Description    `xml:"InstrumentationDescription,omitempty"`
+	ReferenceTitle             *ReferenceTitle `xml:"ReferenceTitle,omitempty" json:",omitempty"`
+	Title                      []Title         `xml:"Title,omitempty" json:",omitempty"` // 0-n
+	InstrumentationDescription *Description    `xml:"InstrumentationDescription,omitempty" json:",omitempty"`
 
 	// Boolean flags
-	IsMedley                     *bool `xml:"IsMedley,omitempty"`
-	IsPotpourri                  *bool `xml:"IsPotpourri,omitempty"`
-	IsInstrumental               *bool `xml:"IsInstrumental,omitempty"`
-	IsBackground                 *bool `xml:"IsBackground,omitempty"`
-	IsHiddenResource             *bool `xml:"IsHiddenResource,omitempty"`
-	IsBonusResource              *bool `xml:"IsBonusResource,omitempty"` // Deprecated
-	HasPreOrderFulfillment       *bool `xml:"HasPreOrderFulfillment,omitempty"`
-	IsRemastered                 *bool `xml:"IsRemastered,omitempty"`
-	NoSilenceBefore              *bool `xml:"NoSilenceBefore,omitempty"`
-	NoSilenceAfter               *bool `xml:"NoSilenceAfter,omitempty"`
-	PerformerInformationRequired *bool `xml:"PerformerInformationRequired,omitempty"`
+	IsMedley                     *bool `xml:"IsMedley,omitempty" json:",omitempty"`
+	IsPotpourri                  *bool `xml:"IsPotpourri,omitempty" json:",omitempty"`
+	IsInstrumental               *bool `xml:"IsInstrumental,omitempty" json:",omitempty"`
+	IsBackground                 *bool `xml:"IsBackground,omitempty" json:",omitempty"`
+	IsHiddenResource             *bool `xml:"IsHiddenResource,omitempty" json:",omitempty"`
+	IsBonusResource              *bool `xml:"IsBonusResource,omitempty" json:",omitempty"` // Deprecated
+	HasPreOrderFulfillment       *bool `xml:"HasPreOrderFulfillment,omitempty" json:",omitempty"`
+	IsRemastered                 *bool `xml:"IsRemastered,omitempty" json:",omitempty"`
+	NoSilenceBefore              *bool `xml:"NoSilenceBefore,omitempty" json:",omitempty"`
+	NoSilenceAfter               *bool `xml:"NoSilenceAfter,omitempty" json:",omitempty"`
+	PerformerInformationRequired *bool `xml:"PerformerInformationRequired,omitempty" json:",omitempty"`
 
 	// Language fields
-	LanguageOfPerformance []string `xml:"LanguageOfPerformance,omitempty"` // ISO 639-2
-	LanguageOfDubbing     []string `xml:"LanguageOfDubbing,omitempty"`     // ISO 639-2
-	SubTitleLanguage      []string `xml:"SubTitleLanguage,omitempty"`      // ISO 639-2
+	LanguageOfPerformance []string `xml:"LanguageOfPerformance,omitempty" json:",omitempty"` // ISO 639-2
+	LanguageOfDubbing     []string `xml:"LanguageOfDubbing,omitempty" json:",omitempty"`     // ISO 639-2
+	SubTitleLanguage      []string `xml:"SubTitleLanguage,omitempty" json:",omitempty"`      // ISO 639-2
 
-	Duration                               string                                  `xml:"Duration"` // Mandatory
-	RightsAgreementId                      *RightsAgreementId                      `xml:"RightsAgreementId,omitempty"`
-	VideoCollectionReferenceList           *SoundRecordingCollectionReferenceList  `xml:"VideoCollectionReferenceList,omitempty"`
-	ResourceMusicalWorkReferenceList       *ResourceMusicalWorkReferenceList       `xml:"ResourceMusicalWorkReferenceList,omitempty"`
-	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `xml:"ResourceContainedResourceReferenceList,omitempty"`
+	Duration                               string                                  `xml:"Duration" json:",omitempty"` // Mandatory
+	RightsAgreementId                      *RightsAgreementId                      `xml:"RightsAgreementId,omitempty" json:",omitempty"`
+	VideoCollectionReferenceList           *SoundRecordingCollectionReferenceList  `xml:"VideoCollectionReferenceList,omitempty" json:",omitempty"`
+	ResourceMusicalWorkReferenceList       *ResourceMusicalWorkReferenceList       `xml:"ResourceMusicalWorkReferenceList,omitempty" json:",omitempty"`
+	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `xml:"ResourceContainedResourceReferenceList,omitempty" json:",omitempty"`
 
 	// Date fields
-	CreationDate   *EventDate `xml:"CreationDate,omitempty"`
-	MasteredDate   *EventDate `xml:"MasteredDate,omitempty"`
-	RemasteredDate *EventDate `xml:"RemasteredDate,omitempty"`
+	CreationDate   *EventDate `xml:"CreationDate,omitempty" json:",omitempty"`
+	MasteredDate   *EventDate `xml:"MasteredDate,omitempty" json:",omitempty"`
+	RemasteredDate *EventDate `xml:"RemasteredDate,omitempty" json:",omitempty"`
 
-	VideoDetailsByTerritory  []VideoDetailsByTerritory `xml:"VideoDetailsByTerritory"` // Mandatory 1-n
-	TerritoryOfCommissioning string                    `xml:"TerritoryOfCommissioning,omitempty"`
+	VideoDetailsByTerritory  []VideoDetailsByTerritory `xml:"VideoDetailsByTerritory" json:",omitempty"` // Mandatory 1-n
+	TerritoryOfCommissioning string                    `xml:"TerritoryOfCommissioning,omitempty" json:",omitempty"`
 
 	// Artist count fields
-	NumberOfFeaturedArtists      *int `xml:"NumberOfFeaturedArtists,omitempty"`
-	NumberOfNonFeaturedArtists   *int `xml:"NumberOfNonFeaturedArtists,omitempty"`
-	NumberOfContractedArtists    *int `xml:"NumberOfContractedArtists,omitempty"`
-	NumberOfNonContractedArtists *int `xml:"NumberOfNonContractedArtists,omitempty"`
+	NumberOfFeaturedArtists      *int         `xml:"NumberOfFeaturedArtists,omitempty" json:",omitempty"`
+	NumberOfNonFeaturedArtists   *int         `xml:"NumberOfNonFeaturedArtists,omitempty" json:",omitempty"`
+	NumberOfContractedArtists    *int         `xml:"NumberOfContractedArtists,omitempty" json:",omitempty"`
+	NumberOfNonContractedArtists *int         `xml:"NumberOfNonContractedArtists,omitempty" json:",omitempty"`
+	Extensions                   []RawElement `xml:",any" json:",omitempty"`
 }
 
 // VideoDetailsByTerritory contains territory-specific video details for ERN 3.8
 type VideoDetailsByTerritory struct {
-	XMLName               xml.Name `xml:"VideoDetailsByTerritory"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"VideoDetailsByTerritory" json:"-"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 
 	// Territory (choice: TerritoryCode OR ExcludedTerritoryCode, at least one required)
-	TerritoryCode         []string `xml:"TerritoryCode,omitempty"`         // 1-n (if used)
-	ExcludedTerritoryCode []string `xml:"ExcludedTerritoryCode,omitempty"` // 1-n (if used)
+	TerritoryCode         []string `xml:"TerritoryCode,omitempty" json:",omitempty"`         // 1-n (if used)
+	ExcludedTerritoryCode []string `xml:"ExcludedTerritoryCode,omitempty" json:",omitempty"` // 1-n (if used)
 
 	// Title and display information
-	Title            []Title         `xml:"Title,omitempty"`            // 0-n
-	DisplayArtist    []DisplayArtist `xml:"DisplayArtist,omitempty"`    // 0-n
-	DisplayConductor []DisplayArtist `xml:"DisplayConductor,omitempty"` // 0-n (uses Artist type)
+	Title            []Title            `xml:"Title,omitempty" json:",omitempty"`            // 0-n
+	DisplayArtist    []DisplayArtist    `xml:"DisplayArtist,omitempty" json:",omitempty"`    // 0-n
+	DisplayConductor []DisplayConductor `xml:"DisplayConductor,omitempty" json:",omitempty"` // 0-n
 
 	// Contributors
-	ResourceContributor         []ResourceContributor         `xml:"ResourceContributor,omitempty"`         // 0-n
-	IndirectResourceContributor []IndirectResourceContributor `xml:"IndirectResourceContributor,omitempty"` // 0-n
+	ResourceContributor         []ResourceContributor         `xml:"ResourceContributor,omitempty" json:",omitempty"`         // 0-n
+	IndirectResourceContributor []IndirectResourceContributor `xml:"IndirectResourceContributor,omitempty" json:",omitempty"` // 0-n
 
 	// Rights and agreements
-	RightsAgreementId *RightsAgreementId  `xml:"RightsAgreementId,omitempty"` // 0-1
-	DisplayArtistName []DisplayArtistName `xml:"DisplayArtistName,omitempty"` // 0-n
-	LabelName         []LabelName         `xml:"LabelName,omitempty"`         // 0-n
-	RightsController  []RightsController  `xml:"RightsController,omitempty"`  // 0-n (TypedRightsController)
+	RightsAgreementId *RightsAgreementId  `xml:"RightsAgreementId,omitempty" json:",omitempty"` // 0-1
+	DisplayArtistName []DisplayArtistName `xml:"DisplayArtistName,omitempty" json:",omitempty"` // 0-n
+	LabelName         []LabelName         `xml:"LabelName,omitempty" json:",omitempty"`         // 0-n
+	RightsController  []RightsController  `xml:"RightsController,omitempty" json:",omitempty"`  // 0-n (TypedRightsController)
 
 	// Dates
-	RemasteredDate              *EventDate `xml:"RemasteredDate,omitempty"`              // 0-1
-	ResourceReleaseDate         *EventDate `xml:"ResourceReleaseDate,omitempty"`         // 0-1
-	OriginalResourceReleaseDate *EventDate `xml:"OriginalResourceReleaseDate,omitempty"` // 0-1
+	RemasteredDate              *EventDate `xml:"RemasteredDate,omitempty" json:",omitempty"`              // 0-1
+	ResourceReleaseDate         *EventDate `xml:"ResourceReleaseDate,omitempty" json:",omitempty"`         // 0-1
+	OriginalResourceReleaseDate *EventDate `xml:"OriginalResourceReleaseDate,omitempty" json:",omitempty"` // 0-1
 
 	// Copyright and credits
-	PLine        []PLine       `xml:"PLine,omitempty"`        // 0-n
-	CourtesyLine *CourtesyLine `xml:"CourtesyLine,omitempty"` // 0-1
+	PLine        []PLine       `xml:"PLine,omitempty" json:",omitempty"`        // 0-n
+	CLine        []CLine       `xml:"CLine,omitempty" json:",omitempty"`        // 0-n
+	CourtesyLine *CourtesyLine `xml:"CourtesyLine,omitempty" json:",omitempty"` // 0-1
 
 	// Sequencing
-	SequenceNumber *int `xml:"SequenceNumber,omitempty"` // 0-1
+	SequenceNumber *int `xml:"SequenceNumber,omitempty" json:",omitempty"` // 0-1
 
 	// Descriptive metadata
-	HostSoundCarrier    []HostSoundCarrier `xml:"HostSoundCarrier,omitempty"`    // 0-n
-	MarketingComment    *Comment           `xml:"MarketingComment,omitempty"`    // 0-1
-	Genre               []Genre            `xml:"Genre,omitempty"`               // 0-n
-	ParentalWarningType []string           `xml:"ParentalWarningType,omitempty"` // 0-n (ParentalWarningType)
-	AvRating            []AvRating         `xml:"AvRating,omitempty"`            // 0-n
-	FulfillmentDate     *FulfillmentDate   `xml:"FulfillmentDate,omitempty"`     // 0-1
-	Keywords            []Keywords         `xml:"Keywords,omitempty"`            // 0-n
-	Synopsis            *Synopsis          `xml:"Synopsis,omitempty"`            // 0-1
-	CLine               []CLine            `xml:"CLine,omitempty"`               // 0-n
+	HostSoundCarrier    []HostSoundCarrier `xml:"HostSoundCarrier,omitempty" json:",omitempty"`    // 0-n
+	MarketingComment    *Comment           `xml:"MarketingComment,omitempty" json:",omitempty"`    // 0-1
+	Genre               []Genre            `xml:"Genre,omitempty" json:",omitempty"`               // 0-n
+	ParentalWarningType []string           `xml:"ParentalWarningType,omitempty" json:",omitempty"` // 0-n (ParentalWarningType)
+	AvRating            []AvRating         `xml:"AvRating,omitempty" json:",omitempty"`            // 0-n
 
 	// Technical details
-	TechnicalVideoDetails []TechnicalVideoDetails `xml:"TechnicalVideoDetails,omitempty"` // 0-n
+	TechnicalVideoDetails []TechnicalVideoDetails `xml:"TechnicalVideoDetails,omitempty" json:",omitempty"` // 0-n
 
 	// Characters
-	Character []Character `xml:"Character,omitempty"` // 0-n
+	Character []Character `xml:"Character,omitempty" json:",omitempty"` // 0-n
+
+	// Fulfillment and descriptive text
+	FulfillmentDate *FulfillmentDate `xml:"FulfillmentDate,omitempty" json:",omitempty"` // 0-1
+	Keywords        []Keywords       `xml:"Keywords,omitempty" json:",omitempty"`        // 0-n
+	Synopsis        *Synopsis        `xml:"Synopsis,omitempty" json:",omitempty"`        // 0-1
+	Extensions      []RawElement     `xml:",any" json:",omitempty"`
 }
 
 // MusicalWorkId represents a musical work identifier
 type MusicalWorkId struct {
-	XMLName       xml.Name        `xml:"IndirectVideoId"`
-	ISWC          string          `xml:"ISWC,omitempty"`
-	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty"`
+	XMLName       xml.Name        `xml:",omitempty" json:"-"` // Element name comes from the parent field
+	ISWC          string          `xml:"ISWC,omitempty" json:",omitempty"`
+	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty" json:",omitempty"`
+	Extensions    []RawElement    `xml:",any" json:",omitempty"`
 }
 
 // ResourceMusicalWorkReferenceList contains references to musical works
 type ResourceMusicalWorkReferenceList struct {
-	XMLName                      xml.Name                       `xml:"ResourceMusicalWorkReferenceList"`
-	ResourceMusicalWorkReference []ResourceMusicalWorkReference `xml:"ResourceMusicalWorkReference,omitempty"`
+	XMLName                      xml.Name                       `xml:"ResourceMusicalWorkReferenceList" json:"-"`
+	ResourceMusicalWorkReference []ResourceMusicalWorkReference `xml:"ResourceMusicalWorkReference,omitempty" json:",omitempty"`
+	Extensions                   []RawElement                   `xml:",any" json:",omitempty"`
 }
 
 // ResourceMusicalWorkReference references a musical work
 type ResourceMusicalWorkReference struct {
-	XMLName       xml.Name        `xml:"ResourceMusicalWorkReference"`
-	MusicalWorkId []MusicalWorkId `xml:"MusicalWorkId,omitempty"`
-	Duration      string          `xml:"Duration,omitempty"`
-	StartPoint    string          `xml:"StartPoint,omitempty"`
+	XMLName       xml.Name        `xml:"ResourceMusicalWorkReference" json:"-"`
+	MusicalWorkId []MusicalWorkId `xml:"MusicalWorkId,omitempty" json:",omitempty"`
+	Duration      string          `xml:"Duration,omitempty" json:",omitempty"`
+	StartPoint    string          `xml:"StartPoint,omitempty" json:",omitempty"`
+	Extensions    []RawElement    `xml:",any" json:",omitempty"`
 }
 
 // ResourceContainedResourceReferenceList contains references to contained resources
 type ResourceContainedResourceReferenceList struct {
-	XMLName                            xml.Name                             `xml:"ResourceContainedResourceReferenceList"`
-	ResourceContainedResourceReference []ResourceContainedResourceReference `xml:"ResourceContainedResourceReference,omitempty"`
+	XMLName                            xml.Name                             `xml:"ResourceContainedResourceReferenceList" json:"-"`
+	ResourceContainedResourceReference []ResourceContainedResourceReference `xml:"ResourceContainedResourceReference,omitempty" json:",omitempty"`
+	Extensions                         []RawElement                         `xml:",any" json:",omitempty"`
 }
 
 // ResourceContainedResourceReference references a contained resource
 type ResourceContainedResourceReference struct {
-	XMLName                            xml.Name `xml:"ResourceContainedResourceReference"`
-	ResourceContainedResourceReference string   `xml:",chardata"`
-	DurationUsed                       string   `xml:"DurationUsed,omitempty"`
-	StartPoint                         string   `xml:"StartPoint,omitempty"`
+	XMLName                            xml.Name `xml:"ResourceContainedResourceReference" json:"-"`
+	ResourceContainedResourceReference string   `xml:",chardata" json:",omitempty"`
+	DurationUsed                       string   `xml:"DurationUsed,omitempty" json:",omitempty"`
+	StartPoint                         string   `xml:"StartPoint,omitempty" json:",omitempty"`
 }
 
 // VideoCueSheetReference references a cue sheet
 type VideoCueSheetReference struct {
-	XMLName xml.Name `xml:"VideoCueSheetReference"`
-	Value   string   `xml:",chardata"`
+	XMLName xml.Name `xml:"VideoCueSheetReference" json:"-"`
+	Value   string   `xml:",chardata" json:",omitempty"`
 }
 
 // Reason provides a textual reason
 type Reason struct {
-	XMLName               xml.Name `xml:"ReasonForCueSheetAbsence"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
-	Value                 string   `xml:",chardata"`
+	XMLName               xml.Name `xml:"ReasonForCueSheetAbsence" json:"-"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+	Value                 string   `xml:",chardata" json:",omitempty"`
 }
 
 // Description provides textual description
 type Description struct {
-	LanguageAndScriptCode string `xml:"LanguageAndScriptCode,attr,omitempty"`
-	Value                 string `xml:",chardata"`
+	LanguageAndScriptCode string `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+	Value                 string `xml:",chardata" json:",omitempty"`
 }
 
 // RightsAgreementId identifies rights agreements
 type RightsAgreementId struct {
-	XMLName       xml.Name        `xml:"RightsAgreementId"`
-	MWLI          string          `xml:"MWLI,omitempty"`
-	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty"`
+	XMLName       xml.Name        `xml:"RightsAgreementId" json:"-"`
+	MWLI          string          `xml:"MWLI,omitempty" json:",omitempty"`
+	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty" json:",omitempty"`
+	Extensions    []RawElement    `xml:",any" json:",omitempty"`
 }
 
 // SoundRecordingCollectionReferenceList contains collection references (used for VideoCollectionReferenceList)
 type SoundRecordingCollectionReferenceList struct {
-	XMLName                           xml.Name                            `xml:"VideoCollectionReferenceList"`
-	SoundRecordingCollectionReference []SoundRecordingCollectionReference `xml:"SoundRecordingCollectionReference,omitempty"`
+	XMLName                           xml.Name                            `xml:"VideoCollectionReferenceList" json:"-"`
+	SoundRecordingCollectionReference []SoundRecordingCollectionReference `xml:"SoundRecordingCollectionReference,omitempty" json:",omitempty"`
+	Extensions                        []RawElement                        `xml:",any" json:",omitempty"`
 }
 
 // SoundRecordingCollectionReference references a collection
 type SoundRecordingCollectionReference struct {
-	XMLName xml.Name `xml:"SoundRecordingCollectionReference"`
-	Value   string   `xml:",chardata"`
+	XMLName xml.Name `xml:"SoundRecordingCollectionReference" json:"-"`
+	Value   string   `xml:",chardata" json:",omitempty"`
 }
 
 // Character represents a character in the video
 type Character struct {
-	XMLName                 xml.Name `xml:"Character"`
-	CharacterPartyReference string   `xml:"CharacterPartyReference,omitempty"`
-	Name                    string   `xml:"Name,omitempty"`
+	XMLName                 xml.Name     `xml:"Character" json:"-"`
+	CharacterPartyReference string       `xml:"CharacterPartyReference,omitempty" json:",omitempty"`
+	Name                    string       `xml:"Name,omitempty" json:",omitempty"`
+	Extensions              []RawElement `xml:",any" json:",omitempty"`
 }
 
 // CourtesyLine represents a courtesy line
 type CourtesyLine struct {
-	XMLName          xml.Name `xml:"CourtesyLine"`
-	Year             int      `xml:"Year,omitempty"`
-	CourtesyLineText string   `xml:"CourtesyLineText"`
+	XMLName          xml.Name     `xml:"CourtesyLine" json:"-"`
+	Year             int          `xml:"Year,omitempty" json:",omitempty"`
+	CourtesyLineText string       `xml:"CourtesyLineText" json:",omitempty"`
+	Extensions       []RawElement `xml:",any" json:",omitempty"`
 }
 
-// FulfillmentDate represents a fulfillment date
+// FulfillmentDate is the date from which a resource may be fulfilled to consumers, e.g. a
+// track available before its release. The date is a child element named FulfillmentDate as
+// well, hence the field name Date.
 type FulfillmentDate struct {
-	XMLName                  xml.Name `xml:"FulfillmentDate"`
-	FulfillmentDate          string   `xml:"FulfillmentDate"`
-	ResourceReleaseReference string   `xml:"ResourceReleaseReference,omitempty"`
+	XMLName                  xml.Name     `xml:"FulfillmentDate" json:"-"`
+	Date                     string       `xml:"FulfillmentDate" json:",omitempty"`
+	ResourceReleaseReference string       `xml:"ResourceReleaseReference,omitempty" json:",omitempty"`
+	Extensions               []RawElement `xml:",any" json:",omitempty"`
 }
 
 // VideoId represents video identification for ERN 3.8
 type VideoId struct {
-	XMLName       xml.Name        `xml:"VideoId"`
-	ISRC          string          `xml:"ISRC,omitempty"`
-	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty"`
+	XMLName       xml.Name        `xml:"VideoId" json:"-"`
+	ISRC          string          `xml:"ISRC,omitempty" json:",omitempty"`
+	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty" json:",omitempty"`
+	Extensions    []RawElement    `xml:",any" json:",omitempty"`
 }
 
 // Image represents an image resource for ERN 3.8
 type Image struct {
-	XMLName               xml.Name `xml:"Image"`
-	IsUpdated             *bool    `xml:"IsUpdated,attr,omitempty"` // Deprecated (0-1)
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"Image" json:"-"`
+	IsUpdated             *bool    `xml:"IsUpdated,attr,omitempty" json:",omitempty"` // Deprecated (0-1)
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 
 	// Type and classification
-	ImageType       *ImageType `xml:"ImageType,omitempty"`       // 0-1
-	IsArtistRelated *bool      `xml:"IsArtistRelated,omitempty"` // 0-1
+	ImageType       *ImageType `xml:"ImageType,omitempty" json:",omitempty"`       // 0-1
+	IsArtistRelated *bool      `xml:"IsArtistRelated,omitempty" json:",omitempty"` // 0-1
 
 	// Identifiers
-	ImageId           []ImageId `xml:"ImageId"`           // Mandatory 1-n
-	ResourceReference string    `xml:"ResourceReference"` // Mandatory (ID)
+	ImageId           []ImageId `xml:"ImageId" json:",omitempty"`           // Mandatory 1-n
+	ResourceReference string    `xml:"ResourceReference" json:",omitempty"` // Mandatory (ID)
 
 	// Descriptive information
-	Title        []Title    `xml:"Title,omitempty"`        // 0-n
-	CreationDate *EventDate `xml:"CreationDate,omitempty"` // 0-1
+	Title        []Title    `xml:"Title,omitempty" json:",omitempty"`        // 0-n
+	CreationDate *EventDate `xml:"CreationDate,omitempty" json:",omitempty"` // 0-1
 
 	// Territory-specific details
-	ImageDetailsByTerritory []ImageDetailsByTerritory `xml:"ImageDetailsByTerritory"` // Mandatory 1-n
+	ImageDetailsByTerritory []ImageDetailsByTerritory `xml:"ImageDetailsByTerritory" json:",omitempty"` // Mandatory 1-n
+	Extensions              []RawElement              `xml:",any" json:",omitempty"`
 }
 
 // ImageType represents the type of an image
 type ImageType struct {
-	XMLName xml.Name `xml:"ImageType"`
-	Value   string   `xml:",chardata"`
+	XMLName xml.Name `xml:"ImageType" json:"-"`
+	Value   string   `xml:",chardata" json:",omitempty"`
 }
 
 // ImageDetailsByTerritory contains territory-specific image details for ERN 3.8
 type ImageDetailsByTerritory struct {
-	XMLName               xml.Name `xml:"ImageDetailsByTerritory"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"ImageDetailsByTerritory" json:"-"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 
 	// Territory (choice: TerritoryCode OR ExcludedTerritoryCode, at least one required)
-	TerritoryCode         []string `xml:"TerritoryCode,omitempty"`         // 1-n (if used)
-	ExcludedTerritoryCode []string `xml:"ExcludedTerritoryCode,omitempty"` // 1-n (if used)
+	TerritoryCode         []string `xml:"TerritoryCode,omitempty" json:",omitempty"`         // 1-n (if used)
+	ExcludedTerritoryCode []string `xml:"ExcludedTerritoryCode,omitempty" json:",omitempty"` // 1-n (if used)
 
 	// Title and contributors
-	Title                       []Title                       `xml:"Title,omitempty"`                       // 0-n
-	ResourceContributor         []ResourceContributor         `xml:"ResourceContributor,omitempty"`         // 0-n
-	IndirectResourceContributor []IndirectResourceContributor `xml:"IndirectResourceContributor,omitempty"` // 0-n
-	DisplayArtistName           []DisplayArtistName           `xml:"DisplayArtistName,omitempty"`           // 0-n
+	Title                       []Title                       `xml:"Title,omitempty" json:",omitempty"`                       // 0-n
+	ResourceContributor         []ResourceContributor         `xml:"ResourceContributor,omitempty" json:",omitempty"`         // 0-n
+	IndirectResourceContributor []IndirectResourceContributor `xml:"IndirectResourceContributor,omitempty" json:",omitempty"` // 0-n
+	DisplayArtistName           []DisplayArtistName           `xml:"DisplayArtistName,omitempty" json:",omitempty"`           // 0-n
 
 	// Copyright and credits
-	CLine        []CLine       `xml:"CLine,omitempty"`        // 0-n
-	Description  *Description  `xml:"Description,omitempty"`  // 0-1
-	CourtesyLine *CourtesyLine `xml:"CourtesyLine,omitempty"` // 0-1
+	CLine        []CLine       `xml:"CLine,omitempty" json:",omitempty"`        // 0-n
+	Description  *Description  `xml:"Description,omitempty" json:",omitempty"`  // 0-1
+	CourtesyLine *CourtesyLine `xml:"CourtesyLine,omitempty" json:",omitempty"` // 0-1
 
 	// Dates
-	ResourceReleaseDate         *EventDate       `xml:"ResourceReleaseDate,omitempty"`         // 0-1
-	OriginalResourceReleaseDate *EventDate       `xml:"OriginalResourceReleaseDate,omitempty"` // 0-1
-	FulfillmentDate             *FulfillmentDate `xml:"FulfillmentDate,omitempty"`             // 0-1
+	ResourceReleaseDate         *EventDate       `xml:"ResourceReleaseDate,omitempty" json:",omitempty"`         // 0-1
+	OriginalResourceReleaseDate *EventDate       `xml:"OriginalResourceReleaseDate,omitempty" json:",omitempty"` // 0-1
+	FulfillmentDate             *FulfillmentDate `xml:"FulfillmentDate,omitempty" json:",omitempty"`             // 0-1
 
 	// Descriptive metadata
-	Keywords            []Keywords `xml:"Keywords,omitempty"`            // 0-n
-	Synopsis            *Synopsis  `xml:"Synopsis,omitempty"`            // 0-1
-	Genre               []Genre    `xml:"Genre,omitempty"`               // 0-n
-	ParentalWarningType []string   `xml:"ParentalWarningType,omitempty"` // 0-n
+	Keywords            []Keywords `xml:"Keywords,omitempty" json:",omitempty"`            // 0-n
+	Synopsis            *Synopsis  `xml:"Synopsis,omitempty" json:",omitempty"`            // 0-1
+	Genre               []Genre    `xml:"Genre,omitempty" json:",omitempty"`               // 0-n
+	ParentalWarningType []string   `xml:"ParentalWarningType,omitempty" json:",omitempty"` // 0-n
 
 	// Technical details
-	TechnicalImageDetails []TechnicalImageDetails `xml:"TechnicalImageDetails,omitempty"` // 0-n
+	TechnicalImageDetails []TechnicalImageDetails `xml:"TechnicalImageDetails,omitempty" json:",omitempty"` // 0-n
+	Extensions            []RawElement            `xml:",any" json:",omitempty"`
 }
 
 // ImageId represents image identification
 type ImageId struct {
-	XMLName       xml.Name        `xml:"ImageId"`
-	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty"`
+	XMLName       xml.Name        `xml:"ImageId" json:"-"`
+	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty" json:",omitempty"`
+	Extensions    []RawElement    `xml:",any" json:",omitempty"`
 }
 
 // IndirectResourceId represents an indirect resource identifier
 type IndirectResourceId struct {
-	XMLName       xml.Name        `xml:"IndirectResourceId"`
-	ISRC          string          `xml:"ISRC,omitempty"`
-	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty"`
+	XMLName       xml.Name        `xml:"IndirectResourceId" json:"-"`
+	ISRC          string          `xml:"ISRC,omitempty" json:",omitempty"`
+	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty" json:",omitempty"`
+	Extensions    []RawElement    `xml:",any" json:",omitempty"`
 }
 
-// SoundRecording represents an audio resource
+// SoundRecording represents an audio resource for ERN 3.8
 type SoundRecording struct {
-	XMLName           xml.Name          `xml:"SoundRecording"`
-	ResourceReference string            `xml:"ResourceReference"`
-	Type              string            `xml:"Type,omitempty"`
-	ResourceId        []ResourceID      `xml:"ResourceId,omitempty"`
-	DisplayTitleText  *DisplayTitleText `xml:"DisplayTitleText,omitempty"`
-	DisplayTitle      *DisplayTitle     `xml:"DisplayTitle,omitempty"`
+	XMLName               xml.Name `xml:"SoundRecording" json:"-"`
+	IsUpdated             *bool    `xml:"IsUpdated,attr,omitempty" json:",omitempty"` // Deprecated
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+
+	SoundRecordingType       string             `xml:"SoundRecordingType,omitempty" json:",omitempty"`
+	IsArtistRelated          *bool              `xml:"IsArtistRelated,omitempty" json:",omitempty"`
+	SoundRecordingId         []SoundRecordingId `xml:"SoundRecordingId" json:",omitempty"`                   // Mandatory 1-n
+	IndirectSoundRecordingId []MusicalWorkId    `xml:"IndirectSoundRecordingId,omitempty" json:",omitempty"` // 0-n
+	ResourceReference        string             `xml:"ResourceReference" json:",omitempty"`                  // Mandatory (ID)
+
+	ReferenceTitle             *ReferenceTitle `xml:"ReferenceTitle,omitempty" json:",omitempty"` // Mandatory
+	InstrumentationDescription *Description    `xml:"InstrumentationDescription,omitempty" json:",omitempty"`
+
+	// Boolean flags
+	IsMedley                     *bool `xml:"IsMedley,omitempty" json:",omitempty"`
+	IsPotpourri                  *bool `xml:"IsPotpourri,omitempty" json:",omitempty"`
+	IsInstrumental               *bool `xml:"IsInstrumental,omitempty" json:",omitempty"`
+	IsBackground                 *bool `xml:"IsBackground,omitempty" json:",omitempty"`
+	IsHiddenResource             *bool `xml:"IsHiddenResource,omitempty" json:",omitempty"`
+	IsBonusResource              *bool `xml:"IsBonusResource,omitempty" json:",omitempty"` // Deprecated
+	HasPreOrderFulfillment       *bool `xml:"HasPreOrderFulfillment,omitempty" json:",omitempty"`
+	IsRemastered                 *bool `xml:"IsRemastered,omitempty" json:",omitempty"`
+	NoSilenceBefore              *bool `xml:"NoSilenceBefore,omitempty" json:",omitempty"`
+	NoSilenceAfter               *bool `xml:"NoSilenceAfter,omitempty" json:",omitempty"`
+	PerformerInformationRequired *bool `xml:"PerformerInformationRequired,omitempty" json:",omitempty"`
+
+	LanguageOfPerformance []string `xml:"LanguageOfPerformance,omitempty" json:",omitempty"` // ISO 639-2
+
+	Duration                               string                                  `xml:"Duration" json:",omitempty"` // Mandatory
+	RightsAgreementId                      *RightsAgreementId                      `xml:"RightsAgreementId,omitempty" json:",omitempty"`
+	ResourceMusicalWorkReferenceList       *ResourceMusicalWorkReferenceList       `xml:"ResourceMusicalWorkReferenceList,omitempty" json:",omitempty"`
+	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `xml:"ResourceContainedResourceReferenceList,omitempty" json:",omitempty"`
+
+	// Date fields
+	CreationDate   *EventDate `xml:"CreationDate,omitempty" json:",omitempty"`
+	MasteredDate   *EventDate `xml:"MasteredDate,omitempty" json:",omitempty"`
+	RemasteredDate *EventDate `xml:"RemasteredDate,omitempty" json:",omitempty"`
+
+	SoundRecordingDetailsByTerritory []SoundRecordingDetailsByTerritory `xml:"SoundRecordingDetailsByTerritory" json:",omitempty"` // Mandatory 1-n
+	TerritoryOfCommissioning         string                             `xml:"TerritoryOfCommissioning,omitempty" json:",omitempty"`
+
+	// Artist count fields
+	NumberOfFeaturedArtists      *int         `xml:"NumberOfFeaturedArtists,omitempty" json:",omitempty"`
+	NumberOfNonFeaturedArtists   *int         `xml:"NumberOfNonFeaturedArtists,omitempty" json:",omitempty"`
+	NumberOfContractedArtists    *int         `xml:"NumberOfContractedArtists,omitempty" json:",omitempty"`
+	NumberOfNonContractedArtists *int         `xml:"NumberOfNonContractedArtists,omitempty" json:",omitempty"`
+	Extensions                   []RawElement `xml:",any" json:",omitempty"`
+}
+
+// SoundRecordingId represents sound recording identification for ERN 3.8
+type SoundRecordingId struct {
+	XMLName       xml.Name        `xml:"SoundRecordingId" json:"-"`
+	ISRC          string          `xml:"ISRC,omitempty" json:",omitempty"`
+	CatalogNumber *CatalogNumber  `xml:"CatalogNumber,omitempty" json:",omitempty"`
+	ProprietaryId []ProprietaryId `xml:"ProprietaryId,omitempty" json:",omitempty"`
+	Extensions    []RawElement    `xml:",any" json:",omitempty"`
+}
+
+// SoundRecordingDetailsByTerritory contains territory-specific sound recording details for ERN 3.8
+type SoundRecordingDetailsByTerritory struct {
+	XMLName               xml.Name `xml:"SoundRecordingDetailsByTerritory" json:"-"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+
+	// Territory (choice: TerritoryCode OR ExcludedTerritoryCode, at least one required)
+	TerritoryCode         []string `xml:"TerritoryCode,omitempty" json:",omitempty"`         // 1-n (if used)
+	ExcludedTerritoryCode []string `xml:"ExcludedTerritoryCode,omitempty" json:",omitempty"` // 1-n (if used)
+
+	// Title and display information
+	Title            []Title            `xml:"Title,omitempty" json:",omitempty"`            // 0-n
+	DisplayArtist    []DisplayArtist    `xml:"DisplayArtist,omitempty" json:",omitempty"`    // 0-n
+	DisplayConductor []DisplayConductor `xml:"DisplayConductor,omitempty" json:",omitempty"` // 0-n
+
+	// Contributors
+	ResourceContributor         []ResourceContributor         `xml:"ResourceContributor,omitempty" json:",omitempty"`         // 0-n
+	IndirectResourceContributor []IndirectResourceContributor `xml:"IndirectResourceContributor,omitempty" json:",omitempty"` // 0-n
+
+	// Rights and agreements
+	RightsAgreementId *RightsAgreementId  `xml:"RightsAgreementId,omitempty" json:",omitempty"` // 0-1
+	DisplayArtistName []DisplayArtistName `xml:"DisplayArtistName,omitempty" json:",omitempty"` // 0-n
+	LabelName         []LabelName         `xml:"LabelName,omitempty" json:",omitempty"`         // 0-n
+	RightsController  []RightsController  `xml:"RightsController,omitempty" json:",omitempty"`  // 0-n (TypedRightsController)
+
+	// Dates
+	RemasteredDate              *EventDate `xml:"RemasteredDate,omitempty" json:",omitempty"`              // 0-1
+	ResourceReleaseDate         *EventDate `xml:"ResourceReleaseDate,omitempty" json:",omitempty"`         // 0-1
+	OriginalResourceReleaseDate *EventDate `xml:"OriginalResourceReleaseDate,omitempty" json:",omitempty"` // 0-1
+
+	// Copyright and credits
+	PLine        []PLine       `xml:"PLine,omitempty" json:",omitempty"`        // 0-n
+	CourtesyLine *CourtesyLine `xml:"CourtesyLine,omitempty" json:",omitempty"` // 0-1
+
+	// Sequencing
+	SequenceNumber *int `xml:"SequenceNumber,omitempty" json:",omitempty"` // 0-1
+
+	// Descriptive metadata
+	HostSoundCarrier    []HostSoundCarrier `xml:"HostSoundCarrier,omitempty" json:",omitempty"`    // 0-n
+	MarketingComment    *Comment           `xml:"MarketingComment,omitempty" json:",omitempty"`    // 0-1
+	Genre               []Genre            `xml:"Genre,omitempty" json:",omitempty"`               // 0-n
+	ParentalWarningType []string           `xml:"ParentalWarningType,omitempty" json:",omitempty"` // 0-n (ParentalWarningType)
+	AvRating            []AvRating         `xml:"AvRating,omitempty" json:",omitempty"`            // 0-n
+
+	// Technical details
+	TechnicalSoundRecordingDetails []TechnicalSoundRecordingDetails `xml:"TechnicalSoundRecordingDetails,omitempty" json:",omitempty"` // 0-n
+
+	FulfillmentDate *FulfillmentDate `xml:"FulfillmentDate,omitempty" json:",omitempty"` // 0-1
+	Keywords        []Keywords       `xml:"Keywords,omitempty" json:",omitempty"`        // 0-n
+	Synopsis        *Synopsis        `xml:"Synopsis,omitempty" json:",omitempty"`        // 0-1
+	Extensions      []RawElement     `xml:",any" json:",omitempty"`
 }
 
 // Text represents a text resource
 type Text struct {
-	XMLName           xml.Name          `xml:"Text"`
-	ResourceReference string            `xml:"ResourceReference"`
-	Type              string            `xml:"Type,omitempty"`
-	ResourceId        []ResourceID      `xml:"ResourceId,omitempty"`
-	DisplayTitleText  *DisplayTitleText `xml:"DisplayTitleText,omitempty"`
+	XMLName           xml.Name          `xml:"Text" json:"-"`
+	ResourceReference string            `xml:"ResourceReference" json:",omitempty"`
+	Type              string            `xml:"Type,omitempty" json:",omitempty"`
+	ResourceId        []ResourceID      `xml:"ResourceId,omitempty" json:",omitempty"`
+	DisplayTitleText  *DisplayTitleText `xml:"DisplayTitleText,omitempty" json:",omitempty"`
+	Extensions        []RawElement      `xml:",any" json:",omitempty"`
 }
 
 // ResourceRightsController represents rights controller for a resource
 type ResourceRightsController struct {
-	XMLName                        xml.Name               `xml:"ResourceRightsController"`
-	RightsControllerPartyReference string                 `xml:"RightsControllerPartyReference"`
-	RightsControlType              string                 `xml:"RightsControlType,omitempty"`
-	RightSharePercentage           string                 `xml:"RightSharePercentage,omitempty"`
-	DelegatedUsageRights           []DelegatedUsageRights `xml:"DelegatedUsageRights,omitempty"`
+	XMLName                        xml.Name               `xml:"ResourceRightsController" json:"-"`
+	RightsControllerPartyReference string                 `xml:"RightsControllerPartyReference" json:",omitempty"`
+	RightsControlType              string                 `xml:"RightsControlType,omitempty" json:",omitempty"`
+	RightSharePercentage           *Decimal               `xml:"RightSharePercentage,omitempty" json:",omitempty"`
+	DelegatedUsageRights           []DelegatedUsageRights `xml:"DelegatedUsageRights,omitempty" json:",omitempty"`
+	Extensions                     []RawElement           `xml:",any" json:",omitempty"`
 }
 
 // DelegatedUsageRights represents delegated rights
 type DelegatedUsageRights struct {
-	XMLName                     xml.Name `xml:"DelegatedUsageRights"`
-	UseType                     []string `xml:"UseType"`
-	TerritoryOfRightsDelegation []string `xml:"TerritoryOfRightsDelegation,omitempty"`
+	XMLName                     xml.Name     `xml:"DelegatedUsageRights" json:"-"`
+	UseType                     []string     `xml:"UseType" json:",omitempty"`
+	TerritoryOfRightsDelegation []string     `xml:"TerritoryOfRightsDelegation,omitempty" json:",omitempty"`
+	Extensions                  []RawElement `xml:",any" json:",omitempty"`
 }
 
 // WorkRightsController represents rights controller for musical works
 type WorkRightsController struct {
-	XMLName                        xml.Name               `xml:"WorkRightsController"`
-	RightsControllerPartyReference string                 `xml:"RightsControllerPartyReference"`
-	RightsControllerRole           string                 `xml:"RightsControllerRole,omitempty"`
-	RightSharePercentage           string                 `xml:"RightSharePercentage,omitempty"`
-	DelegatedUsageRights           []DelegatedUsageRights `xml:"DelegatedUsageRights,omitempty"`
+	XMLName                        xml.Name               `xml:"WorkRightsController" json:"-"`
+	RightsControllerPartyReference string                 `xml:"RightsControllerPartyReference" json:",omitempty"`
+	RightsControllerRole           string                 `xml:"RightsControllerRole,omitempty" json:",omitempty"`
+	RightSharePercentage           *Decimal               `xml:"RightSharePercentage,omitempty" json:",omitempty"`
+	DelegatedUsageRights           []DelegatedUsageRights `xml:"DelegatedUsageRights,omitempty" json:",omitempty"`
+	Extensions                     []RawElement           `xml:",any" json:",omitempty"`
 }
 
 // Technical details types for ERN 3.8
 type TechnicalVideoDetails struct {
-	XMLName                           xml.Name `xml:"TechnicalVideoDetails"`
-	TechnicalResourceDetailsReference string   `xml:"TechnicalResourceDetailsReference"`
-	VideoCodecType                    string   `xml:"VideoCodecType,omitempty"`
-	VideoDefinitionType               string   `xml:"VideoDefinitionType,omitempty"`
-	File                              *File    `xml:"File,omitempty"`
+	XMLName                           xml.Name     `xml:"TechnicalVideoDetails" json:"-"`
+	TechnicalResourceDetailsReference string       `xml:"TechnicalResourceDetailsReference" json:",omitempty"`
+	VideoCodecType                    string       `xml:"VideoCodecType,omitempty" json:",omitempty"`
+	VideoDefinitionType               string       `xml:"VideoDefinitionType,omitempty" json:",omitempty"`
+	Duration                          string       `xml:"Duration,omitempty" json:",omitempty"`
+	File                              *File        `xml:"File,omitempty" json:",omitempty"`
+	Extensions                        []RawElement `xml:",any" json:",omitempty"`
+}
+
+type TechnicalSoundRecordingDetails struct {
+	XMLName                           xml.Name     `xml:"TechnicalSoundRecordingDetails" json:"-"`
+	TechnicalResourceDetailsReference string       `xml:"TechnicalResourceDetailsReference" json:",omitempty"`
+	AudioCodecType                    string       `xml:"AudioCodecType,omitempty" json:",omitempty"`
+	BitRate                           int          `xml:"BitRate,omitempty" json:",omitempty"` // kbps
+	NumberOfChannels                  int          `xml:"NumberOfChannels,omitempty" json:",omitempty"`
+	SamplingRate                      float64      `xml:"SamplingRate,omitempty" json:",omitempty"` // Hz
+	BitsPerSample                     int          `xml:"BitsPerSample,omitempty" json:",omitempty"`
+	Duration                          string       `xml:"Duration,omitempty" json:",omitempty"`
+	IsPreview                         *bool        `xml:"IsPreview,omitempty" json:",omitempty"`
+	File                              *File        `xml:"File,omitempty" json:",omitempty"`
+	Extensions                        []RawElement `xml:",any" json:",omitempty"`
 }
 
 type TechnicalImageDetails struct {
-	XMLName                           xml.Name `xml:"TechnicalImageDetails"`
-	TechnicalResourceDetailsReference string   `xml:"TechnicalResourceDetailsReference"`
-	ImageCodecType                    string   `xml:"ImageCodecType,omitempty"`
-	ImageHeight                       int      `xml:"ImageHeight,omitempty"`
-	ImageWidth                        int      `xml:"ImageWidth,omitempty"`
-	File                              *File    `xml:"File,omitempty"`
+	XMLName                           xml.Name     `xml:"TechnicalImageDetails" json:"-"`
+	TechnicalResourceDetailsReference string       `xml:"TechnicalResourceDetailsReference" json:",omitempty"`
+	ImageCodecType                    string       `xml:"ImageCodecType,omitempty" json:",omitempty"`
+	ImageHeight                       int          `xml:"ImageHeight,omitempty" json:",omitempty"`
+	ImageWidth                        int          `xml:"ImageWidth,omitempty" json:",omitempty"`
+	File                              *File        `xml:"File,omitempty" json:",omitempty"`
+	Extensions                        []RawElement `xml:",any" json:",omitempty"`
 }
 
 type File struct {
-	XMLName  xml.Name `xml:"File"`
-	FileName string   `xml:"FileName,omitempty"`
-	HashSum  *HashSum `xml:"HashSum,omitempty"`
-	FileSize int      `xml:"FileSize,omitempty"`
+	XMLName    xml.Name     `xml:"File" json:"-"`
+	FileName   string       `xml:"FileName,omitempty" json:",omitempty"`
+	HashSum    *HashSum     `xml:"HashSum,omitempty" json:",omitempty"`
+	FileSize   int          `xml:"FileSize,omitempty" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 type HashSum struct {
-	XMLName              xml.Name `xml:"HashSum"`
-	HashSum              string   `xml:"HashSum"`
-	HashSumAlgorithmType string   `xml:"HashSumAlgorithmType,omitempty"`
+	XMLName              xml.Name     `xml:"HashSum" json:"-"`
+	HashSum              string       `xml:"HashSum" json:",omitempty"`
+	HashSumAlgorithmType string       `xml:"HashSumAlgorithmType,omitempty" json:",omitempty"`
+	Extensions           []RawElement `xml:",any" json:",omitempty"`
 }
 
 // Supporting types
 type CreationDate struct {
-	XMLName       xml.Name `xml:"CreationDate"`
-	Value         string   `xml:",chardata"`
-	IsApproximate bool     `xml:"IsApproximate,attr,omitempty"`
+	XMLName       xml.Name `xml:"CreationDate" json:"-"`
+	Value         string   `xml:",chardata" json:",omitempty"`
+	IsApproximate bool     `xml:"IsApproximate,attr,omitempty" json:",omitempty"`
 }
 
 type ProprietaryId struct {
-	XMLName   xml.Name `xml:"ProprietaryId"`
-	Namespace string   `xml:"Namespace,attr,omitempty"`
-	Value     string   `xml:",chardata"`
+	XMLName   xml.Name `xml:"ProprietaryId" json:"-"`
+	Namespace string   `xml:"Namespace,attr,omitempty" json:",omitempty"`
+	Value     string   `xml:",chardata" json:",omitempty"`
 }
 
 type PLine struct {
-	XMLName   xml.Name `xml:"PLine"`
-	Year      int      `xml:"Year,omitempty"`
-	PLineText string   `xml:"PLineText"`
+	XMLName    xml.Name     `xml:"PLine" json:"-"`
+	Year       int          `xml:"Year,omitempty" json:",omitempty"`
+	PLineText  string       `xml:"PLineText" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 type CLine struct {
-	XMLName   xml.Name `xml:"CLine"`
-	Year      int      `xml:"Year,omitempty"`
-	CLineText string   `xml:"CLineText"`
+	XMLName    xml.Name     `xml:"CLine" json:"-"`
+	Year       int          `xml:"Year,omitempty" json:",omitempty"`
+	CLineText  string       `xml:"CLineText" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 type Genre struct {
-	XMLName                 xml.Name `xml:"Genre"`
-	GenreText               string   `xml:"GenreText"`
-	SubGenre                string   `xml:"SubGenre,omitempty"`
-	ApplicableTerritoryCode string   `xml:"ApplicableTerritoryCode,attr,omitempty"`
+	XMLName                 xml.Name     `xml:"Genre" json:"-"`
+	GenreText               string       `xml:"GenreText" json:",omitempty"`
+	SubGenre                string       `xml:"SubGenre,omitempty" json:",omitempty"`
+	ApplicableTerritoryCode string       `xml:"ApplicableTerritoryCode,attr,omitempty" json:",omitempty"`
+	Extensions              []RawElement `xml:",any" json:",omitempty"`
 }
 
 // DisplayGenre represents genre information for display purposes (used in Release)
 // Following ERN 4.3 standard specification
 type DisplayGenre struct {
-	XMLName                 xml.Name `xml:"DisplayGenre"`
-	GenreText               string   `xml:"GenreText"`
-	SubGenre                string   `xml:"SubGenre,omitempty"`
-	ApplicableTerritoryCode string   `xml:"ApplicableTerritoryCode,attr,omitempty"`
+	XMLName                 xml.Name     `xml:"DisplayGenre" json:"-"`
+	GenreText               string       `xml:"GenreText" json:",omitempty"`
+	SubGenre                string       `xml:"SubGenre,omitempty" json:",omitempty"`
+	ApplicableTerritoryCode string       `xml:"ApplicableTerritoryCode,attr,omitempty" json:",omitempty"`
+	Extensions              []RawElement `xml:",any" json:",omitempty"`
 }
 
 type Contributor struct {
-	XMLName                   xml.Name `xml:"Contributor"`
-	SequenceNumber            int      `xml:"SequenceNumber,attr,omitempty"`
-	ContributorPartyReference string   `xml:"ContributorPartyReference"`
-	Role                      []string `xml:"Role"`
+	XMLName                   xml.Name     `xml:"Contributor" json:"-"`
+	SequenceNumber            int          `xml:"SequenceNumber,attr,omitempty" json:",omitempty"`
+	ContributorPartyReference string       `xml:"ContributorPartyReference" json:",omitempty"`
+	Role                      []string     `xml:"Role" json:",omitempty"`
+	Extensions                []RawElement `xml:",any" json:",omitempty"`
 }
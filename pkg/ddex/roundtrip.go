@@ -0,0 +1,244 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// RoundTripReport is the result of VerifyRoundTrip. Differences use the XPath-like path of
+// the input element, e.g. /NewReleaseMessage/ReleaseList/Release[2]/ReleaseId/@IsReplaced,
+// and are Removed for elements, attributes or text the parser lost, Changed for values it
+// normalized and Added for output the input didn't have.
+type RoundTripReport struct {
+	Elements    int // Elements in the input
+	Attributes  int // Attributes in the input, not counting namespace declarations
+	Lost        int // Input elements and attributes missing from the output, including those below a lost element
+	Differences []Difference
+}
+
+// OK reports whether the message survived the round trip unchanged
+func (r *RoundTripReport) OK() bool {
+	return len(r.Differences) == 0
+}
+
+// Coverage returns the share of input elements and attributes that were preserved, from 0
+// to 1
+func (r *RoundTripReport) Coverage() float64 {
+	total := r.Elements + r.Attributes
+	if total == 0 {
+		return 1
+	}
+	return float64(total-r.Lost) / float64(total)
+}
+
+// VerifyRoundTrip parses data with FromXML, marshals the result with ToXML and compares the
+// two documents structurally to measure how much of an incoming file the model covers.
+// Children are matched by name and position among same-named siblings, so elements the
+// marshaler merely reorders aren't differences; whitespace around text is ignored, and so
+// are namespace prefixes and declarations. Gzip-compressed data is decompressed first.
+func VerifyRoundTrip(data []byte) (*RoundTripReport, error) {
+	data, err := gunzipIfNeeded(data)
+	if err != nil {
+		return nil, err
+	}
+	nrm, err := FromXML(data)
+	if err != nil {
+		return nil, err
+	}
+	output, err := nrm.ToXML()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal XML: %w", err)
+	}
+
+	in, err := parseRoundTripTree(data)
+	if err != nil {
+		return nil, err
+	}
+	out, err := parseRoundTripTree(output)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RoundTripReport{}
+	report.Elements, report.Attributes = in.count()
+	report.compare("/"+in.name.Local, in, out)
+	return report, nil
+}
+
+func (r *RoundTripReport) add(kind ChangeKind, path, oldValue, newValue string) {
+	r.Differences = append(r.Differences, Difference{Kind: kind, Path: path, Old: oldValue, New: newValue})
+}
+
+// compare records the differences between an input element and its output counterpart
+func (r *RoundTripReport) compare(path string, in, out *roundTripNode) {
+	for _, attr := range in.attrs {
+		attrPath := path + "/@" + attr.Name.Local
+		value, ok := out.attr(attr.Name)
+		switch {
+		case !ok:
+			r.add(ChangeRemoved, attrPath, attr.Value, "")
+			r.Lost++
+		case value != attr.Value:
+			r.add(ChangeModified, attrPath, attr.Value, value)
+		}
+	}
+	for _, attr := range out.attrs {
+		if _, ok := in.attr(attr.Name); !ok {
+			r.add(ChangeAdded, path+"/@"+attr.Name.Local, "", attr.Value)
+		}
+	}
+
+	switch {
+	case in.text != "" && out.text == "":
+		r.add(ChangeRemoved, path+"/text()", in.text, "")
+	case in.text == "" && out.text != "":
+		r.add(ChangeAdded, path+"/text()", "", out.text)
+	case in.text != out.text:
+		r.add(ChangeModified, path+"/text()", in.text, out.text)
+	}
+
+	inChildren, order := in.childrenByName()
+	outChildren, outOrder := out.childrenByName()
+	for _, name := range outOrder {
+		if _, ok := inChildren[name]; !ok {
+			order = append(order, name)
+		}
+	}
+	for _, name := range order {
+		ins, outs := inChildren[name], outChildren[name]
+		for i := 0; i < len(ins) || i < len(outs); i++ {
+			var childPath string
+			if i < len(ins) {
+				childPath = path + "/" + ins[i].name.Local
+			} else {
+				childPath = path + "/" + outs[i].name.Local
+			}
+			if len(ins) > 1 || len(outs) > 1 {
+				childPath += fmt.Sprintf("[%d]", i+1)
+			}
+
+			switch {
+			case i >= len(outs):
+				elements, attributes := ins[i].count()
+				r.add(ChangeRemoved, childPath, ins[i].summary(), "")
+				r.Lost += elements + attributes
+			case i >= len(ins):
+				r.add(ChangeAdded, childPath, "", outs[i].summary())
+			default:
+				r.compare(childPath, ins[i], outs[i])
+			}
+		}
+	}
+}
+
+// roundTripNode is an element with namespace-resolved names, its attributes other than
+// namespace declarations and its trimmed direct text
+type roundTripNode struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	text     string
+	children []*roundTripNode
+}
+
+// parseRoundTripTree parses data into its root element
+func parseRoundTripTree(data []byte) (*roundTripNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var stack []*roundTripNode
+	var root *roundTripNode
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &roundTripNode{name: t.Name}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+					continue
+				}
+				node.attrs = append(node.attrs, attr)
+			}
+			if n := len(stack); n > 0 {
+				stack[n-1].children = append(stack[n-1].children, node)
+			} else {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			node := stack[len(stack)-1]
+			node.text = strings.TrimSpace(node.text)
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if n := len(stack); n > 0 {
+				stack[n-1].text += string(t)
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("failed to parse XML: no root element")
+	}
+	return root, nil
+}
+
+// roundTripKey identifies an element or attribute name across the input and the output.
+// ERN names are compared unqualified, as the marshaler writes them without a namespace.
+func roundTripKey(name xml.Name) string {
+	if name.Space == "" || name.Space == XmlnsErn {
+		return name.Local
+	}
+	return name.Space + " " + name.Local
+}
+
+func (n *roundTripNode) attr(name xml.Name) (string, bool) {
+	key := roundTripKey(name)
+	for _, attr := range n.attrs {
+		if roundTripKey(attr.Name) == key {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+// childrenByName groups the children by name, with the names in order of first appearance
+func (n *roundTripNode) childrenByName() (map[string][]*roundTripNode, []string) {
+	children := make(map[string][]*roundTripNode)
+	var order []string
+	for _, child := range n.children {
+		key := roundTripKey(child.name)
+		if _, ok := children[key]; !ok {
+			order = append(order, key)
+		}
+		children[key] = append(children[key], child)
+	}
+	return children, order
+}
+
+// count returns the number of elements, including n, and attributes in the subtree
+func (n *roundTripNode) count() (elements, attributes int) {
+	elements, attributes = 1, len(n.attrs)
+	for _, child := range n.children {
+		e, a := child.count()
+		elements += e
+		attributes += a
+	}
+	return elements, attributes
+}
+
+// summary describes an element for a Difference: its text, or its number of children
+func (n *roundTripNode) summary() string {
+	if n.text != "" {
+		return n.text
+	}
+	if len(n.children) == 1 {
+		return "1 child element"
+	}
+	return fmt.Sprintf("%d child elements", len(n.children))
+}
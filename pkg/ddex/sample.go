@@ -0,0 +1,236 @@
+package ddex
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// SampleProfile selects the shape of the message GenerateSample produces
+type SampleProfile string
+
+// Profiles supported by GenerateSample
+const (
+	SampleAudioSingle SampleProfile = "AudioSingle" // One sound recording with cover art, streaming and download deals
+	SampleAudioAlbum  SampleProfile = "AudioAlbum"  // 8-16 sound recordings with cover art, streaming and download deals
+	SampleVideoSingle SampleProfile = "VideoSingle" // YouTube music video with a thumbnail, Content ID and streaming deals
+)
+
+// SampleProfiles returns the profiles supported by GenerateSample
+func SampleProfiles() []SampleProfile {
+	return []SampleProfile{SampleAudioSingle, SampleAudioAlbum, SampleVideoSingle}
+}
+
+var (
+	sampleWords = []string{
+		"Midnight", "Golden", "Echoes", "River", "Neon", "Summer", "Static", "Paper",
+		"Velvet", "Horizon", "Glass", "Wild", "Silent", "Electric", "Northern", "Lights",
+		"Heart", "City", "Ocean", "Fire", "Shadow", "Dream", "Signal", "Garden",
+	}
+	sampleFirstNames = []string{"Alex", "Sam", "Jordan", "Robin", "Kim", "Noa", "Luca", "Maya", "Ines", "Tomas"}
+	sampleLastNames  = []string{"Rivera", "Okafor", "Lindqvist", "Moreau", "Tanaka", "Novak", "Silva", "Brennan"}
+	sampleGenres     = []string{"Pop", "Rock", "Electronic", "Hip Hop", "Jazz", "Folk", "R&B", "Classical"}
+	sampleCountries  = []string{"US", "GB", "DE", "FR", "ES", "SE", "JP", "BR", "CA", "AU"}
+)
+
+// GenerateSample returns a valid, randomized message for a profile. The same profile and
+// seed always produce the same message, including its IDs and MessageCreatedDateTime, so
+// samples can serve as load and fuzz test fixtures for ingestion systems.
+func GenerateSample(profile SampleProfile, seed int64) (*NewReleaseMessage, error) {
+	rng := rand.New(rand.NewSource(seed))
+
+	var manifest *Manifest
+	switch profile {
+	case SampleAudioSingle:
+		manifest = sampleAudioManifest(rng, "Single", 1)
+	case SampleAudioAlbum:
+		manifest = sampleAudioManifest(rng, "Album", 8+rng.Intn(9))
+	case SampleVideoSingle:
+		manifest = sampleVideoManifest(rng)
+	default:
+		return nil, fmt.Errorf("unknown sample profile %q", profile)
+	}
+
+	b, err := manifest.Builder()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build %s sample: %w", profile, err)
+	}
+	created := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC).
+		Add(time.Duration(rng.Int63n(int64(5 * 365 * 24 * time.Hour)))).
+		Truncate(time.Second)
+	b.Message.MessageHeader.MessageCreatedDateTime = &DateTime{Time: created}
+
+	msg := b.Build()
+	if err := msg.Validate(); err != nil {
+		return nil, fmt.Errorf("generated %s sample is invalid: %w", profile, err)
+	}
+	return msg, nil
+}
+
+// sampleAudioManifest describes an audio release with the given number of tracks
+func sampleAudioManifest(rng *rand.Rand, releaseType string, tracks int) *Manifest {
+	artist := sampleArtist(rng)
+	label := sampleTitle(rng, 1) + " Records"
+	year := 1990 + rng.Intn(35)
+	registrant := sampleRegistrant(rng)
+	designation := rng.Intn(100000 - tracks)
+	releaseDate := sampleDate(rng)
+
+	m := sampleManifestBase(rng)
+	m.Message.Recipients = []ManifestParty{{DPID: sampleDPID(rng), Name: sampleTitle(rng, 1) + " Music"}}
+	m.Release = ManifestRelease{
+		Type:          releaseType,
+		Title:         sampleTitle(rng, 1+rng.Intn(3)),
+		ICPN:          sampleUPC(rng),
+		CatalogNumber: fmt.Sprintf("CAT%05d", rng.Intn(100000)),
+		DisplayArtist: artist,
+		Artists:       []ManifestArtist{{Name: artist, Roles: []string{"MainArtist"}}},
+		Label:         label,
+		Genre:         sampleGenres[rng.Intn(len(sampleGenres))],
+		ReleaseDate:   releaseDate,
+		PLine:         &ManifestLine{Year: year, Text: fmt.Sprintf("(P) %d %s", year, label)},
+		CLine:         &ManifestLine{Year: year, Text: fmt.Sprintf("(C) %d %s", year, label)},
+		Assets:        []ManifestAsset{{Type: "FrontCoverImage", File: "resources/cover.jpg"}},
+	}
+	if rng.Intn(4) == 0 {
+		m.Release.ParentalWarning = "Explicit"
+	}
+	for i := 0; i < tracks; i++ {
+		isrc := sampleISRC(registrant, year, designation+i)
+		m.Release.Tracks = append(m.Release.Tracks, ManifestTrack{
+			Type:         "MusicalWorkSoundRecording",
+			Title:        sampleTitle(rng, 1+rng.Intn(4)),
+			ISRC:         isrc,
+			Duration:     fmt.Sprint(120 + rng.Intn(300)),
+			Contributors: []ManifestArtist{{Name: sampleArtist(rng), Roles: []string{"Composer"}}},
+			PLine:        m.Release.PLine,
+			File:         fmt.Sprintf("resources/%s.flac", isrc),
+		})
+	}
+
+	m.Deals = []ManifestDeal{
+		{Preset: DealPresetStreamingStandard.Name, Territories: sampleTerritories(rng), StartDate: releaseDate},
+		{Preset: DealPresetDownloadToOwn.Name, Territories: sampleTerritories(rng), StartDate: releaseDate},
+	}
+	return m
+}
+
+// sampleVideoManifest describes a music video delivered to YouTube
+func sampleVideoManifest(rng *rand.Rand) *Manifest {
+	artist := sampleArtist(rng)
+	label := sampleTitle(rng, 1) + " Records"
+	year := 1990 + rng.Intn(35)
+	title := sampleTitle(rng, 1+rng.Intn(3))
+	isrc := sampleISRC(sampleRegistrant(rng), year, rng.Intn(100000))
+
+	m := sampleManifestBase(rng)
+	m.Message.Recipients = []ManifestParty{
+		{DPID: YouTubeDPID, Name: "YouTube"},
+		{DPID: YouTubeContentIDDPID, Name: "YouTube_ContentID"},
+	}
+	m.Release = ManifestRelease{
+		Type:             "VideoSingle",
+		Title:            title,
+		ICPN:             sampleUPC(rng),
+		DisplayArtist:    artist,
+		Artists:          []ManifestArtist{{Name: artist, Roles: []string{"MainArtist"}}},
+		Label:            label,
+		Genre:            sampleGenres[rng.Intn(len(sampleGenres))],
+		ReleaseDate:      sampleDate(rng),
+		ParentalWarning:  "NotExplicit",
+		MarketingComment: fmt.Sprintf("Official video for %q by %s.", title, artist),
+		Tracks: []ManifestTrack{{
+			Kind:     ManifestTrackVideo,
+			Type:     "ShortFormMusicalWorkVideo",
+			Title:    title,
+			ISRC:     isrc,
+			Duration: fmt.Sprint(150 + rng.Intn(240)),
+			PLine:    &ManifestLine{Year: year, Text: fmt.Sprintf("(P) %d %s", year, label)},
+			File:     fmt.Sprintf("resources/%s.mp4", isrc),
+		}},
+		Assets: []ManifestAsset{{Type: "VideoScreenCapture", File: fmt.Sprintf("resources/%s.jpg", isrc)}},
+	}
+	m.Deals = []ManifestDeal{
+		{Preset: DealPresetYouTubeContentID.Name},
+		{Preset: DealPresetYouTubeStreaming.Name, Territories: sampleTerritories(rng)},
+	}
+	return m
+}
+
+// sampleManifestBase returns a manifest with a randomized sender and message IDs
+func sampleManifestBase(rng *rand.Rand) *Manifest {
+	return &Manifest{
+		Message: ManifestMessage{
+			MessageId:   fmt.Sprintf("MSG_%016x", rng.Uint64()),
+			ThreadId:    fmt.Sprintf("THR_%016x", rng.Uint64()),
+			ControlType: "TestMessage",
+			Sender:      ManifestParty{DPID: sampleDPID(rng), Name: sampleTitle(rng, 1) + " Distribution"},
+		},
+	}
+}
+
+// sampleTitle joins n random words
+func sampleTitle(rng *rand.Rand, n int) string {
+	words := make([]string, n)
+	for i := range words {
+		words[i] = sampleWords[rng.Intn(len(sampleWords))]
+	}
+	return strings.Join(words, " ")
+}
+
+func sampleArtist(rng *rand.Rand) string {
+	return sampleFirstNames[rng.Intn(len(sampleFirstNames))] + " " + sampleLastNames[rng.Intn(len(sampleLastNames))]
+}
+
+// sampleDPID returns a random DDEX party ID (PADPIDA followed by 11 digits)
+func sampleDPID(rng *rand.Rand) string {
+	return fmt.Sprintf("PADPIDA%011d", rng.Int63n(1e11))
+}
+
+// sampleUPC returns a random 12-digit UPC with a valid check digit
+func sampleUPC(rng *rand.Rand) string {
+	digits := fmt.Sprintf("%011d", rng.Int63n(1e11))
+	sum := 0
+	for i, char := range digits {
+		digit := int(char - '0')
+		if i%2 == 0 {
+			sum += digit * 3
+		} else {
+			sum += digit
+		}
+	}
+	return fmt.Sprintf("%s%d", digits, (10-sum%10)%10)
+}
+
+// sampleRegistrant returns a random ISRC country and registrant prefix
+func sampleRegistrant(rng *rand.Rand) string {
+	const alphanumeric = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	prefix := []byte(sampleCountries[rng.Intn(len(sampleCountries))])
+	for i := 0; i < 3; i++ {
+		prefix = append(prefix, alphanumeric[rng.Intn(len(alphanumeric))])
+	}
+	return string(prefix)
+}
+
+func sampleISRC(registrant string, year, designation int) string {
+	return fmt.Sprintf("%s%02d%05d", registrant, year%100, designation)
+}
+
+// sampleDate returns a random date between 2000 and 2029
+func sampleDate(rng *rand.Rand) string {
+	return FormatDate(time.Date(2000+rng.Intn(30), time.Month(1+rng.Intn(12)), 1+rng.Intn(28), 0, 0, 0, 0, time.UTC))
+}
+
+// sampleTerritories returns Worldwide or a few random countries
+func sampleTerritories(rng *rand.Rand) []string {
+	if rng.Intn(2) == 0 {
+		return []string{"Worldwide"}
+	}
+	perm := rng.Perm(len(sampleCountries))
+	territories := make([]string, 1+rng.Intn(4))
+	for i := range territories {
+		territories[i] = sampleCountries[perm[i]]
+	}
+	return territories
+}
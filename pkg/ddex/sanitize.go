@@ -0,0 +1,107 @@
+package ddex
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+var modelPkgPath = reflect.TypeOf(NewReleaseMessage{}).PkgPath()
+
+// Sanitize cleans every text value and attribute of the message with SanitizeText, so
+// stray bytes from spreadsheets or upstream feeds can't make the XML unparseable.
+// Extensions are raw XML and left alone. It returns the number of values changed.
+func (nrm *NewReleaseMessage) Sanitize() int {
+	return mapText(reflect.ValueOf(nrm).Elem(), SanitizeText)
+}
+
+// Sanitize cleans the text of the message (see NewReleaseMessage.Sanitize)
+func (b *Builder) Sanitize() *Builder {
+	b.Message.Sanitize()
+	return b
+}
+
+// SanitizeText returns s with the characters XML 1.0 doesn't allow and other control
+// characters removed, invalid UTF-8 replaced by U+FFFD, and whitespace normalized: tabs and
+// other spaces become a single space, lines are trimmed, and line breaks are kept with at
+// most one empty line between paragraphs.
+func SanitizeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\n' || r == '\r':
+			b.WriteRune('\n')
+		case unicode.IsSpace(r):
+			b.WriteRune(' ')
+		case unicode.IsControl(r) || r == 0xFFFE || r == 0xFFFF:
+			// Not allowed in XML 1.0, or invisible C1 controls from mis-decoded text
+		default:
+			// Invalid UTF-8 is decoded as utf8.RuneError, which is U+FFFD
+			b.WriteRune(r)
+		}
+	}
+
+	var lines []string
+	blank := false
+	for _, line := range strings.Split(b.String(), "\n") {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			blank = len(lines) > 0
+			continue
+		}
+		if blank {
+			lines = append(lines, "")
+			blank = false
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// mapText replaces every text value and attribute below v with fn(value) and returns how
+// many it changed
+func mapText(v reflect.Value, fn func(string) string) int {
+	changed := 0
+	walkModel(v, func(v reflect.Value) {
+		if v.Kind() != reflect.String {
+			return
+		}
+		if mapped := fn(v.String()); mapped != v.String() {
+			v.SetString(mapped)
+			changed++
+		}
+	})
+	return changed
+}
+
+// walkModel calls visit for v and every string and model composite below it. Only model
+// composites are entered: raw extensions and values with their own encoding are skipped.
+func walkModel(v reflect.Value, visit func(reflect.Value)) {
+	switch v.Kind() {
+	case reflect.String:
+		visit(v)
+	case reflect.Ptr:
+		if !v.IsNil() {
+			walkModel(v.Elem(), visit)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkModel(v.Index(i), visit)
+		}
+	case reflect.Struct:
+		if !isComposite(v.Type()) || v.Type().PkgPath() != modelPkgPath {
+			return
+		}
+		visit(v)
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() || field.Name == "XMLName" || field.Tag.Get("xml") == "-" {
+				continue
+			}
+			walkModel(v.Field(i), visit)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// schemaSequences lists the child elements of ERN 3.8 composites in the order of their XSD
+// sequence. Members of a choice (e.g. TerritoryCode and ExcludedTerritoryCode) are listed
+// next to each other. Children not listed are not checked.
+var schemaSequences = map[string][]string{
+	"NewReleaseMessage": {"MessageHeader", "UpdateIndicator", "IsBackfill", "CatalogTransfer", "WorkList", "CueSheetList", "ResourceList", "CollectionList", "ReleaseList", "DealList"},
+	"MessageHeader": {
+		"MessageThreadId", "MessageId", "MessageFileName", "MessageSender", "SentOnBehalfOf",
+		"MessageRecipient", "MessageCreatedDateTime", "MessageAuditTrail", "Comment", "MessageControlType",
+	},
+	"ResourceList": {"SoundRecording", "MIDI", "Video", "Image", "Text", "SheetMusic", "Software", "UserDefinedResource"},
+	"SoundRecording": {
+		"SoundRecordingType", "IsArtistRelated", "SoundRecordingId", "IndirectSoundRecordingId", "ResourceReference",
+		"ReferenceTitle", "InstrumentationDescription", "IsMedley", "IsPotpourri", "IsInstrumental", "IsBackground",
+		"IsHiddenResource", "IsBonusResource", "HasPreOrderFulfillment", "IsRemastered", "NoSilenceBefore",
+		"NoSilenceAfter", "PerformerInformationRequired", "LanguageOfPerformance", "Duration", "RightsAgreementId",
+		"SoundRecordingCollectionReferenceList", "ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList",
+		"CreationDate", "MasteredDate", "RemasteredDate", "SoundRecordingDetailsByTerritory", "TerritoryOfCommissioning",
+		"NumberOfFeaturedArtists", "NumberOfNonFeaturedArtists", "NumberOfContractedArtists", "NumberOfNonContractedArtists",
+	},
+	"SoundRecordingDetailsByTerritory": {
+		"TerritoryCode", "ExcludedTerritoryCode", "Title", "DisplayArtist", "DisplayConductor", "ResourceContributor",
+		"IndirectResourceContributor", "RightsAgreementId", "DisplayArtistName", "LabelName", "RightsController",
+		"RemasteredDate", "ResourceReleaseDate", "OriginalResourceReleaseDate", "PLine", "CourtesyLine", "SequenceNumber",
+		"HostSoundCarrier", "MarketingComment", "Genre", "ParentalWarningType", "AvRating",
+		"TechnicalSoundRecordingDetails", "FulfillmentDate", "Keywords", "Synopsis",
+	},
+	"Video": {
+		"VideoType", "IsArtistRelated", "VideoId", "IndirectVideoId", "ResourceReference", "VideoCueSheetReference",
+		"ReasonForCueSheetAbsence", "ReferenceTitle", "Title", "InstrumentationDescription", "IsMedley", "IsPotpourri",
+		"IsInstrumental", "IsBackground", "IsHiddenResource", "IsBonusResource", "HasPreOrderFulfillment", "IsRemastered",
+		"NoSilenceBefore", "NoSilenceAfter", "PerformerInformationRequired", "LanguageOfPerformance", "LanguageOfDubbing",
+		"SubTitleLanguage", "Duration", "RightsAgreementId", "VideoCollectionReferenceList",
+		"ResourceMusicalWorkReferenceList", "ResourceContainedResourceReferenceList", "CreationDate", "MasteredDate",
+		"RemasteredDate", "VideoDetailsByTerritory", "TerritoryOfCommissioning", "NumberOfFeaturedArtists",
+		"NumberOfNonFeaturedArtists", "NumberOfContractedArtists", "NumberOfNonContractedArtists",
+	},
+	"VideoDetailsByTerritory": {
+		"TerritoryCode", "ExcludedTerritoryCode", "Title", "DisplayArtist", "DisplayConductor", "ResourceContributor",
+		"IndirectResourceContributor", "RightsAgreementId", "DisplayArtistName", "LabelName", "RightsController",
+		"RemasteredDate", "ResourceReleaseDate", "OriginalResourceReleaseDate", "PLine", "CLine", "CourtesyLine",
+		"SequenceNumber", "HostSoundCarrier", "MarketingComment", "Genre", "ParentalWarningType", "AvRating",
+		"TechnicalVideoDetails", "Character", "FulfillmentDate", "Keywords", "Synopsis",
+	},
+	"Image": {"ImageType", "IsArtistRelated", "ImageId", "IndirectResourceId", "ResourceReference", "Title", "CreationDate", "ImageDetailsByTerritory"},
+	"ImageDetailsByTerritory": {
+		"TerritoryCode", "ExcludedTerritoryCode", "Title", "ResourceContributor", "IndirectResourceContributor",
+		"DisplayArtistName", "CLine", "Description", "CourtesyLine", "ResourceReleaseDate", "OriginalResourceReleaseDate",
+		"FulfillmentDate", "Keywords", "Synopsis", "Genre", "ParentalWarningType", "TechnicalImageDetails",
+	},
+	"TechnicalSoundRecordingDetails": {
+		"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "AudioCodecType", "BitRate",
+		"NumberOfChannels", "SamplingRate", "BitsPerSample", "Duration", "ResourceProcessingRequired",
+		"UsableResourceDuration", "IsPreview", "PreviewDetails", "FulfillmentDate", "ConsumerFulfillmentDate", "File",
+	},
+	"TechnicalVideoDetails": {
+		"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "OverallBitRate", "VideoCodecType",
+		"VideoBitRate", "FrameRate", "ImageHeight", "ImageWidth", "AspectRatio", "ColorDepth", "VideoDefinitionType",
+		"AudioCodecType", "AudioBitRate", "NumberOfAudioChannels", "AudioSamplingRate", "AudioBitsPerSample", "Duration",
+		"ResourceProcessingRequired", "UsableResourceDuration", "IsPreview", "PreviewDetails", "FulfillmentDate",
+		"ConsumerFulfillmentDate", "File",
+	},
+	"TechnicalImageDetails": {
+		"TechnicalResourceDetailsReference", "DrmPlatformType", "ContainerFormat", "ImageCodecType", "ImageHeight",
+		"ImageWidth", "AspectRatio", "ColorDepth", "ImageResolution", "IsPreview", "PreviewDetails", "FulfillmentDate",
+		"ConsumerFulfillmentDate", "File",
+	},
+	"ReleaseList": {"Release"},
+	"Release": {
+		"ReleaseId", "ReleaseReference", "ExternalResourceLink", "SalesReportingProxyReleaseId", "ReferenceTitle",
+		"ReleaseResourceReferenceList", "ReleaseCollectionReferenceList", "RelatedResource", "ReleaseType", "ReleaseDetailsByTerritory",
+		"LanguageOfPerformance", "LanguageOfDubbing", "SubTitleLanguage", "Duration", "RightsAgreementId", "PLine",
+		"CLine", "GlobalReleaseDate", "GlobalOriginalReleaseDate",
+	},
+	"ReleaseDetailsByTerritory": {
+		"TerritoryCode", "ExcludedTerritoryCode", "DisplayArtistName", "LabelName", "Title", "DisplayArtist",
+		"IsMultiArtistCompilation", "AdministratingRecordCompany", "ReleaseType", "RelatedRelease",
+		"ParentalWarningType", "AvRating", "MarketingComment", "ResourceGroup", "Genre", "PLine", "CLine",
+		"ReleaseDate", "OriginalReleaseDate", "OriginalDigitalReleaseDate", "FileAvailabilityDescription", "File",
+		"Keywords", "Synopsis",
+	},
+	"DealList":    {"ReleaseDeal"},
+	"ReleaseDeal": {"DealReleaseReference", "Deal", "EffectiveDate"},
+	"Deal":        {"DealReference", "DealTerms", "ResourceUsage", "DealTechnicalResourceDetailsReferenceList", "DistributionChannelPage"},
+	"DealTerms": {
+		"IsPreOrderDeal", "CommercialModelType", "Usage", "AllDealsCancelled", "TakeDown", "TerritoryCode",
+		"ExcludedTerritoryCode", "DistributionChannel", "ExcludedDistributionChannel", "PriceInformation",
+		"IsPromotional", "PromotionalCode", "ValidityPeriod", "ConsumerRentalPeriod", "PreOrderReleaseDate",
+		"ReleaseDisplayStartDate", "TrackListingPreviewStartDate", "CoverArtPreviewStartDate", "ClipPreviewStartDate",
+		"PreOrderPreviewDate", "PreOrderIncentiveResourceList", "InstantGratificationResourceList", "IsExclusive",
+		"RelatedReleaseOfferSet", "PhysicalReturns", "NumberOfProductsPerCarton", "RightsClaimPolicy", "WebPolicy",
+	},
+	"ValidityPeriod": {"StartDate", "StartDateTime", "EndDate", "EndDateTime"},
+	"Title":          {"TitleText", "SubTitle"},
+	"ReferenceTitle": {"TitleText", "SubTitle"},
+	"PLine":          {"Year", "PLineCompany", "PLineText"},
+	"CLine":          {"Year", "CLineCompany", "CLineText"},
+	"Genre":          {"GenreText", "SubGenre"},
+}
+
+// ElementOrderError is a child element that appears after an element the schema sequence
+// puts after it
+type ElementOrderError struct {
+	Line    int    // Line of the misplaced element in the document
+	Parent  string // Composite whose sequence is violated
+	Element string // Misplaced child
+	Before  string // Earlier sibling that should follow Element
+}
+
+func (e ElementOrderError) Error() string {
+	return fmt.Sprintf("line %d: %s must come before %s in %s", e.Line, e.Element, e.Before, e.Parent)
+}
+
+// CheckElementOrder checks that the children of the ERN composites in an XML document appear
+// in the order of the ERN 3.8 schema sequences. It returns one ElementOrderError per
+// misplaced element; the document is otherwise not validated.
+func CheckElementOrder(data []byte) ([]ElementOrderError, error) {
+	type frame struct {
+		name     string
+		sequence map[string]int
+		last     int
+		lastName string
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var stack []*frame
+	var violations []ElementOrderError
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			if n := len(stack); n > 0 && stack[n-1].sequence != nil {
+				parent := stack[n-1]
+				if position, ok := parent.sequence[name]; ok {
+					if position < parent.last {
+						violations = append(violations, ElementOrderError{
+							Line:    1 + bytes.Count(data[:offset], []byte("\n")),
+							Parent:  parent.name,
+							Element: name,
+							Before:  parent.lastName,
+						})
+					} else {
+						parent.last, parent.lastName = position, name
+					}
+				}
+			}
+			stack = append(stack, &frame{name: name, sequence: sequencePositions[name], last: -1})
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return violations, nil
+}
+
+// sequencePositions maps each composite of schemaSequences to the position of its children
+var sequencePositions = func() map[string]map[string]int {
+	positions := make(map[string]map[string]int, len(schemaSequences))
+	for parent, children := range schemaSequences {
+		m := make(map[string]int, len(children))
+		for i, child := range children {
+			m[child] = i
+		}
+		positions[parent] = m
+	}
+	return positions
+}()
+
+// CheckElementOrder marshals the message and checks its element order with the package
+// function CheckElementOrder. The model structs declare their fields in schema order, so
+// this guards against a field added in the wrong place.
+func (nrm *NewReleaseMessage) CheckElementOrder() error {
+	data, err := nrm.ToXML()
+	if err != nil {
+		return err
+	}
+	violations, err := CheckElementOrder(data)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	messages := make([]string, len(violations))
+	for i, v := range violations {
+		messages[i] = v.Error()
+	}
+	return fmt.Errorf("elements out of schema order: %s", strings.Join(messages, "; "))
+}
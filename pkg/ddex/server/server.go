@@ -0,0 +1,355 @@
+// Package server exposes the ddex package over HTTP, so that teams not working in Go can
+// validate, convert and build messages through a REST API:
+//
+//	POST /validate  message XML, or JSON of ToJSON  -> validation report
+//	POST /convert   message XML -> JSON, or JSON -> XML
+//	POST /build     YAML or JSON manifest           -> message XML
+//
+// JSON bodies are recognised by their Content-Type (application/json or a +json type); other
+// bodies are read as XML, and manifests as YAML. /validate answers with the JSON report of
+// ddex.ValidationReport, or its SARIF log with ?format=sarif, and takes the options of the
+// ddex validate command as query parameters (?youtube=true, ?fullShares=true). /convert
+// answers in the other format, or in the one asked for with ?to=json or ?to=xml. /build
+// validates the message it builds, and answers with its validation report and status 422
+// if it has problems; ?format=json answers with the message JSON instead of XML.
+//
+// Gzip-compressed bodies are decompressed first, and the size limit applies to both the
+// compressed and the decompressed body. Bodies compressed more than once are rejected.
+//
+// Other errors are answered with a JSON body {"error": "..."} and status 400 for bodies that
+// can't be parsed, 405 for methods other than POST and 413 for bodies over the size limit.
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// DefaultMaxBodySize is the largest request body accepted unless WithMaxBodySize is set
+const DefaultMaxBodySize = 32 << 20
+
+// Server is an http.Handler serving the endpoints of the package documentation
+type Server struct {
+	mux         *http.ServeMux
+	maxBodySize int64
+	logger      *slog.Logger
+}
+
+// New creates a Server
+func New() *Server {
+	s := &Server{maxBodySize: DefaultMaxBodySize}
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("/validate", s.post(s.handleValidate))
+	s.mux.HandleFunc("/convert", s.post(s.handleConvert))
+	s.mux.HandleFunc("/build", s.post(s.handleBuild))
+	return s
+}
+
+// WithMaxBodySize limits request bodies to n bytes, before and after decompressing them
+func (s *Server) WithMaxBodySize(n int64) *Server {
+	s.maxBodySize = n
+	return s
+}
+
+// WithLogger reports requests and their errors to logger instead of the ddex.SetLogger logger
+func (s *Server) WithLogger(logger *slog.Logger) *Server {
+	s.logger = logger
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// log logs msg to the server's logger, or to the ddex.SetLogger logger
+func (s *Server) log(ctx context.Context, level slog.Level, msg string, args ...interface{}) {
+	logger := s.logger
+	if logger == nil {
+		logger = ddex.Logger()
+	}
+	if logger != nil {
+		logger.Log(ctx, level, msg, args...)
+	}
+}
+
+// httpError is an error answered with its status code
+type httpError struct {
+	status int
+	err    error
+}
+
+func (e *httpError) Error() string {
+	return e.err.Error()
+}
+
+func (e *httpError) Unwrap() error {
+	return e.err
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &httpError{status: http.StatusBadRequest, err: fmt.Errorf(format, args...)}
+}
+
+// post wraps a handler reading the request body, answering errors as JSON
+func (s *Server) post(handle func(w http.ResponseWriter, r *http.Request, body []byte) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			s.writeError(w, r, &httpError{status: http.StatusMethodNotAllowed, err: fmt.Errorf("%s only accepts POST", r.URL.Path)})
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxBodySize))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				err = &httpError{status: http.StatusRequestEntityTooLarge, err: fmt.Errorf("request body is larger than %d bytes", tooLarge.Limit)}
+			} else {
+				err = badRequest("failed to read request body: %w", err)
+			}
+			s.writeError(w, r, err)
+			return
+		}
+		body, err = s.gunzip(body)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+
+		if err := handle(w, r, body); err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.log(r.Context(), slog.LevelDebug, "ddex: request served", "path", r.URL.Path, "bytes", len(body))
+	}
+}
+
+// gunzip decompresses a gzip-compressed body, which mustn't decompress to more than the
+// size limit or to gzip data again, and returns other bodies as they are
+func (s *Server) gunzip(body []byte) ([]byte, error) {
+	if !isGzip(body) {
+		return body, nil
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, badRequest("failed to decompress request body: %w", err)
+	}
+	defer reader.Close()
+
+	decompressed, err := io.ReadAll(io.LimitReader(reader, s.maxBodySize+1))
+	if err != nil {
+		return nil, badRequest("failed to decompress request body: %w", err)
+	}
+	if int64(len(decompressed)) > s.maxBodySize {
+		return nil, &httpError{status: http.StatusRequestEntityTooLarge, err: fmt.Errorf("decompressed request body is larger than %d bytes", s.maxBodySize)}
+	}
+	if isGzip(decompressed) {
+		// Parsing would decompress the inner layer again, past the size limit
+		return nil, badRequest("request body is compressed more than once")
+	}
+	return decompressed, nil
+}
+
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusInternalServerError
+	var httpErr *httpError
+	if errors.As(err, &httpErr) {
+		status = httpErr.status
+	}
+	if status >= http.StatusInternalServerError {
+		s.log(r.Context(), slog.LevelError, "ddex: request failed", "path", r.URL.Path, "error", err)
+	} else {
+		s.log(r.Context(), slog.LevelDebug, "ddex: request rejected", "path", r.URL.Path, "status", status, "error", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+// isJSON reports whether the request body is JSON by its Content-Type
+func isJSON(r *http.Request) bool {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// isGzip reports whether data starts with the gzip magic number
+func isGzip(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+// readMessage parses the message of a request body as JSON or XML. XML is parsed with the
+// size limit, in case the body reaches it without going through gunzip.
+func (s *Server) readMessage(r *http.Request, body []byte) (*ddex.NewReleaseMessage, error) {
+	var message *ddex.NewReleaseMessage
+	var err error
+	if isJSON(r) {
+		message, err = ddex.FromJSON(body)
+	} else {
+		message, err = ddex.FromXMLLimit(r.Context(), body, s.maxBodySize)
+	}
+	var tooLarge *ddex.ErrDecompressedTooLarge
+	switch {
+	case errors.As(err, &tooLarge):
+		return nil, &httpError{status: http.StatusRequestEntityTooLarge, err: err}
+	case err != nil:
+		return nil, badRequest("%w", err)
+	}
+	return message, nil
+}
+
+// queryBool reads a boolean query parameter, false if absent
+func queryBool(r *http.Request, name string) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, badRequest("%s must be true or false, not %q", name, value)
+	}
+	return b, nil
+}
+
+func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request, body []byte) error {
+	var opts ddex.ValidationOptions
+	youtube, err := queryBool(r, "youtube")
+	if err != nil {
+		return err
+	}
+	if youtube {
+		opts = ddex.YouTubeValidationOptions
+	}
+	fullShares, err := queryBool(r, "fullShares")
+	if err != nil {
+		return err
+	}
+	opts.RequireFullRightShares = opts.RequireFullRightShares || fullShares
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "sarif" {
+		return badRequest("unknown format %q", format)
+	}
+	file := r.URL.Query().Get("file")
+	if file == "" {
+		file = "message"
+	}
+
+	var report ddex.ValidationReport
+	message, err := s.readMessage(r, body)
+	if err != nil {
+		report.AddError(file, err)
+	} else {
+		report.Add(file, message, opts)
+	}
+	return writeReport(w, &report, format, http.StatusOK)
+}
+
+// writeReport answers with a validation report as JSON or, for format sarif, SARIF
+func writeReport(w http.ResponseWriter, report *ddex.ValidationReport, format string, status int) error {
+	var buf bytes.Buffer
+	var err error
+	if format == "sarif" {
+		w.Header().Set("Content-Type", "application/sarif+json")
+		err = report.WriteSARIF(&buf)
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+		err = report.WriteJSON(&buf)
+	}
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(status)
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+func (s *Server) handleConvert(w http.ResponseWriter, r *http.Request, body []byte) error {
+	to := r.URL.Query().Get("to")
+	switch {
+	case to == "" && isJSON(r):
+		to = "xml"
+	case to == "":
+		to = "json"
+	case to != "json" && to != "xml":
+		return badRequest("unknown format %q", to)
+	}
+
+	message, err := s.readMessage(r, body)
+	if err != nil {
+		return err
+	}
+	return writeMessage(w, message, to, http.StatusOK)
+}
+
+// writeMessage answers with a message as XML or, for format json, as JSON
+func writeMessage(w http.ResponseWriter, message *ddex.NewReleaseMessage, format string, status int) error {
+	var data []byte
+	var err error
+	if format == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		data, err = message.ToJSON()
+	} else {
+		w.Header().Set("Content-Type", "application/xml")
+		data, err = message.ToXMLWithHeader()
+	}
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(status)
+	_, err = w.Write(data)
+	return err
+}
+
+func (s *Server) handleBuild(w http.ResponseWriter, r *http.Request, body []byte) error {
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "xml" {
+		return badRequest("unknown format %q", format)
+	}
+
+	parse := ddex.ParseManifestYAML
+	if isJSON(r) {
+		parse = ddex.ParseManifestJSON
+	}
+	manifest, err := parse(body)
+	if err != nil {
+		return badRequest("%w", err)
+	}
+	b, err := manifest.Builder()
+	if err != nil {
+		return badRequest("%w", err)
+	}
+	if s.logger != nil {
+		b.WithLogger(s.logger)
+	}
+
+	// Manifests are often exported from spreadsheets, with tabs and stray control characters
+	message := b.Sanitize().Build()
+	if err := message.Validate(); err != nil {
+		var report ddex.ValidationReport
+		report.Add("message", message, ddex.ValidationOptions{})
+		return writeReport(w, &report, "json", http.StatusUnprocessableEntity)
+	}
+
+	if identifier, err := message.DeliveryIdentifier(); err == nil {
+		fileName := ddex.MessageFileName(identifier)
+		if format == "json" {
+			fileName = strings.TrimSuffix(fileName, ".xml") + ".json"
+		}
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	}
+	return writeMessage(w, message, format, http.StatusOK)
+}
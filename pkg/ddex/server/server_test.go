@@ -0,0 +1,70 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+func gzipBytes(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestRequestBodyLimits(t *testing.T) {
+	const limit = 1 << 20
+	message, err := ddex.GenerateSample(ddex.SampleAudioSingle, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err := message.ToXMLWithHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	zeros := make([]byte, 4*limit)
+
+	tests := []struct {
+		name   string
+		body   []byte
+		status int
+	}{
+		{"plain", valid, http.StatusOK},
+		{"gzip", gzipBytes(t, valid), http.StatusOK},
+		{"oversized", append(bytes.Repeat([]byte(" "), limit), valid...), http.StatusRequestEntityTooLarge},
+		{"oversized once decompressed", gzipBytes(t, zeros), http.StatusRequestEntityTooLarge},
+		{"nested gzip", gzipBytes(t, gzipBytes(t, zeros)), http.StatusBadRequest},
+		{"nested gzip of a message", gzipBytes(t, gzipBytes(t, valid)), http.StatusBadRequest},
+	}
+	server := New().WithMaxBodySize(limit)
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/convert", bytes.NewReader(tt.body))
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		if rec.Code != tt.status {
+			t.Errorf("%s: status %d, want %d: %s", tt.name, rec.Code, tt.status, rec.Body.Bytes())
+		}
+	}
+}
+
+func TestReadMessageLimit(t *testing.T) {
+	// A gzip body that reaches readMessage without going through gunzip is still limited
+	server := New().WithMaxBodySize(1 << 10)
+	req := httptest.NewRequest(http.MethodPost, "/convert", nil)
+	_, err := server.readMessage(req, gzipBytes(t, make([]byte, 1<<20)))
+	httpErr, ok := err.(*httpError)
+	if !ok || httpErr.status != http.StatusRequestEntityTooLarge {
+		t.Errorf("readMessage error = %v, want status 413", err)
+	}
+}
@@ -0,0 +1,480 @@
+package ddex
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// ReleaseSheet is the summary of a release that label A&R review before delivery: its
+// identifiers and metadata, artwork, tracklist, territories and deal windows. Texts are
+// taken from the first ReleaseDetailsByTerritory.
+type ReleaseSheet struct {
+	MessageId string
+	Sender    string
+
+	Reference           string
+	IsMain              bool
+	Title               string // "TitleText (SubTitle)"
+	Artist              string
+	ReleaseType         string
+	Identifiers         []string // e.g. "ICPN 123456789012"
+	Label               string
+	Genre               string
+	ReleaseDate         string
+	OriginalReleaseDate string
+	PLine               string
+	CLine               string
+	ParentalWarning     string
+	MarketingComment    string
+	Territories         []string // one entry per ReleaseDetailsByTerritory, e.g. "Worldwide" or "not US, CA"
+
+	Artwork []SheetArtwork
+	Tracks  []SheetTrack
+	Deals   []SheetDeal
+}
+
+// SheetArtwork is an image of a release sheet
+type SheetArtwork struct {
+	Reference string
+	Type      string
+	FileName  string
+	Width     int
+	Height    int
+}
+
+// SheetTrack is a sound recording or video of a release sheet, in release order
+type SheetTrack struct {
+	Number          int
+	Reference       string
+	ISRC            string
+	Title           string
+	Artist          string
+	Duration        string // "3:30"
+	FileName        string
+	ParentalWarning string
+	IsVideo         bool
+}
+
+// SheetDeal is a deal window of a release sheet
+type SheetDeal struct {
+	CommercialModels []string
+	UseTypes         []string
+	Territories      string
+	Start            string
+	End              string
+	TakeDown         bool
+}
+
+// ReleaseSheets returns a sheet for every release of the message, in message order
+func (nrm *NewReleaseMessage) ReleaseSheets() []*ReleaseSheet {
+	if nrm.ReleaseList == nil {
+		return nil
+	}
+
+	var messageId, sender string
+	if header := nrm.MessageHeader; header != nil {
+		messageId = header.MessageId
+		if header.MessageSender != nil && len(header.MessageSender.PartyName) > 0 {
+			sender = header.MessageSender.PartyName[0].FullName
+		}
+	}
+
+	deals := make(map[string][]Deal)
+	if nrm.DealList != nil {
+		for _, releaseDeal := range nrm.DealList.ReleaseDeal {
+			deals[releaseDeal.DealReleaseReference] = append(deals[releaseDeal.DealReleaseReference], releaseDeal.Deal...)
+		}
+	}
+
+	sheets := make([]*ReleaseSheet, 0, len(nrm.ReleaseList.Release))
+	for i := range nrm.ReleaseList.Release {
+		release := &nrm.ReleaseList.Release[i]
+		sheet := &ReleaseSheet{
+			MessageId:   messageId,
+			Sender:      sender,
+			Reference:   release.ReleaseReference,
+			IsMain:      release.IsMainRelease,
+			Title:       sheetTitle(release.ReferenceTitle),
+			Identifiers: releaseIdentifiers(release.ReleaseId),
+		}
+		var types []string
+		for _, releaseType := range release.ReleaseType {
+			types = append(types, releaseType.Value)
+		}
+		sheet.ReleaseType = strings.Join(types, ", ")
+		sheet.PLine = pLineText(release.PLine)
+		sheet.CLine = cLineText(release.CLine)
+		if release.GlobalReleaseDate != nil {
+			sheet.ReleaseDate = release.GlobalReleaseDate.Value
+		}
+		if release.GlobalOriginalReleaseDate != nil {
+			sheet.OriginalReleaseDate = release.GlobalOriginalReleaseDate.Value
+		}
+
+		for j, details := range release.ReleaseDetailsByTerritory {
+			sheet.Territories = append(sheet.Territories, describeTerritoryChoice(details.TerritoryCode, details.ExcludedTerritoryCode))
+			if j > 0 {
+				continue
+			}
+			sheet.Artist = displayArtistText(details.DisplayArtistName, details.DisplayArtist)
+			if len(details.LabelName) > 0 {
+				sheet.Label = details.LabelName[0].Value
+			}
+			sheet.Genre = genreText(details.Genre)
+			if sheet.ReleaseDate == "" && details.ReleaseDate != nil {
+				sheet.ReleaseDate = details.ReleaseDate.Value
+			}
+			if sheet.OriginalReleaseDate == "" && details.OriginalReleaseDate != nil {
+				sheet.OriginalReleaseDate = details.OriginalReleaseDate.Value
+			}
+			if sheet.PLine == "" {
+				sheet.PLine = pLineText(details.PLine)
+			}
+			if sheet.CLine == "" {
+				sheet.CLine = cLineText(details.CLine)
+			}
+			if len(details.ParentalWarningType) > 0 {
+				sheet.ParentalWarning = details.ParentalWarningType[0].Value
+			}
+			if details.MarketingComment != nil {
+				sheet.MarketingComment = details.MarketingComment.Value
+			}
+			if sheet.Title == "" && len(details.Title) > 0 {
+				sheet.Title = joinTitle(details.Title[0].TitleText, details.Title[0].SubTitle)
+			}
+		}
+
+		if release.ReleaseResourceReferenceList != nil {
+			for _, ref := range release.ReleaseResourceReferenceList.ReleaseResourceReference {
+				nrm.addSheetResource(sheet, ref.Value)
+			}
+		}
+
+		for _, deal := range deals[release.ReleaseReference] {
+			if deal.DealTerms != nil {
+				sheet.Deals = append(sheet.Deals, sheetDeal(deal.DealTerms))
+			}
+		}
+		sheets = append(sheets, sheet)
+	}
+	return sheets
+}
+
+// addSheetResource adds the resource with the reference to the artwork or tracklist of sheet
+func (nrm *NewReleaseMessage) addSheetResource(sheet *ReleaseSheet, ref string) {
+	if nrm.ResourceList == nil {
+		return
+	}
+	for _, sr := range nrm.ResourceList.SoundRecording {
+		if sr.ResourceReference != ref {
+			continue
+		}
+		track := SheetTrack{Number: len(sheet.Tracks) + 1, Reference: ref, Title: sheetTitle(sr.ReferenceTitle), Duration: sheetDuration(sr.Duration)}
+		for _, id := range sr.SoundRecordingId {
+			if id.ISRC != "" {
+				track.ISRC = id.ISRC
+				break
+			}
+		}
+		if len(sr.SoundRecordingDetailsByTerritory) > 0 {
+			details := sr.SoundRecordingDetailsByTerritory[0]
+			track.Artist = displayArtistText(details.DisplayArtistName, details.DisplayArtist)
+			if len(details.ParentalWarningType) > 0 {
+				track.ParentalWarning = details.ParentalWarningType[0]
+			}
+			for _, technical := range details.TechnicalSoundRecordingDetails {
+				if technical.File != nil && track.FileName == "" {
+					track.FileName = technical.File.FileName
+				}
+			}
+		}
+		sheet.Tracks = append(sheet.Tracks, track)
+		return
+	}
+	for _, v := range nrm.ResourceList.Video {
+		if v.ResourceReference != ref {
+			continue
+		}
+		track := SheetTrack{Number: len(sheet.Tracks) + 1, Reference: ref, Title: sheetTitle(v.ReferenceTitle), Duration: sheetDuration(v.Duration), IsVideo: true}
+		if v.VideoId != nil {
+			track.ISRC = v.VideoId.ISRC
+		}
+		if len(v.VideoDetailsByTerritory) > 0 {
+			details := v.VideoDetailsByTerritory[0]
+			track.Artist = displayArtistText(details.DisplayArtistName, details.DisplayArtist)
+			if len(details.ParentalWarningType) > 0 {
+				track.ParentalWarning = details.ParentalWarningType[0]
+			}
+			for _, technical := range details.TechnicalVideoDetails {
+				if technical.File != nil && track.FileName == "" {
+					track.FileName = technical.File.FileName
+				}
+			}
+		}
+		sheet.Tracks = append(sheet.Tracks, track)
+		return
+	}
+	for _, img := range nrm.ResourceList.Image {
+		if img.ResourceReference != ref {
+			continue
+		}
+		artwork := SheetArtwork{Reference: ref}
+		if img.ImageType != nil {
+			artwork.Type = img.ImageType.Value
+		}
+		for _, details := range img.ImageDetailsByTerritory {
+			for _, technical := range details.TechnicalImageDetails {
+				if technical.File != nil && artwork.FileName == "" {
+					artwork.FileName = technical.File.FileName
+					artwork.Width, artwork.Height = technical.ImageWidth, technical.ImageHeight
+				}
+			}
+		}
+		sheet.Artwork = append(sheet.Artwork, artwork)
+		return
+	}
+}
+
+// WriteHTML writes the sheet as a standalone HTML page
+func (s *ReleaseSheet) WriteHTML(w io.Writer) error {
+	return releaseSheetHTML.Execute(w, s)
+}
+
+// WriteMarkdown writes the sheet as a Markdown document
+func (s *ReleaseSheet) WriteMarkdown(w io.Writer) error {
+	return releaseSheetMarkdown.Execute(w, s)
+}
+
+func sheetDeal(terms *DealTerms) SheetDeal {
+	deal := SheetDeal{
+		CommercialModels: terms.CommercialModelType,
+		Territories:      describeTerritoryChoice(terms.TerritoryCode, terms.ExcludedTerritoryCode),
+		TakeDown:         terms.TakeDown != nil && *terms.TakeDown,
+	}
+	for _, usage := range terms.Usage {
+		deal.UseTypes = append(deal.UseTypes, usage.UseType...)
+	}
+	for _, period := range terms.ValidityPeriod {
+		if start := FirstNonEmpty(period.StartDate, period.StartDateTime); start != "" && deal.Start == "" {
+			deal.Start = start
+		}
+		if end := FirstNonEmpty(period.EndDate, period.EndDateTime); end != "" && deal.End == "" {
+			deal.End = end
+		}
+	}
+	return deal
+}
+
+func sheetTitle(title *ReferenceTitle) string {
+	if title == nil {
+		return ""
+	}
+	return joinTitle(title.TitleText, title.SubTitle)
+}
+
+func joinTitle(text, subTitle string) string {
+	if subTitle == "" {
+		return text
+	}
+	return text + " (" + subTitle + ")"
+}
+
+// sheetDuration formats an ISO 8601 duration as "m:ss" or "h:mm:ss", or returns it as it is
+// if it doesn't parse
+func sheetDuration(duration string) string {
+	seconds, err := ParseDuration(duration)
+	if err != nil || duration == "" {
+		return duration
+	}
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+func releaseIdentifiers(ids []ReleaseId) []string {
+	var identifiers []string
+	for _, id := range ids {
+		for _, field := range [][2]string{{"ICPN", id.ICPN}, {"GRid", id.GRid}, {"ISRC", id.ISRC}, {"ISAN", id.ISAN}} {
+			if field[1] != "" {
+				identifiers = append(identifiers, field[0]+" "+field[1])
+			}
+		}
+		if id.CatalogNumber != nil && id.CatalogNumber.Value != "" {
+			identifiers = append(identifiers, "Catalog number "+id.CatalogNumber.Value)
+		}
+		for _, proprietary := range id.ProprietaryId {
+			identifiers = append(identifiers, proprietary.Namespace+" "+proprietary.Value)
+		}
+	}
+	return identifiers
+}
+
+// displayArtistText returns the display artist name, or the names of the display artists
+func displayArtistText(names []DisplayArtistName, artists []DisplayArtist) string {
+	if len(names) > 0 {
+		return names[0].Value
+	}
+	var parts []string
+	for _, artist := range artists {
+		if len(artist.PartyName) > 0 {
+			parts = append(parts, artist.PartyName[0].FullName)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func genreText(genres []Genre) string {
+	for _, genre := range genres {
+		if genre.GenreText != "" {
+			if genre.SubGenre != "" {
+				return genre.GenreText + " / " + genre.SubGenre
+			}
+			return genre.GenreText
+		}
+	}
+	return ""
+}
+
+func pLineText(lines []PLine) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return lines[0].PLineText
+}
+
+func cLineText(lines []CLine) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return lines[0].CLineText
+}
+
+// describeTerritoryChoice formats included territories, or excluded ones prefixed with
+// "not"
+func describeTerritoryChoice(included, excluded []string) string {
+	if len(excluded) > 0 {
+		return "not " + strings.Join(excluded, ", ")
+	}
+	return strings.Join(included, ", ")
+}
+
+var releaseSheetHTML = htmltemplate.Must(htmltemplate.New("sheet").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}{{with .Artist}} – {{.}}{{end}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+th { background: #f3f3f3; }
+.meta th { width: 12em; }
+.missing { color: #b00; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{with .Artist}}<h2>{{.}}</h2>{{end}}
+<table class="meta">
+<tr><th>Release</th><td>{{.Reference}}{{if .IsMain}} (main){{end}}{{with .ReleaseType}} · {{.}}{{end}}</td></tr>
+<tr><th>Identifiers</th><td>{{range $i, $id := .Identifiers}}{{if $i}}<br>{{end}}{{$id}}{{else}}<span class="missing">none</span>{{end}}</td></tr>
+<tr><th>Label</th><td>{{with .Label}}{{.}}{{else}}<span class="missing">missing</span>{{end}}</td></tr>
+<tr><th>Genre</th><td>{{with .Genre}}{{.}}{{else}}<span class="missing">missing</span>{{end}}</td></tr>
+<tr><th>Release date</th><td>{{with .ReleaseDate}}{{.}}{{else}}<span class="missing">missing</span>{{end}}</td></tr>
+<tr><th>Original release date</th><td>{{.OriginalReleaseDate}}</td></tr>
+<tr><th>℗ line</th><td>{{.PLine}}</td></tr>
+<tr><th>© line</th><td>{{.CLine}}</td></tr>
+<tr><th>Parental warning</th><td>{{.ParentalWarning}}</td></tr>
+<tr><th>Territories</th><td>{{range $i, $t := .Territories}}{{if $i}}<br>{{end}}{{$t}}{{end}}</td></tr>
+{{with .MarketingComment}}<tr><th>Marketing comment</th><td>{{.}}</td></tr>{{end}}
+{{with .MessageId}}<tr><th>Message</th><td>{{.}}{{with $.Sender}} from {{.}}{{end}}</td></tr>{{end}}
+</table>
+
+<h3>Artwork</h3>
+{{if .Artwork}}<table>
+<tr><th>Resource</th><th>Type</th><th>File</th><th>Size</th></tr>
+{{range .Artwork}}<tr><td>{{.Reference}}</td><td>{{.Type}}</td><td>{{.FileName}}</td><td>{{if .Width}}{{.Width}}×{{.Height}}{{end}}</td></tr>
+{{end}}</table>{{else}}<p class="missing">No artwork</p>{{end}}
+
+<h3>Tracklist</h3>
+{{if .Tracks}}<table>
+<tr><th>#</th><th>Title</th><th>Artist</th><th>ISRC</th><th>Duration</th><th>Parental warning</th><th>File</th></tr>
+{{range .Tracks}}<tr><td>{{.Number}}</td><td>{{.Title}}{{if .IsVideo}} (video){{end}}</td><td>{{.Artist}}</td><td>{{.ISRC}}</td><td>{{.Duration}}</td><td>{{.ParentalWarning}}</td><td>{{.FileName}}</td></tr>
+{{end}}</table>{{else}}<p class="missing">No tracks</p>{{end}}
+
+<h3>Deals</h3>
+{{if .Deals}}<table>
+<tr><th>Commercial models</th><th>Use types</th><th>Territories</th><th>Start</th><th>End</th></tr>
+{{range .Deals}}{{if .TakeDown}}<tr><td colspan="2">Take-down</td><td>{{.Territories}}</td><td></td><td></td></tr>
+{{else}}<tr><td>{{range $i, $m := .CommercialModels}}{{if $i}}, {{end}}{{$m}}{{end}}</td><td>{{range $i, $u := .UseTypes}}{{if $i}}, {{end}}{{$u}}{{end}}</td><td>{{.Territories}}</td><td>{{.Start}}</td><td>{{.End}}</td></tr>
+{{end}}{{end}}</table>{{else}}<p class="missing">No deals</p>{{end}}
+</body>
+</html>
+`))
+
+var releaseSheetMarkdown = template.Must(template.New("sheet").Funcs(template.FuncMap{
+	"cell": func(s string) string {
+		return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+	},
+	"join": func(values []string) string {
+		return strings.Join(values, ", ")
+	},
+}).Parse(`# {{.Title}}{{with .Artist}} – {{.}}{{end}}
+
+| | |
+|---|---|
+| Release | {{.Reference}}{{if .IsMain}} (main){{end}}{{with .ReleaseType}} · {{.}}{{end}} |
+| Identifiers | {{cell (join .Identifiers)}} |
+| Label | {{cell .Label}} |
+| Genre | {{cell .Genre}} |
+| Release date | {{.ReleaseDate}} |
+| Original release date | {{.OriginalReleaseDate}} |
+| ℗ line | {{cell .PLine}} |
+| © line | {{cell .CLine}} |
+| Parental warning | {{.ParentalWarning}} |
+| Territories | {{cell (join .Territories)}} |
+{{- with .MarketingComment}}
+| Marketing comment | {{cell .}} |
+{{- end}}
+{{- with .MessageId}}
+| Message | {{.}}{{with $.Sender}} from {{cell .}}{{end}} |
+{{- end}}
+
+## Artwork
+{{if .Artwork}}
+| Resource | Type | File | Size |
+|---|---|---|---|
+{{- range .Artwork}}
+| {{.Reference}} | {{.Type}} | {{cell .FileName}} | {{if .Width}}{{.Width}}×{{.Height}}{{end}} |
+{{- end}}
+{{else}}
+No artwork.
+{{end}}
+## Tracklist
+{{if .Tracks}}
+| # | Title | Artist | ISRC | Duration | Parental warning | File |
+|---|---|---|---|---|---|---|
+{{- range .Tracks}}
+| {{.Number}} | {{cell .Title}}{{if .IsVideo}} (video){{end}} | {{cell .Artist}} | {{.ISRC}} | {{.Duration}} | {{.ParentalWarning}} | {{cell .FileName}} |
+{{- end}}
+{{else}}
+No tracks.
+{{end}}
+## Deals
+{{if .Deals}}
+| Commercial models | Use types | Territories | Start | End |
+|---|---|---|---|---|
+{{- range .Deals}}
+{{- if .TakeDown}}
+| Take-down | | {{.Territories}} | | |
+{{- else}}
+| {{join .CommercialModels}} | {{join .UseTypes}} | {{.Territories}} | {{.Start}} | {{.End}} |
+{{- end}}
+{{- end}}
+{{else}}
+No deals.
+{{end}}`))
@@ -0,0 +1,581 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// DeliverySpec is the delivery requirements of a recipient kept in a TOML, JSON or YAML file
+// instead of code, so partner requirements can change without a release of the program. It
+// is a RecipientProfile: the party, limits and options work as in DSPProfile, and Validate
+// also runs Check.
+//
+// A spec in TOML:
+//
+//	name = "Acme Music"
+//	dpid = "PADPIDA0000000001X"
+//	party_name = "Acme"
+//	required_fields = ["Release.ICPN", "Release.Genre", "SoundRecording.ISRC"]
+//	require_checksums = true
+//
+//	[codecs]
+//	audio = ["FLAC", "PCM"]
+//
+//	[image]
+//	min_width = 3000
+//	min_height = 3000
+//	square = true
+//
+//	[deals]
+//	commercial_models = ["SubscriptionModel", "AdvertisementSupportedModel"]
+//	require_start_date = true
+type DeliverySpec struct {
+	ProfileName string `toml:"name" yaml:"name" json:"name"`
+	DPID        string `toml:"dpid" yaml:"dpid" json:"dpid,omitempty"`
+	PartyName   string `toml:"party_name" yaml:"party_name" json:"party_name,omitempty"`
+
+	// RequiredFields names the fields the recipient requires, such as "Release.Genre" (see
+	// SpecFields for the names). Release fields are checked on the main release, resource
+	// fields on every resource of the kind.
+	RequiredFields []string `toml:"required_fields" yaml:"required_fields" json:"required_fields,omitempty"`
+
+	Codecs   CodecSpec     `toml:"codecs" yaml:"codecs" json:"codecs"`
+	Image    ImageSpec     `toml:"image" yaml:"image" json:"image"`
+	Deals    DealSpec      `toml:"deals" yaml:"deals" json:"deals"`
+	Keywords KeywordLimits `toml:"keywords" yaml:"keywords" json:"keywords"`
+	Text     TextLimits    `toml:"text" yaml:"text" json:"text"`
+
+	RequireChecksums       bool `toml:"require_checksums" yaml:"require_checksums" json:"require_checksums,omitempty"`
+	RequireFullRightShares bool `toml:"require_full_right_shares" yaml:"require_full_right_shares" json:"require_full_right_shares,omitempty"`
+}
+
+// CodecSpec lists the codec types a recipient accepts for each kind of resource. An empty
+// list accepts any codec type.
+type CodecSpec struct {
+	Audio []string `toml:"audio" yaml:"audio" json:"audio,omitempty"`
+	Video []string `toml:"video" yaml:"video" json:"video,omitempty"`
+	Image []string `toml:"image" yaml:"image" json:"image,omitempty"`
+}
+
+// ImageSpec is the minimum size of images, in pixels. Images without a declared size fail
+// a minimum.
+type ImageSpec struct {
+	MinWidth  int  `toml:"min_width" yaml:"min_width" json:"min_width,omitempty"`
+	MinHeight int  `toml:"min_height" yaml:"min_height" json:"min_height,omitempty"`
+	Square    bool `toml:"square" yaml:"square" json:"square,omitempty"`
+}
+
+// DealSpec constrains the deal terms of a message. Empty lists allow any value.
+type DealSpec struct {
+	CommercialModels []string `toml:"commercial_models" yaml:"commercial_models" json:"commercial_models,omitempty"`
+	UseTypes         []string `toml:"use_types" yaml:"use_types" json:"use_types,omitempty"`
+	RequireStartDate bool     `toml:"require_start_date" yaml:"require_start_date" json:"require_start_date,omitempty"` // every deal has a validity period with a start
+	NoTakeDown       bool     `toml:"no_take_down" yaml:"no_take_down" json:"no_take_down,omitempty"`                   // take-downs go through another channel
+}
+
+// specFields holds, for each kind of element, the fields a DeliverySpec can require and how
+// to tell whether an element has them
+var specFields = map[string]map[string]func(interface{}) bool{
+	"Release": {
+		"ICPN": func(v interface{}) bool {
+			for _, id := range v.(*Release).ReleaseId {
+				if id.ICPN != "" {
+					return true
+				}
+			}
+			return false
+		},
+		"CatalogNumber": func(v interface{}) bool {
+			for _, id := range v.(*Release).ReleaseId {
+				if id.CatalogNumber != nil && id.CatalogNumber.Value != "" {
+					return true
+				}
+			}
+			return false
+		},
+		"ReleaseType": func(v interface{}) bool {
+			release := v.(*Release)
+			return len(release.ReleaseType) > 0 || releaseDetails(release, func(d *ReleaseDetailsByTerritory) bool { return len(d.ReleaseType) > 0 })
+		},
+		"DisplayArtistName": func(v interface{}) bool {
+			return releaseDetails(v.(*Release), func(d *ReleaseDetailsByTerritory) bool { return len(d.DisplayArtistName) > 0 })
+		},
+		"ArtistId": func(v interface{}) bool {
+			return releaseDetails(v.(*Release), func(d *ReleaseDetailsByTerritory) bool { return hasArtistIds(d.DisplayArtist) })
+		},
+		"LabelName": func(v interface{}) bool {
+			return releaseDetails(v.(*Release), func(d *ReleaseDetailsByTerritory) bool { return len(d.LabelName) > 0 })
+		},
+		"Genre": func(v interface{}) bool {
+			return releaseDetails(v.(*Release), func(d *ReleaseDetailsByTerritory) bool { return hasGenre(d.Genre) })
+		},
+		"PLine": func(v interface{}) bool {
+			release := v.(*Release)
+			return len(release.PLine) > 0 || releaseDetails(release, func(d *ReleaseDetailsByTerritory) bool { return len(d.PLine) > 0 })
+		},
+		"CLine": func(v interface{}) bool {
+			release := v.(*Release)
+			return len(release.CLine) > 0 || releaseDetails(release, func(d *ReleaseDetailsByTerritory) bool { return len(d.CLine) > 0 })
+		},
+		"ReleaseDate": func(v interface{}) bool {
+			release := v.(*Release)
+			return release.GlobalReleaseDate != nil || releaseDetails(release, func(d *ReleaseDetailsByTerritory) bool { return d.ReleaseDate != nil })
+		},
+		"OriginalReleaseDate": func(v interface{}) bool {
+			release := v.(*Release)
+			return release.GlobalOriginalReleaseDate != nil || releaseDetails(release, func(d *ReleaseDetailsByTerritory) bool { return d.OriginalReleaseDate != nil })
+		},
+		"Keywords": func(v interface{}) bool {
+			return releaseDetails(v.(*Release), func(d *ReleaseDetailsByTerritory) bool { return len(d.Keywords) > 0 })
+		},
+		"MarketingComment": func(v interface{}) bool {
+			return releaseDetails(v.(*Release), func(d *ReleaseDetailsByTerritory) bool {
+				return d.MarketingComment != nil && d.MarketingComment.Value != ""
+			})
+		},
+	},
+	"SoundRecording": {
+		"ISRC": func(v interface{}) bool {
+			for _, id := range v.(*SoundRecording).SoundRecordingId {
+				if id.ISRC != "" {
+					return true
+				}
+			}
+			return false
+		},
+		"Duration": func(v interface{}) bool {
+			return v.(*SoundRecording).Duration != ""
+		},
+		"LanguageOfPerformance": func(v interface{}) bool {
+			return len(v.(*SoundRecording).LanguageOfPerformance) > 0
+		},
+		"DisplayArtistName": func(v interface{}) bool {
+			return soundRecordingDetails(v.(*SoundRecording), func(d *SoundRecordingDetailsByTerritory) bool { return len(d.DisplayArtistName) > 0 })
+		},
+		"ArtistId": func(v interface{}) bool {
+			return soundRecordingDetails(v.(*SoundRecording), func(d *SoundRecordingDetailsByTerritory) bool { return hasArtistIds(d.DisplayArtist) })
+		},
+		"Keywords": func(v interface{}) bool {
+			return soundRecordingDetails(v.(*SoundRecording), func(d *SoundRecordingDetailsByTerritory) bool { return len(d.Keywords) > 0 })
+		},
+		"LabelName": func(v interface{}) bool {
+			return soundRecordingDetails(v.(*SoundRecording), func(d *SoundRecordingDetailsByTerritory) bool { return len(d.LabelName) > 0 })
+		},
+		"Genre": func(v interface{}) bool {
+			return soundRecordingDetails(v.(*SoundRecording), func(d *SoundRecordingDetailsByTerritory) bool { return hasGenre(d.Genre) })
+		},
+		"PLine": func(v interface{}) bool {
+			return soundRecordingDetails(v.(*SoundRecording), func(d *SoundRecordingDetailsByTerritory) bool { return len(d.PLine) > 0 })
+		},
+		"ParentalWarningType": func(v interface{}) bool {
+			return soundRecordingDetails(v.(*SoundRecording), func(d *SoundRecordingDetailsByTerritory) bool { return len(d.ParentalWarningType) > 0 })
+		},
+		"File": func(v interface{}) bool {
+			return soundRecordingDetails(v.(*SoundRecording), func(d *SoundRecordingDetailsByTerritory) bool {
+				for _, technical := range d.TechnicalSoundRecordingDetails {
+					if technical.File != nil && technical.File.FileName != "" {
+						return true
+					}
+				}
+				return false
+			})
+		},
+	},
+	"Video": {
+		"ISRC": func(v interface{}) bool {
+			video := v.(*Video)
+			return video.VideoId != nil && video.VideoId.ISRC != ""
+		},
+		"Duration": func(v interface{}) bool {
+			return v.(*Video).Duration != ""
+		},
+		"LanguageOfPerformance": func(v interface{}) bool {
+			return len(v.(*Video).LanguageOfPerformance) > 0
+		},
+		"DisplayArtistName": func(v interface{}) bool {
+			return videoDetails(v.(*Video), func(d *VideoDetailsByTerritory) bool { return len(d.DisplayArtistName) > 0 })
+		},
+		"ArtistId": func(v interface{}) bool {
+			return videoDetails(v.(*Video), func(d *VideoDetailsByTerritory) bool { return hasArtistIds(d.DisplayArtist) })
+		},
+		"Keywords": func(v interface{}) bool {
+			return videoDetails(v.(*Video), func(d *VideoDetailsByTerritory) bool { return len(d.Keywords) > 0 })
+		},
+		"LabelName": func(v interface{}) bool {
+			return videoDetails(v.(*Video), func(d *VideoDetailsByTerritory) bool { return len(d.LabelName) > 0 })
+		},
+		"Genre": func(v interface{}) bool {
+			return videoDetails(v.(*Video), func(d *VideoDetailsByTerritory) bool { return hasGenre(d.Genre) })
+		},
+		"PLine": func(v interface{}) bool {
+			return videoDetails(v.(*Video), func(d *VideoDetailsByTerritory) bool { return len(d.PLine) > 0 })
+		},
+		"ParentalWarningType": func(v interface{}) bool {
+			return videoDetails(v.(*Video), func(d *VideoDetailsByTerritory) bool { return len(d.ParentalWarningType) > 0 })
+		},
+		"File": func(v interface{}) bool {
+			return videoDetails(v.(*Video), func(d *VideoDetailsByTerritory) bool {
+				for _, technical := range d.TechnicalVideoDetails {
+					if technical.File != nil && technical.File.FileName != "" {
+						return true
+					}
+				}
+				return false
+			})
+		},
+	},
+	"Image": {
+		"CLine": func(v interface{}) bool {
+			return imageDetails(v.(*Image), func(d *ImageDetailsByTerritory) bool { return len(d.CLine) > 0 })
+		},
+		"File": func(v interface{}) bool {
+			return imageDetails(v.(*Image), func(d *ImageDetailsByTerritory) bool {
+				for _, technical := range d.TechnicalImageDetails {
+					if technical.File != nil && technical.File.FileName != "" {
+						return true
+					}
+				}
+				return false
+			})
+		},
+	},
+}
+
+// SpecFields returns the names DeliverySpec.RequiredFields accepts, sorted
+func SpecFields() []string {
+	var names []string
+	for kind, fields := range specFields {
+		for field := range fields {
+			names = append(names, kind+"."+field)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadDeliverySpec reads a spec file, choosing the format from the extension (.toml, .json,
+// .yaml or .yml)
+func LoadDeliverySpec(path string) (*DeliverySpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read delivery spec: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		return ParseDeliverySpecTOML(data)
+	case ".json":
+		return ParseDeliverySpecJSON(data)
+	case ".yaml", ".yml":
+		return ParseDeliverySpecYAML(data)
+	default:
+		return nil, fmt.Errorf("unsupported delivery spec extension: %s", filepath.Ext(path))
+	}
+}
+
+// ParseDeliverySpecTOML parses a TOML spec. Unknown keys and required fields are rejected
+// to catch typos.
+func ParseDeliverySpecTOML(data []byte) (*DeliverySpec, error) {
+	var s DeliverySpec
+	meta, err := toml.Decode(string(data), &s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse TOML delivery spec: %w", err)
+	}
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		return nil, fmt.Errorf("failed to parse TOML delivery spec: unknown key %s", undecoded[0])
+	}
+	return &s, s.check()
+}
+
+// ParseDeliverySpecJSON parses a JSON spec. Unknown keys and required fields are rejected
+// to catch typos.
+func ParseDeliverySpecJSON(data []byte) (*DeliverySpec, error) {
+	var s DeliverySpec
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON delivery spec: %w", err)
+	}
+	return &s, s.check()
+}
+
+// ParseDeliverySpecYAML parses a YAML spec. Unknown keys and required fields are rejected
+// to catch typos.
+func ParseDeliverySpecYAML(data []byte) (*DeliverySpec, error) {
+	var s DeliverySpec
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML delivery spec: %w", err)
+	}
+	return &s, s.check()
+}
+
+// check rejects a spec without a name or with a required field Check doesn't know
+func (s *DeliverySpec) check() error {
+	if s.ProfileName == "" {
+		return fmt.Errorf("delivery spec has no name")
+	}
+	for _, name := range s.RequiredFields {
+		kind, field, _ := strings.Cut(name, ".")
+		if _, ok := specFields[kind][field]; !ok {
+			return fmt.Errorf("delivery spec %s: unknown required field %q", s.ProfileName, name)
+		}
+	}
+	return nil
+}
+
+// Check reports everything in the message the spec's required fields, codecs, image sizes
+// and deal constraints rule out. The limits and options shared with DSPProfile are checked
+// by Validate.
+func (s *DeliverySpec) Check(message *NewReleaseMessage) ValidationErrors {
+	var errs ValidationErrors
+	s.checkRequiredFields(message, &errs)
+	s.checkResources(message, &errs)
+	s.checkDeals(message, &errs)
+	return errs
+}
+
+func (s *DeliverySpec) checkRequiredFields(message *NewReleaseMessage, errs *ValidationErrors) {
+	checkSpecFields(message, s.RequiredFields, func(i int, path string, has bool) {
+		if !has {
+			_, field, _ := strings.Cut(s.RequiredFields[i], ".")
+			errs.add(path, "%s is required by %s", field, s.ProfileName)
+		}
+	})
+}
+
+// checkSpecFields calls check with the index of each of the named fields (see SpecFields)
+// and whether it is there, for the main release and for every resource of the kind the
+// name starts with. Unknown names are skipped.
+func checkSpecFields(message *NewReleaseMessage, names []string, check func(i int, path string, has bool)) {
+	visit := func(kind, path string, element interface{}) {
+		for i, name := range names {
+			field := strings.TrimPrefix(name, kind+".")
+			if field == name {
+				continue
+			}
+			if has, ok := specFields[kind][field]; ok {
+				check(i, path, has(element))
+			}
+		}
+	}
+
+	if release := message.mainRelease(); release != nil {
+		visit("Release", fmt.Sprintf("Release[%s]", release.ReleaseReference), release)
+	}
+	if message.ResourceList == nil {
+		return
+	}
+	for i := range message.ResourceList.SoundRecording {
+		sr := &message.ResourceList.SoundRecording[i]
+		visit("SoundRecording", fmt.Sprintf("SoundRecording[%s]", sr.ResourceReference), sr)
+	}
+	for i := range message.ResourceList.Video {
+		v := &message.ResourceList.Video[i]
+		visit("Video", fmt.Sprintf("Video[%s]", v.ResourceReference), v)
+	}
+	for i := range message.ResourceList.Image {
+		img := &message.ResourceList.Image[i]
+		visit("Image", fmt.Sprintf("Image[%s]", img.ResourceReference), img)
+	}
+}
+
+func (s *DeliverySpec) checkResources(message *NewReleaseMessage, errs *ValidationErrors) {
+	if message.ResourceList == nil {
+		return
+	}
+	codec := func(path, kind, codecType string, allowed []string) {
+		switch {
+		case len(allowed) == 0 || stringSet(allowed)[codecType]:
+		case codecType == "":
+			errs.add(path, "no %s codec type, %s accepts %s", kind, s.ProfileName, strings.Join(allowed, ", "))
+		default:
+			errs.add(path, "%s codec type %s is not accepted by %s (accepted: %s)", kind, codecType, s.ProfileName, strings.Join(allowed, ", "))
+		}
+	}
+
+	for _, sr := range message.ResourceList.SoundRecording {
+		for i, details := range sr.SoundRecordingDetailsByTerritory {
+			for j, technical := range details.TechnicalSoundRecordingDetails {
+				path := fmt.Sprintf("SoundRecording[%s].SoundRecordingDetailsByTerritory[%d].TechnicalSoundRecordingDetails[%d]", sr.ResourceReference, i, j)
+				codec(path, "audio", technical.AudioCodecType, s.Codecs.Audio)
+			}
+		}
+	}
+	for _, v := range message.ResourceList.Video {
+		for i, details := range v.VideoDetailsByTerritory {
+			for j, technical := range details.TechnicalVideoDetails {
+				path := fmt.Sprintf("Video[%s].VideoDetailsByTerritory[%d].TechnicalVideoDetails[%d]", v.ResourceReference, i, j)
+				codec(path, "video", technical.VideoCodecType, s.Codecs.Video)
+			}
+		}
+	}
+	for _, img := range message.ResourceList.Image {
+		for i, details := range img.ImageDetailsByTerritory {
+			for j, technical := range details.TechnicalImageDetails {
+				path := fmt.Sprintf("Image[%s].ImageDetailsByTerritory[%d].TechnicalImageDetails[%d]", img.ResourceReference, i, j)
+				codec(path, "image", technical.ImageCodecType, s.Codecs.Image)
+				if technical.ImageWidth < s.Image.MinWidth || technical.ImageHeight < s.Image.MinHeight {
+					errs.add(path, "image is %dx%d, smaller than the %dx%d minimum of %s", technical.ImageWidth, technical.ImageHeight, s.Image.MinWidth, s.Image.MinHeight, s.ProfileName)
+				}
+				if s.Image.Square && technical.ImageWidth != technical.ImageHeight {
+					errs.add(path, "image is %dx%d, but %s requires square images", technical.ImageWidth, technical.ImageHeight, s.ProfileName)
+				}
+			}
+		}
+	}
+}
+
+func (s *DeliverySpec) checkDeals(message *NewReleaseMessage, errs *ValidationErrors) {
+	if message.DealList == nil {
+		return
+	}
+	models := stringSet(s.Deals.CommercialModels)
+	useTypes := stringSet(s.Deals.UseTypes)
+
+	for _, releaseDeal := range message.DealList.ReleaseDeal {
+		for i, deal := range releaseDeal.Deal {
+			terms := deal.DealTerms
+			if terms == nil {
+				continue
+			}
+			path := fmt.Sprintf("ReleaseDeal[%s].Deal[%d]", releaseDeal.DealReleaseReference, i)
+
+			for _, model := range terms.CommercialModelType {
+				if !models[model] && !models["*"] {
+					errs.add(path, "commercial model %s is not accepted by %s", model, s.ProfileName)
+				}
+			}
+			for _, usage := range terms.Usage {
+				for _, useType := range usage.UseType {
+					if !useTypes[useType] && !useTypes["*"] {
+						errs.add(path, "use type %s is not accepted by %s", useType, s.ProfileName)
+					}
+				}
+			}
+			if s.Deals.NoTakeDown && terms.TakeDown != nil && *terms.TakeDown {
+				errs.add(path, "%s does not accept take-down deals", s.ProfileName)
+			}
+			if s.Deals.RequireStartDate {
+				started := false
+				for _, period := range terms.ValidityPeriod {
+					started = started || period.StartDate != "" || period.StartDateTime != ""
+				}
+				if !started {
+					errs.add(path, "%s requires a validity period start date", s.ProfileName)
+				}
+			}
+		}
+	}
+}
+
+// Name returns ProfileName
+func (s *DeliverySpec) Name() string {
+	return s.ProfileName
+}
+
+// MutateMessage adds the recipient party and applies the keyword limits, as
+// DSPProfile.MutateMessage does
+func (s *DeliverySpec) MutateMessage(message *NewReleaseMessage) error {
+	return s.dsp().MutateMessage(message)
+}
+
+// Validate runs DSPProfile.Validate with the spec's options and limits, then Check
+func (s *DeliverySpec) Validate(message *NewReleaseMessage) error {
+	if err := s.dsp().Validate(message); err != nil {
+		return err
+	}
+	if errs := s.Check(message); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// FileNaming returns DDEXFileNaming
+func (s *DeliverySpec) FileNaming() FileNaming {
+	return DDEXFileNaming{}
+}
+
+// dsp returns the settings the spec shares with DSPProfile
+func (s *DeliverySpec) dsp() *DSPProfile {
+	return &DSPProfile{
+		ProfileName: s.ProfileName,
+		DPID:        s.DPID,
+		PartyName:   s.PartyName,
+		Keywords:    s.Keywords,
+		Text:        s.Text,
+		Validation: ValidationOptions{
+			RequireChecksums:       s.RequireChecksums,
+			RequireFullRightShares: s.RequireFullRightShares,
+		},
+	}
+}
+
+func releaseDetails(release *Release, has func(*ReleaseDetailsByTerritory) bool) bool {
+	for i := range release.ReleaseDetailsByTerritory {
+		if has(&release.ReleaseDetailsByTerritory[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func soundRecordingDetails(sr *SoundRecording, has func(*SoundRecordingDetailsByTerritory) bool) bool {
+	for i := range sr.SoundRecordingDetailsByTerritory {
+		if has(&sr.SoundRecordingDetailsByTerritory[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func videoDetails(v *Video, has func(*VideoDetailsByTerritory) bool) bool {
+	for i := range v.VideoDetailsByTerritory {
+		if has(&v.VideoDetailsByTerritory[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func imageDetails(img *Image, has func(*ImageDetailsByTerritory) bool) bool {
+	for i := range img.ImageDetailsByTerritory {
+		if has(&img.ImageDetailsByTerritory[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasArtistIds reports whether there are display artists and every one has an ISNI, DPID,
+// IPI name number or proprietary ID
+func hasArtistIds(artists []DisplayArtist) bool {
+	for _, artist := range artists {
+		identified := false
+		for _, id := range artist.PartyId {
+			identified = identified || id.ISNI != "" || id.DPID != "" || id.IpiNameNumber != "" || len(id.ProprietaryId) > 0
+		}
+		if !identified {
+			return false
+		}
+	}
+	return len(artists) > 0
+}
+
+func hasGenre(genres []Genre) bool {
+	for _, genre := range genres {
+		if genre.GenreText != "" {
+			return true
+		}
+	}
+	return false
+}
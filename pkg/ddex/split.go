@@ -0,0 +1,121 @@
+package ddex
+
+// SplitByRelease shards a multi-release catalog message into one message per release, as
+// many DSPs require. Each message has a copy of the header with a new MessageId from
+// GenerateMessageID (the thread ID is kept, so the shards stay related), the release
+// marked as main, its deals, and the resources and collections it references, including
+// resources contained in those. The messages share nothing with msg or each other.
+func SplitByRelease(msg *NewReleaseMessage) ([]*NewReleaseMessage, error) {
+	if msg.ReleaseList == nil {
+		return nil, nil
+	}
+
+	index := msg.Index()
+
+	var shards []*NewReleaseMessage
+	for _, release := range msg.ReleaseList.Release {
+		shard := &NewReleaseMessage{
+			XmlnsErn:               msg.XmlnsErn,
+			XmlnsXsi:               msg.XmlnsXsi,
+			XsiSchemaLocation:      msg.XsiSchemaLocation,
+			MessageSchemaVersionId: msg.MessageSchemaVersionId,
+			LanguageAndScriptCode:  msg.LanguageAndScriptCode,
+			OtherAttr:              msg.OtherAttr,
+			MessageHeader:          msg.MessageHeader,
+			UpdateIndicator:        msg.UpdateIndicator,
+			ReleaseList:            &ReleaseList{Release: []Release{release}},
+			DealList:               &DealList{},
+			Extensions:             msg.Extensions,
+		}
+		if msg.DealList != nil {
+			for _, releaseDeal := range msg.DealList.ReleaseDeal {
+				if releaseDeal.DealReleaseReference == release.ReleaseReference {
+					shard.DealList.ReleaseDeal = append(shard.DealList.ReleaseDeal, releaseDeal)
+				}
+			}
+		}
+
+		// Resources referenced by the release and its deals, then those they contain
+		resources := make(map[string]bool)
+		var queue []string
+		usedCollections := make(map[string]bool)
+		shard.forEachReference(func(kind referenceKind, ref *string) {
+			switch kind {
+			case resourceReference:
+				if !resources[*ref] {
+					resources[*ref] = true
+					queue = append(queue, *ref)
+				}
+			case collectionReference:
+				usedCollections[*ref] = true
+			}
+		})
+		for len(queue) > 0 {
+			ref := queue[0]
+			queue = queue[1:]
+			var contained *ResourceContainedResourceReferenceList
+			switch resource := index.Resources[ref]; {
+			case resource.SoundRecording != nil:
+				contained = resource.SoundRecording.ResourceContainedResourceReferenceList
+			case resource.Video != nil:
+				contained = resource.Video.ResourceContainedResourceReferenceList
+			}
+			if contained == nil {
+				continue
+			}
+			for _, item := range contained.ResourceContainedResourceReference {
+				if ref := item.ResourceContainedResourceReference; ref != "" && !resources[ref] {
+					resources[ref] = true
+					queue = append(queue, ref)
+				}
+			}
+		}
+
+		if msg.ResourceList != nil {
+			list := &ResourceList{Extensions: msg.ResourceList.Extensions}
+			for _, recording := range msg.ResourceList.SoundRecording {
+				if resources[recording.ResourceReference] {
+					list.SoundRecording = append(list.SoundRecording, recording)
+				}
+			}
+			for _, video := range msg.ResourceList.Video {
+				if resources[video.ResourceReference] {
+					list.Video = append(list.Video, video)
+				}
+			}
+			for _, image := range msg.ResourceList.Image {
+				if resources[image.ResourceReference] {
+					list.Image = append(list.Image, image)
+				}
+			}
+			for _, text := range msg.ResourceList.Text {
+				if resources[text.ResourceReference] {
+					list.Text = append(list.Text, text)
+				}
+			}
+			shard.ResourceList = list
+		}
+		if msg.CollectionList != nil {
+			list := &CollectionList{Extensions: msg.CollectionList.Extensions}
+			for _, collection := range msg.CollectionList.Collection {
+				if usedCollections[collection.CollectionReference] {
+					list.Collection = append(list.Collection, collection)
+				}
+			}
+			if len(list.Collection) > 0 {
+				shard.CollectionList = list
+			}
+		}
+
+		// Copy so the shards can be edited independently
+		shard = shard.clone()
+		shard.ReleaseList.Release[0].IsMainRelease = true
+		if shard.MessageHeader != nil {
+			shard.MessageHeader.MessageId = GenerateMessageID("")
+			shard.MessageHeader.MessageFileName = ""
+		}
+		shards = append(shards, shard)
+	}
+
+	return shards, nil
+}
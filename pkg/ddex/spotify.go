@@ -0,0 +1,213 @@
+package ddex
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SpotifyAlbum is the subset of a Spotify Web API album object used to re-deliver catalog.
+// Many DSP exports follow the same shape. Album track listings from the API omit
+// external_ids; merge in the full track objects (GET /tracks) to carry ISRCs.
+type SpotifyAlbum struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	AlbumType            string             `json:"album_type,omitempty"` // album, single or compilation
+	ReleaseDate          string             `json:"release_date,omitempty"`
+	ReleaseDatePrecision string             `json:"release_date_precision,omitempty"` // year, month or day
+	Label                string             `json:"label,omitempty"`
+	Genres               []string           `json:"genres,omitempty"`
+	Artists              []SpotifyArtist    `json:"artists,omitempty"`
+	Copyrights           []SpotifyCopyright `json:"copyrights,omitempty"`
+	ExternalIDs          SpotifyExternalIDs `json:"external_ids,omitempty"`
+	Tracks               SpotifyTrackPage   `json:"tracks,omitempty"`
+}
+
+// SpotifyArtist is a simplified artist object
+type SpotifyArtist struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// SpotifyCopyright is a copyright statement; Type is C (copyright) or P (sound recording)
+type SpotifyCopyright struct {
+	Text string `json:"text"`
+	Type string `json:"type"`
+}
+
+// SpotifyExternalIDs holds the industry identifiers of an album or track
+type SpotifyExternalIDs struct {
+	ISRC string `json:"isrc,omitempty"`
+	UPC  string `json:"upc,omitempty"`
+	EAN  string `json:"ean,omitempty"`
+}
+
+// SpotifyTrackPage is the paged track listing embedded in an album object
+type SpotifyTrackPage struct {
+	Items []SpotifyTrack `json:"items,omitempty"`
+}
+
+// SpotifyTrack is a track object
+type SpotifyTrack struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	DiscNumber  int                `json:"disc_number"`
+	TrackNumber int                `json:"track_number"`
+	DurationMs  int                `json:"duration_ms"`
+	Explicit    bool               `json:"explicit"`
+	Artists     []SpotifyArtist    `json:"artists,omitempty"`
+	ExternalIDs SpotifyExternalIDs `json:"external_ids,omitempty"`
+}
+
+// SpotifyOptions configures how a Spotify album is mapped
+type SpotifyOptions struct {
+	ReleaseReference string   // Defaults to R1
+	Territories      []string // Defaults to Worldwide
+	Language         string   // Defaults to en
+}
+
+// copyrightYear finds the year in a copyright statement such as "℗ 2019 Label"
+var copyrightYear = regexp.MustCompile(`\b(19|20)\d{2}\b`)
+
+// ParseSpotifyAlbum parses a Spotify Web API album JSON document
+func ParseSpotifyAlbum(data []byte) (*SpotifyAlbum, error) {
+	var album SpotifyAlbum
+	if err := json.Unmarshal(data, &album); err != nil {
+		return nil, fmt.Errorf("failed to parse Spotify album: %w", err)
+	}
+	return &album, nil
+}
+
+// MergeTracks replaces the album's simplified track objects with full track objects
+// fetched separately, matching them by ID, so ISRCs are available for the mapping
+func (a *SpotifyAlbum) MergeTracks(tracks []SpotifyTrack) {
+	byID := make(map[string]SpotifyTrack, len(tracks))
+	for _, track := range tracks {
+		byID[track.ID] = track
+	}
+	for i, item := range a.Tracks.Items {
+		if full, ok := byID[item.ID]; ok {
+			a.Tracks.Items[i] = full
+		}
+	}
+}
+
+// ManifestRelease maps the album onto a ManifestRelease: title, type, UPC/EAN, label,
+// genre, P and C lines, release date, and tracks in disc/track order with ISRCs,
+// durations and explicit flags
+func (a *SpotifyAlbum) ManifestRelease(opts SpotifyOptions) ManifestRelease {
+	release := ManifestRelease{
+		Reference:     opts.ReleaseReference,
+		Type:          a.releaseType(),
+		Title:         a.Name,
+		ICPN:          a.ExternalIDs.UPC,
+		Territories:   opts.Territories,
+		Language:      opts.Language,
+		DisplayArtist: spotifyArtistName(a.Artists),
+		Artists:       spotifyArtists(a.Artists),
+		Label:         a.Label,
+	}
+	if release.ICPN == "" {
+		release.ICPN = a.ExternalIDs.EAN
+	}
+	if len(a.Genres) > 0 {
+		release.Genre = a.Genres[0]
+	}
+	if (EventDate{Value: a.ReleaseDate}).Validate() == nil {
+		release.ReleaseDate = a.ReleaseDate
+	}
+
+	for _, copyright := range a.Copyrights {
+		line := &ManifestLine{Text: strings.TrimSpace(copyright.Text)}
+		if year := copyrightYear.FindString(copyright.Text); year != "" {
+			line.Year, _ = strconv.Atoi(year)
+		}
+		switch copyright.Type {
+		case "P":
+			if release.PLine == nil {
+				release.PLine = line
+			}
+		case "C":
+			if release.CLine == nil {
+				release.CLine = line
+			}
+		}
+	}
+
+	tracks := make([]SpotifyTrack, len(a.Tracks.Items))
+	copy(tracks, a.Tracks.Items)
+	sort.SliceStable(tracks, func(i, j int) bool {
+		if tracks[i].DiscNumber != tracks[j].DiscNumber {
+			return tracks[i].DiscNumber < tracks[j].DiscNumber
+		}
+		return tracks[i].TrackNumber < tracks[j].TrackNumber
+	})
+
+	for _, track := range tracks {
+		mapped := ManifestTrack{
+			Kind:     ManifestTrackSoundRecording,
+			Type:     "MusicalWorkSoundRecording",
+			Title:    track.Name,
+			ISRC:     track.ExternalIDs.ISRC,
+			Duration: FormatDuration(float64(track.DurationMs) / 1000),
+		}
+		if name := spotifyArtistName(track.Artists); name != "" && name != release.DisplayArtist {
+			mapped.DisplayArtist = name
+			mapped.Artists = spotifyArtists(track.Artists)
+		}
+		if track.Explicit {
+			mapped.ParentalWarning = "Explicit"
+			release.ParentalWarning = "Explicit"
+		}
+		release.Tracks = append(release.Tracks, mapped)
+	}
+
+	return release
+}
+
+// AddSpotifyAlbum adds the album's tracks as SoundRecordings and the album as a release,
+// returning the pre-filled ReleaseBuilder so callers can complete it
+func (b *Builder) AddSpotifyAlbum(album *SpotifyAlbum, opts SpotifyOptions) (*ReleaseBuilder, error) {
+	if album == nil {
+		return nil, fmt.Errorf("Spotify album is nil")
+	}
+	if album.Name == "" {
+		return nil, fmt.Errorf("Spotify album %s has no name", album.ID)
+	}
+
+	release := album.ManifestRelease(opts)
+	if len(release.Tracks) == 0 {
+		return nil, fmt.Errorf("Spotify album %s has no tracks", album.ID)
+	}
+
+	return b.addManifestRelease(release, ""), nil
+}
+
+// releaseType maps album_type onto a DDEX ReleaseType. Spotify reports EPs as singles.
+func (a *SpotifyAlbum) releaseType() string {
+	if a.AlbumType == "single" {
+		return "Single"
+	}
+	return "Album"
+}
+
+// spotifyArtistName joins artist names the way Spotify displays them
+func spotifyArtistName(artists []SpotifyArtist) string {
+	names := make([]string, 0, len(artists))
+	for _, artist := range artists {
+		names = append(names, artist.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// spotifyArtists lists each artist as a MainArtist
+func spotifyArtists(artists []SpotifyArtist) []ManifestArtist {
+	var result []ManifestArtist
+	for _, artist := range artists {
+		result = append(result, ManifestArtist{Name: artist.Name, Roles: []string{"MainArtist"}})
+	}
+	return result
+}
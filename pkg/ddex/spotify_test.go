@@ -0,0 +1,44 @@
+package ddex
+
+import "testing"
+
+func TestSpotifyReleaseDate(t *testing.T) {
+	tests := []struct {
+		date      string
+		precision string
+		want      string
+	}{
+		{"2019-06-14", "day", "2019-06-14"},
+		{"2019-06", "month", "2019-06"},
+		{"1979", "year", "1979"},
+		{"2019-06-14", "", "2019-06-14"},
+		{"", "", ""},
+		{"2019-13", "month", ""},
+	}
+	for _, tt := range tests {
+		album := &SpotifyAlbum{
+			ID:                   "album",
+			Name:                 "Album",
+			ReleaseDate:          tt.date,
+			ReleaseDatePrecision: tt.precision,
+			Tracks:               SpotifyTrackPage{Items: []SpotifyTrack{{ID: "t1", Name: "Track", TrackNumber: 1, DurationMs: 180000}}},
+		}
+		if got := album.ManifestRelease(SpotifyOptions{}).ReleaseDate; got != tt.want {
+			t.Errorf("date %q: ReleaseDate = %q, want %q", tt.date, got, tt.want)
+		}
+
+		builder := NewDDEXBuilder()
+		rb, err := builder.AddSpotifyAlbum(album, SpotifyOptions{Territories: []string{"Worldwide"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		details := rb.release().ReleaseDetailsByTerritory
+		var got string
+		if len(details) > 0 && details[0].ReleaseDate != nil {
+			got = details[0].ReleaseDate.Value
+		}
+		if got != tt.want {
+			t.Errorf("date %q: built ReleaseDate = %q, want %q", tt.date, got, tt.want)
+		}
+	}
+}
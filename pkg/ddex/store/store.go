@@ -0,0 +1,285 @@
+// Package store keeps a delivery history of DDEX messages in PostgreSQL. Each message is a
+// row of ddex_messages with its header fields as columns and the whole message as JSONB,
+// and the identifiers it can be looked up by (ICPNs, GRids, ISRCs and recipient DPIDs) are
+// rows of ddex_message_keys. Stored messages are read back as NewReleaseMessage structs.
+//
+// The store uses database/sql and works with any PostgreSQL driver, such as
+// github.com/jackc/pgx/v5/stdlib or github.com/lib/pq:
+//
+//	db, err := sql.Open("pgx", "postgres://localhost/catalog")
+//	s := store.New(db)
+//	if err := s.Migrate(ctx); err != nil { ... }
+//	record, err := s.Save(ctx, message)
+//	history, err := s.FindByICPN(ctx, "123456789012")
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+// ErrNotFound is returned by Get for an ID no message is stored under
+var ErrNotFound = errors.New("message not found")
+
+// Kinds of keys messages are looked up by
+const (
+	KeyICPN      = "ICPN"
+	KeyGRid      = "GRid"
+	KeyISRC      = "ISRC"
+	KeyRecipient = "RecipientDPID"
+)
+
+// schema creates the tables of the store. Messages are unique by sender and MessageId;
+// their keys are deleted with them.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS ddex_messages (
+		id                   BIGSERIAL PRIMARY KEY,
+		message_id           TEXT NOT NULL,
+		message_thread_id    TEXT NOT NULL,
+		sender_dpid          TEXT NOT NULL,
+		message_control_type TEXT NOT NULL,
+		update_indicator     TEXT NOT NULL,
+		created_at           TIMESTAMPTZ,
+		stored_at            TIMESTAMPTZ NOT NULL DEFAULT now(),
+		body                 JSONB NOT NULL,
+		UNIQUE (sender_dpid, message_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS ddex_message_keys (
+		message_pk BIGINT NOT NULL REFERENCES ddex_messages (id) ON DELETE CASCADE,
+		kind       TEXT NOT NULL,
+		value      TEXT NOT NULL,
+		PRIMARY KEY (message_pk, kind, value)
+	)`,
+	`CREATE INDEX IF NOT EXISTS ddex_message_keys_lookup ON ddex_message_keys (kind, value)`,
+	`CREATE INDEX IF NOT EXISTS ddex_messages_message_id ON ddex_messages (message_id)`,
+}
+
+// Record is a stored message
+type Record struct {
+	ID                 int64
+	MessageId          string
+	MessageThreadId    string
+	SenderDPID         string
+	MessageControlType string
+	UpdateIndicator    string
+	CreatedAt          time.Time // MessageCreatedDateTime; zero without one
+	StoredAt           time.Time // When the message was last saved
+	Message            *ddex.NewReleaseMessage
+}
+
+// Store saves and looks up messages in a PostgreSQL database
+type Store struct {
+	db *sql.DB
+}
+
+// New creates a store on db. Call Migrate once to create its tables.
+func New(db *sql.DB) *Store {
+	return &Store{db: db}
+}
+
+// Migrate creates the tables and indexes of the store if they don't exist yet
+func (s *Store) Migrate(ctx context.Context) error {
+	for _, statement := range schema {
+		if _, err := s.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to create store schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// Save stores a message and its keys. Saving a message with the MessageId of one already
+// stored from the same sender replaces it, so retried saves don't duplicate history.
+func (s *Store) Save(ctx context.Context, message *ddex.NewReleaseMessage) (*Record, error) {
+	header := message.MessageHeader
+	if header == nil || header.MessageId == "" {
+		return nil, fmt.Errorf("message has no MessageId: %w", ddex.ErrMissingMessageHeader)
+	}
+
+	body, err := message.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+	record := &Record{
+		MessageId:          header.MessageId,
+		MessageThreadId:    header.MessageThreadId,
+		MessageControlType: header.MessageControlType,
+		UpdateIndicator:    message.UpdateIndicator,
+		Message:            message,
+	}
+	if header.MessageSender != nil {
+		record.SenderDPID = ddex.PartyDPID(header.MessageSender.PartyId)
+	}
+	var createdAt sql.NullTime
+	if header.MessageCreatedDateTime != nil && !header.MessageCreatedDateTime.IsZero() {
+		record.CreatedAt = header.MessageCreatedDateTime.Time
+		createdAt = sql.NullTime{Time: record.CreatedAt, Valid: true}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO ddex_messages (message_id, message_thread_id, sender_dpid, message_control_type,
+			update_indicator, created_at, body)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (sender_dpid, message_id) DO UPDATE SET
+			message_thread_id = EXCLUDED.message_thread_id,
+			message_control_type = EXCLUDED.message_control_type,
+			update_indicator = EXCLUDED.update_indicator,
+			created_at = EXCLUDED.created_at,
+			stored_at = now(),
+			body = EXCLUDED.body
+		RETURNING id, stored_at`,
+		record.MessageId, record.MessageThreadId, record.SenderDPID, record.MessageControlType,
+		record.UpdateIndicator, createdAt, string(body),
+	).Scan(&record.ID, &record.StoredAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save message %s: %w", record.MessageId, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM ddex_message_keys WHERE message_pk = $1`, record.ID); err != nil {
+		return nil, fmt.Errorf("failed to save keys of message %s: %w", record.MessageId, err)
+	}
+	for _, key := range Keys(message) {
+		_, err := tx.ExecContext(ctx, `INSERT INTO ddex_message_keys (message_pk, kind, value) VALUES ($1, $2, $3)`,
+			record.ID, key.Kind, key.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save keys of message %s: %w", record.MessageId, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Key is an identifier a message can be looked up by
+type Key struct {
+	Kind  string // KeyICPN, KeyGRid, KeyISRC or KeyRecipient
+	Value string
+}
+
+// Keys returns the keys Save stores for a message: the ICPNs and GRids of its releases,
+// the ISRCs of its sound recordings and videos, and the DPIDs of its recipients, sorted
+// and without duplicates. ISRCs are stored in upper case without hyphens.
+func Keys(message *ddex.NewReleaseMessage) []Key {
+	seen := make(map[Key]bool)
+	var keys []Key
+	add := func(kind, value string) {
+		key := Key{Kind: kind, Value: value}
+		if value != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	index := message.Index()
+	for icpn := range index.ReleasesByICPN {
+		add(KeyICPN, icpn)
+	}
+	for isrc := range index.ResourcesByISRC {
+		add(KeyISRC, ddex.NormalizeISRC(isrc))
+	}
+	if message.ReleaseList != nil {
+		for _, release := range message.ReleaseList.Release {
+			for _, id := range release.ReleaseId {
+				add(KeyGRid, id.GRid)
+			}
+		}
+	}
+	if message.MessageHeader != nil {
+		for _, recipient := range message.MessageHeader.MessageRecipient {
+			add(KeyRecipient, ddex.PartyDPID(recipient.PartyId))
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Kind != keys[j].Kind {
+			return keys[i].Kind < keys[j].Kind
+		}
+		return keys[i].Value < keys[j].Value
+	})
+	return keys
+}
+
+// selectRecords selects the columns scanned by query
+const selectRecords = `SELECT id, message_id, message_thread_id, sender_dpid, message_control_type,
+	update_indicator, created_at, stored_at, body FROM ddex_messages`
+
+// orderHistory lists the most recently saved messages first
+const orderHistory = ` ORDER BY stored_at DESC, id DESC`
+
+// Get returns the message stored under id, or ErrNotFound
+func (s *Store) Get(ctx context.Context, id int64) (*Record, error) {
+	records, err := s.query(ctx, selectRecords+` WHERE id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("message %d: %w", id, ErrNotFound)
+	}
+	return records[0], nil
+}
+
+// FindByMessageId returns the messages with a MessageId, most recently saved first.
+// Messages of different senders may share one.
+func (s *Store) FindByMessageId(ctx context.Context, messageId string) ([]*Record, error) {
+	return s.query(ctx, selectRecords+` WHERE message_id = $1`+orderHistory, messageId)
+}
+
+// FindByICPN returns the messages with a release of the ICPN, most recently saved first
+func (s *Store) FindByICPN(ctx context.Context, icpn string) ([]*Record, error) {
+	return s.FindByKey(ctx, KeyICPN, icpn)
+}
+
+// FindByISRC returns the messages with a sound recording or video of the ISRC, most
+// recently saved first
+func (s *Store) FindByISRC(ctx context.Context, isrc string) ([]*Record, error) {
+	return s.FindByKey(ctx, KeyISRC, ddex.NormalizeISRC(isrc))
+}
+
+// FindByKey returns the messages with a key (see Keys), most recently saved first
+func (s *Store) FindByKey(ctx context.Context, kind, value string) ([]*Record, error) {
+	return s.query(ctx, selectRecords+` WHERE id IN (
+		SELECT message_pk FROM ddex_message_keys WHERE kind = $1 AND value = $2)`+orderHistory, kind, value)
+}
+
+// query runs a query selecting selectRecords and decodes the messages
+func (s *Store) query(ctx context.Context, query string, args ...interface{}) ([]*Record, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query messages: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*Record
+	for rows.Next() {
+		var record Record
+		var createdAt sql.NullTime
+		var body []byte
+		err := rows.Scan(&record.ID, &record.MessageId, &record.MessageThreadId, &record.SenderDPID,
+			&record.MessageControlType, &record.UpdateIndicator, &createdAt, &record.StoredAt, &body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read messages: %w", err)
+		}
+		if record.Message, err = ddex.FromJSON(body); err != nil {
+			return nil, fmt.Errorf("failed to decode message %d: %w", record.ID, err)
+		}
+		record.CreatedAt = createdAt.Time
+		records = append(records, &record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read messages: %w", err)
+	}
+	return records, nil
+}
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/manosdetijera/ddex/pkg/ddex"
+)
+
+func TestKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		message *ddex.NewReleaseMessage
+		want    []Key
+	}{
+		{
+			name:    "empty",
+			message: &ddex.NewReleaseMessage{},
+		},
+		{
+			name: "releases, resources and recipients",
+			message: &ddex.NewReleaseMessage{
+				MessageHeader: &ddex.MessageHeader{
+					MessageRecipient: []*ddex.MessageRecipient{
+						{PartyId: []ddex.PartyID{{Value: "internal-7", Namespace: "PADPIDA0000000001X"}, {Value: "PADPIDA2013020802I"}}},
+						{PartyId: []ddex.PartyID{{Value: "internal-8", Namespace: "PADPIDA0000000001X"}}},
+						{PartyId: []ddex.PartyID{{Value: "PADPIDA2013020802I"}}},
+					},
+				},
+				ResourceList: &ddex.ResourceList{
+					SoundRecording: []ddex.SoundRecording{
+						{ResourceReference: "A1", SoundRecordingId: []ddex.SoundRecordingId{{ISRC: "us-rc1-24-00001"}}},
+						{ResourceReference: "A2", SoundRecordingId: []ddex.SoundRecordingId{{ISRC: "USRC12400001"}}},
+					},
+					Video: []ddex.Video{
+						{ResourceReference: "A3", VideoId: &ddex.VideoId{ISRC: "USRC12400002"}},
+					},
+				},
+				ReleaseList: &ddex.ReleaseList{
+					Release: []ddex.Release{
+						{ReleaseReference: "R0", ReleaseId: []ddex.ReleaseId{{ICPN: "0123456789012", GRid: "A10302B0001234567X"}}},
+						{ReleaseReference: "R1", ReleaseId: []ddex.ReleaseId{{ICPN: "0123456789012"}, {ICPN: "0123456789029"}}},
+					},
+				},
+			},
+			want: []Key{
+				{KeyGRid, "A10302B0001234567X"},
+				{KeyICPN, "0123456789012"},
+				{KeyICPN, "0123456789029"},
+				{KeyISRC, "USRC12400001"},
+				{KeyISRC, "USRC12400002"},
+				{KeyRecipient, "PADPIDA2013020802I"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Keys(tt.message); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Keys = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
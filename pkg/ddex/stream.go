@@ -0,0 +1,214 @@
+package ddex
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// StreamItemKind identifies the composite held by a StreamItem
+type StreamItemKind string
+
+// Composites yielded by a StreamReader
+const (
+	StreamItemSoundRecording StreamItemKind = "SoundRecording"
+	StreamItemVideo          StreamItemKind = "Video"
+	StreamItemImage          StreamItemKind = "Image"
+	StreamItemText           StreamItemKind = "Text"
+	StreamItemRelease        StreamItemKind = "Release"
+	StreamItemReleaseDeal    StreamItemKind = "ReleaseDeal"
+)
+
+// StreamItem is a single composite decoded by a StreamReader.
+// Exactly one of the pointer fields is set, matching Kind.
+type StreamItem struct {
+	Kind           StreamItemKind
+	SoundRecording *SoundRecording
+	Video          *Video
+	Image          *Image
+	Text           *Text
+	Release        *Release
+	ReleaseDeal    *ReleaseDeal
+}
+
+// StreamReader parses an ERN message token by token and yields resources, releases and
+// deals one at a time, so catalog files of several hundred megabytes can be processed
+// with memory bounded by the largest single composite rather than the whole message.
+type StreamReader struct {
+	decoder *xml.Decoder
+	source  *contextReader
+	message *NewReleaseMessage
+	path    []string
+}
+
+// NewStreamReader creates a StreamReader reading ERN XML from r
+func NewStreamReader(r io.Reader) *StreamReader {
+	source := &contextReader{ctx: context.Background(), r: r}
+	return &StreamReader{
+		decoder: xml.NewDecoder(source),
+		source:  source,
+	}
+}
+
+// WithContext makes Next, Walk and WalkReleases stop with the context's error once ctx is
+// cancelled or times out, for catalog jobs with a deadline
+func (sr *StreamReader) WithContext(ctx context.Context) *StreamReader {
+	sr.source.ctx = ctx
+	return sr
+}
+
+// Message returns the message envelope read so far: root attributes and, once it has
+// been passed, the MessageHeader. Resource, release and deal lists are never populated.
+// Returns nil until the root element has been read.
+func (sr *StreamReader) Message() *NewReleaseMessage {
+	return sr.message
+}
+
+// Header returns the MessageHeader, or nil if it has not been read yet.
+// In a schema-valid message the header precedes every resource and release,
+// so it is available once the first item has been returned by Next.
+func (sr *StreamReader) Header() *MessageHeader {
+	if sr.message == nil {
+		return nil
+	}
+	return sr.message.MessageHeader
+}
+
+// Next decodes and returns the next resource, release or deal in document order.
+// It returns io.EOF when the message has been fully read.
+func (sr *StreamReader) Next() (*StreamItem, error) {
+	for {
+		token, err := sr.decoder.Token()
+		if err == io.EOF {
+			if len(sr.path) > 0 {
+				return nil, fmt.Errorf("unexpected end of XML inside <%s>", sr.path[len(sr.path)-1])
+			}
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read XML token: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			item, decoded, err := sr.decodeStart(t)
+			if err != nil {
+				return nil, err
+			}
+			if item != nil {
+				return item, nil
+			}
+			if !decoded {
+				sr.path = append(sr.path, t.Name.Local)
+			}
+		case xml.EndElement:
+			if len(sr.path) > 0 {
+				sr.path = sr.path[:len(sr.path)-1]
+			}
+		}
+	}
+}
+
+// Walk calls fn for every item in the stream until the end of the message,
+// stopping early if fn returns an error
+func (sr *StreamReader) Walk(fn func(*StreamItem) error) error {
+	for {
+		item, err := sr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+}
+
+// WalkReleases calls fn for every Release in the stream, skipping resources and deals
+func (sr *StreamReader) WalkReleases(fn func(*Release) error) error {
+	return sr.Walk(func(item *StreamItem) error {
+		if item.Kind != StreamItemRelease {
+			return nil
+		}
+		return fn(item.Release)
+	})
+}
+
+// decodeStart handles a start element. It reports whether the element was consumed
+// entirely (so it must not be pushed on the path) and returns an item when the
+// element is one of the composites yielded by the stream.
+func (sr *StreamReader) decodeStart(start xml.StartElement) (*StreamItem, bool, error) {
+	if len(sr.path) == 0 {
+		if start.Name.Local != "NewReleaseMessage" {
+			return nil, false, fmt.Errorf("expected element type <NewReleaseMessage> but have <%s>", start.Name.Local)
+		}
+		if err := checkVersion(rootVersion(start)); err != nil {
+			return nil, false, err
+		}
+		sr.message = &NewReleaseMessage{}
+		sr.message.setRootAttributes(start)
+		return nil, false, nil
+	}
+
+	parent := sr.path[len(sr.path)-1]
+	switch {
+	case parent == "NewReleaseMessage" && start.Name.Local == "MessageHeader":
+		header := &MessageHeader{}
+		if err := sr.decode(header, &start); err != nil {
+			return nil, true, err
+		}
+		sr.message.MessageHeader = header
+		return nil, true, nil
+
+	case parent == "ResourceList":
+		item := &StreamItem{Kind: StreamItemKind(start.Name.Local)}
+		var target interface{}
+		switch item.Kind {
+		case StreamItemSoundRecording:
+			item.SoundRecording = &SoundRecording{}
+			target = item.SoundRecording
+		case StreamItemVideo:
+			item.Video = &Video{}
+			target = item.Video
+		case StreamItemImage:
+			item.Image = &Image{}
+			target = item.Image
+		case StreamItemText:
+			item.Text = &Text{}
+			target = item.Text
+		default:
+			return nil, false, nil
+		}
+		if err := sr.decode(target, &start); err != nil {
+			return nil, true, err
+		}
+		return item, true, nil
+
+	case parent == "ReleaseList" && start.Name.Local == "Release":
+		item := &StreamItem{Kind: StreamItemRelease, Release: &Release{}}
+		if err := sr.decode(item.Release, &start); err != nil {
+			return nil, true, err
+		}
+		return item, true, nil
+
+	case parent == "DealList" && start.Name.Local == "ReleaseDeal":
+		item := &StreamItem{Kind: StreamItemReleaseDeal, ReleaseDeal: &ReleaseDeal{}}
+		if err := sr.decode(item.ReleaseDeal, &start); err != nil {
+			return nil, true, err
+		}
+		return item, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// decode decodes a single element into v, annotating errors with the element name
+func (sr *StreamReader) decode(v interface{}, start *xml.StartElement) error {
+	if err := sr.decoder.DecodeElement(v, start); err != nil {
+		return fmt.Errorf("failed to decode <%s>: %w", start.Name.Local, err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package ddex
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MetadataStyle selects the house-style clean-up of ApplyMetadataStyle
+type MetadataStyle struct {
+	Featuring bool // write "ft.", "feat" and "featuring" in titles and display artist names as "feat."
+	SortNames bool // fill the empty FullNameIndexed of party names with SortName
+	TitleCase bool // apply TitleCase to titles and subtitles
+}
+
+// ApplyMetadataStyle cleans up the titles and artist names of the message following style
+// and returns the number of values changed
+func (nrm *NewReleaseMessage) ApplyMetadataStyle(style MetadataStyle) int {
+	changed := 0
+	set := func(value *string, fn func(string) string) {
+		if styled := fn(*value); styled != *value {
+			*value = styled
+			changed++
+		}
+	}
+	title := func(text *string) {
+		if style.Featuring {
+			set(text, FormatFeaturing)
+		}
+		if style.TitleCase {
+			set(text, TitleCase)
+		}
+	}
+
+	walkModel(reflect.ValueOf(nrm).Elem(), func(v reflect.Value) {
+		switch value := v.Addr().Interface().(type) {
+		case *Title:
+			title(&value.TitleText)
+			title(&value.SubTitle)
+		case *ReferenceTitle:
+			title(&value.TitleText)
+			title(&value.SubTitle)
+		case *DisplayArtistName:
+			if style.Featuring {
+				set(&value.Value, FormatFeaturing)
+			}
+		case *PartyName:
+			if style.SortNames && value.FullNameIndexed == "" && value.FullName != "" {
+				set(&value.FullNameIndexed, func(string) string { return SortName(value.FullName) })
+			}
+		}
+	})
+	return changed
+}
+
+// WithMetadataStyle applies ApplyMetadataStyle with style to the message each time ToXML,
+// WriteToFile or WriteToDelivery marshals it
+func (b *Builder) WithMetadataStyle(style MetadataStyle) *Builder {
+	b.style = style
+	return b
+}
+
+var featuringPattern = regexp.MustCompile(`(?i)\b(?:ft|feat|featuring)\b\.?`)
+
+// FormatFeaturing writes the featuring marks of an artist name or title the same way:
+// "Artist ft Guest", "Song (Featuring Guest)" and "Song (FEAT. Guest)" become
+// "Artist feat. Guest", "Song (feat. Guest)" and "Song (feat. Guest)"
+func FormatFeaturing(s string) string {
+	return featuringPattern.ReplaceAllString(s, "feat.")
+}
+
+// Words SortName moves behind the rest of a name
+var (
+	sortArticles = map[string]bool{"the": true, "a": true, "an": true}
+	sortSuffixes = map[string]bool{"jr": true, "jr.": true, "sr": true, "sr.": true, "ii": true, "iii": true, "iv": true}
+)
+
+// SortName returns the indexed form of a name for alphabetical listing. Person names are
+// inverted at the surname, keeping particles and suffixes after the given names ("John
+// Doe" becomes "Doe, John", "Ludwig van Beethoven" "Beethoven, Ludwig van" and "Sammy
+// Davis Jr." "Davis, Sammy, Jr."), and a leading article moves to the end ("The Beatles"
+// becomes "Beatles, The"). Single words and names that already hold a comma are returned
+// as they are. Band names without an article can't be told from person names, so only
+// use it for persons and "The" bands.
+func SortName(name string) string {
+	words := strings.Fields(name)
+	if len(words) < 2 || strings.Contains(name, ",") {
+		return strings.Join(words, " ")
+	}
+	if sortArticles[strings.ToLower(words[0])] {
+		return strings.Join(words[1:], " ") + ", " + words[0]
+	}
+
+	var suffix string
+	if last := words[len(words)-1]; sortSuffixes[strings.ToLower(last)] && len(words) > 2 {
+		suffix = ", " + last
+		words = words[:len(words)-1]
+	}
+	surname := len(words) - 1
+	return words[surname] + ", " + strings.Join(words[:surname], " ") + suffix
+}
+
+// titleMinorWords are written in lowercase by TitleCase unless they start or end a phrase
+var titleMinorWords = map[string]bool{
+	"a": true, "an": true, "the": true, "and": true, "but": true, "or": true, "nor": true,
+	"for": true, "so": true, "yet": true, "as": true, "at": true, "by": true, "in": true,
+	"of": true, "on": true, "to": true, "up": true, "via": true, "vs.": true,
+}
+
+// TitleCase capitalizes a title following music-catalog conventions: every word starts
+// with a capital except articles, conjunctions and short prepositions, which are
+// lowercased unless they start or end the title or follow a colon, dash or opening
+// bracket. "feat." stays lowercase after a bracket too. Words with capitals after their
+// first letter ("DJ", "iPhone", "McCartney") are kept as they are, and words with an
+// apostrophe or hyphen are handled as in "Don't" and "Hip-Hop".
+func TitleCase(title string) string {
+	words := strings.Fields(title)
+	startsPhrase := true
+	for i, word := range words {
+		core := strings.TrimLeft(word, "([{\"'“‘")
+		opens := len(core) < len(word)
+		last := i == len(words)-1
+
+		switch {
+		case core == "" || hasInnerCapital(core):
+		case strings.EqualFold(core, "feat.") && i > 0:
+			words[i] = word[:len(word)-len(core)] + "feat."
+		case titleMinorWords[strings.ToLower(core)] && !startsPhrase && !opens && !last:
+			words[i] = word[:len(word)-len(core)] + strings.ToLower(core)
+		default:
+			words[i] = word[:len(word)-len(core)] + capitalizeWord(core)
+		}
+
+		startsPhrase = strings.HasSuffix(word, ":") || word == "-" || word == "–" || word == "—" ||
+			strings.HasSuffix(word, "(") || strings.HasSuffix(word, "[")
+	}
+	return strings.Join(words, " ")
+}
+
+// hasInnerCapital reports whether a word has an uppercase letter after its first letter
+func hasInnerCapital(word string) bool {
+	_, size := utf8.DecodeRuneInString(word)
+	for _, r := range word[size:] {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// capitalizeWord uppercases the first letter of a word and of each hyphenated part
+func capitalizeWord(word string) string {
+	parts := strings.Split(word, "-")
+	for i, part := range parts {
+		r, size := utf8.DecodeRuneInString(part)
+		if size > 0 {
+			parts[i] = string(unicode.ToUpper(r)) + part[size:]
+		}
+	}
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,236 @@
+package ddex
+
+import (
+	"sort"
+	"strings"
+)
+
+// territoryMatch ranks how well a DetailsByTerritory entry applies to a territory: 2 when
+// the entry lists it in TerritoryCode, 1 when it covers it through Worldwide or an
+// ExcludedTerritoryCode list that doesn't name it, and 0 when it doesn't apply
+func territoryMatch(codes, excluded []string, territory string) int {
+	worldwide := len(codes) == 0 && len(excluded) > 0
+	for _, code := range codes {
+		if strings.EqualFold(code, territory) {
+			return 2
+		}
+		worldwide = worldwide || strings.EqualFold(code, "Worldwide")
+	}
+	if !worldwide {
+		return 0
+	}
+	for _, code := range excluded {
+		if strings.EqualFold(code, territory) {
+			return 0
+		}
+	}
+	return 1
+}
+
+// bestTerritoryMatch returns the index of the first of n entries that applies most
+// specifically to a territory, or -1 if none does
+func bestTerritoryMatch(n int, entry func(i int) (codes, excluded []string), territory string) int {
+	best, bestRank := -1, 0
+	for i := 0; i < n; i++ {
+		codes, excluded := entry(i)
+		if rank := territoryMatch(codes, excluded, territory); rank > bestRank {
+			best, bestRank = i, rank
+		}
+	}
+	return best
+}
+
+// titleFromReference converts a ReferenceTitle into a Title
+func titleFromReference(ref *ReferenceTitle) []Title {
+	if ref == nil || ref.TitleText == "" {
+		return nil
+	}
+	return []Title{{TitleText: ref.TitleText, SubTitle: ref.SubTitle}}
+}
+
+// EffectiveDetails returns the metadata of the release in a territory: a shallow copy of
+// the ReleaseDetailsByTerritory entry that applies there, preferring an entry naming the
+// territory over Worldwide or exclusion-based ones, with TerritoryCode set to territory.
+// Titles, release types, P and C lines and release dates the entry leaves out are taken
+// from the release-level fields. ok is false if no entry covers the territory.
+func (r *Release) EffectiveDetails(territory string) (details ReleaseDetailsByTerritory, ok bool) {
+	i := bestTerritoryMatch(len(r.ReleaseDetailsByTerritory), func(i int) ([]string, []string) {
+		return r.ReleaseDetailsByTerritory[i].TerritoryCode, r.ReleaseDetailsByTerritory[i].ExcludedTerritoryCode
+	}, territory)
+	if i < 0 {
+		return ReleaseDetailsByTerritory{}, false
+	}
+
+	details = r.ReleaseDetailsByTerritory[i]
+	details.TerritoryCode = []string{territory}
+	details.ExcludedTerritoryCode = nil
+	if len(details.Title) == 0 {
+		details.Title = titleFromReference(r.ReferenceTitle)
+	}
+	if len(details.ReleaseType) == 0 {
+		details.ReleaseType = r.ReleaseType
+	}
+	if len(details.PLine) == 0 {
+		details.PLine = r.PLine
+	}
+	if len(details.CLine) == 0 {
+		details.CLine = r.CLine
+	}
+	if details.ReleaseDate == nil {
+		details.ReleaseDate = r.GlobalReleaseDate
+	}
+	if details.OriginalReleaseDate == nil {
+		details.OriginalReleaseDate = r.GlobalOriginalReleaseDate
+	}
+	return details, true
+}
+
+// EffectiveDetails returns the SoundRecordingDetailsByTerritory entry that applies in a
+// territory (see Release.EffectiveDetails), with the reference title as Title if the
+// entry has none
+func (sr *SoundRecording) EffectiveDetails(territory string) (details SoundRecordingDetailsByTerritory, ok bool) {
+	i := bestTerritoryMatch(len(sr.SoundRecordingDetailsByTerritory), func(i int) ([]string, []string) {
+		return sr.SoundRecordingDetailsByTerritory[i].TerritoryCode, sr.SoundRecordingDetailsByTerritory[i].ExcludedTerritoryCode
+	}, territory)
+	if i < 0 {
+		return SoundRecordingDetailsByTerritory{}, false
+	}
+
+	details = sr.SoundRecordingDetailsByTerritory[i]
+	details.TerritoryCode = []string{territory}
+	details.ExcludedTerritoryCode = nil
+	if len(details.Title) == 0 {
+		details.Title = titleFromReference(sr.ReferenceTitle)
+	}
+	return details, true
+}
+
+// EffectiveDetails returns the VideoDetailsByTerritory entry that applies in a territory
+// (see Release.EffectiveDetails), with the video-level titles, or else the reference
+// title, if the entry has none
+func (v *Video) EffectiveDetails(territory string) (details VideoDetailsByTerritory, ok bool) {
+	i := bestTerritoryMatch(len(v.VideoDetailsByTerritory), func(i int) ([]string, []string) {
+		return v.VideoDetailsByTerritory[i].TerritoryCode, v.VideoDetailsByTerritory[i].ExcludedTerritoryCode
+	}, territory)
+	if i < 0 {
+		return VideoDetailsByTerritory{}, false
+	}
+
+	details = v.VideoDetailsByTerritory[i]
+	details.TerritoryCode = []string{territory}
+	details.ExcludedTerritoryCode = nil
+	if len(details.Title) == 0 {
+		details.Title = v.Title
+	}
+	if len(details.Title) == 0 {
+		details.Title = titleFromReference(v.ReferenceTitle)
+	}
+	return details, true
+}
+
+// EffectiveDetails returns the ImageDetailsByTerritory entry that applies in a territory
+// (see Release.EffectiveDetails), with the image-level titles if the entry has none
+func (img *Image) EffectiveDetails(territory string) (details ImageDetailsByTerritory, ok bool) {
+	i := bestTerritoryMatch(len(img.ImageDetailsByTerritory), func(i int) ([]string, []string) {
+		return img.ImageDetailsByTerritory[i].TerritoryCode, img.ImageDetailsByTerritory[i].ExcludedTerritoryCode
+	}, territory)
+	if i < 0 {
+		return ImageDetailsByTerritory{}, false
+	}
+
+	details = img.ImageDetailsByTerritory[i]
+	details.TerritoryCode = []string{territory}
+	details.ExcludedTerritoryCode = nil
+	if len(details.Title) == 0 {
+		details.Title = img.Title
+	}
+	return details, true
+}
+
+// isoTerritories are the ISO 3166-1 alpha-2 country codes, in sorted order, that Worldwide
+// expands to
+var isoTerritories = strings.Fields(`
+	AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ
+	BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ
+	CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ
+	DE DJ DK DM DO DZ
+	EC EE EG EH ER ES ET
+	FI FJ FK FM FO FR
+	GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY
+	HK HM HN HR HT HU
+	ID IE IL IM IN IO IQ IR IS IT
+	JE JM JO JP
+	KE KG KH KI KM KN KP KR KW KY KZ
+	LA LB LC LI LK LR LS LT LU LV LY
+	MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ
+	NA NC NE NF NG NI NL NO NP NR NU NZ
+	OM
+	PA PE PF PG PH PK PL PM PN PR PS PT PW PY
+	QA
+	RE RO RS RU RW
+	SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ
+	TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ
+	UA UG UM US UY UZ
+	VA VC VE VG VI VN VU
+	WF WS
+	YE YT
+	ZA ZM ZW
+`)
+
+// ISOTerritories returns the ISO 3166-1 alpha-2 country codes Worldwide stands for, sorted
+func ISOTerritories() []string {
+	return append([]string(nil), isoTerritories...)
+}
+
+// ExpandTerritories turns a TerritoryCode/ExcludedTerritoryCode pair into the explicit,
+// sorted set of territories it covers. Worldwide, or an exclusion list on its own, expands
+// to every ISO 3166-1 country; the excluded codes are then removed. Codes are upper-cased
+// and duplicates dropped.
+func ExpandTerritories(codes, excluded []string) []string {
+	set := make(map[string]bool)
+	worldwide := len(codes) == 0 && len(excluded) > 0
+	for _, code := range codes {
+		if strings.EqualFold(code, "Worldwide") {
+			worldwide = true
+			continue
+		}
+		set[strings.ToUpper(code)] = true
+	}
+	if worldwide {
+		for _, code := range isoTerritories {
+			set[code] = true
+		}
+	}
+	for _, code := range excluded {
+		delete(set, strings.ToUpper(code))
+	}
+
+	territories := make([]string, 0, len(set))
+	for code := range set {
+		territories = append(territories, code)
+	}
+	sort.Strings(territories)
+	return territories
+}
+
+// CompressTerritories is the inverse of ExpandTerritories: it returns the shorter of the
+// sorted explicit list and Worldwide with the missing ISO countries as exclusions, for
+// compact TerritoryCode and ExcludedTerritoryCode output. Codes outside ISO 3166-1 are
+// implied by Worldwide and only kept in the explicit form.
+func CompressTerritories(territories []string) (codes, excluded []string) {
+	explicit := ExpandTerritories(territories, nil)
+	set := make(map[string]bool, len(explicit))
+	for _, code := range explicit {
+		set[code] = true
+	}
+	for _, code := range isoTerritories {
+		if !set[code] {
+			excluded = append(excluded, code)
+		}
+	}
+
+	if len(excluded) < len(explicit) {
+		return []string{"Worldwide"}, excluded
+	}
+	return explicit, nil
+}
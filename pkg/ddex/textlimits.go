@@ -0,0 +1,81 @@
+package ddex
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// TextLimits are the character limits a recipient applies to text fields. DSPs often
+// reject or silently cut oversized text instead of reporting it, so CheckTextLengths
+// finds it before delivery. A zero limit is no limit.
+type TextLimits struct {
+	Title            int `toml:"title" yaml:"title" json:"title,omitempty"` // displayed title, "TitleText (SubTitle)"
+	MarketingComment int `toml:"marketing_comment" yaml:"marketing_comment" json:"marketing_comment,omitempty"`
+	LabelName        int `toml:"label_name" yaml:"label_name" json:"label_name,omitempty"`
+}
+
+// YouTubeTextLimits are the limits of YouTube video titles and descriptions, which the
+// titles and marketing comments of the message become
+var YouTubeTextLimits = TextLimits{Title: 100, MarketingComment: 5000}
+
+// CheckTextLengths reports reference titles, titles, marketing comments and label names
+// of releases, sound recordings and videos longer than limits, counted in characters
+func (nrm *NewReleaseMessage) CheckTextLengths(limits TextLimits) ValidationErrors {
+	var errs ValidationErrors
+	check := func(path, field, text string, limit int) {
+		if n := utf8.RuneCountInString(text); limit > 0 && n > limit {
+			errs.add(path, "%s has %d characters, more than the limit of %d", field, n, limit)
+		}
+	}
+	checkTitle := func(path, text, subTitle string) {
+		if subTitle != "" {
+			text += " (" + subTitle + ")"
+		}
+		check(path, "title", text, limits.Title)
+	}
+	checkDetails := func(path string, titles []Title, comment *Comment, labels []LabelName) {
+		for i, title := range titles {
+			checkTitle(fmt.Sprintf("%s.Title[%d]", path, i), title.TitleText, title.SubTitle)
+		}
+		if comment != nil {
+			check(path+".MarketingComment", "marketing comment", comment.Value, limits.MarketingComment)
+		}
+		for i, label := range labels {
+			check(fmt.Sprintf("%s.LabelName[%d]", path, i), "label name", label.Value, limits.LabelName)
+		}
+	}
+
+	if nrm.ReleaseList != nil {
+		for _, release := range nrm.ReleaseList.Release {
+			path := fmt.Sprintf("Release[%s]", release.ReleaseReference)
+			if release.ReferenceTitle != nil {
+				checkTitle(path+".ReferenceTitle", release.ReferenceTitle.TitleText, release.ReferenceTitle.SubTitle)
+			}
+			for i, details := range release.ReleaseDetailsByTerritory {
+				checkDetails(fmt.Sprintf("%s.ReleaseDetailsByTerritory[%d]", path, i), details.Title, details.MarketingComment, details.LabelName)
+			}
+		}
+	}
+	if nrm.ResourceList != nil {
+		for _, sr := range nrm.ResourceList.SoundRecording {
+			path := fmt.Sprintf("SoundRecording[%s]", sr.ResourceReference)
+			if sr.ReferenceTitle != nil {
+				checkTitle(path+".ReferenceTitle", sr.ReferenceTitle.TitleText, sr.ReferenceTitle.SubTitle)
+			}
+			for i, details := range sr.SoundRecordingDetailsByTerritory {
+				checkDetails(fmt.Sprintf("%s.SoundRecordingDetailsByTerritory[%d]", path, i), details.Title, details.MarketingComment, details.LabelName)
+			}
+		}
+		for _, v := range nrm.ResourceList.Video {
+			path := fmt.Sprintf("Video[%s]", v.ResourceReference)
+			if v.ReferenceTitle != nil {
+				checkTitle(path+".ReferenceTitle", v.ReferenceTitle.TitleText, v.ReferenceTitle.SubTitle)
+			}
+			for i, details := range v.VideoDetailsByTerritory {
+				checkDetails(fmt.Sprintf("%s.VideoDetailsByTerritory[%d]", path, i), details.Title, details.MarketingComment, details.LabelName)
+			}
+		}
+	}
+
+	return errs
+}
@@ -2,6 +2,7 @@ package ddex
 
 import (
 	"encoding/xml"
+	"fmt"
 	"time"
 )
 
@@ -38,166 +39,272 @@ func (dt *DateTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 // Following ERN 3.8 standard specification for ReleaseDate and OriginalReleaseDate
 // In ERN 3.8, dates don't have territory attributes at this level
 type EventDate struct {
-	XMLName               xml.Name `xml:",omitempty"`
-	Value                 string   `xml:",chardata"`
-	IsApproximate         bool     `xml:"IsApproximate,attr,omitempty"`
-	IsBefore              bool     `xml:"IsBefore,attr,omitempty"`
-	IsAfter               bool     `xml:"IsAfter,attr,omitempty"`
-	TerritoryCode         string   `xml:"TerritoryCode,attr,omitempty"`
-	LocationDescription   string   `xml:"LocationDescription,attr,omitempty"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:",omitempty" json:"-"`
+	Value                 string   `xml:",chardata" json:",omitempty"`
+	IsApproximate         bool     `xml:"IsApproximate,attr,omitempty" json:",omitempty"`
+	IsBefore              bool     `xml:"IsBefore,attr,omitempty" json:",omitempty"`
+	IsAfter               bool     `xml:"IsAfter,attr,omitempty" json:",omitempty"`
+	TerritoryCode         string   `xml:"TerritoryCode,attr,omitempty" json:",omitempty"`
+	LocationDescription   string   `xml:"LocationDescription,attr,omitempty" json:",omitempty"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+}
+
+// DatePrecision is how much of a date an EventDate gives
+type DatePrecision int
+
+// Precisions of an EventDate value
+const (
+	DatePrecisionYear  DatePrecision = iota + 1 // YYYY
+	DatePrecisionMonth                          // YYYY-MM
+	DatePrecisionDay                            // YYYY-MM-DD
+)
+
+// eventDateLayouts are the ISO 8601 layouts of an EventDate value, by precision
+var eventDateLayouts = map[DatePrecision]string{
+	DatePrecisionYear:  "2006",
+	DatePrecisionMonth: "2006-01",
+	DatePrecisionDay:   "2006-01-02",
+}
+
+// Precision parses the value and returns whether it is a year (YYYY), a month (YYYY-MM)
+// or a full date (YYYY-MM-DD)
+func (d EventDate) Precision() (DatePrecision, error) {
+	precision := DatePrecision(0)
+	switch len(d.Value) {
+	case 4:
+		precision = DatePrecisionYear
+	case 7:
+		precision = DatePrecisionMonth
+	case 10:
+		precision = DatePrecisionDay
+	}
+	if precision != 0 {
+		if _, err := time.Parse(eventDateLayouts[precision], d.Value); err == nil {
+			return precision, nil
+		}
+	}
+	return 0, fmt.Errorf("%q is not an ISO 8601 date (YYYY, YYYY-MM or YYYY-MM-DD)", d.Value)
+}
+
+// Validate checks that the value is a full or partial ISO 8601 date
+func (d EventDate) Validate() error {
+	_, err := d.Precision()
+	return err
+}
+
+// IsYearOnly reports whether the value is a valid year without month or day, as original
+// release dates often are
+func (d EventDate) IsYearOnly() bool {
+	precision, err := d.Precision()
+	return err == nil && precision == DatePrecisionYear
+}
+
+// Resolve returns the first moment of the date in loc: midnight on January 1st for a year,
+// on the 1st for a month. A nil loc means UTC.
+func (d EventDate) Resolve(loc *time.Location) (time.Time, error) {
+	precision, err := d.Precision()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.ParseInLocation(eventDateLayouts[precision], d.Value, loc)
 }
 
 // PartyID represents various party identification types
 type PartyID struct {
-	XMLName   xml.Name `xml:"PartyId"`
-	Value     string   `xml:",chardata"`
-	Namespace string   `xml:"Namespace,attr,omitempty"`
+	XMLName   xml.Name `xml:"PartyId" json:"-"`
+	Value     string   `xml:",chardata" json:",omitempty"`
+	Namespace string   `xml:"Namespace,attr,omitempty" json:",omitempty"`
+}
+
+// PartyDPID returns the DDEX Party ID among a party's IDs: the one without a Namespace
+func PartyDPID(ids []PartyID) string {
+	for _, id := range ids {
+		if id.Namespace == "" {
+			return id.Value
+		}
+	}
+	return ""
 }
 
 // ResourceID represents unique resource identification
 type ResourceID struct {
-	XMLName   xml.Name `xml:"ResourceId"`
-	Value     string   `xml:",chardata"`
-	Namespace string   `xml:"Namespace,attr,omitempty"`
+	XMLName   xml.Name `xml:"ResourceId" json:"-"`
+	Value     string   `xml:",chardata" json:",omitempty"`
+	Namespace string   `xml:"Namespace,attr,omitempty" json:",omitempty"`
 }
 
 // DisplayTitle
 type DisplayTitle struct {
-	XMLName   xml.Name    `xml:"DisplayTitle"`
-	TitleText []TitleText `xml:"TitleText"`
+	XMLName    xml.Name     `xml:"DisplayTitle" json:"-"`
+	TitleText  []TitleText  `xml:"TitleText" json:",omitempty"`
+	Extensions []RawElement `xml:",any" json:",omitempty"`
 }
 
 // TitleText represents localized title information
 type TitleText struct {
-	XMLName               xml.Name `xml:"TitleText"`
-	Value                 string   `xml:",chardata"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
-	TitleType             string   `xml:"TitleType,attr,omitempty"`
+	XMLName               xml.Name `xml:"TitleText" json:"-"`
+	Value                 string   `xml:",chardata" json:",omitempty"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+	TitleType             string   `xml:"TitleType,attr,omitempty" json:",omitempty"`
 }
 
 // DisplayTitleText represents title suggested to show consumer
 // ERN 3.8 version - simpler structure without territory attributes
 type DisplayTitleText struct {
-	XMLName               xml.Name `xml:"DisplayTitleText"`
-	Value                 string   `xml:",chardata"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"DisplayTitleText" json:"-"`
+	Value                 string   `xml:",chardata" json:",omitempty"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 }
 
 // Name represents party names with localization
 type Name struct {
 	//XMLName       xml.Name `xml:"Name"`
-	FullName      string `xml:"FullName"`
-	FullNameAscii string `xml:"FullNameAscii,omitempty"`
-	LanguageCode  string `xml:"LanguageAndScriptCode,attr,omitempty"`
-	NameType      string `xml:"NameType,attr,omitempty"`
+	FullName      string       `xml:"FullName" json:",omitempty"`
+	FullNameAscii string       `xml:"FullNameAscii,omitempty" json:",omitempty"`
+	LanguageCode  string       `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
+	NameType      string       `xml:"NameType,attr,omitempty" json:",omitempty"`
+	Extensions    []RawElement `xml:",any" json:",omitempty"`
 }
 
 // Territory represents geographic territories
 type Territory struct {
-	XMLName               xml.Name `xml:"Territory"`
-	TerritoryCode         string   `xml:"TerritoryCode"`
-	ExcludedTerritoryCode []string `xml:"ExcludedTerritoryCode,omitempty"`
+	XMLName               xml.Name     `xml:"Territory" json:"-"`
+	TerritoryCode         string       `xml:"TerritoryCode" json:",omitempty"`
+	ExcludedTerritoryCode []string     `xml:"ExcludedTerritoryCode,omitempty" json:",omitempty"`
+	Extensions            []RawElement `xml:",any" json:",omitempty"`
 }
 
 // Duration represents time duration in ISO 8601 format
 type Duration struct {
-	XMLName xml.Name `xml:"Duration"`
-	Value   string   `xml:",chardata"` // ISO 8601 duration format (PT3M30S)
+	XMLName xml.Name `xml:"Duration" json:"-"`
+	Value   string   `xml:",chardata" json:",omitempty"` // ISO 8601 duration format (PT3M30S)
 }
 
 // Keywords represents keywords for enhanced search and display
 // ERN 3.8 version
 type Keywords struct {
-	XMLName               xml.Name `xml:"Keywords"`
-	Value                 string   `xml:",chardata"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"Keywords" json:"-"`
+	Value                 string   `xml:",chardata" json:",omitempty"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 }
 
 // Synopsis represents a synopsis with language attributes
 // Following ERN 3.8 standard specification
 type Synopsis struct {
-	XMLName               xml.Name `xml:"Synopsis"`
-	Value                 string   `xml:",chardata"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"Synopsis" json:"-"`
+	Value                 string   `xml:",chardata" json:",omitempty"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 }
 
 // MarketingComment represents a comment about the promotion and marketing of the Release
 // Following ERN 3.8 standard specification
 type MarketingComment struct {
-	XMLName               xml.Name `xml:"MarketingComment"`
-	Value                 string   `xml:",chardata"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"MarketingComment" json:"-"`
+	Value                 string   `xml:",chardata" json:",omitempty"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 }
 
 // AvRating represents an audio-visual rating for a Release
 // Following ERN 3.8 standard specification
 type AvRating struct {
-	XMLName      xml.Name      `xml:"AvRating"`
-	RatingText   string        `xml:"RatingText,omitempty"`
-	RatingAgency *RatingAgency `xml:"RatingAgency,omitempty"`
+	XMLName      xml.Name      `xml:"AvRating" json:"-"`
+	RatingText   string        `xml:"RatingText,omitempty" json:",omitempty"`
+	RatingAgency *RatingAgency `xml:"RatingAgency,omitempty" json:",omitempty"`
+	Extensions   []RawElement  `xml:",any" json:",omitempty"`
 }
 
 // RatingAgency represents a rating agency with optional namespace
 type RatingAgency struct {
-	Value     string `xml:",chardata"`
-	Namespace string `xml:"Namespace,attr,omitempty"`
+	Value     string `xml:",chardata" json:",omitempty"`
+	Namespace string `xml:"Namespace,attr,omitempty" json:",omitempty"`
+}
+
+// Common rating agencies for AvRating. The values carry the ISO 3166 code of the country
+// the agency rates for, e.g. US-MPAA.
+const (
+	RatingAgencyMPAA = "US-MPAA" // Motion Picture Association, United States
+	RatingAgencyBBFC = "GB-BBFC" // British Board of Film Classification
+	RatingAgencyFSK  = "DE-FSK"  // Freiwillige Selbstkontrolle der Filmwirtschaft, Germany
+	RatingAgencyCERO = "JP-CERO" // Computer Entertainment Rating Organization, Japan
+	RatingAgencyKMRB = "KR-KMRB" // Korea Media Rating Board
+)
+
+// ratingAgencyTerritories maps the rating agencies above to the territory they rate for
+var ratingAgencyTerritories = map[string]string{
+	RatingAgencyMPAA: "US",
+	RatingAgencyBBFC: "GB",
+	RatingAgencyFSK:  "DE",
+	RatingAgencyCERO: "JP",
+	RatingAgencyKMRB: "KR",
+}
+
+// RatingAgencyTerritory returns the territory a known rating agency rates for, or "" for
+// other agencies
+func RatingAgencyTerritory(agency string) string {
+	return ratingAgencyTerritories[agency]
 }
 
 // VideoType represents the type of a video.
 type VideoType struct {
-	XMLName xml.Name `xml:"VideoType"`
-	Value   string   `xml:",chardata"`
+	XMLName xml.Name `xml:"VideoType" json:"-"`
+	Value   string   `xml:",chardata" json:",omitempty"`
 }
 
 // DisplayArtistName represents a display artist name with language attributes
 // Following ERN 3.8 standard specification - simpler than ERN 4.3
 type DisplayArtistName struct {
-	XMLName               xml.Name `xml:"DisplayArtistName"`
-	Value                 string   `xml:",chardata"`
-	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty"`
+	XMLName               xml.Name `xml:"DisplayArtistName" json:"-"`
+	Value                 string   `xml:",chardata" json:",omitempty"`
+	LanguageAndScriptCode string   `xml:"LanguageAndScriptCode,attr,omitempty" json:",omitempty"`
 }
 
 // ResourceContributor represents a contributor to a resource (ERN 3.8)
 type ResourceContributor struct {
-	XMLName                       xml.Name    `xml:"ResourceContributor"`
-	SequenceNumber                int         `xml:"SequenceNumber,attr,omitempty"`
-	PartyId                       []PartyId   `xml:"PartyId,omitempty"`
-	PartyName                     []PartyName `xml:"PartyName,omitempty"`
-	ResourceContributorRole       []string    `xml:"ResourceContributorRole,omitempty"`
-	InstrumentType                []string    `xml:"InstrumentType,omitempty"`
-	HasMadeFeaturedContribution   *bool       `xml:"HasMadeFeaturedContribution,omitempty"`
-	HasMadeContractedContribution *bool       `xml:"HasMadeContractedContribution,omitempty"`
+	XMLName                       xml.Name     `xml:"ResourceContributor" json:"-"`
+	SequenceNumber                int          `xml:"SequenceNumber,attr,omitempty" json:",omitempty"`
+	PartyId                       []PartyId    `xml:"PartyId,omitempty" json:",omitempty"`
+	PartyName                     []PartyName  `xml:"PartyName,omitempty" json:",omitempty"`
+	ResourceContributorRole       []string     `xml:"ResourceContributorRole,omitempty" json:",omitempty"`
+	InstrumentType                []string     `xml:"InstrumentType,omitempty" json:",omitempty"`
+	HasMadeFeaturedContribution   *bool        `xml:"HasMadeFeaturedContribution,omitempty" json:",omitempty"`
+	HasMadeContractedContribution *bool        `xml:"HasMadeContractedContribution,omitempty" json:",omitempty"`
+	Extensions                    []RawElement `xml:",any" json:",omitempty"`
 }
 
 // IndirectResourceContributor represents an indirect contributor (ERN 3.8)
 type IndirectResourceContributor struct {
-	XMLName                         xml.Name    `xml:"IndirectResourceContributor"`
-	SequenceNumber                  int         `xml:"SequenceNumber,attr,omitempty"`
-	PartyName                       []PartyName `xml:"PartyName,omitempty"`
-	PartyId                         []PartyId   `xml:"PartyId,omitempty"`
-	IndirectResourceContributorRole []string    `xml:"IndirectResourceContributorRole,omitempty"`
+	XMLName                         xml.Name     `xml:"IndirectResourceContributor" json:"-"`
+	SequenceNumber                  int          `xml:"SequenceNumber,attr,omitempty" json:",omitempty"`
+	PartyName                       []PartyName  `xml:"PartyName,omitempty" json:",omitempty"`
+	PartyId                         []PartyId    `xml:"PartyId,omitempty" json:",omitempty"`
+	IndirectResourceContributorRole []string     `xml:"IndirectResourceContributorRole,omitempty" json:",omitempty"`
+	Extensions                      []RawElement `xml:",any" json:",omitempty"`
 }
 
 // RightsController represents a rights controller (TypedRightsController in ERN 3.8)
 type RightsController struct {
-	XMLName                        xml.Name  `xml:"RightsController"`
-	SequenceNumber                 *int      `xml:"SequenceNumber,omitempty"`
-	PartyName                      []Name    `xml:"PartyName,omitempty"`
-	PartyId                        []PartyID `xml:"PartyId,omitempty"`
-	RightsControllerPartyReference string    `xml:"RightsControllerPartyReference,omitempty"`
-	RightsControllerRole           []string  `xml:"RightsControllerRole,omitempty"`
-	RightSharePercentage           string    `xml:"RightSharePercentage,omitempty"`
-	RightShareUnknown              string    `xml:"RightShareUnknown,omitempty"`
+	XMLName                        xml.Name     `xml:"RightsController" json:"-"`
+	SequenceNumber                 *int         `xml:"SequenceNumber,omitempty" json:",omitempty"`
+	PartyName                      []Name       `xml:"PartyName,omitempty" json:",omitempty"`
+	PartyId                        []PartyID    `xml:"PartyId,omitempty" json:",omitempty"`
+	RightsControllerPartyReference string       `xml:"RightsControllerPartyReference,omitempty" json:",omitempty"`
+	RightsControllerRole           []string     `xml:"RightsControllerRole,omitempty" json:",omitempty"`
+	RightSharePercentage           *Decimal     `xml:"RightSharePercentage,omitempty" json:",omitempty"`
+	RightShareUnknown              string       `xml:"RightShareUnknown,omitempty" json:",omitempty"`
+	Extensions                     []RawElement `xml:",any" json:",omitempty"`
 }
 
 // HostSoundCarrier represents the sound carrier on which a resource was originally released (ERN 3.8)
 type HostSoundCarrier struct {
-	XMLName             xml.Name            `xml:"HostSoundCarrier"`
-	ReleaseId           []ReleaseId         `xml:"ReleaseId,omitempty"`
-	CatalogNumber       *CatalogNumber      `xml:"CatalogNumber,omitempty"`
-	Title               []Title             `xml:"Title,omitempty"`
-	DisplayArtistName   []DisplayArtistName `xml:"DisplayArtistName,omitempty"`
-	DisplayArtist       []DisplayArtist     `xml:"DisplayArtist,omitempty"`
-	OriginalReleaseDate *EventDate          `xml:"OriginalReleaseDate,omitempty"`
+	XMLName             xml.Name            `xml:"HostSoundCarrier" json:"-"`
+	ReleaseId           []ReleaseId         `xml:"ReleaseId,omitempty" json:",omitempty"`
+	CatalogNumber       *CatalogNumber      `xml:"CatalogNumber,omitempty" json:",omitempty"`
+	Title               []Title             `xml:"Title,omitempty" json:",omitempty"`
+	DisplayArtistName   []DisplayArtistName `xml:"DisplayArtistName,omitempty" json:",omitempty"`
+	DisplayArtist       []DisplayArtist     `xml:"DisplayArtist,omitempty" json:",omitempty"`
+	OriginalReleaseDate *EventDate          `xml:"OriginalReleaseDate,omitempty" json:",omitempty"`
+	Extensions          []RawElement        `xml:",any" json:",omitempty"`
 }
@@ -0,0 +1,92 @@
+package ddex
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// UnicodeOptions selects the Unicode clean-up of NormalizeUnicode
+type UnicodeOptions struct {
+	// NFC composes every text value and attribute to Unicode Normalization Form C, so an
+	// "é" typed as e + combining accent matches one typed as a single character. DSPs
+	// compare titles and names byte for byte when detecting duplicates.
+	NFC bool
+
+	// ASCIINames fills the empty FullNameAscii of every name that isn't ASCII with its
+	// transliteration (see TransliterateASCII), where one exists
+	ASCIINames bool
+}
+
+// NormalizeUnicode applies opts to the titles, names and other text of the message and
+// returns the number of values changed
+func (nrm *NewReleaseMessage) NormalizeUnicode(opts UnicodeOptions) int {
+	changed := 0
+	root := reflect.ValueOf(nrm).Elem()
+	if opts.NFC {
+		changed += mapText(root, norm.NFC.String)
+	}
+	if opts.ASCIINames {
+		walkModel(root, func(v reflect.Value) {
+			name, ok := v.Addr().Interface().(*Name)
+			if !ok || name.FullNameAscii != "" || isASCII(name.FullName) {
+				return
+			}
+			if ascii, ok := TransliterateASCII(name.FullName); ok {
+				name.FullNameAscii = ascii
+				changed++
+			}
+		})
+	}
+	return changed
+}
+
+// WithUnicodeNormalization applies NormalizeUnicode with opts to the message each time
+// ToXML, WriteToFile or WriteToDelivery marshals it
+func (b *Builder) WithUnicodeNormalization(opts UnicodeOptions) *Builder {
+	b.unicode = opts
+	return b
+}
+
+// asciiFoldings are the letters that don't decompose into an ASCII letter and accents
+var asciiFoldings = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE", 'ø': "o", 'Ø': "O",
+	'đ': "d", 'Đ': "D", 'ð': "d", 'Ð': "D", 'þ': "th", 'Þ': "Th", 'ł': "l", 'Ł': "L",
+	'ı': "i", 'ŋ': "ng", 'Ŋ': "NG",
+	'‘': "'", '’': "'", '“': "\"", '”': "\"", '–': "-", '—': "-", '…': "...",
+}
+
+// TransliterateASCII returns s in ASCII with accents removed and ligatures and special
+// letters spelled out ("Sigur Rós" becomes "Sigur Ros", "Mötley Crüe" "Motley Crue").
+// ok is false when s holds characters with no ASCII spelling, such as Cyrillic or CJK.
+func TransliterateASCII(s string) (ascii string, ok bool) {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case r < utf8.RuneSelf:
+			b.WriteRune(r)
+		case unicode.Is(unicode.Mn, r):
+			// Combining accent of the previous letter
+		case asciiFoldings[r] != "":
+			b.WriteString(asciiFoldings[r])
+		case unicode.IsSpace(r):
+			b.WriteRune(' ')
+		default:
+			return "", false
+		}
+	}
+	return b.String(), true
+}
+
+// isASCII reports whether s only holds ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
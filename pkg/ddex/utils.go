@@ -2,7 +2,9 @@ package ddex
 
 import (
 	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"regexp"
 	"strings"
 	"time"
@@ -10,45 +12,113 @@ import (
 
 // Utils provides utility functions for DDEX message creation and validation
 
-// GenerateMessageID generates a unique message ID following DDEX conventions
-func GenerateMessageID(prefix string) string {
-	timestamp := time.Now().Format("20060102150405")
-	randomBytes := make([]byte, 4)
-	rand.Read(randomBytes)
-	randomHex := fmt.Sprintf("%x", randomBytes)
+// Bool returns a pointer to v, for the optional flags of the model
+func Bool(v bool) *bool {
+	return &v
+}
 
-	if prefix == "" {
-		prefix = "MSG"
+// FirstNonEmpty returns the first of values that isn't empty
+func FirstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
 	}
+	return ""
+}
 
-	return fmt.Sprintf("%s_%s_%s", prefix, timestamp, randomHex)
+// IDGenerator generates message, thread and reference IDs from a random source and a
+// clock. Seed Random (e.g. with a math/rand source) and fix the Clock to get the same IDs
+// on every run in tests, or pass a recording reader to audit the randomness used in
+// production.
+type IDGenerator struct {
+	Random io.Reader // crypto/rand.Reader if nil
+	Clock  Clock     // The system time if nil
 }
 
-// GenerateThreadID generates a unique thread ID following DDEX conventions
-func GenerateThreadID(prefix string) string {
-	timestamp := time.Now().Format("20060102")
-	randomBytes := make([]byte, 6)
-	rand.Read(randomBytes)
-	randomHex := fmt.Sprintf("%x", randomBytes)
+// MessageID returns <prefix>_<YYYYMMDDhhmmss>_<8 hex digits>, with MSG as default prefix
+func (g IDGenerator) MessageID(prefix string) (string, error) {
+	if prefix == "" {
+		prefix = "MSG"
+	}
+	random, err := g.randomHex(4)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s_%s_%s", prefix, g.now().Format("20060102150405"), random), nil
+}
 
+// ThreadID returns <prefix>_<YYYYMMDD>_<12 hex digits>, with THR as default prefix
+func (g IDGenerator) ThreadID(prefix string) (string, error) {
 	if prefix == "" {
 		prefix = "THR"
 	}
+	random, err := g.randomHex(6)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s_%s_%s", prefix, g.now().Format("20060102"), random), nil
+}
+
+// Reference returns <prefix>_<16 hex digits>, with REF as default prefix
+func (g IDGenerator) Reference(prefix string) (string, error) {
+	if prefix == "" {
+		prefix = "REF"
+	}
+	random, err := g.randomHex(8)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s_%s", prefix, random), nil
+}
+
+// randomHex reads n bytes from the random source and returns them hex-encoded
+func (g IDGenerator) randomHex(n int) (string, error) {
+	source := g.Random
+	if source == nil {
+		source = rand.Reader
+	}
+	randomBytes := make([]byte, n)
+	if _, err := io.ReadFull(source, randomBytes); err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %w", err)
+	}
+	return hex.EncodeToString(randomBytes), nil
+}
+
+func (g IDGenerator) now() time.Time {
+	if g.Clock == nil {
+		return time.Now()
+	}
+	return g.Clock.Now()
+}
+
+// defaultIDs generates IDs from crypto/rand and the system time
+var defaultIDs IDGenerator
+
+// GenerateMessageID generates a unique message ID following DDEX conventions. It panics
+// if the system's random source fails; use IDGenerator to handle that error.
+func GenerateMessageID(prefix string) string {
+	return mustID(defaultIDs.MessageID(prefix))
+}
 
-	return fmt.Sprintf("%s_%s_%s", prefix, timestamp, randomHex)
+// GenerateThreadID generates a unique thread ID following DDEX conventions. It panics if
+// the system's random source fails; use IDGenerator to handle that error.
+func GenerateThreadID(prefix string) string {
+	return mustID(defaultIDs.ThreadID(prefix))
 }
 
 // GenerateReference generates a unique reference ID for resources, releases, deals, etc.
+// It panics if the system's random source fails; use IDGenerator to handle that error.
 func GenerateReference(prefix string) string {
-	randomBytes := make([]byte, 8)
-	rand.Read(randomBytes)
-	randomHex := fmt.Sprintf("%x", randomBytes)
+	return mustID(defaultIDs.Reference(prefix))
+}
 
-	if prefix == "" {
-		prefix = "REF"
+// mustID panics on err, which a broken system random source is the only cause of
+func mustID(id string, err error) string {
+	if err != nil {
+		panic("ddex: " + err.Error())
 	}
-
-	return fmt.Sprintf("%s_%s", prefix, randomHex)
+	return id
 }
 
 // ValidateUPC validates a UPC (Universal Product Code)
@@ -114,7 +184,7 @@ func ValidateEAN(ean string) bool {
 // ValidateISRC validates an ISRC (International Standard Recording Code)
 func ValidateISRC(isrc string) bool {
 	// ISRC format: CC-XXX-YY-NNNNN (12 characters without hyphens, 15 with)
-	isrcClean := strings.ReplaceAll(strings.ToUpper(isrc), "-", "")
+	isrcClean := NormalizeISRC(isrc)
 
 	if len(isrcClean) != 12 {
 		return false
@@ -130,6 +200,12 @@ func ValidateISRC(isrc string) bool {
 	return matched
 }
 
+// NormalizeISRC removes the hyphens from an ISRC and upper-cases it, so codes written
+// either way compare equal
+func NormalizeISRC(isrc string) string {
+	return strings.ToUpper(strings.ReplaceAll(isrc, "-", ""))
+}
+
 // ValidateISWC validates an ISWC (International Standard Musical Work Code)
 func ValidateISWC(iswc string) bool {
 	// ISWC format: T-DDD.DDD.DDD-C (where D=digit, C=check digit)
@@ -161,6 +237,40 @@ func ValidateDPID(dpid string) bool {
 	return matched
 }
 
+// iso639Alpha3 maps ISO 639-1 codes to the ISO 639-2 (bibliographic) codes DDEX uses
+// for LanguageOfPerformance, LanguageOfDubbing and SubTitleLanguage
+var iso639Alpha3 = map[string]string{
+	"ar": "ara", "bg": "bul", "bn": "ben", "ca": "cat", "cs": "cze", "cy": "wel",
+	"da": "dan", "de": "ger", "el": "gre", "en": "eng", "es": "spa", "et": "est",
+	"eu": "baq", "fa": "per", "fi": "fin", "fr": "fre", "ga": "gle", "gl": "glg",
+	"he": "heb", "hi": "hin", "hr": "hrv", "hu": "hun", "hy": "arm", "id": "ind",
+	"is": "ice", "it": "ita", "ja": "jpn", "ka": "geo", "ko": "kor", "la": "lat",
+	"lt": "lit", "lv": "lav", "mk": "mac", "ms": "may", "mt": "mlt", "nb": "nob",
+	"nl": "dut", "nn": "nno", "no": "nor", "pa": "pan", "pl": "pol", "pt": "por",
+	"ro": "rum", "ru": "rus", "sk": "slo", "sl": "slv", "sq": "alb", "sr": "srp",
+	"sv": "swe", "sw": "swa", "ta": "tam", "th": "tha", "tl": "tgl", "tr": "tur",
+	"uk": "ukr", "ur": "urd", "vi": "vie", "yo": "yor", "zh": "chi", "zu": "zul",
+}
+
+// languageCodePattern matches an ISO 639-2 code such as eng or ger
+var languageCodePattern = regexp.MustCompile(`^[a-z]{3}$`)
+
+// ValidateLanguageCode validates an ISO 639-2 language code (three lowercase letters,
+// e.g. "eng"; the special codes "mul", "und" and "zxx" are valid too)
+func ValidateLanguageCode(code string) bool {
+	return languageCodePattern.MatchString(code)
+}
+
+// languageCode returns code as ISO 639-2, converting common ISO 639-1 codes ("en" is
+// "eng") and lowercasing. Other codes are returned as given, for validation to report.
+func languageCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if alpha3, ok := iso639Alpha3[code]; ok {
+		return alpha3
+	}
+	return code
+}
+
 // FormatDuration formats a duration in seconds to ISO 8601 duration format (PT3M30S or PT4M23.583S)
 func FormatDuration(seconds float64) string {
 	if seconds <= 0 {
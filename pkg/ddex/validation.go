@@ -0,0 +1,986 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ValidationError is a problem a validation check found on an element of a message
+type ValidationError struct {
+	Path    string // Element with the problem, e.g. Release[R1].ReleaseDetailsByTerritory[1]
+	Message string
+	Err     error // Kind of problem, e.g. ErrInvalidISRC, if it has one
+}
+
+func (e ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// Unwrap returns the kind of problem for errors.Is/As
+func (e ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors is every problem a validation check found
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the individual problems so errors.Is/As can inspect them
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// add appends a problem found on path
+func (e *ValidationErrors) add(path, format string, args ...interface{}) {
+	*e = append(*e, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// addErr appends a problem of the kind err found on path
+func (e *ValidationErrors) addErr(path string, err error, format string, args ...interface{}) {
+	*e = append(*e, ValidationError{Path: path, Message: fmt.Sprintf(format, args...), Err: err})
+}
+
+// CheckTerritoryOverlaps reports DetailsByTerritory entries of a release or resource that
+// claim a territory another entry in the same language already covers. DSPs reject such
+// messages, including a Worldwide entry combined with an entry for a single country, so
+// the territories must be split with ExcludedTerritoryCode instead.
+func (nrm *NewReleaseMessage) CheckTerritoryOverlaps() ValidationErrors {
+	var errs ValidationErrors
+
+	if nrm.ResourceList != nil {
+		for _, sr := range nrm.ResourceList.SoundRecording {
+			details := sr.SoundRecordingDetailsByTerritory
+			checkTerritoryOverlaps(&errs, "SoundRecording["+sr.ResourceReference+"].SoundRecordingDetailsByTerritory", len(details), func(i int) (string, []string, []string) {
+				return details[i].LanguageAndScriptCode, details[i].TerritoryCode, details[i].ExcludedTerritoryCode
+			})
+		}
+		for _, v := range nrm.ResourceList.Video {
+			details := v.VideoDetailsByTerritory
+			checkTerritoryOverlaps(&errs, "Video["+v.ResourceReference+"].VideoDetailsByTerritory", len(details), func(i int) (string, []string, []string) {
+				return details[i].LanguageAndScriptCode, details[i].TerritoryCode, details[i].ExcludedTerritoryCode
+			})
+		}
+		for _, img := range nrm.ResourceList.Image {
+			details := img.ImageDetailsByTerritory
+			checkTerritoryOverlaps(&errs, "Image["+img.ResourceReference+"].ImageDetailsByTerritory", len(details), func(i int) (string, []string, []string) {
+				return details[i].LanguageAndScriptCode, details[i].TerritoryCode, details[i].ExcludedTerritoryCode
+			})
+		}
+	}
+
+	if nrm.ReleaseList != nil {
+		for _, release := range nrm.ReleaseList.Release {
+			details := release.ReleaseDetailsByTerritory
+			checkTerritoryOverlaps(&errs, "Release["+release.ReleaseReference+"].ReleaseDetailsByTerritory", len(details), func(i int) (string, []string, []string) {
+				return details[i].LanguageAndScriptCode, details[i].TerritoryCode, details[i].ExcludedTerritoryCode
+			})
+		}
+	}
+
+	return errs
+}
+
+// CheckDealTerritories reports deals granting territories their release has no
+// ReleaseDetailsByTerritory for. Take-down and cancellation deals are not checked.
+func (nrm *NewReleaseMessage) CheckDealTerritories() ValidationErrors {
+	var errs ValidationErrors
+	if nrm.ReleaseList == nil || nrm.DealList == nil {
+		return errs
+	}
+
+	covered := make(map[string]map[string]bool)
+	for _, release := range nrm.ReleaseList.Release {
+		territories := make(map[string]bool)
+		for _, details := range release.ReleaseDetailsByTerritory {
+			for _, code := range ExpandTerritories(details.TerritoryCode, details.ExcludedTerritoryCode) {
+				territories[code] = true
+			}
+		}
+		covered[release.ReleaseReference] = territories
+	}
+
+	for _, releaseDeal := range nrm.DealList.ReleaseDeal {
+		territories, ok := covered[releaseDeal.DealReleaseReference]
+		if !ok {
+			continue
+		}
+		for i, deal := range releaseDeal.Deal {
+			terms := deal.DealTerms
+			if terms == nil || terms.TakeDown != nil && *terms.TakeDown || terms.AllDealsCancelled != nil && *terms.AllDealsCancelled {
+				continue
+			}
+			var missing []string
+			for _, code := range ExpandTerritories(terms.TerritoryCode, terms.ExcludedTerritoryCode) {
+				if !territories[code] {
+					missing = append(missing, code)
+				}
+			}
+			if len(missing) > 0 {
+				errs.add(fmt.Sprintf("ReleaseDeal[%s].Deal[%d]", releaseDeal.DealReleaseReference, i),
+					"deal grants %s but Release[%s] has no ReleaseDetailsByTerritory there", describeTerritories(missing), releaseDeal.DealReleaseReference)
+			}
+		}
+	}
+
+	return errs
+}
+
+// CheckDealResourceReferences reports pre-order incentive and instant gratification
+// resources that are not resources of the deal's release
+func (nrm *NewReleaseMessage) CheckDealResourceReferences() ValidationErrors {
+	var errs ValidationErrors
+	if nrm.DealList == nil {
+		return errs
+	}
+
+	resources := nrm.resourceReferences()
+
+	// Resources listed by each release; releases without a list are only checked against
+	// the ResourceList
+	releaseResources := make(map[string]map[string]bool)
+	if nrm.ReleaseList != nil {
+		for _, release := range nrm.ReleaseList.Release {
+			if release.ReleaseResourceReferenceList == nil {
+				continue
+			}
+			refs := make(map[string]bool)
+			for _, ref := range release.ReleaseResourceReferenceList.ReleaseResourceReference {
+				refs[ref.Value] = true
+			}
+			releaseResources[release.ReleaseReference] = refs
+		}
+	}
+
+	for _, releaseDeal := range nrm.DealList.ReleaseDeal {
+		for i, deal := range releaseDeal.Deal {
+			if deal.DealTerms == nil {
+				continue
+			}
+			lists := []struct {
+				name string
+				list *DealResourceReferenceList
+			}{
+				{"PreOrderIncentiveResourceList", deal.DealTerms.PreOrderIncentiveResourceList},
+				{"InstantGratificationResourceList", deal.DealTerms.InstantGratificationResourceList},
+			}
+			for _, l := range lists {
+				if l.list == nil {
+					continue
+				}
+				path := fmt.Sprintf("ReleaseDeal[%s].Deal[%d].%s", releaseDeal.DealReleaseReference, i, l.name)
+				for _, ref := range l.list.DealResourceReference {
+					refs, listed := releaseResources[releaseDeal.DealReleaseReference]
+					switch {
+					case !resources[ref]:
+						errs.addErr(path, &ErrDanglingReference{Ref: ref}, "resource %s is not in the ResourceList", ref)
+					case listed && !refs[ref]:
+						errs.add(path, "resource %s is not part of Release[%s]", ref, releaseDeal.DealReleaseReference)
+					}
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+// CheckResourceReferences reports an empty ResourceList, which ERN 3.8 doesn't allow, and
+// resources listed by a release's ReleaseResourceReferenceList or ResourceGroups that are
+// not in the ResourceList (ErrDanglingReference)
+func (nrm *NewReleaseMessage) CheckResourceReferences() ValidationErrors {
+	var errs ValidationErrors
+	resources := nrm.resourceReferences()
+	if len(resources) == 0 {
+		errs.add("ResourceList", "at least one resource is required")
+	}
+	if nrm.ReleaseList == nil {
+		return errs
+	}
+
+	check := func(path, ref string) {
+		if !resources[ref] {
+			errs.addErr(path, &ErrDanglingReference{Ref: ref}, "resource %s is not in the ResourceList", ref)
+		}
+	}
+	for _, release := range nrm.ReleaseList.Release {
+		path := "Release[" + release.ReleaseReference + "]"
+		if release.ReleaseResourceReferenceList != nil {
+			for _, ref := range release.ReleaseResourceReferenceList.ReleaseResourceReference {
+				check(path+".ReleaseResourceReferenceList", ref.Value)
+			}
+		}
+		for i, details := range release.ReleaseDetailsByTerritory {
+			for j, group := range details.ResourceGroup {
+				groupPath := fmt.Sprintf("%s.ReleaseDetailsByTerritory[%d].ResourceGroup[%d]", path, i, j)
+				for _, item := range group.ResourceGroupContentItem {
+					check(groupPath, item.ReleaseResourceReference.Value)
+					for _, linked := range item.LinkedReleaseResourceReference {
+						check(groupPath, linked.Value)
+					}
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// resourceReferences returns the ResourceReferences of the resources in the ResourceList
+func (nrm *NewReleaseMessage) resourceReferences() map[string]bool {
+	resources := make(map[string]bool)
+	if nrm.ResourceList == nil {
+		return resources
+	}
+	for _, sr := range nrm.ResourceList.SoundRecording {
+		resources[sr.ResourceReference] = true
+	}
+	for _, v := range nrm.ResourceList.Video {
+		resources[v.ResourceReference] = true
+	}
+	for _, img := range nrm.ResourceList.Image {
+		resources[img.ResourceReference] = true
+	}
+	for _, text := range nrm.ResourceList.Text {
+		resources[text.ResourceReference] = true
+	}
+	return resources
+}
+
+// CheckOrphanResources reports resources in the ResourceList that no release lists in its
+// ReleaseResourceReferenceList or ResourceGroups, which usually means a release was not
+// linked to a resource added for it
+func (nrm *NewReleaseMessage) CheckOrphanResources() ValidationErrors {
+	var errs ValidationErrors
+	if nrm.ResourceList == nil {
+		return errs
+	}
+
+	referenced := make(map[string]bool)
+	if nrm.ReleaseList != nil {
+		for _, release := range nrm.ReleaseList.Release {
+			if release.ReleaseResourceReferenceList != nil {
+				for _, ref := range release.ReleaseResourceReferenceList.ReleaseResourceReference {
+					referenced[ref.Value] = true
+				}
+			}
+			for _, details := range release.ReleaseDetailsByTerritory {
+				for _, group := range details.ResourceGroup {
+					for _, item := range group.ResourceGroupContentItem {
+						referenced[item.ReleaseResourceReference.Value] = true
+						for _, linked := range item.LinkedReleaseResourceReference {
+							referenced[linked.Value] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	check := func(kind, ref string) {
+		if !referenced[ref] {
+			errs.add(kind+"["+ref+"]", "resource is not referenced by any Release or ResourceGroup")
+		}
+	}
+	for _, sr := range nrm.ResourceList.SoundRecording {
+		check("SoundRecording", sr.ResourceReference)
+	}
+	for _, v := range nrm.ResourceList.Video {
+		check("Video", v.ResourceReference)
+	}
+	for _, img := range nrm.ResourceList.Image {
+		check("Image", img.ResourceReference)
+	}
+	for _, text := range nrm.ResourceList.Text {
+		check("Text", text.ResourceReference)
+	}
+
+	return errs
+}
+
+// CheckIdentifiers reports malformed ISRCs of sound recordings, videos and releases
+// (ErrInvalidISRC) and ICPNs of releases that are neither a UPC nor an EAN with a valid
+// check digit (ErrInvalidICPN)
+func (nrm *NewReleaseMessage) CheckIdentifiers() ValidationErrors {
+	var errs ValidationErrors
+	checkISRC := func(path, isrc string) {
+		if isrc != "" && !ValidateISRC(isrc) {
+			errs.addErr(path, ErrInvalidISRC, "ISRC %q is not of the form CCXXXYYNNNNN", isrc)
+		}
+	}
+
+	if nrm.ResourceList != nil {
+		for _, sr := range nrm.ResourceList.SoundRecording {
+			for i, id := range sr.SoundRecordingId {
+				checkISRC(fmt.Sprintf("SoundRecording[%s].SoundRecordingId[%d]", sr.ResourceReference, i), id.ISRC)
+			}
+		}
+		for _, v := range nrm.ResourceList.Video {
+			if v.VideoId != nil {
+				checkISRC(fmt.Sprintf("Video[%s].VideoId", v.ResourceReference), v.VideoId.ISRC)
+			}
+		}
+	}
+	if nrm.ReleaseList != nil {
+		for _, release := range nrm.ReleaseList.Release {
+			for i, id := range release.ReleaseId {
+				path := fmt.Sprintf("Release[%s].ReleaseId[%d]", release.ReleaseReference, i)
+				checkISRC(path, id.ISRC)
+				if id.ICPN != "" && !ValidateUPC(id.ICPN) && !ValidateEAN(id.ICPN) {
+					errs.addErr(path, ErrInvalidICPN, "ICPN %q is not a UPC or EAN with a valid check digit", id.ICPN)
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+// CheckCharacterParties reports video characters whose CharacterPartyReference is not in
+// the PartyList of the message. ERN 3.8 has no PartyList of its own, but messages carrying
+// one as an extension (as ERN 4 does) must resolve their references; without a PartyList
+// nothing is checked.
+func (nrm *NewReleaseMessage) CheckCharacterParties() ValidationErrors {
+	var errs ValidationErrors
+	parties, ok := nrm.partyReferences()
+	if !ok || nrm.ResourceList == nil {
+		return errs
+	}
+
+	for _, v := range nrm.ResourceList.Video {
+		for i, details := range v.VideoDetailsByTerritory {
+			for j, character := range details.Character {
+				if ref := character.CharacterPartyReference; ref != "" && !parties[ref] {
+					errs.addErr(fmt.Sprintf("Video[%s].VideoDetailsByTerritory[%d].Character[%d]", v.ResourceReference, i, j), &ErrDanglingReference{Ref: ref}, "party %s is not in the PartyList", ref)
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// partyReferences returns the references of the parties in a PartyList kept among the
+// extensions of the message, and whether there is one
+func (nrm *NewReleaseMessage) partyReferences() (map[string]bool, bool) {
+	for _, ext := range nrm.Extensions {
+		name := ext.Name.Local
+		if name[strings.LastIndex(name, ":")+1:] != "PartyList" {
+			continue
+		}
+		var list PartyList
+		if err := xml.Unmarshal([]byte("<PartyList>"+ext.InnerXML+"</PartyList>"), &list); err != nil {
+			continue
+		}
+		parties := make(map[string]bool)
+		for _, party := range list.Party {
+			parties[party.PartyReference] = true
+		}
+		return parties, true
+	}
+	return nil, false
+}
+
+// CheckAvRatings reports AvRatings by a known rating agency (see RatingAgencyTerritory) on
+// DetailsByTerritory entries that don't apply to the agency's territory, such as an FSK
+// rating on details for the US only. Agencies with a Namespace or not in the list are not
+// checked.
+func (nrm *NewReleaseMessage) CheckAvRatings() ValidationErrors {
+	var errs ValidationErrors
+	check := func(path string, ratings []AvRating, codes, excluded []string) {
+		for i, rating := range ratings {
+			if rating.RatingAgency == nil || rating.RatingAgency.Namespace != "" {
+				continue
+			}
+			territory := RatingAgencyTerritory(rating.RatingAgency.Value)
+			if territory != "" && territoryMatch(codes, excluded, territory) == 0 {
+				errs.add(fmt.Sprintf("%s.AvRating[%d]", path, i), "%s rates for %s, which the details don't apply to", rating.RatingAgency.Value, territory)
+			}
+		}
+	}
+
+	if nrm.ReleaseList != nil {
+		for _, release := range nrm.ReleaseList.Release {
+			for i, details := range release.ReleaseDetailsByTerritory {
+				check(fmt.Sprintf("Release[%s].ReleaseDetailsByTerritory[%d]", release.ReleaseReference, i), details.AvRating, details.TerritoryCode, details.ExcludedTerritoryCode)
+			}
+		}
+	}
+	if nrm.ResourceList != nil {
+		for _, sr := range nrm.ResourceList.SoundRecording {
+			for i, details := range sr.SoundRecordingDetailsByTerritory {
+				check(fmt.Sprintf("SoundRecording[%s].SoundRecordingDetailsByTerritory[%d]", sr.ResourceReference, i), details.AvRating, details.TerritoryCode, details.ExcludedTerritoryCode)
+			}
+		}
+		for _, v := range nrm.ResourceList.Video {
+			for i, details := range v.VideoDetailsByTerritory {
+				check(fmt.Sprintf("Video[%s].VideoDetailsByTerritory[%d]", v.ResourceReference, i), details.AvRating, details.TerritoryCode, details.ExcludedTerritoryCode)
+			}
+		}
+	}
+
+	return errs
+}
+
+// CheckLanguageCodes reports LanguageOfPerformance, LanguageOfDubbing and
+// SubTitleLanguage values of releases, sound recordings and videos that are not ISO 639-2
+// codes (see ValidateLanguageCode)
+func (nrm *NewReleaseMessage) CheckLanguageCodes() ValidationErrors {
+	var errs ValidationErrors
+	check := func(path string, languages []string) {
+		for i, language := range languages {
+			if !ValidateLanguageCode(language) {
+				errs.add(fmt.Sprintf("%s[%d]", path, i), "%q is not an ISO 639-2 language code", language)
+			}
+		}
+	}
+
+	if nrm.ReleaseList != nil {
+		for _, release := range nrm.ReleaseList.Release {
+			path := fmt.Sprintf("Release[%s]", release.ReleaseReference)
+			check(path+".LanguageOfPerformance", release.LanguageOfPerformance)
+			check(path+".LanguageOfDubbing", release.LanguageOfDubbing)
+			check(path+".SubTitleLanguage", release.SubTitleLanguage)
+		}
+	}
+	if nrm.ResourceList != nil {
+		for _, sr := range nrm.ResourceList.SoundRecording {
+			check(fmt.Sprintf("SoundRecording[%s].LanguageOfPerformance", sr.ResourceReference), sr.LanguageOfPerformance)
+		}
+		for _, v := range nrm.ResourceList.Video {
+			path := fmt.Sprintf("Video[%s]", v.ResourceReference)
+			check(path+".LanguageOfPerformance", v.LanguageOfPerformance)
+			check(path+".LanguageOfDubbing", v.LanguageOfDubbing)
+			check(path+".SubTitleLanguage", v.SubTitleLanguage)
+		}
+	}
+
+	return errs
+}
+
+// CheckRightShares checks the RightsControllers of every sound recording and video
+// territory branch: each RightSharePercentage must be from 0 to 100, a controller
+// must not give a percentage while declaring RightShareUnknown, and the percentages of a
+// branch must add up to at most 100. With exact, they must add up to exactly 100 unless a
+// share is unknown.
+func (nrm *NewReleaseMessage) CheckRightShares(exact bool) ValidationErrors {
+	var errs ValidationErrors
+	if nrm.ResourceList == nil {
+		return errs
+	}
+
+	hundred := big.NewRat(100, 1)
+	check := func(path string, controllers []RightsController) {
+		total := new(big.Rat)
+		shares, unknown := 0, false
+		for i, controller := range controllers {
+			controllerPath := fmt.Sprintf("%s.RightsController[%d]", path, i)
+			isUnknown := strings.EqualFold(strings.TrimSpace(controller.RightShareUnknown), "true")
+			unknown = unknown || isUnknown
+			if controller.RightSharePercentage == nil {
+				continue
+			}
+			if isUnknown {
+				errs.add(controllerPath, "RightSharePercentage %s conflicts with RightShareUnknown", controller.RightSharePercentage)
+				continue
+			}
+			share := controller.RightSharePercentage.Rat()
+			if share.Sign() < 0 || share.Cmp(hundred) > 0 {
+				errs.add(controllerPath, "RightSharePercentage %s is not between 0 and 100", controller.RightSharePercentage)
+				continue
+			}
+			total.Add(total, share)
+			shares++
+		}
+
+		switch {
+		case total.Cmp(hundred) > 0:
+			errs.add(path, "right shares add up to %s%%, more than 100%%", total.FloatString(2))
+		case exact && shares > 0 && !unknown && total.Cmp(hundred) != 0:
+			errs.add(path, "right shares add up to %s%%, not 100%%", total.FloatString(2))
+		}
+	}
+
+	for _, sr := range nrm.ResourceList.SoundRecording {
+		for i, details := range sr.SoundRecordingDetailsByTerritory {
+			check(fmt.Sprintf("SoundRecording[%s].SoundRecordingDetailsByTerritory[%d]", sr.ResourceReference, i), details.RightsController)
+		}
+	}
+	for _, v := range nrm.ResourceList.Video {
+		for i, details := range v.VideoDetailsByTerritory {
+			check(fmt.Sprintf("Video[%s].VideoDetailsByTerritory[%d]", v.ResourceReference, i), details.RightsController)
+		}
+	}
+
+	return errs
+}
+
+// codecExtensions lists the file extensions accepted for a codec type. Codec types not
+// listed, such as UserDefined, accept any extension.
+var codecExtensions = map[string][]string{
+	// AudioCodecType
+	"AAC":    {"aac", "m4a", "mp4"},
+	"ALAC":   {"m4a"},
+	"FLAC":   {"flac"},
+	"MP2":    {"mp2"},
+	"MP3":    {"mp3"},
+	"PCM":    {"wav", "aif", "aiff"},
+	"Vorbis": {"ogg", "oga"},
+	"WMA":    {"wma"},
+	// VideoCodecType
+	"H.264":  {"mp4", "m4v", "mov", "mkv"},
+	"H.265":  {"mp4", "m4v", "mov", "mkv"},
+	"MPEG-1": {"mpg", "mpeg"},
+	"MPEG-2": {"mpg", "mpeg", "m2v", "ts", "vob"},
+	"MPEG-4": {"mp4", "m4v", "mov"},
+	"ProRes": {"mov"},
+	"WMV":    {"wmv", "asf"},
+	// ImageCodecType
+	"BMP":  {"bmp"},
+	"GIF":  {"gif"},
+	"JPEG": {"jpg", "jpeg"},
+	"PNG":  {"png"},
+	"TIFF": {"tif", "tiff"},
+}
+
+// CheckFileNames checks the FileName of every technical details File: it must be a
+// relative path inside the release folder (no absolute path, URL or ".." segment), its
+// extension must match the declared codec type, and no two resources may deliver files
+// with the same name, as every file lands in the same resources/ folder
+func (nrm *NewReleaseMessage) CheckFileNames() ValidationErrors {
+	var errs ValidationErrors
+	if nrm.ResourceList == nil {
+		return errs
+	}
+
+	owners := make(map[string]string) // base name -> resource reference
+	check := func(path, resourceRef, codecType string, file *File) {
+		if file == nil || file.FileName == "" {
+			return
+		}
+		name := strings.ReplaceAll(file.FileName, "\\", "/")
+		switch {
+		case strings.Contains(name, "://"):
+			errs.add(path, "file name %s is a URL, not a path relative to the release folder", file.FileName)
+			return
+		case strings.HasPrefix(name, "/") || len(name) >= 2 && name[1] == ':':
+			errs.add(path, "file name %s is an absolute path", file.FileName)
+			return
+		}
+		for _, segment := range strings.Split(name, "/") {
+			if segment == ".." {
+				errs.add(path, "file name %s leaves the release folder", file.FileName)
+				return
+			}
+		}
+
+		base := name[strings.LastIndex(name, "/")+1:]
+		extension := ""
+		if dot := strings.LastIndex(base, "."); dot >= 0 {
+			extension = strings.ToLower(base[dot+1:])
+		}
+		if allowed, ok := codecExtensions[codecType]; ok {
+			matches := false
+			for _, candidate := range allowed {
+				matches = matches || extension == candidate
+			}
+			if !matches {
+				errs.add(path, "file name %s does not match codec type %s (expected .%s)", file.FileName, codecType, strings.Join(allowed, ", ."))
+			}
+		}
+
+		if owner, ok := owners[base]; ok && owner != resourceRef {
+			errs.add(path, "file name %s is already used by resource %s", base, owner)
+		} else {
+			owners[base] = resourceRef
+		}
+	}
+
+	for _, sr := range nrm.ResourceList.SoundRecording {
+		for i, details := range sr.SoundRecordingDetailsByTerritory {
+			for j, technical := range details.TechnicalSoundRecordingDetails {
+				path := fmt.Sprintf("SoundRecording[%s].SoundRecordingDetailsByTerritory[%d].TechnicalSoundRecordingDetails[%d].File", sr.ResourceReference, i, j)
+				check(path, sr.ResourceReference, technical.AudioCodecType, technical.File)
+			}
+		}
+	}
+	for _, v := range nrm.ResourceList.Video {
+		for i, details := range v.VideoDetailsByTerritory {
+			for j, technical := range details.TechnicalVideoDetails {
+				path := fmt.Sprintf("Video[%s].VideoDetailsByTerritory[%d].TechnicalVideoDetails[%d].File", v.ResourceReference, i, j)
+				check(path, v.ResourceReference, technical.VideoCodecType, technical.File)
+			}
+		}
+	}
+	for _, img := range nrm.ResourceList.Image {
+		for i, details := range img.ImageDetailsByTerritory {
+			for j, technical := range details.TechnicalImageDetails {
+				path := fmt.Sprintf("Image[%s].ImageDetailsByTerritory[%d].TechnicalImageDetails[%d].File", img.ResourceReference, i, j)
+				check(path, img.ResourceReference, technical.ImageCodecType, technical.File)
+			}
+		}
+	}
+
+	return errs
+}
+
+// CheckFileChecksums reports every technical details entry whose File lacks a HashSum or a
+// FileSize, or that has no File at all. Recipients such as YouTube reject deliveries
+// without checksums; Validate only runs this check with ValidationOptions.RequireChecksums.
+func (nrm *NewReleaseMessage) CheckFileChecksums() ValidationErrors {
+	var errs ValidationErrors
+	if nrm.ResourceList == nil {
+		return errs
+	}
+
+	check := func(path string, file *File) {
+		if file == nil {
+			errs.add(path, "File is required")
+			return
+		}
+		var missing []string
+		if file.HashSum == nil || file.HashSum.HashSum == "" {
+			missing = append(missing, "HashSum")
+		} else if file.HashSum.HashSumAlgorithmType == "" {
+			missing = append(missing, "HashSumAlgorithmType")
+		}
+		if file.FileSize <= 0 {
+			missing = append(missing, "FileSize")
+		}
+		if len(missing) > 0 {
+			errs.add(path+".File", "%s required", strings.Join(missing, " and "))
+		}
+	}
+
+	for _, sr := range nrm.ResourceList.SoundRecording {
+		for i, details := range sr.SoundRecordingDetailsByTerritory {
+			for j, technical := range details.TechnicalSoundRecordingDetails {
+				check(fmt.Sprintf("SoundRecording[%s].SoundRecordingDetailsByTerritory[%d].TechnicalSoundRecordingDetails[%d]", sr.ResourceReference, i, j), technical.File)
+			}
+		}
+	}
+	for _, v := range nrm.ResourceList.Video {
+		for i, details := range v.VideoDetailsByTerritory {
+			for j, technical := range details.TechnicalVideoDetails {
+				check(fmt.Sprintf("Video[%s].VideoDetailsByTerritory[%d].TechnicalVideoDetails[%d]", v.ResourceReference, i, j), technical.File)
+			}
+		}
+	}
+	for _, img := range nrm.ResourceList.Image {
+		for i, details := range img.ImageDetailsByTerritory {
+			for j, technical := range details.TechnicalImageDetails {
+				check(fmt.Sprintf("Image[%s].ImageDetailsByTerritory[%d].TechnicalImageDetails[%d]", img.ResourceReference, i, j), technical.File)
+			}
+		}
+	}
+
+	return errs
+}
+
+// validityWindow is a ValidityPeriod of a deal with the scope it applies to
+type validityWindow struct {
+	path        string
+	start, end  time.Time // end is exclusive; zero values mean open-ended
+	models      map[string]bool
+	useTypes    map[string]bool
+	territories map[string]bool
+}
+
+// CheckEventDates checks that the release, resource and pre-order dates of the message are
+// full or partial ISO 8601 dates (YYYY-MM-DD, YYYY-MM or YYYY), and that an original
+// release date doesn't come after the release date it sits next to
+func (nrm *NewReleaseMessage) CheckEventDates() ValidationErrors {
+	var errs ValidationErrors
+
+	if nrm.ResourceList != nil {
+		for _, sr := range nrm.ResourceList.SoundRecording {
+			path := "SoundRecording[" + sr.ResourceReference + "]"
+			checkEventDate(&errs, path+".CreationDate", sr.CreationDate)
+			checkEventDate(&errs, path+".MasteredDate", sr.MasteredDate)
+			checkEventDate(&errs, path+".RemasteredDate", sr.RemasteredDate)
+			for i, details := range sr.SoundRecordingDetailsByTerritory {
+				detailsPath := fmt.Sprintf("%s.SoundRecordingDetailsByTerritory[%d]", path, i)
+				checkEventDate(&errs, detailsPath+".RemasteredDate", details.RemasteredDate)
+				checkReleaseDates(&errs, detailsPath, "ResourceReleaseDate", details.ResourceReleaseDate, details.OriginalResourceReleaseDate)
+			}
+		}
+		for _, v := range nrm.ResourceList.Video {
+			path := "Video[" + v.ResourceReference + "]"
+			checkEventDate(&errs, path+".CreationDate", v.CreationDate)
+			checkEventDate(&errs, path+".MasteredDate", v.MasteredDate)
+			checkEventDate(&errs, path+".RemasteredDate", v.RemasteredDate)
+			for i, details := range v.VideoDetailsByTerritory {
+				detailsPath := fmt.Sprintf("%s.VideoDetailsByTerritory[%d]", path, i)
+				checkReleaseDates(&errs, detailsPath, "ResourceReleaseDate", details.ResourceReleaseDate, details.OriginalResourceReleaseDate)
+			}
+		}
+		for _, img := range nrm.ResourceList.Image {
+			checkEventDate(&errs, "Image["+img.ResourceReference+"].CreationDate", img.CreationDate)
+		}
+	}
+
+	if nrm.ReleaseList != nil {
+		for _, release := range nrm.ReleaseList.Release {
+			path := "Release[" + release.ReleaseReference + "]"
+			checkReleaseDates(&errs, path, "GlobalReleaseDate", release.GlobalReleaseDate, release.GlobalOriginalReleaseDate)
+			for i, details := range release.ReleaseDetailsByTerritory {
+				checkReleaseDates(&errs, fmt.Sprintf("%s.ReleaseDetailsByTerritory[%d]", path, i), "ReleaseDate", details.ReleaseDate, details.OriginalReleaseDate)
+			}
+		}
+	}
+
+	if nrm.DealList != nil {
+		for _, releaseDeal := range nrm.DealList.ReleaseDeal {
+			for i, deal := range releaseDeal.Deal {
+				if deal.DealTerms == nil {
+					continue
+				}
+				path := fmt.Sprintf("ReleaseDeal[%s].Deal[%d]", releaseDeal.DealReleaseReference, i)
+				checkEventDate(&errs, path+".PreOrderReleaseDate", deal.DealTerms.PreOrderReleaseDate)
+				checkEventDate(&errs, path+".PreOrderPreviewDate", deal.DealTerms.PreOrderPreviewDate)
+			}
+		}
+	}
+
+	return errs
+}
+
+// checkEventDate records an EventDate that isn't a full or partial ISO 8601 date
+func checkEventDate(errs *ValidationErrors, path string, date *EventDate) bool {
+	if date == nil {
+		return false
+	}
+	if err := date.Validate(); err != nil {
+		errs.add(path, "%v", err)
+		return false
+	}
+	return true
+}
+
+// checkReleaseDates checks a release date and the original release date next to it. Partial
+// dates cover their whole year or month, so an original release date of 2019 is fine next
+// to a release date of 2019-06-01.
+func checkReleaseDates(errs *ValidationErrors, path, name string, date, original *EventDate) {
+	originalName := "Original" + name
+	if strings.HasPrefix(name, "Global") {
+		originalName = "GlobalOriginal" + strings.TrimPrefix(name, "Global")
+	}
+	dateOK := checkEventDate(errs, path+"."+name, date)
+	if !checkEventDate(errs, path+"."+originalName, original) || !dateOK {
+		return
+	}
+
+	originalStart, _ := original.Resolve(nil)
+	start, _ := date.Resolve(nil)
+	precision, _ := date.Precision()
+	end := map[DatePrecision]time.Time{
+		DatePrecisionYear:  start.AddDate(1, 0, 0),
+		DatePrecisionMonth: start.AddDate(0, 1, 0),
+		DatePrecisionDay:   start.AddDate(0, 0, 1),
+	}[precision]
+	if !originalStart.Before(end) {
+		errs.add(path+"."+originalName, "%s is after the %s %s", original.Value, name, date.Value)
+	}
+}
+
+// CheckValidityPeriods checks the ValidityPeriods of every deal: dates must be ISO 8601
+// (YYYY-MM-DD, StartDateTime and EndDateTime with a time), the start must not be after the end, and the
+// periods of deals for a release must not overlap where they share a commercial model, use
+// type and territory. Take-down and cancellation deals are not checked for overlaps.
+func (nrm *NewReleaseMessage) CheckValidityPeriods() ValidationErrors {
+	var errs ValidationErrors
+	if nrm.DealList == nil {
+		return errs
+	}
+
+	for _, releaseDeal := range nrm.DealList.ReleaseDeal {
+		var windows []validityWindow
+		for i, deal := range releaseDeal.Deal {
+			terms := deal.DealTerms
+			if terms == nil {
+				continue
+			}
+			dealPath := fmt.Sprintf("ReleaseDeal[%s].Deal[%d]", releaseDeal.DealReleaseReference, i)
+			cancelled := terms.TakeDown != nil && *terms.TakeDown || terms.AllDealsCancelled != nil && *terms.AllDealsCancelled
+
+			var useTypes []string
+			for _, usage := range terms.Usage {
+				useTypes = append(useTypes, usage.UseType...)
+			}
+			models := stringSet(terms.CommercialModelType)
+			uses := stringSet(useTypes)
+			territories := make(map[string]bool)
+			for _, code := range ExpandTerritories(terms.TerritoryCode, terms.ExcludedTerritoryCode) {
+				territories[code] = true
+			}
+
+			for j, period := range terms.ValidityPeriod {
+				path := fmt.Sprintf("%s.ValidityPeriod[%d]", dealPath, j)
+				start, end, ok := validityBounds(&errs, path, period)
+				if !ok {
+					continue
+				}
+				if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+					errs.add(path, "the period starts after it ends")
+					continue
+				}
+				if !cancelled {
+					windows = append(windows, validityWindow{path, start, end, models, uses, territories})
+				}
+			}
+		}
+
+		for i := 1; i < len(windows); i++ {
+			for j := 0; j < i; j++ {
+				a, b := windows[i], windows[j]
+				if !windowsOverlap(a, b) {
+					continue
+				}
+				common := commonKeys(a.territories, b.territories)
+				if len(common) == 0 || len(commonKeys(a.models, b.models)) == 0 || len(commonKeys(a.useTypes, b.useTypes)) == 0 {
+					continue
+				}
+				errs.add(a.path, "overlaps %s in %s for the same commercial model and use type", b.path, describeTerritories(common))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validityBounds parses a ValidityPeriod into a start and an exclusive end, recording
+// invalid dates
+func validityBounds(errs *ValidationErrors, path string, period ValidityPeriod) (start, end time.Time, ok bool) {
+	ok = true
+	var err error
+	switch {
+	case period.StartDate != "":
+		if start, err = time.Parse("2006-01-02", period.StartDate); err != nil {
+			errs.add(path, "StartDate %q is not an ISO 8601 date (YYYY-MM-DD)", period.StartDate)
+			ok = false
+		}
+	case period.StartDateTime != "":
+		if start, err = parseISODateTime(period.StartDateTime); err != nil {
+			errs.add(path, "StartDateTime %q is not an ISO 8601 date and time", period.StartDateTime)
+			ok = false
+		}
+	}
+	switch {
+	case period.EndDate != "":
+		if end, err = time.Parse("2006-01-02", period.EndDate); err != nil {
+			errs.add(path, "EndDate %q is not an ISO 8601 date (YYYY-MM-DD)", period.EndDate)
+			ok = false
+		} else {
+			// The end date is the last day of the period
+			end = end.AddDate(0, 0, 1)
+		}
+	case period.EndDateTime != "":
+		if end, err = parseISODateTime(period.EndDateTime); err != nil {
+			errs.add(path, "EndDateTime %q is not an ISO 8601 date and time", period.EndDateTime)
+			ok = false
+		}
+	}
+	return start, end, ok
+}
+
+// parseISODateTime parses an ISO 8601 date and time with or without a UTC offset
+func parseISODateTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t, err = time.Parse("2006-01-02T15:04:05", value)
+	}
+	return t, err
+}
+
+// windowsOverlap reports whether two validity windows share a moment
+func windowsOverlap(a, b validityWindow) bool {
+	return before(a.start, b.end) && before(b.start, a.end)
+}
+
+// before reports whether start is before end, treating zero times as open bounds
+func before(start, end time.Time) bool {
+	if start.IsZero() || end.IsZero() {
+		return true
+	}
+	return start.Before(end)
+}
+
+// stringSet returns the values as a set; an empty list matches everything, as a deal without
+// commercial models or use types isn't limited to any
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	if len(set) == 0 {
+		set["*"] = true
+	}
+	return set
+}
+
+// commonKeys returns the keys of both sets, treating the wildcard set as matching anything
+func commonKeys(a, b map[string]bool) []string {
+	if a["*"] {
+		a, b = b, a
+	}
+	var common []string
+	for key := range a {
+		if b[key] || b["*"] {
+			common = append(common, key)
+		}
+	}
+	return common
+}
+
+// checkTerritoryOverlaps compares n DetailsByTerritory entries pairwise and records each
+// entry that shares territories with an earlier one in the same language
+func checkTerritoryOverlaps(errs *ValidationErrors, path string, n int, entry func(i int) (language string, codes, excluded []string)) {
+	languages := make([]string, n)
+	territories := make([]map[string]bool, n)
+	for i := 0; i < n; i++ {
+		language, codes, excluded := entry(i)
+		languages[i] = language
+		territories[i] = make(map[string]bool)
+		for _, code := range ExpandTerritories(codes, excluded) {
+			territories[i][code] = true
+		}
+	}
+
+	for i := 1; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if !strings.EqualFold(languages[i], languages[j]) {
+				continue
+			}
+			var common []string
+			for code := range territories[i] {
+				if territories[j][code] {
+					common = append(common, code)
+				}
+			}
+			if len(common) > 0 {
+				errs.add(fmt.Sprintf("%s[%d]", path, i), "%s already covered by %s[%d]", describeTerritories(common), path, j)
+			}
+		}
+	}
+}
+
+// describeTerritories formats a territory set compactly, e.g. "CA, US" or
+// "Worldwide except CA, US"
+func describeTerritories(territories []string) string {
+	codes, excluded := CompressTerritories(territories)
+	if len(excluded) > 0 {
+		return strings.Join(codes, ", ") + " except " + strings.Join(excluded, ", ")
+	}
+	return strings.Join(codes, ", ")
+}
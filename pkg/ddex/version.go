@@ -0,0 +1,77 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// The structs are transcribed by hand from the ERN 3.8.2 schema. go generate checks them
+// against the published XSDs, reporting fields the schema doesn't have and fields holding
+// one value where the schema allows several.
+//go:generate go run ../../cmd/xsdgen -verify
+
+// ErrUnsupportedVersion is returned when a message uses an ERN version this package has no
+// structs for, such as ERN 4.x or 3.7. Only ERN 3.8 (3.8, 3.8.1 and 3.8.2) is supported.
+type ErrUnsupportedVersion struct {
+	Version string // as detected, e.g. "ern/43"
+}
+
+func (e *ErrUnsupportedVersion) Error() string {
+	return fmt.Sprintf("unsupported ERN version %s: only ERN 3.8 is supported", e.Version)
+}
+
+// DetectVersion returns the ERN version of a message without parsing it, as a
+// MessageSchemaVersionId such as "ern/382". It is read from the MessageSchemaVersionId of
+// the root element, or from its namespace (http://ddex.net/xml/ern/382) when that is
+// missing, and is empty if neither gives one. Gzip-compressed data is decompressed first.
+func DetectVersion(data []byte) (string, error) {
+	data, err := gunzipIfNeeded(data)
+	if err != nil {
+		return "", err
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return "", fmt.Errorf("no root element found")
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read root element: %w", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return rootVersion(start), nil
+		}
+	}
+}
+
+// rootVersion returns the ERN version declared by a root element
+func rootVersion(start xml.StartElement) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "" && attr.Name.Local == "MessageSchemaVersionId" {
+			if version := strings.Trim(strings.TrimSpace(attr.Value), "/"); version != "" {
+				return version
+			}
+		}
+	}
+	if strings.HasPrefix(start.Name.Space, "http://ddex.net/xml/") {
+		return strings.TrimPrefix(start.Name.Space, "http://ddex.net/xml/")
+	}
+	return ""
+}
+
+// checkVersion returns an ErrUnsupportedVersion unless version is an ERN 3.8 version.
+// Messages that declare no version are assumed to be ERN 3.8.
+func checkVersion(version string) error {
+	if version == "" {
+		return nil
+	}
+	number := strings.ReplaceAll(strings.TrimPrefix(strings.ToLower(version), "ern/"), ".", "")
+	if !strings.HasPrefix(number, "38") {
+		return &ErrUnsupportedVersion{Version: version}
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package ddex
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// XML-DSIG algorithm identifiers
+const (
+	XmlnsDsig                   = "http://www.w3.org/2000/09/xmldsig#"
+	ExcC14NAlgorithm            = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	EnvelopedSignatureAlgorithm = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	SHA256DigestAlgorithm       = "http://www.w3.org/2001/04/xmlenc#sha256"
+	RSASHA256Algorithm          = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	ECDSASHA256Algorithm        = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256"
+)
+
+// SignXML adds an enveloped XML-DSIG signature to an XML document: a ds:Signature element
+// appended to the root element, covering the whole document (Reference URI=""), with
+// exclusive canonicalization and SHA-256. key must be an RSA or ECDSA private key; the
+// certificates, if given, are embedded in KeyInfo so recipients can identify the signer.
+// An XML declaration in data is kept.
+func SignXML(data []byte, key crypto.Signer, certificates ...*x509.Certificate) ([]byte, error) {
+	signatureMethod, err := signatureAlgorithm(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	// The signature goes on its own line before the root end tag. The line break and
+	// indentation around it are part of the signed content.
+	end := bytes.LastIndex(data, []byte("</"))
+	if end < 0 {
+		return nil, fmt.Errorf("failed to sign XML: no root end tag")
+	}
+	prefix := data[:end]
+	suffix := data[end:]
+	indent, lineEnd := "", ""
+	if bytes.HasSuffix(prefix, []byte("\n")) {
+		// Indent like the last child of the root element
+		lastLine := prefix[bytes.LastIndexByte(prefix[:len(prefix)-1], '\n')+1:]
+		indent = string(lastLine[:len(lastLine)-len(bytes.TrimLeft(lastLine, " \t"))])
+		lineEnd = "\n"
+	}
+
+	unsigned := make([]byte, 0, len(data)+len(indent)+len(lineEnd))
+	unsigned = append(unsigned, prefix...)
+	unsigned = append(unsigned, indent...)
+	unsigned = append(unsigned, lineEnd...)
+	unsigned = append(unsigned, suffix...)
+
+	document, err := parseC14N(unsigned)
+	if err != nil {
+		return nil, err
+	}
+	var canonical bytes.Buffer
+	if err := canonicalizeDocument(&canonical, document); err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(canonical.Bytes())
+	digestValue := base64.StdEncoding.EncodeToString(digest[:])
+
+	// SignedInfo is signed in canonical form, where it declares the ds namespace itself
+	signedHash := sha256.Sum256([]byte(signedInfo(` xmlns:ds="`+XmlnsDsig+`"`, signatureMethod, digestValue)))
+	signature, err := key.Sign(rand.Reader, signedHash[:], crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign XML: %w", err)
+	}
+	if ecKey, ok := key.Public().(*ecdsa.PublicKey); ok {
+		if signature, err = ecdsaRawSignature(signature, ecKey); err != nil {
+			return nil, fmt.Errorf("failed to sign XML: %w", err)
+		}
+	}
+
+	var sig strings.Builder
+	sig.WriteString(`<ds:Signature xmlns:ds="` + XmlnsDsig + `">`)
+	sig.WriteString(signedInfo("", signatureMethod, digestValue))
+	sig.WriteString("<ds:SignatureValue>" + base64.StdEncoding.EncodeToString(signature) + "</ds:SignatureValue>")
+	if len(certificates) > 0 {
+		sig.WriteString("<ds:KeyInfo><ds:X509Data>")
+		for _, certificate := range certificates {
+			sig.WriteString("<ds:X509Certificate>" + base64.StdEncoding.EncodeToString(certificate.Raw) + "</ds:X509Certificate>")
+		}
+		sig.WriteString("</ds:X509Data></ds:KeyInfo>")
+	}
+	sig.WriteString("</ds:Signature>")
+
+	signed := make([]byte, 0, len(unsigned)+sig.Len())
+	signed = append(signed, prefix...)
+	signed = append(signed, indent...)
+	signed = append(signed, sig.String()...)
+	signed = append(signed, lineEnd...)
+	signed = append(signed, suffix...)
+	return signed, nil
+}
+
+// VerifyXMLSignature checks an enveloped signature created by SignXML against the signer's
+// public key: the digest of the document without the signature, and the signature over
+// SignedInfo. It does not evaluate certificates embedded in KeyInfo.
+func VerifyXMLSignature(data []byte, publicKey crypto.PublicKey) error {
+	document, err := parseC14N(data)
+	if err != nil {
+		return err
+	}
+
+	root := document.root()
+	var signatureNode *c14nNode
+	for _, child := range root.children {
+		if !child.isText && child.procInst == nil && child.name.Local == "Signature" && child.scope()[child.name.Space] == XmlnsDsig {
+			signatureNode = child
+			break
+		}
+	}
+	if signatureNode == nil {
+		return fmt.Errorf("no XML signature found")
+	}
+
+	signedInfoNode := dsigChild(signatureNode, "SignedInfo")
+	signatureValueNode := dsigChild(signatureNode, "SignatureValue")
+	if signedInfoNode == nil || signatureValueNode == nil {
+		return fmt.Errorf("signature is missing SignedInfo or SignatureValue")
+	}
+	reference := dsigChild(signedInfoNode, "Reference")
+	if reference == nil || dsigChild(reference, "DigestValue") == nil {
+		return fmt.Errorf("signature is missing Reference/DigestValue")
+	}
+	if uri, _ := attrValue(reference, "URI"); uri != "" {
+		return fmt.Errorf("unsupported signature reference URI %q", uri)
+	}
+	if method := dsigChild(signedInfoNode, "CanonicalizationMethod"); method == nil || mustAttr(method, "Algorithm") != ExcC14NAlgorithm {
+		return fmt.Errorf("unsupported canonicalization method")
+	}
+	if method := dsigChild(reference, "DigestMethod"); method == nil || mustAttr(method, "Algorithm") != SHA256DigestAlgorithm {
+		return fmt.Errorf("unsupported digest method")
+	}
+	expectedMethod, err := signatureAlgorithm(publicKey)
+	if err != nil {
+		return err
+	}
+	if method := dsigChild(signedInfoNode, "SignatureMethod"); method == nil || mustAttr(method, "Algorithm") != expectedMethod {
+		return fmt.Errorf("signature method does not match the public key")
+	}
+
+	digestValue, err := base64.StdEncoding.DecodeString(strings.TrimSpace(nodeText(dsigChild(reference, "DigestValue"))))
+	if err != nil {
+		return fmt.Errorf("invalid DigestValue: %w", err)
+	}
+	signatureValue, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(nodeText(signatureValueNode)), ""))
+	if err != nil {
+		return fmt.Errorf("invalid SignatureValue: %w", err)
+	}
+
+	var canonicalSignedInfo bytes.Buffer
+	if err := canonicalizeSubtree(&canonicalSignedInfo, signedInfoNode); err != nil {
+		return err
+	}
+
+	signatureNode.remove()
+	var canonical bytes.Buffer
+	if err := canonicalizeDocument(&canonical, document); err != nil {
+		return err
+	}
+	digest := sha256.Sum256(canonical.Bytes())
+	if !bytes.Equal(digest[:], digestValue) {
+		return fmt.Errorf("document digest does not match: the message was modified after signing")
+	}
+
+	hashed := sha256.Sum256(canonicalSignedInfo.Bytes())
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], signatureValue); err != nil {
+			return fmt.Errorf("invalid signature: %w", err)
+		}
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(signatureValue) != 2*size {
+			return fmt.Errorf("invalid signature length")
+		}
+		r := new(big.Int).SetBytes(signatureValue[:size])
+		s := new(big.Int).SetBytes(signatureValue[size:])
+		if !ecdsa.Verify(key, hashed[:], r, s) {
+			return fmt.Errorf("invalid signature")
+		}
+	}
+	return nil
+}
+
+// signedInfo returns the SignedInfo element in canonical form; nsDecl is the ds namespace
+// declaration when SignedInfo is rendered on its own
+func signedInfo(nsDecl, signatureMethod, digestValue string) string {
+	return `<ds:SignedInfo` + nsDecl + `>` +
+		`<ds:CanonicalizationMethod Algorithm="` + ExcC14NAlgorithm + `"></ds:CanonicalizationMethod>` +
+		`<ds:SignatureMethod Algorithm="` + signatureMethod + `"></ds:SignatureMethod>` +
+		`<ds:Reference URI="">` +
+		`<ds:Transforms>` +
+		`<ds:Transform Algorithm="` + EnvelopedSignatureAlgorithm + `"></ds:Transform>` +
+		`<ds:Transform Algorithm="` + ExcC14NAlgorithm + `"></ds:Transform>` +
+		`</ds:Transforms>` +
+		`<ds:DigestMethod Algorithm="` + SHA256DigestAlgorithm + `"></ds:DigestMethod>` +
+		`<ds:DigestValue>` + digestValue + `</ds:DigestValue>` +
+		`</ds:Reference>` +
+		`</ds:SignedInfo>`
+}
+
+// signatureAlgorithm returns the SignatureMethod for a public key
+func signatureAlgorithm(publicKey crypto.PublicKey) (string, error) {
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
+		return RSASHA256Algorithm, nil
+	case *ecdsa.PublicKey:
+		return ECDSASHA256Algorithm, nil
+	default:
+		return "", fmt.Errorf("unsupported signing key type %T: use an RSA or ECDSA key", publicKey)
+	}
+}
+
+// ecdsaRawSignature converts an ASN.1 ECDSA signature to the r||s form XML-DSIG uses
+func ecdsaRawSignature(signature []byte, key *ecdsa.PublicKey) ([]byte, error) {
+	var sig struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(signature, &sig); err != nil {
+		return nil, err
+	}
+	size := (key.Curve.Params().BitSize + 7) / 8
+	raw := make([]byte, 2*size)
+	sig.R.FillBytes(raw[:size])
+	sig.S.FillBytes(raw[size:])
+	return raw, nil
+}
+
+// dsigChild returns the first child element of n with the given local name in the
+// XML-DSIG namespace
+func dsigChild(n *c14nNode, local string) *c14nNode {
+	for _, child := range n.children {
+		if !child.isText && child.procInst == nil && child.name.Local == local && child.scope()[child.name.Space] == XmlnsDsig {
+			return child
+		}
+	}
+	return nil
+}
+
+// attrValue returns the value of an unprefixed attribute
+func attrValue(n *c14nNode, name string) (string, bool) {
+	for _, attr := range n.attrs {
+		if attr.Name.Space == "" && attr.Name.Local == name {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+func mustAttr(n *c14nNode, name string) string {
+	value, _ := attrValue(n, name)
+	return value
+}
+
+// nodeText returns the concatenated text of n's children
+func nodeText(n *c14nNode) string {
+	var sb strings.Builder
+	for _, child := range n.children {
+		if child.isText {
+			sb.WriteString(child.text)
+		}
+	}
+	return sb.String()
+}
+
+// ToSignedXML marshals the message with the XML declaration and signs it with SignXML
+func (nrm *NewReleaseMessage) ToSignedXML(key crypto.Signer, certificates ...*x509.Certificate) ([]byte, error) {
+	data, err := nrm.ToXMLWithHeader()
+	if err != nil {
+		return nil, err
+	}
+	return SignXML(data, key, certificates...)
+}
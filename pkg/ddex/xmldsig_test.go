@@ -0,0 +1,151 @@
+package ddex
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"strings"
+	"testing"
+)
+
+const unsignedTestXML = `<?xml version="1.0" encoding="UTF-8"?>
+<ern:NewReleaseMessage xmlns:ern="http://ddex.net/xml/ern/382" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" MessageSchemaVersionId="ern/382">
+  <MessageHeader>
+    <MessageId>MSG1</MessageId>
+  </MessageHeader>
+</ern:NewReleaseMessage>`
+
+// signedTestXML is unsignedTestXML signed with testdata/xmldsig_rsa_key.pem. DigestValue
+// and SignatureValue were computed with openssl dgst -sha256 over the canonical document
+// and SignedInfo written out by hand.
+const signedTestXML = `<?xml version="1.0" encoding="UTF-8"?>
+<ern:NewReleaseMessage xmlns:ern="http://ddex.net/xml/ern/382" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" MessageSchemaVersionId="ern/382">
+  <MessageHeader>
+    <MessageId>MSG1</MessageId>
+  </MessageHeader>
+  <ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#">` +
+	`<ds:SignedInfo>` +
+	`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"></ds:CanonicalizationMethod>` +
+	`<ds:SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"></ds:SignatureMethod>` +
+	`<ds:Reference URI="">` +
+	`<ds:Transforms>` +
+	`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"></ds:Transform>` +
+	`<ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"></ds:Transform>` +
+	`</ds:Transforms>` +
+	`<ds:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"></ds:DigestMethod>` +
+	`<ds:DigestValue>VkKD2c7gm6TJYj7YNeyNP/ygUJGVaqo/TUaEueKPfl4=</ds:DigestValue>` +
+	`</ds:Reference>` +
+	`</ds:SignedInfo>` +
+	`<ds:SignatureValue>e2HVz4rCWNlAGBvZOFXLAg1nWkTtTf28alkanPlyQsVfJehTjNNabX80ens/Bu79Jw4qJTVSLKyX2a/3BJ5nCtCIUoT5N27MaZH0IvpS5ZEjPvw2hzoLiPzsi4Dn9FEw6dshDbbqEjPh3XTdPESjlzBI9JiROkLGBuEROkDev57kD5syQ2l9ir52ElYd7qB7fTvyq83TnxcFJ9kPGfQCiG8CRpeAbppfQh5TsutY66cTXqnmuC3HVQ7kEB6+jwM1px3w5sUGEpQBBLWX4wCctjCGMYP6OChta1i8pICR1VXp7R149lGXjh9cnhn/fC7Z85TPCVDkG6z5NFbCVmvtfQ==</ds:SignatureValue>` +
+	`</ds:Signature>
+</ern:NewReleaseMessage>`
+
+func loadTestRSAKey(t *testing.T) crypto.Signer {
+	t.Helper()
+	data, err := os.ReadFile("testdata/xmldsig_rsa_key.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatal("no PEM block in testdata/xmldsig_rsa_key.pem")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key.(crypto.Signer)
+}
+
+func TestSignXMLKnownVector(t *testing.T) {
+	key := loadTestRSAKey(t)
+	signed, err := SignXML([]byte(unsignedTestXML), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(signed) != signedTestXML {
+		t.Errorf("SignXML =\n%s\nwant\n%s", signed, signedTestXML)
+	}
+	if err := VerifyXMLSignature([]byte(signedTestXML), key.Public()); err != nil {
+		t.Errorf("VerifyXMLSignature: %v", err)
+	}
+}
+
+func TestVerifyXMLSignature(t *testing.T) {
+	key := loadTestRSAKey(t)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		key     crypto.PublicKey
+		wantErr string
+	}{
+		{"valid", signedTestXML, key.Public(), ""},
+		{
+			"reserialized",
+			strings.NewReplacer(
+				`xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" MessageSchemaVersionId="ern/382"`, `MessageSchemaVersionId='ern/382'`,
+				`<?xml version="1.0" encoding="UTF-8"?>`, `<?xml version="1.0"?>`,
+			).Replace(signedTestXML),
+			key.Public(),
+			"",
+		},
+		{"modified content", strings.Replace(signedTestXML, "MSG1", "MSG2", 1), key.Public(), "digest does not match"},
+		{"added attribute", strings.Replace(signedTestXML, "<MessageHeader>", `<MessageHeader a="1">`, 1), key.Public(), "digest does not match"},
+		{"modified SignedInfo", strings.Replace(signedTestXML, `<ds:Reference URI="">`, `<ds:Reference URI="" Id="r">`, 1), key.Public(), "invalid signature"},
+		{"other key", signedTestXML, ecKey.Public(), "signature method does not match"},
+		{"unsigned", unsignedTestXML, key.Public(), "no XML signature found"},
+		{"external reference", strings.Replace(signedTestXML, `URI=""`, `URI="#id"`, 1), key.Public(), "unsupported signature reference URI"},
+	}
+	for _, tt := range tests {
+		err := VerifyXMLSignature([]byte(tt.data), tt.key)
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("%s: %v", tt.name, err)
+		case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+			t.Errorf("%s: error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSignXMLECDSA(t *testing.T) {
+	for _, curve := range []elliptic.Curve{elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signed, err := SignXML([]byte(unsignedTestXML), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Contains(signed, []byte(ECDSASHA256Algorithm)) {
+			t.Errorf("%s: signature method is not ECDSA-SHA256", curve.Params().Name)
+		}
+		if err := VerifyXMLSignature(signed, key.Public()); err != nil {
+			t.Errorf("%s: %v", curve.Params().Name, err)
+		}
+		if err := VerifyXMLSignature(bytes.Replace(signed, []byte("MSG1"), []byte("MSG2"), 1), key.Public()); err == nil {
+			t.Errorf("%s: modified message verified", curve.Params().Name)
+		}
+	}
+}
+
+func TestSignXMLUnsupportedKey(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := SignXML([]byte(unsignedTestXML), key); err == nil {
+		t.Error("SignXML with an Ed25519 key returned no error")
+	}
+}